@@ -6,6 +6,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/packers"
@@ -31,6 +32,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 var build = buildinfo.Revision()
@@ -49,6 +51,13 @@ func main() {
 
 	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
 
+	shutdownTelemetry, err := telemetry.Setup(ctx, "notifications", cfg.Telemetry.TracesEndpoint, cfg.Telemetry.TracesSampleRatio)
+	if err != nil {
+		log.Error(ctx, "cannot setup telemetry", "err", err)
+		return
+	}
+	defer func() { _ = shutdownTelemetry(context.Background()) }()
+
 	cachex, err := cache.NewCacheClient(ctx, *cfg)
 	if err != nil {
 		log.Error(ctx, "cannot initialize cache", "err", err)
@@ -92,7 +101,9 @@ func main() {
 	}
 
 	notificationGateway := gateways.NewPushNotificationClient(httpPkg.DefaultHTTPClientWithRetry)
-	notificationService := services.NewNotification(notificationGateway, connectionsService, credentialsService)
+	messageGateway := gateways.NewMessageClient(httpPkg.DefaultHTTPClientWithRetry, notificationGateway, keyStore)
+	messageService := services.NewMessage(repositories.NewMessage(*storage), connectionsService, messageGateway, cfg.Messages)
+	notificationService := services.NewNotification(messageService, connectionsService, credentialsService)
 	ctxCancel, cancel := context.WithCancel(ctx)
 	defer func() {
 		log.Info(ctx, "Shutting down...")
@@ -106,6 +117,22 @@ func main() {
 	ps.Subscribe(ctxCancel, event.CreateConnectionEvent, notificationService.SendCreateConnectionNotification)
 	ps.Subscribe(ctxCancel, event.CreateStateEvent, notificationService.SendRevokeCredentialNotification)
 
+	go func(ctx context.Context) {
+		ticker := time.NewTicker(cfg.Messages.DeliveryPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if delivered, err := messageService.DeliverPending(ctx); err == nil && delivered > 0 {
+					log.Info(ctx, "pending messages delivered", "count", delivered)
+				}
+			case <-ctx.Done():
+				log.Info(ctx, "finishing message delivery job")
+				return
+			}
+		}
+	}(ctxCancel)
+
 	gracefulShutdown := make(chan os.Signal, 1)
 	signal.Notify(gracefulShutdown, syscall.SIGINT, syscall.SIGTERM)
 
@@ -116,6 +143,7 @@ func main() {
 				log.Error(ctx, "error writing response", "err", err)
 			}
 		}))
+		http.Handle("/metrics", telemetry.Handler())
 		log.Info(ctx, "Starting server at port 3004")
 		err := http.ListenAndServe(":3004", nil)
 		if err != nil {
@@ -145,9 +173,12 @@ func newCredentialsService(ctx context.Context, cfg *config.Configuration, stora
 		return nil, err
 	}
 
+	services.NewNetworkService(cfg, networkResolver, identityRepository, storage).ReloadOnSignal(ctx)
+
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
-	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
+	schemaSnapshotRepository := repositories.NewSchemaSnapshot(*storage)
+	schemaLoader := loader.NewSnapshotDocumentLoader(schemaSnapshotRepository, loader.NewDocumentLoaderFromConfig(*cfg, cachex))
 
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
 	qrService := services.NewQrStoreService(cachex)
@@ -160,8 +191,9 @@ func newCredentialsService(ctx context.Context, cfg *config.Configuration, stora
 		*cfg.MediaTypeManager.Enabled,
 	)
 
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, eventBus, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, eventBus, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	tenantService := services.NewTenant(repositories.NewTenant(*storage), storage)
+	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, nil, storage, nil, nil, eventBus, *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, tenantService)
+	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, eventBus, cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, services.NewStatusList(repositories.NewStatusList(), storage, keyStore, cfg.ServerUrl), services.NewAudit(repositories.NewAudit(*storage)), tenantService)
 
 	return claimsService, nil
 }
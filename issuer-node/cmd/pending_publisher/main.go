@@ -19,6 +19,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
+	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/network"
@@ -28,6 +29,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 	circuitLoaders "github.com/polygonid/sh-id-platform/pkg/loaders"
 )
 
@@ -47,6 +49,13 @@ func main() {
 
 	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
 
+	shutdownTelemetry, err := telemetry.Setup(ctx, "pending_publisher", cfg.Telemetry.TracesEndpoint, cfg.Telemetry.TracesSampleRatio)
+	if err != nil {
+		log.Error(ctx, "cannot setup telemetry", "err", err)
+		return
+	}
+	defer func() { _ = shutdownTelemetry(context.Background()) }()
+
 	cachex, err := cache.NewCacheClient(ctx, *cfg)
 	if err != nil {
 		log.Error(ctx, "cannot initialize cache", "err", err)
@@ -71,8 +80,8 @@ func main() {
 		}
 	}(storage)
 
-	// TODO: Cache only if cfg.APIUI.SchemaCache == true
-	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
+	schemaSnapshotRepository := repositories.NewSchemaSnapshot(*storage)
+	schemaLoader := loader.NewSnapshotDocumentLoader(schemaSnapshotRepository, loader.NewDocumentLoaderFromConfig(*cfg, cachex))
 
 	vaultCfg := providers.Config{
 		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
@@ -106,11 +115,14 @@ func main() {
 	identityStateRepo := repositories.NewIdentityState()
 	revocationRepository := repositories.NewRevocation()
 	keyRepository := repositories.NewKey(*storage)
+	transactionRepository := repositories.NewTransaction(*storage)
 	mtService := services.NewIdentityMerkleTrees(mtRepo)
 	qrService := services.NewQrStoreService(cachex)
 
 	connectionsRepository := repositories.NewConnection()
 
+	services.NewNetworkService(cfg, networkResolver, identityRepo, storage).ReloadOnSignal(ctx)
+
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
 
@@ -122,23 +134,38 @@ func main() {
 		*cfg.MediaTypeManager.Enabled,
 	)
 
-	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, adapters.NewMockEventBusAdapter(ctx), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, adapters.NewPubSubEventBusAdapter(ps, ctx), cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	tenantService := services.NewTenant(repositories.NewTenant(*storage), storage)
+	identityService := services.NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, qrService, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, adapters.NewMockEventBusAdapter(ctx), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, tenantService)
+	auditService := services.NewAudit(repositories.NewAudit(*storage))
+	claimsService := services.NewClaim(claimsRepo, identityService, qrService, mtService, identityStateRepo, schemaLoader, storage, cfg.ServerUrl, adapters.NewPubSubEventBusAdapter(ps, ctx), cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, services.NewStatusList(repositories.NewStatusList(), storage, keyStore, cfg.ServerUrl), auditService, tenantService)
 
 	circuitsLoaderService := circuitLoaders.NewCircuits(cfg.Circuit.Path)
 	proofService := initProofService(circuitsLoaderService)
 
+	transactionHistoryService := services.NewTransactionHistory(transactionRepository)
 	transactionService, err := gateways.NewTransaction(*networkResolver)
 	if err != nil {
 		log.Error(ctx, "error creating transaction service", "err", err)
 		panic("error creating transaction service")
 	}
-	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
+	publisherEthGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
 	if err != nil {
 		log.Error(ctx, "error creating publish gateway", "err", err)
 		panic("error creating publish gateway")
 	}
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps)
+	publisherGateway, err := gateways.NewPublisherRelayerGateway(*networkResolver, publisherEthGateway)
+	if err != nil {
+		log.Error(ctx, "error creating publish gateway", "err", err)
+		panic("error creating publish gateway")
+	}
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, transactionHistoryService, proofService, publisherGateway, networkResolver, ps)
+	publishingScheduler := gateways.NewPublishingScheduler(publisher, identityService, transactionHistoryService, publisherGateway, networkResolver, repositories.NewDeferredState(*storage))
+
+	connectionsService := services.NewConnection(connectionsRepository, claimsRepo, storage)
+	messageGateway := gateways.NewMessageClient(httpPkg.DefaultHTTPClientWithRetry, gateways.NewPushNotificationClient(httpPkg.DefaultHTTPClientWithRetry), keyStore)
+	messageService := services.NewMessage(repositories.NewMessage(*storage), connectionsService, messageGateway, cfg.Messages)
+	onchainIssuerService := services.NewOnchainIssuer(repositories.NewOnchainIssuer(*storage), claimsRepo, identityService, gateways.NewOnchainIdentityGateway(*networkResolver, keyStore), transactionHistoryService, messageService, schemaLoader, storage, auditService, tenantService)
+	schemaFamilyService := services.NewSchemaFamily(repositories.NewSchemaFamily(*storage), repositories.NewSchemaMigration(*storage), repositories.NewSchema(*storage), claimsService, schemaLoader)
 
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
@@ -149,7 +176,14 @@ func main() {
 			select {
 			// TODO: Config this
 			case <-ticker.C:
-				publisher.CheckTransactionStatus(ctx, nil)
+				publishingScheduler.ReplaceStuckTransactions(ctx)
+				publishingScheduler.CheckTransactionStatus(ctx, nil)
+				if published, err := onchainIssuerService.CheckPending(ctx); err == nil && published > 0 {
+					log.Info(ctx, "onchain credentials published", "count", published)
+				}
+				if migrated, err := schemaFamilyService.RunPending(ctx); err == nil && migrated > 0 {
+					log.Info(ctx, "schema migrations processed", "count", migrated)
+				}
 			case <-ctx.Done():
 				log.Info(ctx, "finishing check transaction status job")
 			}
@@ -163,6 +197,7 @@ func main() {
 				log.Error(ctx, "error writing response", "err", err)
 			}
 		}))
+		http.Handle("/metrics", telemetry.Handler())
 		log.Info(ctx, "Starting server at port 3005")
 		err := http.ListenAndServe(":3005", nil)
 		if err != nil {
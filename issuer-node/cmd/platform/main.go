@@ -18,25 +18,31 @@ import (
 	iden3commProtocol "github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/api"
+	oidcAuth "github.com/polygonid/sh-id-platform/internal/auth"
 	"github.com/polygonid/sh-id-platform/internal/buildinfo"
 	"github.com/polygonid/sh-id-platform/internal/cache"
 	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
 	"github.com/polygonid/sh-id-platform/internal/health"
+	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
+	"github.com/polygonid/sh-id-platform/internal/ipfs"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/packagemanager"
 	"github.com/polygonid/sh-id-platform/internal/payments"
 	"github.com/polygonid/sh-id-platform/internal/providers"
+	"github.com/polygonid/sh-id-platform/internal/ratelimit"
 	"github.com/polygonid/sh-id-platform/internal/adapters"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 	circuitLoaders "github.com/polygonid/sh-id-platform/pkg/loaders"
 )
 
@@ -55,6 +61,13 @@ func main() {
 	}
 	log.Config(cfg.Log.Level, cfg.Log.Mode, os.Stdout)
 
+	shutdownTelemetry, err := telemetry.Setup(ctx, "platform", cfg.Telemetry.TracesEndpoint, cfg.Telemetry.TracesSampleRatio)
+	if err != nil {
+		log.Error(ctx, "cannot setup telemetry", "err", err)
+		return
+	}
+	defer func() { _ = shutdownTelemetry(context.Background()) }()
+
 	storage, err := db.NewStorage(cfg.Database.URL)
 	if err != nil {
 		log.Error(ctx, "cannot connect to database", "err", err)
@@ -66,14 +79,19 @@ func main() {
 		log.Error(ctx, "cannot initialize cache", "err", err)
 		return
 	}
+	rateLimiter, err := ratelimit.NewLimiter(cachex, cfg.RateLimit)
+	if err != nil {
+		log.Error(ctx, "invalid rate limit configuration", "err", err)
+		return
+	}
 	ps, err := pubsub.NewPubSub(ctx, *cfg)
 	if err != nil {
 		log.Error(ctx, "cannot initialize pubsub", "err", err)
 		return
 	}
 
-	// TODO: Cache only if cfg.APIUI.SchemaCache == true
-	schemaLoader := loader.NewDocumentLoader(cfg.IPFS.GatewayURL, cfg.SchemaCache)
+	schemaSnapshotRepository := repositories.NewSchemaSnapshot(*storage)
+	schemaLoader := loader.NewSnapshotDocumentLoader(schemaSnapshotRepository, loader.NewDocumentLoaderFromConfig(*cfg, cachex))
 
 	vaultCfg := providers.Config{
 		UserPassAuthEnabled: cfg.KeyStore.VaultUserPassAuthEnabled,
@@ -116,6 +134,8 @@ func main() {
 	sessionRepository := repositories.NewSessionCached(cachex)
 	keyRepository := repositories.NewKey(*storage)
 	paymentsRepo := repositories.NewPayment(*storage)
+	transactionRepository := repositories.NewTransaction(*storage)
+	messageRepository := repositories.NewMessage(*storage)
 
 	// services initialization
 	mtService := services.NewIdentityMerkleTrees(mtRepository)
@@ -124,9 +144,7 @@ func main() {
 
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
-			iden3commProtocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			iden3commProtocol.RevocationStatusRequestMessageType: {"*"},
-			iden3commProtocol.DiscoverFeatureQueriesMessageType:  {"*"},
+			iden3commProtocol.DiscoverFeatureQueriesMessageType: {"*"},
 		},
 		*cfg.MediaTypeManager.Enabled,
 	)
@@ -137,7 +155,7 @@ func main() {
 	}
 	universalDIDResolverHandler := packagemanager.NewUniversalDIDResolverHandler(universalDIDResolverUrl)
 
-	packageManager, err := packagemanager.New(ctx, networkResolver.GetSupportedContracts(), cfg.Circuit.Path, universalDIDResolverHandler)
+	packageManager, err := packagemanager.New(ctx, networkResolver.GetSupportedContracts(), cfg.Circuit.Path, universalDIDResolverHandler, keyStore)
 	if err != nil {
 		log.Error(ctx, "failed init package packagemanager", "err", err)
 		return
@@ -157,33 +175,80 @@ func main() {
 	}
 
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
-	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionsRepository, storage, verifier, sessionRepository, adapters.NewPubSubEventBusAdapter(ps, ctx), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, adapters.NewPubSubEventBusAdapter(ps, ctx), cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	tenantService := services.NewTenant(repositories.NewTenant(*storage), storage)
+	identityService := services.NewIdentity(keyStore, identityRepository, mtRepository, identityStateRepository, mtService, qrService, claimsRepository, revocationRepository, connectionsRepository, storage, verifier, sessionRepository, adapters.NewPubSubEventBusAdapter(ps, ctx), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, tenantService)
+	statusListService := services.NewStatusList(repositories.NewStatusList(), storage, keyStore, cfg.ServerUrl)
+	auditService := services.NewAudit(repositories.NewAudit(*storage))
+	claimsService := services.NewClaim(claimsRepository, identityService, qrService, mtService, identityStateRepository, schemaLoader, storage, cfg.ServerUrl, adapters.NewPubSubEventBusAdapter(ps, ctx), cfg.IPFS.GatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, statusListService, auditService, tenantService)
 	proofService := services.NewProver(circuitsLoaderService)
 	displayMethodService := services.NewDisplayMethod(repositories.NewDisplayMethod(*storage))
-	schemaService := services.NewSchema(schemaRepository, schemaLoader, displayMethodService)
-	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepository, linkRepository, schemaRepository, schemaLoader, sessionRepository, ps, identityService, *networkResolver, cfg.UniversalLinks)
-	paymentService, err := services.NewPaymentService(paymentsRepo, *networkResolver, schemaService, paymentSettings, keyStore)
+	transactionHistoryService := services.NewTransactionHistory(transactionRepository)
+	messageGateway := gateways.NewMessageClient(httpPkg.DefaultHTTPClientWithRetry, gateways.NewPushNotificationClient(httpPkg.DefaultHTTPClientWithRetry), keyStore)
+	messageService := services.NewMessage(messageRepository, connectionsService, messageGateway, cfg.Messages)
+	proofRequestService := services.NewProofRequest(repositories.NewProofRequest(*storage), connectionsService, messageService, verifier, cfg.ServerUrl)
+	onchainIssuerService := services.NewOnchainIssuer(repositories.NewOnchainIssuer(*storage), claimsRepository, identityService, gateways.NewOnchainIdentityGateway(*networkResolver, keyStore), transactionHistoryService, messageService, schemaLoader, storage, auditService, tenantService)
+	presentationService := services.NewPresentation(claimsService, identityService, keyStore, schemaLoader)
+	credentialFormatService := services.NewCredentialFormat(repositories.NewEncodedCredential(*storage), linkRepository, keyStore)
+	schemaService := services.NewSchema(schemaRepository, schemaLoader, displayMethodService, schemaSnapshotRepository, loader.MultiProtocolFactory(cfg.IPFS.GatewayURL), storage, auditService)
+	var ipfsPinner ports.IPFSPinner
+	if cfg.IPFS.APIURL != "" {
+		ipfsPinner = ipfs.NewPinner(cfg.IPFS.APIURL)
+	}
+	schemaBuilder := services.NewSchemaBuilder(repositories.NewSchemaDocument(*storage), ipfsPinner, cfg.ServerUrl)
+	schemaFamilyService := services.NewSchemaFamily(repositories.NewSchemaFamily(*storage), repositories.NewSchemaMigration(*storage), schemaRepository, claimsService, schemaLoader)
+	apiKeyService := services.NewAPIKey(repositories.NewAPIKey(*storage), tenantService)
+	var oidcVerifier *oidcAuth.Verifier
+	if cfg.OIDC.JWKSURL != "" {
+		oidcVerifier, err = oidcAuth.NewVerifier(oidcAuth.NewJWKS(cfg.OIDC.JWKSURL, cfg.OIDC.JWKSCacheTTL), cfg.OIDC.Issuer, cfg.OIDC.Audience, cfg.OIDC.ClaimMappings, cfg.OIDC.TenantClaim)
+		if err != nil {
+			log.Error(ctx, "cannot initialize oidc authentication", "err", err)
+			return
+		}
+	}
+	linkService := services.NewLinkService(storage, claimsService, qrService, claimsRepository, linkRepository, schemaRepository, schemaLoader, sessionRepository, ps, identityService, *networkResolver, cfg.UniversalLinks, auditService, tenantService)
+	oid4vpService := services.NewOID4VP(sessionRepository, schemaService, claimsService, schemaLoader, keyStore, cfg.ServerUrl)
+	oid4vciService := services.NewOID4VCI(repositories.NewOID4VCIOffer(*storage), linkService, linkRepository, schemaService, identityService, credentialFormatService, cfg.ServerUrl)
+	paymentService, err := services.NewPaymentService(paymentsRepo, *networkResolver, schemaService, transactionHistoryService, paymentSettings, keyStore, storage, auditService)
 	if err != nil {
 		log.Error(ctx, "error creating payment service", "err", err)
 		return
 	}
-	keyService := services.NewKey(keyStore, claimsService, keyRepository)
+	keyService := services.NewKey(keyStore, claimsService, keyRepository, storage, auditService)
 	transactionService, err := gateways.NewTransaction(*networkResolver)
-	discoveryService := services.NewDiscovery(mediaTypeManager, packageManager, mediaTypeManager.GetSupportedProtocolMessages())
 	if err != nil {
 		log.Error(ctx, "error creating transaction service", "err", err)
 		return
 	}
+
+	agentRouter := services.NewAgentRouter(mediaTypeManager)
+	agentRouter.Register(iden3commProtocol.CredentialFetchRequestMessageType, []string{string(packers.MediaTypeZKPMessage)}, claimsService.Agent)
+	agentRouter.Register(iden3commProtocol.RevocationStatusRequestMessageType, []string{"*"}, claimsService.Agent)
+	agentRouter.Register(iden3commProtocol.CredentialProposalRequestMessageType, []string{string(packers.MediaTypeZKPMessage)}, paymentService.Agent)
+	agentRouter.Register(iden3commProtocol.CredentialPaymentMessageType, []string{string(packers.MediaTypeZKPMessage)}, paymentService.Agent)
+	agentRouter.Register(iden3commProtocol.CredentialOnchainOfferMessageType, []string{string(packers.MediaTypeZKPMessage)}, onchainIssuerService.Agent)
+	discoveryService := services.NewDiscovery(mediaTypeManager, packageManager, mediaTypeManager.GetSupportedProtocolMessages())
+	// discover feature queries are already allowed for any media type
+	agentRouter.Register(iden3commProtocol.DiscoverFeatureQueriesMessageType, nil, func(ctx context.Context, req *ports.AgentRequest, _ iden3comm.MediaType) (*iden3comm.BasicMessage, error) {
+		return discoveryService.Agent(ctx, req)
+	})
 	accountService := services.NewAccountService(*networkResolver)
+	networkService := services.NewNetworkService(cfg, networkResolver, identityRepository, storage)
+	networkService.ReloadOnSignal(ctx)
 
-	publisherGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
+	publisherEthGateway, err := gateways.NewPublisherEthGateway(*networkResolver, keyStore, cfg.PublishingKeyPath)
+	if err != nil {
+		log.Error(ctx, "error creating publish gateway", "err", err)
+		return
+	}
+	publisherGateway, err := gateways.NewPublisherRelayerGateway(*networkResolver, publisherEthGateway)
 	if err != nil {
 		log.Error(ctx, "error creating publish gateway", "err", err)
 		return
 	}
 
-	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, proofService, publisherGateway, networkResolver, ps)
+	publisher := gateways.NewPublisher(storage, identityService, claimsService, mtService, keyStore, transactionService, transactionHistoryService, proofService, publisherGateway, networkResolver, ps)
+	publishingScheduler := gateways.NewPublishingScheduler(publisher, identityService, transactionHistoryService, publisherGateway, networkResolver, repositories.NewDeferredState(*storage))
+	publishingScheduler.Run(ctx, cfg.PublishSchedulerPeriod)
 
 	serverHealth := health.New(health.Monitors{
 		"postgres": storage.Ping,
@@ -191,6 +256,7 @@ func main() {
 		//	return func(ctx context.Context) error { return rdb.Ping(ctx).Err() }
 		//}(rdb),
 	})
+	serverHealth.AddProvider(networkResolver.HealthMonitors)
 	serverHealth.Run(ctx, health.DefaultPingPeriod)
 
 	mux := chi.NewRouter()
@@ -198,12 +264,14 @@ func main() {
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"localhost", "127.0.0.1", "*", "https://wallet.privado.id", "https://0a4cdc17f2d4.ngrok-free.app", "https://7fbab6d82de1.ngrok-free.app"},
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "ngrok-skip-browser-warning"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-API-Key", "ngrok-skip-browser-warning"},
 		AllowCredentials: true,
 	})
 
 	mux.Use(
 		chiMiddleware.RequestID,
+		api.RealIPMiddleware(cfg.TrustedProxies),
+		telemetry.Middleware,
 		log.ChiMiddleware(ctx),
 		chiMiddleware.Recoverer,
 		corsMiddleware.Handler,
@@ -212,8 +280,8 @@ func main() {
 
 	api.HandlerWithOptions(
 		api.NewStrictHandlerWithOptions(
-			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publisher, packageManager, *networkResolver, serverHealth, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService, nil),
-			middlewares(ctx, cfg.HTTPBasicAuth),
+			api.NewServer(cfg, identityService, accountService, connectionsService, claimsService, qrService, publishingScheduler, packageManager, *networkResolver, serverHealth, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService, nil, transactionHistoryService, networkService, agentRouter, services.NewAgentResponsePacker(packageManager, keyStore), messageService, proofRequestService, onchainIssuerService, presentationService, credentialFormatService, oid4vciService, oid4vpService, statusListService, schemaBuilder, schemaFamilyService, apiKeyService, auditService, tenantService),
+			middlewares(ctx, cfg.HTTPBasicAuth, apiKeyService, tenantService, oidcVerifier, rateLimiter),
 			api.StrictHTTPServerOptions{
 				RequestErrorHandlerFunc:  errors.RequestErrorHandlerFunc,
 				ResponseErrorHandlerFunc: errors.ResponseErrorHandlerFunc,
@@ -223,6 +291,7 @@ func main() {
 			ErrorHandlerFunc: api.ErrorHandlerFunc,
 		})
 	api.RegisterStatic(mux)
+	mux.Handle("/metrics", telemetry.Handler())
 
 	server := &http.Server{
 		Addr:    fmt.Sprintf(":%d", cfg.ServerPort),
@@ -242,9 +311,15 @@ func main() {
 	log.Info(ctx, "Shutting down")
 }
 
-func middlewares(ctx context.Context, auth config.HTTPBasicAuth) []api.StrictMiddlewareFunc {
-	return []api.StrictMiddlewareFunc{
+func middlewares(ctx context.Context, basicAuth config.HTTPBasicAuth, apiKeyService ports.APIKeyService, tenantService ports.TenantService, oidcVerifier *oidcAuth.Verifier, rateLimiter *ratelimit.Limiter) []api.StrictMiddlewareFunc {
+	m := []api.StrictMiddlewareFunc{
 		api.LogMiddleware(ctx),
-		api.BasicAuthMiddleware(ctx, auth.User, auth.Password),
+		api.RateLimitMiddleware(ctx, rateLimiter),
+		api.TenantMiddleware(ctx, tenantService),
+		api.APIKeyAuthMiddleware(ctx, apiKeyService, basicAuth.User, basicAuth.Password),
+	}
+	if oidcVerifier != nil {
+		m = append(m, api.JWTAuthMiddleware(ctx, oidcVerifier))
 	}
+	return append(m, api.TelemetryMiddleware())
 }
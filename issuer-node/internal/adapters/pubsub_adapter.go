@@ -39,13 +39,18 @@ func (e *GenericEvent) Unmarshal(msg pubsub.Message) error {
 
 // Publish implements bus.EventBus interface
 func (a *PubSubEventBusAdapter) Publish(topic string, data ...interface{}) error {
+	return a.PublishContext(a.ctx, topic, data...)
+}
+
+// PublishContext implements bus.ContextPublisher interface, so the subscribers continue the trace of ctx
+func (a *PubSubEventBusAdapter) PublishContext(ctx context.Context, topic string, data ...interface{}) error {
 	if len(data) == 0 {
 		return nil
 	}
 	
 	// Use the first data item as the event payload
 	event := &GenericEvent{Data: data[0]}
-	return a.pubsubClient.Publish(a.ctx, topic, event)
+	return a.pubsubClient.Publish(ctx, topic, event)
 }
 
 // Subscribe implements bus.EventBus interface
@@ -1,13 +1,12 @@
 package api
 
 import (
+	"bytes"
 	"context"
 
-	"github.com/iden3/iden3comm/v2"
-	"github.com/iden3/iden3comm/v2/protocol"
-
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/packagemanager"
 )
 
 // Agent is the controller to fetch credentials from mobile
@@ -23,29 +22,32 @@ func (s *Server) Agent(ctx context.Context, request AgentRequestObject) (AgentRe
 		return Agent400JSONResponse{N400JSONResponse{"cannot proceed with the given request"}}, nil
 	}
 
-	var response *iden3comm.BasicMessage
-
 	req, err := ports.NewAgentRequest(basicMessage)
 	if err != nil {
 		log.Error(ctx, "agent parsing request", "err", err)
 		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
-	switch basicMessage.Type {
-	case protocol.DiscoverFeatureQueriesMessageType:
-		response, err = s.discoveryService.Agent(ctx, req)
-		if err != nil {
-			log.Error(ctx, "agent error", "err", err)
-			return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
-		}
+	// the conversation is best effort, it must not prevent the agent from answering
+	_ = s.messageService.RecordInbound(ctx, req)
+
+	// encrypted messages are routed by the media type of their payload, the response is encrypted by the envelope one
+	response, err := s.agentRouter.Route(ctx, req, packagemanager.PayloadMediaType(basicMessage, mediatype))
+	if err != nil {
+		log.Error(ctx, "agent error", "err", err)
+		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
+	if response == nil {
+		return Agent202Response{}, nil
+	}
+	_ = s.messageService.RecordReply(ctx, req, response)
 
-	case protocol.CredentialFetchRequestMessageType, protocol.RevocationStatusRequestMessageType:
-		response, err = s.claimService.Agent(ctx, req, mediatype)
-		if err != nil {
-			log.Error(ctx, "agent error", "err", err)
-			return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
-		}
-	default:
-		log.Error(ctx, "agent error", "err", "type is not supported", basicMessage.Type)
+	envelope, err := s.agentResponsePacker.Pack(ctx, req, mediatype, response)
+	if err != nil {
+		log.Error(ctx, "agent error", "err", err)
+		return Agent400JSONResponse{N400JSONResponse{err.Error()}}, nil
+	}
+	if envelope != nil {
+		return Agent200Applicationiden3commEncryptedJsonResponse{Body: bytes.NewReader(envelope), ContentLength: int64(len(envelope))}, nil
 	}
 
 	return Agent200JSONResponse{
@@ -77,12 +79,14 @@ func (s *Server) AgentV1(ctx context.Context, request AgentV1RequestObject) (Age
 		log.Error(ctx, "agent parsing request", "err", err)
 		return AgentV1400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
+	_ = s.messageService.RecordInbound(ctx, req)
 
-	agent, err := s.claimService.Agent(ctx, req, mediatype)
+	agent, err := s.claimService.Agent(ctx, req, packagemanager.PayloadMediaType(basicMessage, mediatype))
 	if err != nil {
 		log.Error(ctx, "agent error", "err", err)
 		return AgentV1400JSONResponse{N400JSONResponse{err.Error()}}, nil
 	}
+	_ = s.messageService.RecordReply(ctx, req, agent)
 	return AgentV1200JSONResponse{
 		Body:     agent.Body,
 		From:     agent.From,
@@ -20,12 +20,56 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	payments "github.com/polygonid/sh-id-platform/internal/payments"
 	timeapi "github.com/polygonid/sh-id-platform/internal/timeapi"
+	oid4vci "github.com/polygonid/sh-id-platform/pkg/credentials/oid4vci"
+	oid4vp "github.com/polygonid/sh-id-platform/pkg/credentials/oid4vp"
+	presentation "github.com/polygonid/sh-id-platform/pkg/credentials/presentation"
 )
 
 const (
+	ApiKeyScopes    = "apiKey.Scopes"
 	BasicAuthScopes = "basicAuth.Scopes"
 )
 
+// Defines values for APIKeyRole.
+const (
+	APIKeyRoleAdmin    APIKeyRole = "admin"
+	APIKeyRoleIssuer   APIKeyRole = "issuer"
+	APIKeyRoleReadOnly APIKeyRole = "read-only"
+	APIKeyRoleRevoker  APIKeyRole = "revoker"
+)
+
+// Defines values for AuditEntity.
+const (
+	AuditEntityCredential    AuditEntity = "credential"
+	AuditEntityKey           AuditEntity = "key"
+	AuditEntityLink          AuditEntity = "link"
+	AuditEntityPaymentOption AuditEntity = "payment-option"
+	AuditEntitySchema        AuditEntity = "schema"
+)
+
+// Defines values for AuditOperation.
+const (
+	AuditOperationCreate AuditOperation = "create"
+	AuditOperationDelete AuditOperation = "delete"
+	AuditOperationRevoke AuditOperation = "revoke"
+	AuditOperationUpdate AuditOperation = "update"
+)
+
+// Defines values for ConversationMessageDirection.
+const (
+	Inbound  ConversationMessageDirection = "inbound"
+	Outbound ConversationMessageDirection = "outbound"
+)
+
+// Defines values for ConversationMessageStatus.
+const (
+	ConversationMessageStatusAcknowledged ConversationMessageStatus = "acknowledged"
+	ConversationMessageStatusFailed       ConversationMessageStatus = "failed"
+	ConversationMessageStatusQueued       ConversationMessageStatus = "queued"
+	ConversationMessageStatusReceived     ConversationMessageStatus = "received"
+	ConversationMessageStatusSent         ConversationMessageStatus = "sent"
+)
+
 // Defines values for CreateAuthCredentialRequestCredentialStatusType.
 const (
 	CreateAuthCredentialRequestCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 CreateAuthCredentialRequestCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
@@ -35,6 +79,7 @@ const (
 
 // Defines values for CreateCredentialRequestCredentialStatusType.
 const (
+	CreateCredentialRequestCredentialStatusTypeBitstringStatusListEntry              CreateCredentialRequestCredentialStatusType = "BitstringStatusListEntry"
 	CreateCredentialRequestCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 CreateCredentialRequestCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
 	CreateCredentialRequestCredentialStatusTypeIden3ReverseSparseMerkleTreeProof     CreateCredentialRequestCredentialStatusType = "Iden3ReverseSparseMerkleTreeProof"
 	CreateCredentialRequestCredentialStatusTypeIden3commRevocationStatusV10          CreateCredentialRequestCredentialStatusType = "Iden3commRevocationStatusV1.0"
@@ -70,6 +115,21 @@ const (
 const (
 	CreateKeyRequestKeyTypeBabyjubJub CreateKeyRequestKeyType = "babyjubJub"
 	CreateKeyRequestKeyTypeSecp256k1  CreateKeyRequestKeyType = "secp256k1"
+	CreateKeyRequestKeyTypeX25519     CreateKeyRequestKeyType = "x25519"
+)
+
+// Defines values for CreateLinkRequestCredentialStatusType.
+const (
+	CreateLinkRequestCredentialStatusTypeBitstringStatusListEntry              CreateLinkRequestCredentialStatusType = "BitstringStatusListEntry"
+	CreateLinkRequestCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 CreateLinkRequestCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
+	CreateLinkRequestCredentialStatusTypeIden3ReverseSparseMerkleTreeProof     CreateLinkRequestCredentialStatusType = "Iden3ReverseSparseMerkleTreeProof"
+	CreateLinkRequestCredentialStatusTypeIden3commRevocationStatusV10          CreateLinkRequestCredentialStatusType = "Iden3commRevocationStatusV1.0"
+)
+
+// Defines values for CreateOID4VPSessionRequestCredentialFormat.
+const (
+	CreateOID4VPSessionRequestCredentialFormatJwtVcJson CreateOID4VPSessionRequestCredentialFormat = "jwt_vc_json"
+	CreateOID4VPSessionRequestCredentialFormatVcSdJwt   CreateOID4VPSessionRequestCredentialFormat = "vc+sd-jwt"
 )
 
 // Defines values for CreatePaymentRequestResponseStatus.
@@ -81,6 +141,13 @@ const (
 	CreatePaymentRequestResponseStatusSuccess     CreatePaymentRequestResponseStatus = "success"
 )
 
+// Defines values for CredentialFormat.
+const (
+	CredentialFormatIden3     CredentialFormat = "iden3"
+	CredentialFormatJwtVcJson CredentialFormat = "jwt_vc_json"
+	CredentialFormatVcSdJwt   CredentialFormat = "vc+sd-jwt"
+)
+
 // Defines values for DisplayMethodType.
 const (
 	Iden3BasicDisplayMethodV1 DisplayMethodType = "Iden3BasicDisplayMethodV1"
@@ -95,15 +162,16 @@ const (
 
 // Defines values for GetIdentityDetailsResponseCredentialStatusType.
 const (
-	GetIdentityDetailsResponseCredentialStatusTypeIden3OnchainSparseMerkleTreeProof2023 GetIdentityDetailsResponseCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
-	GetIdentityDetailsResponseCredentialStatusTypeIden3ReverseSparseMerkleTreeProof     GetIdentityDetailsResponseCredentialStatusType = "Iden3ReverseSparseMerkleTreeProof"
-	GetIdentityDetailsResponseCredentialStatusTypeIden3commRevocationStatusV10          GetIdentityDetailsResponseCredentialStatusType = "Iden3commRevocationStatusV1.0"
+	Iden3OnchainSparseMerkleTreeProof2023 GetIdentityDetailsResponseCredentialStatusType = "Iden3OnchainSparseMerkleTreeProof2023"
+	Iden3ReverseSparseMerkleTreeProof     GetIdentityDetailsResponseCredentialStatusType = "Iden3ReverseSparseMerkleTreeProof"
+	Iden3commRevocationStatusV10          GetIdentityDetailsResponseCredentialStatusType = "Iden3commRevocationStatusV1.0"
 )
 
 // Defines values for KeyKeyType.
 const (
 	KeyKeyTypeBabyjubJub KeyKeyType = "babyjubJub"
 	KeyKeyTypeSecp256k1  KeyKeyType = "secp256k1"
+	KeyKeyTypeX25519     KeyKeyType = "x25519"
 )
 
 // Defines values for LinkStatus.
@@ -113,6 +181,20 @@ const (
 	LinkStatusInactive LinkStatus = "inactive"
 )
 
+// Defines values for OID4VPSessionStatus.
+const (
+	OID4VPSessionStatusPending  OID4VPSessionStatus = "pending"
+	OID4VPSessionStatusRejected OID4VPSessionStatus = "rejected"
+	OID4VPSessionStatusVerified OID4VPSessionStatus = "verified"
+)
+
+// Defines values for OnchainCredentialStatus.
+const (
+	OnchainCredentialStatusFailed    OnchainCredentialStatus = "failed"
+	OnchainCredentialStatusPending   OnchainCredentialStatus = "pending"
+	OnchainCredentialStatusPublished OnchainCredentialStatus = "published"
+)
+
 // Defines values for PaymentStatusStatus.
 const (
 	PaymentStatusStatusCanceled PaymentStatusStatus = "canceled"
@@ -121,17 +203,67 @@ const (
 	PaymentStatusStatusSuccess  PaymentStatusStatus = "success"
 )
 
+// Defines values for ProofRequestStatus.
+const (
+	ProofRequestStatusFailed   ProofRequestStatus = "failed"
+	ProofRequestStatusPending  ProofRequestStatus = "pending"
+	ProofRequestStatusRejected ProofRequestStatus = "rejected"
+	ProofRequestStatusVerified ProofRequestStatus = "verified"
+)
+
 // Defines values for RefreshServiceType.
 const (
 	Iden3RefreshService2023 RefreshServiceType = "Iden3RefreshService2023"
 )
 
+// Defines values for SchemaAttributeType.
+const (
+	Boolean SchemaAttributeType = "boolean"
+	Date    SchemaAttributeType = "date"
+	Integer SchemaAttributeType = "integer"
+	Number  SchemaAttributeType = "number"
+	String  SchemaAttributeType = "string"
+)
+
+// Defines values for SchemaMappingRuleOp.
+const (
+	Default   SchemaMappingRuleOp = "default"
+	Drop      SchemaMappingRuleOp = "drop"
+	Rename    SchemaMappingRuleOp = "rename"
+	Transform SchemaMappingRuleOp = "transform"
+)
+
+// Defines values for SchemaMappingRuleTransform.
+const (
+	ToBoolean   SchemaMappingRuleTransform = "boolean"
+	ToInteger   SchemaMappingRuleTransform = "integer"
+	ToLowercase SchemaMappingRuleTransform = "lowercase"
+	ToNumber    SchemaMappingRuleTransform = "number"
+	ToString    SchemaMappingRuleTransform = "string"
+	ToUppercase SchemaMappingRuleTransform = "uppercase"
+	ToValues    SchemaMappingRuleTransform = "values"
+)
+
+// Defines values for SchemaMigrationStatus.
+const (
+	SchemaMigrationStatusDone    SchemaMigrationStatus = "done"
+	SchemaMigrationStatusFailed  SchemaMigrationStatus = "failed"
+	SchemaMigrationStatusPending SchemaMigrationStatus = "pending"
+	SchemaMigrationStatusRunning SchemaMigrationStatus = "running"
+)
+
 // Defines values for StateTransactionStatus.
 const (
-	Created   StateTransactionStatus = "created"
-	Failed    StateTransactionStatus = "failed"
-	Pending   StateTransactionStatus = "pending"
-	Published StateTransactionStatus = "published"
+	StateTransactionStatusCreated   StateTransactionStatus = "created"
+	StateTransactionStatusFailed    StateTransactionStatus = "failed"
+	StateTransactionStatusPending   StateTransactionStatus = "pending"
+	StateTransactionStatusPublished StateTransactionStatus = "published"
+)
+
+// Defines values for TransactionCostTotalType.
+const (
+	TransactionCostTotalTypeOnchainCredential TransactionCostTotalType = "onchain_credential"
+	TransactionCostTotalTypeStateTransition   TransactionCostTotalType = "state_transition"
 )
 
 // Defines values for GetConnectionsParamsSort.
@@ -190,6 +322,7 @@ const (
 const (
 	BabyjubJub GetKeysParamsType = "babyjubJub"
 	Secp256k1  GetKeysParamsType = "secp256k1"
+	X25519     GetKeysParamsType = "x25519"
 )
 
 // Defines values for GetStateTransactionsParamsFilter.
@@ -212,9 +345,84 @@ const (
 	AuthenticationParamsTypeRaw  AuthenticationParamsType = "raw"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt TimeUTC   `json:"createdAt"`
+	ExpiresAt *TimeUTC  `json:"expiresAt"`
+	Id        uuid.UUID `json:"id"`
+
+	// Issuers issuers the key is valid for, empty when it is valid for every issuer of its tenant or of the node
+	Issuers   []string `json:"issuers"`
+	Name      string   `json:"name"`
+	RevokedAt *TimeUTC `json:"revokedAt"`
+
+	// Role read-only keys can only read. revoker keys can also revoke credentials. issuer keys can do anything on their
+	// issuers. admin keys can also manage the API keys.
+	Role APIKeyRole `json:"role"`
+
+	// TenantId id of a tenant
+	TenantId *TenantID `json:"tenantId,omitempty"`
+}
+
+// APIKeyRole read-only keys can only read. revoker keys can also revoke credentials. issuer keys can do anything on their
+// issuers. admin keys can also manage the API keys.
+type APIKeyRole string
+
 // AgentResponse defines model for AgentResponse.
 type AgentResponse = BasicMessage
 
+// AuditEntity defines model for AuditEntity.
+type AuditEntity string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	// Actor id of the caller, system for the operations performed by the node itself
+	Actor string `json:"actor"`
+
+	// ActorMethod authentication method of the caller
+	ActorMethod string  `json:"actorMethod"`
+	CreatedAt   TimeUTC `json:"createdAt"`
+
+	// Diff changed fields of the entity, by name
+	Diff      map[string]AuditFieldChange `json:"diff"`
+	Entity    AuditEntity                 `json:"entity"`
+	EntityId  string                      `json:"entityId"`
+	Hash      string                      `json:"hash"`
+	IssuerDID string                      `json:"issuerDID"`
+	Operation AuditOperation              `json:"operation"`
+
+	// PrevHash hash of the previous entry of the identity, empty for the first one
+	PrevHash  string `json:"prevHash"`
+	RequestId string `json:"requestId"`
+	Seq       int64  `json:"seq"`
+	SourceIp  string `json:"sourceIp"`
+}
+
+// AuditFieldChange value of the field before and after the operation, missing when the field didn't exist
+type AuditFieldChange struct {
+	After  *json.RawMessage `json:"after,omitempty"`
+	Before *json.RawMessage `json:"before,omitempty"`
+}
+
+// AuditLogPaginated defines model for AuditLogPaginated.
+type AuditLogPaginated struct {
+	Items []AuditEntry      `json:"items"`
+	Meta  PaginatedMetadata `json:"meta"`
+}
+
+// AuditLogVerification defines model for AuditLogVerification.
+type AuditLogVerification struct {
+	// BrokenAt sequence number of the first entry that breaks the chain
+	BrokenAt *int64 `json:"brokenAt,omitempty"`
+
+	// Entries number of entries checked
+	Entries int64 `json:"entries"`
+	Valid   bool  `json:"valid"`
+}
+
+// AuditOperation defines model for AuditOperation.
+type AuditOperation string
+
 // AuthenticationConnection defines model for AuthenticationConnection.
 type AuthenticationConnection struct {
 	CreatedAt  TimeUTC    `json:"createdAt"`
@@ -241,12 +449,98 @@ type BasicMessage struct {
 	Type     string      `json:"type"`
 }
 
+// BuildSchemaRequest defines model for BuildSchemaRequest.
+type BuildSchemaRequest struct {
+	Attributes  []SchemaAttribute `json:"attributes"`
+	Description *string           `json:"description,omitempty"`
+	SchemaType  string            `json:"schemaType"`
+	Title       *string           `json:"title,omitempty"`
+	Version     string            `json:"version"`
+}
+
+// BuildSchemaResponse defines model for BuildSchemaResponse.
+type BuildSchemaResponse struct {
+	ContextUrl string    `json:"contextUrl"`
+	Id         uuid.UUID `json:"id"`
+	SchemaType string    `json:"schemaType"`
+	Url        string    `json:"url"`
+	Version    string    `json:"version"`
+}
+
 // ConnectionsPaginated defines model for ConnectionsPaginated.
 type ConnectionsPaginated struct {
 	Items GetConnectionsResponse `json:"items"`
 	Meta  PaginatedMetadata      `json:"meta"`
 }
 
+// Conversation defines model for Conversation.
+type Conversation struct {
+	Messages []ConversationMessage `json:"messages"`
+	ThreadID string                `json:"threadID"`
+}
+
+// ConversationMessage defines model for ConversationMessage.
+type ConversationMessage struct {
+	// Attempts Number of delivery attempts of outbound messages
+	Attempts  int                          `json:"attempts"`
+	CreatedAt TimeUTC                      `json:"createdAt"`
+	Direction ConversationMessageDirection `json:"direction"`
+	Id        uuid.UUID                    `json:"id"`
+
+	// LastError Error of the last failed delivery attempt
+	LastError *string `json:"lastError,omitempty"`
+
+	// Message The iden3comm message
+	Message       map[string]interface{}    `json:"message"`
+	MessageID     string                    `json:"messageID"`
+	ModifiedAt    TimeUTC                   `json:"modifiedAt"`
+	NextAttemptAt *TimeUTC                  `json:"nextAttemptAt"`
+	Status        ConversationMessageStatus `json:"status"`
+	Type          string                    `json:"type"`
+}
+
+// ConversationMessageDirection defines model for ConversationMessage.Direction.
+type ConversationMessageDirection string
+
+// ConversationMessageStatus defines model for ConversationMessage.Status.
+type ConversationMessageStatus string
+
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	Issuers   []string   `json:"issuers,omitempty"`
+	Name      string     `json:"name"`
+
+	// Role read-only keys can only read. revoker keys can also revoke credentials. issuer keys can do anything on their
+	// issuers. admin keys can also manage the API keys.
+	Role APIKeyRole `json:"role"`
+
+	// TenantId id of a tenant
+	TenantId *TenantID `json:"tenantId,omitempty"`
+}
+
+// CreateAPIKeyResponse defines model for CreateAPIKeyResponse.
+type CreateAPIKeyResponse struct {
+	CreatedAt TimeUTC   `json:"createdAt"`
+	ExpiresAt *TimeUTC  `json:"expiresAt"`
+	Id        uuid.UUID `json:"id"`
+
+	// Issuers issuers the key is valid for, empty when it is valid for every issuer of its tenant or of the node
+	Issuers []string `json:"issuers"`
+
+	// Key the API key, it is not returned again
+	Key       string   `json:"key"`
+	Name      string   `json:"name"`
+	RevokedAt *TimeUTC `json:"revokedAt"`
+
+	// Role read-only keys can only read. revoker keys can also revoke credentials. issuer keys can do anything on their
+	// issuers. admin keys can also manage the API keys.
+	Role APIKeyRole `json:"role"`
+
+	// TenantId id of a tenant
+	TenantId *TenantID `json:"tenantId,omitempty"`
+}
+
 // CreateAuthCredentialRequest defines model for CreateAuthCredentialRequest.
 type CreateAuthCredentialRequest struct {
 	CredentialStatusType CreateAuthCredentialRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
@@ -266,9 +560,20 @@ type CreateConnectionRequest struct {
 	UserDoc   map[string]interface{} `json:"userDoc"`
 }
 
+// CreateCredentialPresentationRequest defines model for CreateCredentialPresentationRequest.
+type CreateCredentialPresentationRequest struct {
+	Fields []string `json:"fields"`
+}
+
 // CreateCredentialRequest defines model for CreateCredentialRequest.
 type CreateCredentialRequest struct {
-	ClaimID               *uuid.UUID                                   `json:"claimID"`
+	ClaimID *uuid.UUID `json:"claimID"`
+
+	// CredentialFormat Format the credential is delivered in:
+	//   * `iden3` - (default value) W3C credential with BJJ signature and SMT proofs
+	//   * `jwt_vc_json` - W3C Data Model 2.0 credential secured as a JWT signed with ES256K
+	//   * `vc+sd-jwt` - SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+	CredentialFormat      *CredentialFormat                            `json:"credentialFormat,omitempty"`
 	CredentialSchema      string                                       `json:"credentialSchema"`
 	CredentialStatusType  *CreateCredentialRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
 	CredentialSubject     map[string]interface{}                       `json:"credentialSubject"`
@@ -313,6 +618,9 @@ type CreateIdentityRequest struct {
 		Type       CreateIdentityRequestDidMetadataType `json:"type"`
 	} `json:"didMetadata"`
 	DisplayName *string `json:"displayName"`
+
+	// TenantId id of a tenant
+	TenantId *TenantID `json:"tenantId,omitempty"`
 }
 
 // CreateIdentityRequestCredentialStatusType defines model for CreateIdentityRequest.CredentialStatusType.
@@ -352,15 +660,59 @@ type CreateKeyResponse struct {
 
 // CreateLinkRequest defines model for CreateLinkRequest.
 type CreateLinkRequest struct {
-	CredentialExpiration *time.Time        `json:"credentialExpiration,omitempty"`
-	CredentialSubject    CredentialSubject `json:"credentialSubject"`
-	DisplayMethod        *DisplayMethod    `json:"displayMethod,omitempty"`
-	Expiration           *time.Time        `json:"expiration,omitempty"`
-	LimitedClaims        *int              `json:"limitedClaims"`
-	MtProof              bool              `json:"mtProof"`
-	RefreshService       *RefreshService   `json:"refreshService,omitempty"`
-	SchemaID             uuid.UUID         `json:"schemaID"`
-	SignatureProof       bool              `json:"signatureProof"`
+	CredentialExpiration *time.Time `json:"credentialExpiration,omitempty"`
+
+	// CredentialFormat Format the credential is delivered in:
+	//   * `iden3` - (default value) W3C credential with BJJ signature and SMT proofs
+	//   * `jwt_vc_json` - W3C Data Model 2.0 credential secured as a JWT signed with ES256K
+	//   * `vc+sd-jwt` - SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+	CredentialFormat *CredentialFormat `json:"credentialFormat,omitempty"`
+
+	// CredentialStatusType Credential status of the issued credentials. The status of the issuer auth credential is used if not set.
+	CredentialStatusType *CreateLinkRequestCredentialStatusType `json:"credentialStatusType,omitempty"`
+	CredentialSubject    CredentialSubject                      `json:"credentialSubject"`
+	DisplayMethod        *DisplayMethod                         `json:"displayMethod,omitempty"`
+	Expiration           *time.Time                             `json:"expiration,omitempty"`
+	LimitedClaims        *int                                   `json:"limitedClaims"`
+	MtProof              bool                                   `json:"mtProof"`
+	RefreshService       *RefreshService                        `json:"refreshService,omitempty"`
+	SchemaID             uuid.UUID                              `json:"schemaID"`
+	SignatureProof       bool                                   `json:"signatureProof"`
+}
+
+// CreateLinkRequestCredentialStatusType Credential status of the issued credentials. The status of the issuer auth credential is used if not set.
+type CreateLinkRequestCredentialStatusType string
+
+// CreateOID4VPSessionRequest defines model for CreateOID4VPSessionRequest.
+type CreateOID4VPSessionRequest struct {
+	// CredentialFormat Format of the requested credential. Defaults to `jwt_vc_json`.
+	CredentialFormat *CreateOID4VPSessionRequestCredentialFormat `json:"credentialFormat,omitempty"`
+	Fields           []OID4VPField                               `json:"fields"`
+	Purpose          *string                                     `json:"purpose,omitempty"`
+	SchemaID         uuid.UUID                                   `json:"schemaID"`
+}
+
+// CreateOID4VPSessionRequestCredentialFormat Format of the requested credential. Defaults to `jwt_vc_json`.
+type CreateOID4VPSessionRequestCredentialFormat string
+
+// CreateOID4VPSessionResponse defines model for CreateOID4VPSessionResponse.
+type CreateOID4VPSessionResponse struct {
+	Id                     uuid.UUID                    `json:"id"`
+	PresentationDefinition OID4VPPresentationDefinition `json:"presentationDefinition"`
+
+	// RequestUri Authorization request URI for the wallet, referencing the request object
+	RequestUri string `json:"requestUri"`
+}
+
+// CreateOnchainCredentialRequest defines model for CreateOnchainCredentialRequest.
+type CreateOnchainCredentialRequest struct {
+	CredentialSchema      string                 `json:"credentialSchema"`
+	CredentialSubject     map[string]interface{} `json:"credentialSubject"`
+	Expiration            *int64                 `json:"expiration,omitempty"`
+	MerklizedRootPosition *string                `json:"merklizedRootPosition,omitempty"`
+	SubjectPosition       *string                `json:"subjectPosition,omitempty"`
+	Type                  string                 `json:"type"`
+	Version               *uint32                `json:"version,omitempty"`
 }
 
 // CreatePaymentRequest defines model for CreatePaymentRequest.
@@ -388,14 +740,36 @@ type CreatePaymentRequestResponse struct {
 // CreatePaymentRequestResponseStatus defines model for CreatePaymentRequestResponse.Status.
 type CreatePaymentRequestResponseStatus string
 
+// CreateProofRequest defines model for CreateProofRequest.
+type CreateProofRequest struct {
+	Reason *string `json:"reason,omitempty"`
+
+	// Scope Zero knowledge proof requests, as in the iden3comm authorization request scope
+	Scope []protocol.ZeroKnowledgeProofRequest `json:"scope"`
+}
+
 // Credential defines model for Credential.
 type Credential struct {
-	Id         string                   `json:"id"`
-	ProofTypes []string                 `json:"proofTypes"`
-	Revoked    bool                     `json:"revoked"`
-	SchemaHash string                   `json:"schemaHash"`
-	Vc         verifiable.W3CCredential `json:"vc"`
-}
+	// CredentialFormat Format the credential is delivered in:
+	//   * `iden3` - (default value) W3C credential with BJJ signature and SMT proofs
+	//   * `jwt_vc_json` - W3C Data Model 2.0 credential secured as a JWT signed with ES256K
+	//   * `vc+sd-jwt` - SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+	CredentialFormat *CredentialFormat `json:"credentialFormat,omitempty"`
+
+	// EncodedCredential The credential as a JWT-VC or SD-JWT VC, when it is delivered in one of those formats
+	EncodedCredential *string                  `json:"encodedCredential,omitempty"`
+	Id                string                   `json:"id"`
+	ProofTypes        []string                 `json:"proofTypes"`
+	Revoked           bool                     `json:"revoked"`
+	SchemaHash        string                   `json:"schemaHash"`
+	Vc                verifiable.W3CCredential `json:"vc"`
+}
+
+// CredentialFormat Format the credential is delivered in:
+//   - `iden3` - (default value) W3C credential with BJJ signature and SMT proofs
+//   - `jwt_vc_json` - W3C Data Model 2.0 credential secured as a JWT signed with ES256K
+//   - `vc+sd-jwt` - SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+type CredentialFormat string
 
 // CredentialLinkQrCodeResponse defines model for CredentialLinkQrCodeResponse.
 type CredentialLinkQrCodeResponse struct {
@@ -412,6 +786,9 @@ type CredentialOfferResponse struct {
 	UniversalLink string `json:"universalLink"`
 }
 
+// CredentialPresentation defines model for CredentialPresentation.
+type CredentialPresentation = presentation.Presentation
+
 // CredentialSubject defines model for CredentialSubject.
 type CredentialSubject = map[string]interface{}
 
@@ -559,9 +936,16 @@ type KeysPaginated struct {
 
 // Link defines model for Link.
 type Link struct {
-	Active               bool              `json:"active"`
-	CreatedAt            TimeUTC           `json:"createdAt"`
-	CredentialExpiration *TimeUTC          `json:"credentialExpiration"`
+	Active               bool     `json:"active"`
+	CreatedAt            TimeUTC  `json:"createdAt"`
+	CredentialExpiration *TimeUTC `json:"credentialExpiration"`
+
+	// CredentialFormat Format the credential is delivered in:
+	//   * `iden3` - (default value) W3C credential with BJJ signature and SMT proofs
+	//   * `jwt_vc_json` - W3C Data Model 2.0 credential secured as a JWT signed with ES256K
+	//   * `vc+sd-jwt` - SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+	CredentialFormat     *CredentialFormat `json:"credentialFormat,omitempty"`
+	CredentialStatusType *string           `json:"credentialStatusType,omitempty"`
 	CredentialSubject    CredentialSubject `json:"credentialSubject"`
 	DeepLink             string            `json:"deepLink"`
 	DisplayMethod        *DisplayMethod    `json:"displayMethod,omitempty"`
@@ -596,9 +980,115 @@ type NetworkData struct {
 	Name             string   `json:"name"`
 }
 
+// OID4VCIAuthorizationServerMetadata defines model for OID4VCIAuthorizationServerMetadata.
+type OID4VCIAuthorizationServerMetadata = oid4vci.AuthorizationServerMetadata
+
+// OID4VCICredentialOffer defines model for OID4VCICredentialOffer.
+type OID4VCICredentialOffer = oid4vci.CredentialOffer
+
+// OID4VCICredentialRequest defines model for OID4VCICredentialRequest.
+type OID4VCICredentialRequest = oid4vci.CredentialRequest
+
+// OID4VCICredentialResponse defines model for OID4VCICredentialResponse.
+type OID4VCICredentialResponse = oid4vci.CredentialResponse
+
+// OID4VCIError defines model for OID4VCIError.
+type OID4VCIError = oid4vci.Error
+
+// OID4VCIIssuerMetadata defines model for OID4VCIIssuerMetadata.
+type OID4VCIIssuerMetadata = oid4vci.IssuerMetadata
+
+// OID4VCIOfferResponse defines model for OID4VCIOfferResponse.
+type OID4VCIOfferResponse struct {
+	CredentialOffer    OID4VCICredentialOffer `json:"credentialOffer"`
+	CredentialOfferUri string                 `json:"credentialOfferUri"`
+}
+
+// OID4VCITokenResponse defines model for OID4VCITokenResponse.
+type OID4VCITokenResponse = oid4vci.TokenResponse
+
+// OID4VPCredential defines model for OID4VPCredential.
+type OID4VPCredential struct {
+	// Claims Attributes of the credential subject presented by the holder
+	Claims map[string]interface{} `json:"claims"`
+
+	// CredentialFormat Format the credential is delivered in:
+	//   * `iden3` - (default value) W3C credential with BJJ signature and SMT proofs
+	//   * `jwt_vc_json` - W3C Data Model 2.0 credential secured as a JWT signed with ES256K
+	//   * `vc+sd-jwt` - SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+	CredentialFormat  CredentialFormat `json:"credentialFormat"`
+	Id                string           `json:"id"`
+	InputDescriptorId string           `json:"inputDescriptorId"`
+	Issuer            string           `json:"issuer"`
+	Type              string           `json:"type"`
+}
+
+// OID4VPError defines model for OID4VPError.
+type OID4VPError = oid4vp.Error
+
+// OID4VPField defines model for OID4VPField.
+type OID4VPField struct {
+	// Filter JSON Schema the attribute must be valid against. The keywords type, const, enum, minimum, maximum,
+	// exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern and contains are supported.
+	Filter *map[string]interface{} `json:"filter,omitempty"`
+
+	// Name Attribute of the credential subject
+	Name     string `json:"name"`
+	Optional *bool  `json:"optional,omitempty"`
+}
+
+// OID4VPPresentationDefinition defines model for OID4VPPresentationDefinition.
+type OID4VPPresentationDefinition = oid4vp.PresentationDefinition
+
+// OID4VPSession defines model for OID4VPSession.
+type OID4VPSession struct {
+	CreatedAt TimeUTC `json:"createdAt"`
+
+	// CredentialFormat Format the credential is delivered in:
+	//   * `iden3` - (default value) W3C credential with BJJ signature and SMT proofs
+	//   * `jwt_vc_json` - W3C Data Model 2.0 credential secured as a JWT signed with ES256K
+	//   * `vc+sd-jwt` - SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+	CredentialFormat CredentialFormat   `json:"credentialFormat"`
+	Credentials      []OID4VPCredential `json:"credentials"`
+
+	// Error Reason the presentation was rejected
+	Error *string `json:"error,omitempty"`
+
+	// Holder DID of the holder of the verified presentation
+	Holder                 *string                      `json:"holder,omitempty"`
+	Id                     uuid.UUID                    `json:"id"`
+	PresentationDefinition OID4VPPresentationDefinition `json:"presentationDefinition"`
+	Status                 OID4VPSessionStatus          `json:"status"`
+	VerifiedAt             *TimeUTC                     `json:"verifiedAt"`
+}
+
+// OID4VPSessionStatus defines model for OID4VPSession.Status.
+type OID4VPSessionStatus string
+
 // Offer defines model for Offer.
 type Offer = protocol.CredentialsOfferMessage
 
+// OnchainCredential defines model for OnchainCredential.
+type OnchainCredential struct {
+	Id     uuid.UUID               `json:"id"`
+	Status OnchainCredentialStatus `json:"status"`
+	TxID   string                  `json:"txID"`
+}
+
+// OnchainCredentialStatus defines model for OnchainCredential.Status.
+type OnchainCredentialStatus string
+
+// OnchainIssuer defines model for OnchainIssuer.
+type OnchainIssuer struct {
+	Blockchain      string    `json:"blockchain"`
+	ChainID         int64     `json:"chainID"`
+	ContractAddress string    `json:"contractAddress"`
+	CreatedAt       TimeUTC   `json:"createdAt"`
+	Id              uuid.UUID `json:"id"`
+	Identifier      string    `json:"identifier"`
+	Network         string    `json:"network"`
+}
+
 // PaginatedMetadata defines model for PaginatedMetadata.
 type PaginatedMetadata struct {
 	MaxResults uint `json:"max_results"`
@@ -672,6 +1162,28 @@ type PaymentVerifyRequest struct {
 // PaymentsConfiguration defines model for PaymentsConfiguration.
 type PaymentsConfiguration = payments.Config
 
+// ProofRequest defines model for ProofRequest.
+type ProofRequest struct {
+	ConnectionID uuid.UUID `json:"connectionID"`
+	CreatedAt    TimeUTC   `json:"createdAt"`
+
+	// Error Reason the last response could not be verified
+	Error      *string   `json:"error,omitempty"`
+	Id         uuid.UUID `json:"id"`
+	ModifiedAt TimeUTC   `json:"modifiedAt"`
+
+	// Proofs Verified zero knowledge proofs of the response
+	Proofs     *[]protocol.ZeroKnowledgeProofResponse `json:"proofs,omitempty"`
+	Reason     string                                 `json:"reason"`
+	Scope      []protocol.ZeroKnowledgeProofRequest   `json:"scope"`
+	Status     ProofRequestStatus                     `json:"status"`
+	ThreadID   string                                 `json:"threadID"`
+	VerifiedAt *TimeUTC                               `json:"verifiedAt"`
+}
+
+// ProofRequestStatus defines model for ProofRequest.Status.
+type ProofRequestStatus string
+
 // PublishIdentityStateResponse defines model for PublishIdentityStateResponse.
 type PublishIdentityStateResponse struct {
 	ClaimsTreeRoot     *string `json:"claimsTreeRoot,omitempty"`
@@ -690,6 +1202,18 @@ type RefreshService struct {
 // RefreshServiceType defines model for RefreshService.Type.
 type RefreshServiceType string
 
+// RegisterOnchainIssuerRequest defines model for RegisterOnchainIssuerRequest.
+type RegisterOnchainIssuerRequest struct {
+	ContractAddress string `json:"contractAddress"`
+}
+
+// ReloadSupportedNetworksResponse defines model for ReloadSupportedNetworksResponse.
+type ReloadSupportedNetworksResponse struct {
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+	Updated []string `json:"updated"`
+}
+
 // RevocationStatusResponse defines model for RevocationStatusResponse.
 type RevocationStatusResponse struct {
 	Issuer struct {
@@ -722,10 +1246,120 @@ type Schema struct {
 	DisplayMethodID *uuid.UUID `json:"displayMethodID"`
 	Hash            string     `json:"hash"`
 	Id              string     `json:"id"`
-	Title           *string    `json:"title"`
-	Type            string     `json:"type"`
-	Url             string     `json:"url"`
-	Version         string     `json:"version"`
+
+	// Snapshots Snapshots of the JSON schema and JSON-LD context the credentials are issued with
+	Snapshots *[]SchemaSnapshot `json:"snapshots,omitempty"`
+	Title     *string           `json:"title"`
+	Type      string            `json:"type"`
+	Url       string            `json:"url"`
+	Version   string            `json:"version"`
+}
+
+// SchemaAttribute defines model for SchemaAttribute.
+type SchemaAttribute struct {
+	Description *string `json:"description,omitempty"`
+
+	// Enum Allowed values of the attribute
+	Enum     *[]interface{} `json:"enum,omitempty"`
+	Name     string         `json:"name"`
+	Required *bool          `json:"required,omitempty"`
+	Title    *string        `json:"title,omitempty"`
+
+	// Type date values are strings formatted as YYYY-MM-DD
+	Type SchemaAttributeType `json:"type"`
+}
+
+// SchemaAttributeType date values are strings formatted as YYYY-MM-DD
+type SchemaAttributeType string
+
+// SchemaFamily defines model for SchemaFamily.
+type SchemaFamily struct {
+	CreatedAt TimeUTC               `json:"createdAt"`
+	Id        uuid.UUID             `json:"id"`
+	Name      string                `json:"name"`
+	Versions  []SchemaFamilyVersion `json:"versions"`
+}
+
+// SchemaFamilyVersion defines model for SchemaFamilyVersion.
+type SchemaFamilyVersion struct {
+	CreatedAt TimeUTC `json:"createdAt"`
+
+	// Mapping rules from the credential subjects of the previous version
+	Mapping []SchemaMappingRule `json:"mapping"`
+
+	// Position order of the version in the family, starting at 1
+	Position int       `json:"position"`
+	SchemaId uuid.UUID `json:"schemaId"`
+}
+
+// SchemaMappingRule defines model for SchemaMappingRule.
+type SchemaMappingRule struct {
+	Field string              `json:"field"`
+	Op    SchemaMappingRuleOp `json:"op"`
+
+	// To new name of the field, for rename
+	To *string `json:"to,omitempty"`
+
+	// Transform function applied to the value, for transform. values replaces it with the one for it in values
+	Transform *SchemaMappingRuleTransform `json:"transform,omitempty"`
+
+	// Value value of the field when missing, for default
+	Value  interface{}             `json:"value,omitempty"`
+	Values *map[string]interface{} `json:"values,omitempty"`
+}
+
+// SchemaMappingRuleOp defines model for SchemaMappingRule.Op.
+type SchemaMappingRuleOp string
+
+// SchemaMappingRuleTransform function applied to the value, for transform. values replaces it with the one for it in values
+type SchemaMappingRuleTransform string
+
+// SchemaMigration defines model for SchemaMigration.
+type SchemaMigration struct {
+	CreatedAt TimeUTC `json:"createdAt"`
+	DryRun    bool    `json:"dryRun"`
+
+	// Error reason of a failed migration
+	Error        *string                  `json:"error,omitempty"`
+	Failures     []SchemaMigrationFailure `json:"failures"`
+	FamilyId     uuid.UUID                `json:"familyId"`
+	FromSchemaId uuid.UUID                `json:"fromSchemaId"`
+	Id           uuid.UUID                `json:"id"`
+
+	// Migrated credentials issued again under the new version, or that would be in a dry run
+	Migrated   int                   `json:"migrated"`
+	ModifiedAt TimeUTC               `json:"modifiedAt"`
+	Status     SchemaMigrationStatus `json:"status"`
+	ToSchemaId uuid.UUID             `json:"toSchemaId"`
+
+	// Total active credentials of the old version
+	Total int `json:"total"`
+}
+
+// SchemaMigrationStatus defines model for SchemaMigration.Status.
+type SchemaMigrationStatus string
+
+// SchemaMigrationFailure defines model for SchemaMigrationFailure.
+type SchemaMigrationFailure struct {
+	CredentialId uuid.UUID `json:"credentialId"`
+	Error        string    `json:"error"`
+	Subject      string    `json:"subject"`
+}
+
+// SchemaSnapshot defines model for SchemaSnapshot.
+type SchemaSnapshot struct {
+	CheckedAt *TimeUTC `json:"checkedAt"`
+	CreatedAt TimeUTC  `json:"createdAt"`
+
+	// Digest hex encoded sha256 of the snapshot content
+	Digest string `json:"digest"`
+
+	// Drift true when the remote content has changed since the snapshot was taken
+	Drift bool `json:"drift"`
+
+	// RemoteDigest hex encoded sha256 of the remote content the last time it was checked
+	RemoteDigest *string `json:"remoteDigest,omitempty"`
+	Url          string  `json:"url"`
 }
 
 // StateStatusResponse defines model for StateStatusResponse.
@@ -760,9 +1394,70 @@ type SupportedNetworks struct {
 	Networks   []NetworkData `json:"networks"`
 }
 
+// Tenant defines model for Tenant.
+type Tenant struct {
+	CreatedAt TimeUTC   `json:"createdAt"`
+	Id        uuid.UUID `json:"id"`
+
+	// IssuerLogo logo of the issuers of the tenant in the credential offers, the one of the node when it is omitted
+	IssuerLogo *string `json:"issuerLogo,omitempty"`
+
+	// IssuerName name of the issuers of the tenant in the credential offers, the one of the node when it is omitted
+	IssuerName *string `json:"issuerName,omitempty"`
+
+	// MaxCredentialsPerMonth maximum number of credentials issued by the issuers of the tenant every calendar month, no limit when it is omitted
+	MaxCredentialsPerMonth *int `json:"maxCredentialsPerMonth,omitempty"`
+
+	// MaxIdentities maximum number of identities of the tenant, no limit when it is omitted
+	MaxIdentities *int   `json:"maxIdentities,omitempty"`
+	Name          string `json:"name"`
+}
+
+// TenantID id of a tenant
+type TenantID = uuid.UUID
+
+// TenantRequest defines model for TenantRequest.
+type TenantRequest struct {
+	// IssuerLogo logo of the issuers of the tenant in the credential offers, the one of the node when it is omitted
+	IssuerLogo *string `json:"issuerLogo,omitempty"`
+
+	// IssuerName name of the issuers of the tenant in the credential offers, the one of the node when it is omitted
+	IssuerName *string `json:"issuerName,omitempty"`
+
+	// MaxCredentialsPerMonth maximum number of credentials issued by the issuers of the tenant every calendar month, no limit when it is omitted
+	MaxCredentialsPerMonth *int `json:"maxCredentialsPerMonth,omitempty"`
+
+	// MaxIdentities maximum number of identities of the tenant, no limit when it is omitted
+	MaxIdentities *int   `json:"maxIdentities,omitempty"`
+	Name          string `json:"name"`
+}
+
 // TimeUTC defines model for TimeUTC.
 type TimeUTC = timeapi.Time
 
+// TransactionCostTotal defines model for TransactionCostTotal.
+type TransactionCostTotal struct {
+	// Fee Total fee paid in wei
+	Fee     string `json:"fee"`
+	GasUsed string `json:"gasUsed"`
+	Network string `json:"network"`
+
+	// Replacements Number of times a stuck transaction was re-sent with higher fees
+	Replacements int                      `json:"replacements"`
+	Transactions int                      `json:"transactions"`
+	Type         TransactionCostTotalType `json:"type"`
+}
+
+// TransactionCostTotalType defines model for TransactionCostTotal.Type.
+type TransactionCostTotalType string
+
+// TransactionCostsResponse defines model for TransactionCostsResponse.
+type TransactionCostsResponse struct {
+	From   *time.Time             `json:"from,omitempty"`
+	To     *time.Time             `json:"to,omitempty"`
+	Totals []TransactionCostTotal `json:"totals"`
+}
+
 // UUIDResponse defines model for UUIDResponse.
 type UUIDResponse struct {
 	Id string `json:"id"`
@@ -823,6 +1518,9 @@ type N410 = GenericErrorMessage
 // N422 defines model for 422.
 type N422 = GenericErrorMessage
 
+// N429 defines model for 429.
+type N429 = GenericErrorMessage
+
 // N500 defines model for 500.
 type N500 = GenericErrorMessage
 
@@ -853,6 +1551,33 @@ type UpdateIdentityJSONBody struct {
 	DisplayName string `json:"displayName"`
 }
 
+// GetAuditLogParams defines parameters for GetAuditLog.
+type GetAuditLogParams struct {
+	// Entity If not provided, entries of all the entities will be returned.
+	Entity *AuditEntity `form:"entity,omitempty" json:"entity,omitempty"`
+
+	// Operation If not provided, entries of all the operations will be returned.
+	Operation *AuditOperation `form:"operation,omitempty" json:"operation,omitempty"`
+
+	// EntityId Id of the changed entity
+	EntityId *string `form:"entityId,omitempty" json:"entityId,omitempty"`
+
+	// Actor Id of the caller that performed the operations
+	Actor *string `form:"actor,omitempty" json:"actor,omitempty"`
+
+	// From Start of the date range. If not provided, there is no lower bound.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the date range, excluded. If not provided, there is no upper bound.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// MaxResults Number of items to fetch on each page. Minimum is 10. Default is 50. No maximum by the moment.
+	MaxResults *uint `form:"max_results,omitempty" json:"max_results,omitempty"`
+
+	// Page Page to fetch. First is one. If omitted, page 1 will be returned.
+	Page *uint `form:"page,omitempty" json:"page,omitempty"`
+}
+
 // GetConnectionsParams defines parameters for GetConnections.
 type GetConnectionsParams struct {
 	// Query Query string to do full text search in connections.
@@ -937,6 +1662,11 @@ type ActivateLinkJSONBody struct {
 	Active bool `json:"active"`
 }
 
+// GetCredentialParams defines parameters for GetCredential.
+type GetCredentialParams struct {
+	Format *CredentialFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // GetCredentialOfferParams defines parameters for GetCredentialOffer.
 type GetCredentialOfferParams struct {
 	// Type Type:
@@ -1000,6 +1730,24 @@ type GetPaymentRequestsParams struct {
 	Nonce *string `form:"nonce,omitempty" json:"nonce,omitempty"`
 }
 
+// CreateSchemaFamilyJSONBody defines parameters for CreateSchemaFamily.
+type CreateSchemaFamilyJSONBody struct {
+	Name string `json:"name"`
+}
+
+// CreateSchemaMigrationJSONBody defines parameters for CreateSchemaMigration.
+type CreateSchemaMigrationJSONBody struct {
+	DryRun       *bool     `json:"dryRun,omitempty"`
+	FromSchemaId uuid.UUID `json:"fromSchemaId"`
+	ToSchemaId   uuid.UUID `json:"toSchemaId"`
+}
+
+// AddSchemaFamilyVersionJSONBody defines parameters for AddSchemaFamilyVersion.
+type AddSchemaFamilyVersionJSONBody struct {
+	Mapping  *[]SchemaMappingRule `json:"mapping,omitempty"`
+	SchemaId uuid.UUID            `json:"schemaId"`
+}
+
 // GetSchemasParams defines parameters for GetSchemas.
 type GetSchemasParams struct {
 	// Query Query string to do full text search in schema types and attributes.
@@ -1011,6 +1759,12 @@ type UpdateSchemaJSONBody struct {
 	DisplayMethodID *uuid.UUID `json:"displayMethodID"`
 }
 
+// PublishIdentityStateParams defines parameters for PublishIdentityState.
+type PublishIdentityStateParams struct {
+	// Urgent If false, the state transition can be deferred until the network base fee is below the configured ceiling.
+	Urgent *bool `form:"urgent,omitempty" json:"urgent,omitempty"`
+}
+
 // GetStateTransactionsParams defines parameters for GetStateTransactions.
 type GetStateTransactionsParams struct {
 	Filter *GetStateTransactionsParamsFilter `form:"filter,omitempty" json:"filter,omitempty"`
@@ -1029,6 +1783,48 @@ type GetStateTransactionsParamsFilter string
 // GetStateTransactionsParamsSort defines parameters for GetStateTransactions.
 type GetStateTransactionsParamsSort string
 
+// GetTransactionCostsParams defines parameters for GetTransactionCosts.
+type GetTransactionCostsParams struct {
+	// From Start of the date range. If not provided, there is no lower bound.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To End of the date range. If not provided, there is no upper bound.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Network Only return the costs of the given network.
+	Network *string `form:"network,omitempty" json:"network,omitempty"`
+}
+
+// CreateOID4VCICredentialParams defines parameters for CreateOID4VCICredential.
+type CreateOID4VCICredentialParams struct {
+	// Authorization Bearer access token
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// CreateOID4VCITokenFormdataBody defines parameters for CreateOID4VCIToken.
+type CreateOID4VCITokenFormdataBody struct {
+	GrantType         string  `form:"grant_type" json:"grant_type"`
+	PreAuthorizedCode *string `form:"pre-authorized_code,omitempty" json:"pre-authorized_code,omitempty"`
+	TxCode            *string `form:"tx_code,omitempty" json:"tx_code,omitempty"`
+}
+
+// CreateOID4VPResponseFormdataBody defines parameters for CreateOID4VPResponse.
+type CreateOID4VPResponseFormdataBody struct {
+	// PresentationSubmission JSON encoded presentation submission
+	PresentationSubmission string `form:"presentation_submission" json:"presentation_submission"`
+	State                  string `form:"state" json:"state"`
+	VpToken                string `form:"vp_token" json:"vp_token"`
+}
+
+// ProofRequestCallbackTextBody defines parameters for ProofRequestCallback.
+type ProofRequestCallbackTextBody = string
+
+// ProofRequestCallbackParams defines parameters for ProofRequestCallback.
+type ProofRequestCallbackParams struct {
+	// Id Proof request id
+	Id uuid.UUID `form:"id" json:"id"`
+}
+
 // GetQrFromStoreParams defines parameters for GetQrFromStore.
 type GetQrFromStoreParams struct {
 	Id     *uuid.UUID `form:"id,omitempty" json:"id,omitempty"`
@@ -1052,6 +1848,9 @@ type AgentV1TextRequestBody = AgentV1TextBody
 // AgentTextRequestBody defines body for Agent for text/plain ContentType.
 type AgentTextRequestBody = AgentTextBody
 
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = CreateAPIKeyRequest
+
 // AuthCallbackTextRequestBody defines body for AuthCallback for text/plain ContentType.
 type AuthCallbackTextRequestBody = AuthCallbackTextBody
 
@@ -1064,6 +1863,9 @@ type UpdateIdentityJSONRequestBody UpdateIdentityJSONBody
 // CreateConnectionJSONRequestBody defines body for CreateConnection for application/json ContentType.
 type CreateConnectionJSONRequestBody = CreateConnectionRequest
 
+// CreateProofRequestJSONRequestBody defines body for CreateProofRequest for application/json ContentType.
+type CreateProofRequestJSONRequestBody = CreateProofRequest
+
 // CreateAuthCredentialJSONRequestBody defines body for CreateAuthCredential for application/json ContentType.
 type CreateAuthCredentialJSONRequestBody = CreateAuthCredentialRequest
 
@@ -1079,6 +1881,9 @@ type CreateLinkQrCodeCallbackTextRequestBody = CreateLinkQrCodeCallbackTextBody
 // ActivateLinkJSONRequestBody defines body for ActivateLink for application/json ContentType.
 type ActivateLinkJSONRequestBody ActivateLinkJSONBody
 
+// CreateCredentialPresentationJSONRequestBody defines body for CreateCredentialPresentation for application/json ContentType.
+type CreateCredentialPresentationJSONRequestBody = CreateCredentialPresentationRequest
+
 // CreateDisplayMethodJSONRequestBody defines body for CreateDisplayMethod for application/json ContentType.
 type CreateDisplayMethodJSONRequestBody = CreateDisplayMethodRequest
 
@@ -1091,6 +1896,15 @@ type CreateKeyJSONRequestBody = CreateKeyRequest
 // UpdateKeyJSONRequestBody defines body for UpdateKey for application/json ContentType.
 type UpdateKeyJSONRequestBody UpdateKeyJSONBody
 
+// CreateOID4VPSessionJSONRequestBody defines body for CreateOID4VPSession for application/json ContentType.
+type CreateOID4VPSessionJSONRequestBody = CreateOID4VPSessionRequest
+
+// RegisterOnchainIssuerJSONRequestBody defines body for RegisterOnchainIssuer for application/json ContentType.
+type RegisterOnchainIssuerJSONRequestBody = RegisterOnchainIssuerRequest
+
+// CreateOnchainCredentialJSONRequestBody defines body for CreateOnchainCredential for application/json ContentType.
+type CreateOnchainCredentialJSONRequestBody = CreateOnchainCredentialRequest
+
 // CreatePaymentRequestJSONRequestBody defines body for CreatePaymentRequest for application/json ContentType.
 type CreatePaymentRequestJSONRequestBody = CreatePaymentRequest
 
@@ -1103,14 +1917,44 @@ type UpdatePaymentOptionJSONRequestBody = UpdatePaymentOptionRequest
 // VerifyPaymentJSONRequestBody defines body for VerifyPayment for application/json ContentType.
 type VerifyPaymentJSONRequestBody = PaymentVerifyRequest
 
+// BuildSchemaJSONRequestBody defines body for BuildSchema for application/json ContentType.
+type BuildSchemaJSONRequestBody = BuildSchemaRequest
+
+// CreateSchemaFamilyJSONRequestBody defines body for CreateSchemaFamily for application/json ContentType.
+type CreateSchemaFamilyJSONRequestBody CreateSchemaFamilyJSONBody
+
+// CreateSchemaMigrationJSONRequestBody defines body for CreateSchemaMigration for application/json ContentType.
+type CreateSchemaMigrationJSONRequestBody CreateSchemaMigrationJSONBody
+
+// AddSchemaFamilyVersionJSONRequestBody defines body for AddSchemaFamilyVersion for application/json ContentType.
+type AddSchemaFamilyVersionJSONRequestBody AddSchemaFamilyVersionJSONBody
+
 // ImportSchemaJSONRequestBody defines body for ImportSchema for application/json ContentType.
 type ImportSchemaJSONRequestBody = ImportSchemaRequest
 
 // UpdateSchemaJSONRequestBody defines body for UpdateSchema for application/json ContentType.
 type UpdateSchemaJSONRequestBody UpdateSchemaJSONBody
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
+// CreateOID4VCICredentialJSONRequestBody defines body for CreateOID4VCICredential for application/json ContentType.
+type CreateOID4VCICredentialJSONRequestBody = OID4VCICredentialRequest
+
+// CreateOID4VCITokenFormdataRequestBody defines body for CreateOID4VCIToken for application/x-www-form-urlencoded ContentType.
+type CreateOID4VCITokenFormdataRequestBody CreateOID4VCITokenFormdataBody
+
+// CreateOID4VPResponseFormdataRequestBody defines body for CreateOID4VPResponse for application/x-www-form-urlencoded ContentType.
+type CreateOID4VPResponseFormdataRequestBody CreateOID4VPResponseFormdataBody
+
+// ProofRequestCallbackTextRequestBody defines body for ProofRequestCallback for text/plain ContentType.
+type ProofRequestCallbackTextRequestBody = ProofRequestCallbackTextBody
+
+// CreateTenantJSONRequestBody defines body for CreateTenant for application/json ContentType.
+type CreateTenantJSONRequestBody = TenantRequest
+
+// UpdateTenantJSONRequestBody defines body for UpdateTenant for application/json ContentType.
+type UpdateTenantJSONRequestBody = TenantRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
 	// Healthcheck
 	// (GET /status)
 	Health(w http.ResponseWriter, r *http.Request)
@@ -1123,6 +1967,15 @@ type ServerInterface interface {
 	// Agent
 	// (POST /v2/agent)
 	Agent(w http.ResponseWriter, r *http.Request)
+	// Get API Keys
+	// (GET /v2/api-keys)
+	GetAPIKeys(w http.ResponseWriter, r *http.Request)
+	// Create API Key
+	// (POST /v2/api-keys)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Revoke API Key
+	// (DELETE /v2/api-keys/{id})
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request, id Id)
 	// Authentication Callback
 	// (POST /v2/authentication/callback)
 	AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams)
@@ -1141,6 +1994,12 @@ type ServerInterface interface {
 	// Update Identity
 	// (PATCH /v2/identities/{identifier})
 	UpdateIdentity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Audit Log
+	// (GET /v2/identities/{identifier}/audit)
+	GetAuditLog(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetAuditLogParams)
+	// Verify Audit Log
+	// (GET /v2/identities/{identifier}/audit/verify)
+	VerifyAuditLog(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2)
 	// Get Connections
 	// (GET /v2/identities/{identifier}/connections)
 	GetConnections(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetConnectionsParams)
@@ -1153,12 +2012,21 @@ type ServerInterface interface {
 	// Get Connection
 	// (GET /v2/identities/{identifier}/connections/{id})
 	GetConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Connection Conversations
+	// (GET /v2/identities/{identifier}/connections/{id}/conversations)
+	GetConnectionConversations(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
 	// Delete Connection Credentials
 	// (DELETE /v2/identities/{identifier}/connections/{id}/credentials)
 	DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
 	// Revoke Connection Credentials
 	// (POST /v2/identities/{identifier}/connections/{id}/credentials/revoke)
 	RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Proof Requests
+	// (GET /v2/identities/{identifier}/connections/{id}/proof-requests)
+	GetProofRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Create Proof Request
+	// (POST /v2/identities/{identifier}/connections/{id}/proof-requests)
+	CreateProofRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
 	// Create Auth Credential
 	// (POST /v2/identities/{identifier}/create-auth-credential)
 	CreateAuthCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2)
@@ -1189,6 +2057,9 @@ type ServerInterface interface {
 	// Create a credential offer for a link
 	// (POST /v2/identities/{identifier}/credentials/links/{id}/offer)
 	CreateLinkOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Create an OpenID4VCI credential offer for a link
+	// (POST /v2/identities/{identifier}/credentials/links/{id}/oid4vci-offer)
+	CreateLinkOID4VCIOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
 	// Get Revocation Status
 	// (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
 	GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce)
@@ -1200,10 +2071,13 @@ type ServerInterface interface {
 	DeleteCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
 	// Get Credential
 	// (GET /v2/identities/{identifier}/credentials/{id})
-	GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
+	GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialParams)
 	// Get Credentials Offer
 	// (GET /v2/identities/{identifier}/credentials/{id}/offer)
 	GetCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialOfferParams)
+	// Create Credential Presentation
+	// (POST /v2/identities/{identifier}/credentials/{id}/presentation)
+	CreateCredentialPresentation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim)
 	// Get All Display Methods
 	// (GET /v2/identities/{identifier}/display-method)
 	GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams)
@@ -1234,6 +2108,21 @@ type ServerInterface interface {
 	// Update a Key
 	// (PATCH /v2/identities/{identifier}/keys/{id})
 	UpdateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID)
+	// Create an OpenID4VP presentation session
+	// (POST /v2/identities/{identifier}/oid4vp/sessions)
+	CreateOID4VPSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get an OpenID4VP presentation session
+	// (GET /v2/identities/{identifier}/oid4vp/sessions/{id})
+	GetOID4VPSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Onchain Issuers
+	// (GET /v2/identities/{identifier}/onchain-issuers)
+	GetOnchainIssuers(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Register Onchain Issuer
+	// (POST /v2/identities/{identifier}/onchain-issuers)
+	RegisterOnchainIssuer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Create Onchain Credential
+	// (POST /v2/identities/{identifier}/onchain-issuers/{onchainIssuer}/credentials)
+	CreateOnchainCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, onchainIssuer string)
 	// Get Payment Requests
 	// (GET /v2/identities/{identifier}/payment-request)
 	GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams)
@@ -1264,6 +2153,27 @@ type ServerInterface interface {
 	// Verify Payment
 	// (POST /v2/identities/{identifier}/payment/verify/{nonce})
 	VerifyPayment(w http.ResponseWriter, r *http.Request, identifier string, nonce string)
+	// Build JSON schema
+	// (POST /v2/identities/{identifier}/schema-builder)
+	BuildSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Schema Families
+	// (GET /v2/identities/{identifier}/schema-families)
+	GetSchemaFamilies(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Create Schema Family
+	// (POST /v2/identities/{identifier}/schema-families)
+	CreateSchemaFamily(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get Schema Family
+	// (GET /v2/identities/{identifier}/schema-families/{id})
+	GetSchemaFamily(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Create Schema Migration
+	// (POST /v2/identities/{identifier}/schema-families/{id}/migrations)
+	CreateSchemaMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Add Schema Family Version
+	// (POST /v2/identities/{identifier}/schema-families/{id}/versions)
+	AddSchemaFamilyVersion(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Get Schema Migration
+	// (GET /v2/identities/{identifier}/schema-migrations/{id})
+	GetSchemaMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
 	// Get Schemas
 	// (GET /v2/identities/{identifier}/schemas)
 	GetSchemas(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetSchemasParams)
@@ -1276,9 +2186,12 @@ type ServerInterface interface {
 	// Update Schema
 	// (PATCH /v2/identities/{identifier}/schemas/{id})
 	UpdateSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
+	// Check Schema Drift
+	// (POST /v2/identities/{identifier}/schemas/{id}/drift)
+	CheckSchemaDrift(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id)
 	// Publish Identity State
 	// (POST /v2/identities/{identifier}/state/publish)
-	PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params PublishIdentityStateParams)
 	// Retry Publish Identity State
 	// (POST /v2/identities/{identifier}/state/retry)
 	RetryPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
@@ -1288,15 +2201,63 @@ type ServerInterface interface {
 	// Get Identity State Transactions
 	// (GET /v2/identities/{identifier}/state/transactions)
 	GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateTransactionsParams)
+	// Get Identity Transaction Costs
+	// (GET /v2/identities/{identifier}/transactions/costs)
+	GetTransactionCosts(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetTransactionCostsParams)
+	// Get OpenID4VCI authorization server metadata
+	// (GET /v2/oid4vci/{identifier}/.well-known/oauth-authorization-server)
+	GetOID4VCIAuthorizationServerMetadata(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get OpenID4VCI issuer metadata
+	// (GET /v2/oid4vci/{identifier}/.well-known/openid-credential-issuer)
+	GetOID4VCIIssuerMetadata(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// OpenID4VCI credential endpoint
+	// (POST /v2/oid4vci/{identifier}/credential)
+	CreateOID4VCICredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateOID4VCICredentialParams)
+	// OpenID4VCI token endpoint
+	// (POST /v2/oid4vci/{identifier}/token)
+	CreateOID4VCIToken(w http.ResponseWriter, r *http.Request, identifier PathIdentifier)
+	// Get the OpenID4VP request object
+	// (GET /v2/oid4vp/sessions/{id}/request)
+	GetOID4VPRequestObject(w http.ResponseWriter, r *http.Request, id Id)
+	// OpenID4VP response endpoint
+	// (POST /v2/oid4vp/sessions/{id}/response)
+	CreateOID4VPResponse(w http.ResponseWriter, r *http.Request, id Id)
 	// Payments Configuration
 	// (GET /v2/payment/settings)
 	GetPaymentSettings(w http.ResponseWriter, r *http.Request)
+	// Proof Request Callback
+	// (POST /v2/proof-requests/callback)
+	ProofRequestCallback(w http.ResponseWriter, r *http.Request, params ProofRequestCallbackParams)
 	// Get QrCode from store
 	// (GET /v2/qr-store)
 	GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams)
+	// Get Built JSON-LD Context
+	// (GET /v2/schemas/{id}/context.jsonld)
+	GetBuiltSchemaContext(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Built JSON Schema
+	// (GET /v2/schemas/{id}/schema.json)
+	GetBuiltSchema(w http.ResponseWriter, r *http.Request, id Id)
+	// Get Status List Credential
+	// (GET /v2/status-lists/{id})
+	GetStatusListCredential(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Supported Networks
 	// (GET /v2/supported-networks)
 	GetSupportedNetworks(w http.ResponseWriter, r *http.Request)
+	// Reload Supported Networks
+	// (POST /v2/supported-networks/reload)
+	ReloadSupportedNetworks(w http.ResponseWriter, r *http.Request)
+	// Get Tenants
+	// (GET /v2/tenants)
+	GetTenants(w http.ResponseWriter, r *http.Request)
+	// Create Tenant
+	// (POST /v2/tenants)
+	CreateTenant(w http.ResponseWriter, r *http.Request)
+	// Get Tenant
+	// (GET /v2/tenants/{id})
+	GetTenant(w http.ResponseWriter, r *http.Request, id Id)
+	// Update Tenant
+	// (PUT /v2/tenants/{id})
+	UpdateTenant(w http.ResponseWriter, r *http.Request, id Id)
 	// Get Authentication Message
 	// (POST /v2/{identifier}/authentication)
 	Authentication(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params AuthenticationParams)
@@ -1330,6 +2291,24 @@ func (_ Unimplemented) Agent(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get API Keys
+// (GET /v2/api-keys)
+func (_ Unimplemented) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create API Key
+// (POST /v2/api-keys)
+func (_ Unimplemented) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Revoke API Key
+// (DELETE /v2/api-keys/{id})
+func (_ Unimplemented) RevokeAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Authentication Callback
 // (POST /v2/authentication/callback)
 func (_ Unimplemented) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
@@ -1366,6 +2345,18 @@ func (_ Unimplemented) UpdateIdentity(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Audit Log
+// (GET /v2/identities/{identifier}/audit)
+func (_ Unimplemented) GetAuditLog(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetAuditLogParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Verify Audit Log
+// (GET /v2/identities/{identifier}/audit/verify)
+func (_ Unimplemented) VerifyAuditLog(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Connections
 // (GET /v2/identities/{identifier}/connections)
 func (_ Unimplemented) GetConnections(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetConnectionsParams) {
@@ -1390,6 +2381,12 @@ func (_ Unimplemented) GetConnection(w http.ResponseWriter, r *http.Request, ide
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Connection Conversations
+// (GET /v2/identities/{identifier}/connections/{id}/conversations)
+func (_ Unimplemented) GetConnectionConversations(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete Connection Credentials
 // (DELETE /v2/identities/{identifier}/connections/{id}/credentials)
 func (_ Unimplemented) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
@@ -1402,6 +2399,18 @@ func (_ Unimplemented) RevokeConnectionCredentials(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Proof Requests
+// (GET /v2/identities/{identifier}/connections/{id}/proof-requests)
+func (_ Unimplemented) GetProofRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Proof Request
+// (POST /v2/identities/{identifier}/connections/{id}/proof-requests)
+func (_ Unimplemented) CreateProofRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Create Auth Credential
 // (POST /v2/identities/{identifier}/create-auth-credential)
 func (_ Unimplemented) CreateAuthCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
@@ -1462,6 +2471,12 @@ func (_ Unimplemented) CreateLinkOffer(w http.ResponseWriter, r *http.Request, i
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create an OpenID4VCI credential offer for a link
+// (POST /v2/identities/{identifier}/credentials/links/{id}/oid4vci-offer)
+func (_ Unimplemented) CreateLinkOID4VCIOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Revocation Status
 // (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
 func (_ Unimplemented) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
@@ -1482,7 +2497,7 @@ func (_ Unimplemented) DeleteCredential(w http.ResponseWriter, r *http.Request,
 
 // Get Credential
 // (GET /v2/identities/{identifier}/credentials/{id})
-func (_ Unimplemented) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+func (_ Unimplemented) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1492,6 +2507,12 @@ func (_ Unimplemented) GetCredentialOffer(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create Credential Presentation
+// (POST /v2/identities/{identifier}/credentials/{id}/presentation)
+func (_ Unimplemented) CreateCredentialPresentation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get All Display Methods
 // (GET /v2/identities/{identifier}/display-method)
 func (_ Unimplemented) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams) {
@@ -1552,6 +2573,36 @@ func (_ Unimplemented) UpdateKey(w http.ResponseWriter, r *http.Request, identif
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Create an OpenID4VP presentation session
+// (POST /v2/identities/{identifier}/oid4vp/sessions)
+func (_ Unimplemented) CreateOID4VPSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get an OpenID4VP presentation session
+// (GET /v2/identities/{identifier}/oid4vp/sessions/{id})
+func (_ Unimplemented) GetOID4VPSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Onchain Issuers
+// (GET /v2/identities/{identifier}/onchain-issuers)
+func (_ Unimplemented) GetOnchainIssuers(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Register Onchain Issuer
+// (POST /v2/identities/{identifier}/onchain-issuers)
+func (_ Unimplemented) RegisterOnchainIssuer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Onchain Credential
+// (POST /v2/identities/{identifier}/onchain-issuers/{onchainIssuer}/credentials)
+func (_ Unimplemented) CreateOnchainCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, onchainIssuer string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Payment Requests
 // (GET /v2/identities/{identifier}/payment-request)
 func (_ Unimplemented) GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams) {
@@ -1612,6 +2663,48 @@ func (_ Unimplemented) VerifyPayment(w http.ResponseWriter, r *http.Request, ide
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Build JSON schema
+// (POST /v2/identities/{identifier}/schema-builder)
+func (_ Unimplemented) BuildSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Schema Families
+// (GET /v2/identities/{identifier}/schema-families)
+func (_ Unimplemented) GetSchemaFamilies(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Schema Family
+// (POST /v2/identities/{identifier}/schema-families)
+func (_ Unimplemented) CreateSchemaFamily(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Schema Family
+// (GET /v2/identities/{identifier}/schema-families/{id})
+func (_ Unimplemented) GetSchemaFamily(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Schema Migration
+// (POST /v2/identities/{identifier}/schema-families/{id}/migrations)
+func (_ Unimplemented) CreateSchemaMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add Schema Family Version
+// (POST /v2/identities/{identifier}/schema-families/{id}/versions)
+func (_ Unimplemented) AddSchemaFamilyVersion(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Schema Migration
+// (GET /v2/identities/{identifier}/schema-migrations/{id})
+func (_ Unimplemented) GetSchemaMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Schemas
 // (GET /v2/identities/{identifier}/schemas)
 func (_ Unimplemented) GetSchemas(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetSchemasParams) {
@@ -1636,9 +2729,15 @@ func (_ Unimplemented) UpdateSchema(w http.ResponseWriter, r *http.Request, iden
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Check Schema Drift
+// (POST /v2/identities/{identifier}/schemas/{id}/drift)
+func (_ Unimplemented) CheckSchemaDrift(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Publish Identity State
 // (POST /v2/identities/{identifier}/state/publish)
-func (_ Unimplemented) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+func (_ Unimplemented) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params PublishIdentityStateParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1660,24 +2759,120 @@ func (_ Unimplemented) GetStateTransactions(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Identity Transaction Costs
+// (GET /v2/identities/{identifier}/transactions/costs)
+func (_ Unimplemented) GetTransactionCosts(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetTransactionCostsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get OpenID4VCI authorization server metadata
+// (GET /v2/oid4vci/{identifier}/.well-known/oauth-authorization-server)
+func (_ Unimplemented) GetOID4VCIAuthorizationServerMetadata(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get OpenID4VCI issuer metadata
+// (GET /v2/oid4vci/{identifier}/.well-known/openid-credential-issuer)
+func (_ Unimplemented) GetOID4VCIIssuerMetadata(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// OpenID4VCI credential endpoint
+// (POST /v2/oid4vci/{identifier}/credential)
+func (_ Unimplemented) CreateOID4VCICredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateOID4VCICredentialParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// OpenID4VCI token endpoint
+// (POST /v2/oid4vci/{identifier}/token)
+func (_ Unimplemented) CreateOID4VCIToken(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the OpenID4VP request object
+// (GET /v2/oid4vp/sessions/{id}/request)
+func (_ Unimplemented) GetOID4VPRequestObject(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// OpenID4VP response endpoint
+// (POST /v2/oid4vp/sessions/{id}/response)
+func (_ Unimplemented) CreateOID4VPResponse(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Payments Configuration
 // (GET /v2/payment/settings)
 func (_ Unimplemented) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Proof Request Callback
+// (POST /v2/proof-requests/callback)
+func (_ Unimplemented) ProofRequestCallback(w http.ResponseWriter, r *http.Request, params ProofRequestCallbackParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get QrCode from store
 // (GET /v2/qr-store)
 func (_ Unimplemented) GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get Built JSON-LD Context
+// (GET /v2/schemas/{id}/context.jsonld)
+func (_ Unimplemented) GetBuiltSchemaContext(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Built JSON Schema
+// (GET /v2/schemas/{id}/schema.json)
+func (_ Unimplemented) GetBuiltSchema(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Status List Credential
+// (GET /v2/status-lists/{id})
+func (_ Unimplemented) GetStatusListCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Supported Networks
 // (GET /v2/supported-networks)
 func (_ Unimplemented) GetSupportedNetworks(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Reload Supported Networks
+// (POST /v2/supported-networks/reload)
+func (_ Unimplemented) ReloadSupportedNetworks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Tenants
+// (GET /v2/tenants)
+func (_ Unimplemented) GetTenants(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create Tenant
+// (POST /v2/tenants)
+func (_ Unimplemented) CreateTenant(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get Tenant
+// (GET /v2/tenants/{id})
+func (_ Unimplemented) GetTenant(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update Tenant
+// (PUT /v2/tenants/{id})
+func (_ Unimplemented) UpdateTenant(w http.ResponseWriter, r *http.Request, id Id) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get Authentication Message
 // (POST /v2/{identifier}/authentication)
 func (_ Unimplemented) Authentication(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params AuthenticationParams) {
@@ -1769,6 +2964,83 @@ func (siw *ServerInterfaceWrapper) Agent(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r)
 }
 
+// GetAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAPIKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeAPIKey(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // AuthCallback operation middleware
 func (siw *ServerInterfaceWrapper) AuthCallback(w http.ResponseWriter, r *http.Request) {
 
@@ -1821,6 +3093,8 @@ func (siw *ServerInterfaceWrapper) GetAuthenticationConnection(w http.ResponseWr
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1841,6 +3115,8 @@ func (siw *ServerInterfaceWrapper) GetIdentities(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1861,6 +3137,8 @@ func (siw *ServerInterfaceWrapper) CreateIdentity(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1892,6 +3170,8 @@ func (siw *ServerInterfaceWrapper) GetIdentityDetails(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1923,6 +3203,8 @@ func (siw *ServerInterfaceWrapper) UpdateIdentity(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -1936,13 +3218,13 @@ func (siw *ServerInterfaceWrapper) UpdateIdentity(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetConnections operation middleware
-func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http.Request) {
+// GetAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditLog(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1954,32 +3236,58 @@ func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetConnectionsParams
+	var params GetAuditLogParams
 
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Optional query parameter "entity" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindQueryParameter("form", true, false, "entity", r.URL.Query(), &params.Entity)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entity", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "credentials" -------------
+	// ------------- Optional query parameter "operation" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "credentials", r.URL.Query(), &params.Credentials)
+	err = runtime.BindQueryParameter("form", true, false, "operation", r.URL.Query(), &params.Operation)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "credentials", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "operation", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "entityId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "entityId", r.URL.Query(), &params.EntityId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor", r.URL.Query(), &params.Actor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
 		return
 	}
 
@@ -1991,16 +3299,16 @@ func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	// ------------- Optional query parameter "page" -------------
 
-	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConnections(w, r, identifier, params)
+		siw.Handler.GetAuditLog(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2010,13 +3318,13 @@ func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// CreateConnection operation middleware
-func (siw *ServerInterfaceWrapper) CreateConnection(w http.ResponseWriter, r *http.Request) {
+// VerifyAuditLog operation middleware
+func (siw *ServerInterfaceWrapper) VerifyAuditLog(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier PathIdentifier2
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -2028,10 +3336,12 @@ func (siw *ServerInterfaceWrapper) CreateConnection(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateConnection(w, r, identifier)
+		siw.Handler.VerifyAuditLog(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2041,8 +3351,8 @@ func (siw *ServerInterfaceWrapper) CreateConnection(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteConnection operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConnection(w http.ResponseWriter, r *http.Request) {
+// GetConnections operation middleware
+func (siw *ServerInterfaceWrapper) GetConnections(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2055,25 +3365,136 @@ func (siw *ServerInterfaceWrapper) DeleteConnection(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteConnectionParams
+	var params GetConnectionsParams
 
-	// ------------- Optional query parameter "revokeCredentials" -------------
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "credentials" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "credentials", r.URL.Query(), &params.Credentials)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "credentials", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_results" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConnections(w, r, identifier, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateConnection operation middleware
+func (siw *ServerInterfaceWrapper) CreateConnection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateConnection(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteConnection operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConnection(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteConnectionParams
+
+	// ------------- Optional query parameter "revokeCredentials" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "revokeCredentials", r.URL.Query(), &params.RevokeCredentials)
 	if err != nil {
@@ -2127,6 +3548,8 @@ func (siw *ServerInterfaceWrapper) GetConnection(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2140,6 +3563,48 @@ func (siw *ServerInterfaceWrapper) GetConnection(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
+// GetConnectionConversations operation middleware
+func (siw *ServerInterfaceWrapper) GetConnectionConversations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConnectionConversations(w, r, identifier, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // DeleteConnectionCredentials operation middleware
 func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request) {
 
@@ -2167,6 +3632,8 @@ func (siw *ServerInterfaceWrapper) DeleteConnectionCredentials(w http.ResponseWr
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2207,6 +3674,8 @@ func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWr
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2220,6 +3689,90 @@ func (siw *ServerInterfaceWrapper) RevokeConnectionCredentials(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
+// GetProofRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetProofRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProofRequests(w, r, identifier, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProofRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateProofRequest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProofRequest(w, r, identifier, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // CreateAuthCredential operation middleware
 func (siw *ServerInterfaceWrapper) CreateAuthCredential(w http.ResponseWriter, r *http.Request) {
 
@@ -2238,6 +3791,8 @@ func (siw *ServerInterfaceWrapper) CreateAuthCredential(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2269,6 +3824,8 @@ func (siw *ServerInterfaceWrapper) GetCredentials(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
@@ -2351,6 +3908,8 @@ func (siw *ServerInterfaceWrapper) CreateCredential(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2382,6 +3941,8 @@ func (siw *ServerInterfaceWrapper) GetLinks(w http.ResponseWriter, r *http.Reque
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
@@ -2432,6 +3993,8 @@ func (siw *ServerInterfaceWrapper) CreateLink(w http.ResponseWriter, r *http.Req
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2515,6 +4078,8 @@ func (siw *ServerInterfaceWrapper) DeleteLink(w http.ResponseWriter, r *http.Req
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2555,6 +4120,8 @@ func (siw *ServerInterfaceWrapper) GetLink(w http.ResponseWriter, r *http.Reques
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2595,6 +4162,8 @@ func (siw *ServerInterfaceWrapper) ActivateLink(w http.ResponseWriter, r *http.R
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2642,8 +4211,8 @@ func (siw *ServerInterfaceWrapper) CreateLinkOffer(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetRevocationStatusV2 operation middleware
-func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request) {
+// CreateLinkOID4VCIOffer operation middleware
+func (siw *ServerInterfaceWrapper) CreateLinkOID4VCIOffer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2656,17 +4225,17 @@ func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce PathNonce
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRevocationStatusV2(w, r, identifier, nonce)
+		siw.Handler.CreateLinkOID4VCIOffer(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2676,8 +4245,42 @@ func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// RevokeCredential operation middleware
-func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
+// GetRevocationStatusV2 operation middleware
+func (siw *ServerInterfaceWrapper) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "nonce" -------------
+	var nonce PathNonce
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRevocationStatusV2(w, r, identifier, nonce)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeCredential operation middleware
+func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2703,6 +4306,8 @@ func (siw *ServerInterfaceWrapper) RevokeCredential(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2743,6 +4348,8 @@ func (siw *ServerInterfaceWrapper) DeleteCredential(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2783,10 +4390,23 @@ func (siw *ServerInterfaceWrapper) GetCredential(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCredentialParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCredential(w, r, identifier, id)
+		siw.Handler.GetCredential(w, r, identifier, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2823,6 +4443,8 @@ func (siw *ServerInterfaceWrapper) GetCredentialOffer(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
@@ -2847,6 +4469,48 @@ func (siw *ServerInterfaceWrapper) GetCredentialOffer(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
+// CreateCredentialPresentation operation middleware
+func (siw *ServerInterfaceWrapper) CreateCredentialPresentation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id PathClaim
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCredentialPresentation(w, r, identifier, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetAllDisplayMethods operation middleware
 func (siw *ServerInterfaceWrapper) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request) {
 
@@ -2865,6 +4529,8 @@ func (siw *ServerInterfaceWrapper) GetAllDisplayMethods(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
@@ -2923,6 +4589,8 @@ func (siw *ServerInterfaceWrapper) CreateDisplayMethod(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -2963,6 +4631,8 @@ func (siw *ServerInterfaceWrapper) DeleteDisplayMethod(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3003,6 +4673,8 @@ func (siw *ServerInterfaceWrapper) GetDisplayMethod(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3043,6 +4715,8 @@ func (siw *ServerInterfaceWrapper) UpdateDisplayMethod(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3074,6 +4748,8 @@ func (siw *ServerInterfaceWrapper) GetKeys(w http.ResponseWriter, r *http.Reques
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
@@ -3132,6 +4808,8 @@ func (siw *ServerInterfaceWrapper) CreateKey(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3172,6 +4850,8 @@ func (siw *ServerInterfaceWrapper) DeleteKey(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3212,6 +4892,8 @@ func (siw *ServerInterfaceWrapper) GetKey(w http.ResponseWriter, r *http.Request
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3252,6 +4934,8 @@ func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -3265,8 +4949,8 @@ func (siw *ServerInterfaceWrapper) UpdateKey(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentRequests operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *http.Request) {
+// CreateOID4VPSession operation middleware
+func (siw *ServerInterfaceWrapper) CreateOID4VPSession(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3283,37 +4967,54 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	r = r.WithContext(ctx)
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPaymentRequestsParams
+	r = r.WithContext(ctx)
 
-	// ------------- Optional query parameter "userDID" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOID4VPSession(w, r, identifier)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "userDID", r.URL.Query(), &params.UserDID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userDID", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "schemaID" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "schemaID", r.URL.Query(), &params.SchemaID)
+// GetOID4VPSession operation middleware
+func (siw *ServerInterfaceWrapper) GetOID4VPSession(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "nonce" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "nonce", r.URL.Query(), &params.Nonce)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentRequests(w, r, identifier, params)
+		siw.Handler.GetOID4VPSession(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3323,8 +5024,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// CreatePaymentRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r *http.Request) {
+// GetOnchainIssuers operation middleware
+func (siw *ServerInterfaceWrapper) GetOnchainIssuers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3341,10 +5042,12 @@ func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePaymentRequest(w, r, identifier)
+		siw.Handler.GetOnchainIssuers(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3354,8 +5057,8 @@ func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeletePaymentRequest operation middleware
-func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r *http.Request) {
+// RegisterOnchainIssuer operation middleware
+func (siw *ServerInterfaceWrapper) RegisterOnchainIssuer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3368,23 +5071,16 @@ func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePaymentRequest(w, r, identifier, id)
+		siw.Handler.RegisterOnchainIssuer(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3394,8 +5090,8 @@ func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentRequest operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *http.Request) {
+// CreateOnchainCredential operation middleware
+func (siw *ServerInterfaceWrapper) CreateOnchainCredential(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3408,12 +5104,12 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *h
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// ------------- Path parameter "onchainIssuer" -------------
+	var onchainIssuer string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "onchainIssuer", chi.URLParam(r, "onchainIssuer"), &onchainIssuer, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "onchainIssuer", Err: err})
 		return
 	}
 
@@ -3421,10 +5117,12 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *h
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentRequest(w, r, identifier, id)
+		siw.Handler.CreateOnchainCredential(w, r, identifier, onchainIssuer)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3434,8 +5132,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentOptions operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *http.Request) {
+// GetPaymentRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentRequests(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3452,10 +5150,39 @@ func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *h
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPaymentRequestsParams
+
+	// ------------- Optional query parameter "userDID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userDID", r.URL.Query(), &params.UserDID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userDID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "schemaID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "schemaID", r.URL.Query(), &params.SchemaID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "schemaID", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "nonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nonce", r.URL.Query(), &params.Nonce)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentOptions(w, r, identifier)
+		siw.Handler.GetPaymentRequests(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3465,8 +5192,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// CreatePaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r *http.Request) {
+// CreatePaymentRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreatePaymentRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3483,10 +5210,12 @@ func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePaymentOption(w, r, identifier)
+		siw.Handler.CreatePaymentRequest(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3496,8 +5225,8 @@ func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// DeletePaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r *http.Request) {
+// DeletePaymentRequest operation middleware
+func (siw *ServerInterfaceWrapper) DeletePaymentRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3523,10 +5252,12 @@ func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePaymentOption(w, r, identifier, id)
+		siw.Handler.DeletePaymentRequest(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3536,8 +5267,8 @@ func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *http.Request) {
+// GetPaymentRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentRequest(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3563,10 +5294,12 @@ func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *ht
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentOption(w, r, identifier, id)
+		siw.Handler.GetPaymentRequest(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3576,8 +5309,8 @@ func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// UpdatePaymentOption operation middleware
-func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r *http.Request) {
+// GetPaymentOptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentOptions(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3590,23 +5323,16 @@ func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdatePaymentOption(w, r, identifier, id)
+		siw.Handler.GetPaymentOptions(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3616,13 +5342,13 @@ func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// VerifyPayment operation middleware
-func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.Request) {
+// CreatePaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) CreatePaymentOption(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier string
+	var identifier PathIdentifier
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3630,23 +5356,16 @@ func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "nonce" -------------
-	var nonce string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.VerifyPayment(w, r, identifier, nonce)
+		siw.Handler.CreatePaymentOption(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3656,8 +5375,8 @@ func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetSchemas operation middleware
-func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
+// DeletePaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) DeletePaymentOption(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3670,25 +5389,25 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSchemasParams
-
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchemas(w, r, identifier, params)
+		siw.Handler.DeletePaymentOption(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3698,8 +5417,8 @@ func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// ImportSchema operation middleware
-func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
+// GetPaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentOption(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3712,14 +5431,25 @@ func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ImportSchema(w, r, identifier)
+		siw.Handler.GetPaymentOption(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3729,8 +5459,8 @@ func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetSchema operation middleware
-func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
+// UpdatePaymentOption operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePaymentOption(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3756,10 +5486,12 @@ func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Requ
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSchema(w, r, identifier, id)
+		siw.Handler.UpdatePaymentOption(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3769,13 +5501,13 @@ func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateSchema operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.Request) {
+// VerifyPayment operation middleware
+func (siw *ServerInterfaceWrapper) VerifyPayment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "identifier" -------------
-	var identifier PathIdentifier
+	var identifier string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -3783,12 +5515,12 @@ func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Path parameter "id" -------------
-	var id Id
+	// ------------- Path parameter "nonce" -------------
+	var nonce string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "nonce", chi.URLParam(r, "nonce"), &nonce, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nonce", Err: err})
 		return
 	}
 
@@ -3796,10 +5528,12 @@ func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.R
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSchema(w, r, identifier, id)
+		siw.Handler.VerifyPayment(w, r, identifier, nonce)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3809,8 +5543,8 @@ func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// PublishIdentityState operation middleware
-func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
+// BuildSchema operation middleware
+func (siw *ServerInterfaceWrapper) BuildSchema(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3827,10 +5561,12 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PublishIdentityState(w, r, identifier)
+		siw.Handler.BuildSchema(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3840,8 +5576,8 @@ func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// RetryPublishState operation middleware
-func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+// GetSchemaFamilies operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaFamilies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3858,10 +5594,12 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RetryPublishState(w, r, identifier)
+		siw.Handler.GetSchemaFamilies(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3871,8 +5609,8 @@ func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetStateStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
+// CreateSchemaFamily operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchemaFamily(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3889,10 +5627,12 @@ func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateStatus(w, r, identifier)
+		siw.Handler.CreateSchemaFamily(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3902,8 +5642,8 @@ func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetStateTransactions operation middleware
-func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+// GetSchemaFamily operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaFamily(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3916,49 +5656,109 @@ func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetStateTransactionsParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSchemaFamily(w, r, identifier, id)
+	}))
 
-	// ------------- Optional query parameter "filter" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSchemaMigration operation middleware
+func (siw *ServerInterfaceWrapper) CreateSchemaMigration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "max_results" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSchemaMigration(w, r, identifier, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddSchemaFamilyVersion operation middleware
+func (siw *ServerInterfaceWrapper) AddSchemaFamilyVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStateTransactions(w, r, identifier, params)
+		siw.Handler.AddSchemaFamilyVersion(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3968,17 +5768,39 @@ func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// GetPaymentSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
+// GetSchemaMigration operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemaMigration(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPaymentSettings(w, r)
+		siw.Handler.GetSchemaMigration(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3988,32 +5810,41 @@ func (siw *ServerInterfaceWrapper) GetPaymentSettings(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetQrFromStore operation middleware
-func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http.Request) {
+// GetSchemas operation middleware
+func (siw *ServerInterfaceWrapper) GetSchemas(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetQrFromStoreParams
-
-	// ------------- Optional query parameter "id" -------------
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "issuer" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "issuer", r.URL.Query(), &params.Issuer)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSchemasParams
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "issuer", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetQrFromStore(w, r, params)
+		siw.Handler.GetSchemas(w, r, identifier, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4023,17 +5854,30 @@ func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// GetSupportedNetworks operation middleware
-func (siw *ServerInterfaceWrapper) GetSupportedNetworks(w http.ResponseWriter, r *http.Request) {
+// ImportSchema operation middleware
+func (siw *ServerInterfaceWrapper) ImportSchema(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSupportedNetworks(w, r)
+		siw.Handler.ImportSchema(w, r, identifier)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4043,8 +5887,8 @@ func (siw *ServerInterfaceWrapper) GetSupportedNetworks(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// Authentication operation middleware
-func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http.Request) {
+// GetSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetSchema(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4057,19 +5901,25 @@ func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params AuthenticationParams
-
-	// ------------- Optional query parameter "type" -------------
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Authentication(w, r, identifier, params)
+		siw.Handler.GetSchema(w, r, identifier, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4079,3257 +5929,7372 @@ func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// UpdateSchema operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSchema(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	ctx := r.Context()
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	r = r.WithContext(ctx)
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSchema(w, r, identifier, id)
+	}))
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+	handler.ServeHTTP(w, r)
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+// CheckSchemaDrift operation middleware
+func (siw *ServerInterfaceWrapper) CheckSchemaDrift(w http.ResponseWriter, r *http.Request) {
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	var err error
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// ------------- Path parameter "id" -------------
+	var id Id
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckSchemaDrift(w, r, identifier, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+// PublishIdentityState operation middleware
+func (siw *ServerInterfaceWrapper) PublishIdentityState(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PublishIdentityStateParams
+
+	// ------------- Optional query parameter "urgent" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "urgent", r.URL.Query(), &params.Urgent)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "urgent", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishIdentityState(w, r, identifier, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+// RetryPublishState operation middleware
+func (siw *ServerInterfaceWrapper) RetryPublishState(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryPublishState(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+// GetStateStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStateStatus(w http.ResponseWriter, r *http.Request) {
 
-	if r == nil {
-		r = chi.NewRouter()
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateStatus(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStateTransactions operation middleware
+func (siw *ServerInterfaceWrapper) GetStateTransactions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
+
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/status", wrapper.Health)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v1/agent", wrapper.AgentV1)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v1/{identifier}/claims/revocation/status/{nonce}", wrapper.GetRevocationStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/agent", wrapper.Agent)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/authentication/callback", wrapper.AuthCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/authentication/sessions/{id}", wrapper.GetAuthenticationConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities", wrapper.GetIdentities)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities", wrapper.CreateIdentity)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}", wrapper.GetIdentityDetails)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}", wrapper.UpdateIdentity)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.GetConnections)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.CreateConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.DeleteConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.GetConnection)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/create-auth-credential", wrapper.CreateAuthCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.GetCredentials)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.CreateCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.GetLinks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.CreateLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.DeleteLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.GetLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.ActivateLink)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}/offer", wrapper.CreateLinkOffer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatusV2)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/revoke/{nonce}", wrapper.RevokeCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.DeleteCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.GetCredential)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}/offer", wrapper.GetCredentialOffer)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.GetAllDisplayMethods)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.CreateDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.DeleteDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.GetDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.UpdateDisplayMethod)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.GetKeys)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.CreateKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.DeleteKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.GetKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.UpdateKey)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.GetPaymentRequests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.CreatePaymentRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.DeletePaymentRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.GetPaymentRequest)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.GetPaymentOptions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.CreatePaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.DeletePaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.GetPaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.UpdatePaymentOption)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/verify/{nonce}", wrapper.VerifyPayment)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.GetSchemas)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.ImportSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.GetSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.UpdateSchema)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/publish", wrapper.PublishIdentityState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/retry", wrapper.RetryPublishState)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/status", wrapper.GetStateStatus)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/transactions", wrapper.GetStateTransactions)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/payment/settings", wrapper.GetPaymentSettings)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/qr-store", wrapper.GetQrFromStore)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/v2/supported-networks", wrapper.GetSupportedNetworks)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/v2/{identifier}/authentication", wrapper.Authentication)
-	})
+	ctx := r.Context()
 
-	return r
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type N400JSONResponse GenericErrorMessage
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-type N401JSONResponse GenericErrorMessage
+	r = r.WithContext(ctx)
 
-type N403JSONResponse GenericErrorMessage
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStateTransactionsParams
 
-type N404JSONResponse GenericErrorMessage
+	// ------------- Optional query parameter "filter" -------------
 
-type N409JSONResponse GenericErrorMessage
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
 
-type N410JSONResponse GenericErrorMessage
+	// ------------- Optional query parameter "page" -------------
 
-type N422JSONResponse GenericErrorMessage
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
 
-type N500JSONResponse GenericErrorMessage
+	// ------------- Optional query parameter "max_results" -------------
 
-type N500CreateIdentityJSONResponse struct {
-	Code      *int    `json:"code,omitempty"`
-	Error     *string `json:"error,omitempty"`
-	RequestID *string `json:"requestID,omitempty"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "max_results", r.URL.Query(), &params.MaxResults)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_results", Err: err})
+		return
+	}
 
-type HealthRequestObject struct {
-}
+	// ------------- Optional query parameter "sort" -------------
 
-type HealthResponseObject interface {
-	VisitHealthResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", false, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
 
-type Health200JSONResponse Health
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStateTransactions(w, r, identifier, params)
+	}))
 
-func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type Health500JSONResponse struct{ N500JSONResponse }
-
-func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetTransactionCosts operation middleware
+func (siw *ServerInterfaceWrapper) GetTransactionCosts(w http.ResponseWriter, r *http.Request) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type AgentV1RequestObject struct {
-	Body *AgentV1TextRequestBody
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type AgentV1ResponseObject interface {
-	VisitAgentV1Response(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type AgentV1200JSONResponse AgentResponse
+	ctx := r.Context()
 
-func (response AgentV1200JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-type AgentV1400JSONResponse struct{ N400JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response AgentV1400JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionCostsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "from" -------------
 
-type AgentV1500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
 
-func (response AgentV1500JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Optional query parameter "to" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-type GetRevocationStatusRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
-}
+	// ------------- Optional query parameter "network" -------------
 
-type GetRevocationStatusResponseObject interface {
-	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, false, "network", r.URL.Query(), &params.Network)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "network", Err: err})
+		return
+	}
 
-type GetRevocationStatus200JSONResponse RevocationStatusResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTransactionCosts(w, r, identifier, params)
+	}))
 
-func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+// GetOID4VCIAuthorizationServerMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetOID4VCIAuthorizationServerMetadata(w http.ResponseWriter, r *http.Request) {
 
-func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOID4VCIAuthorizationServerMetadata(w, r, identifier)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type AgentRequestObject struct {
-	Body *AgentTextRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type AgentResponseObject interface {
-	VisitAgentResponse(w http.ResponseWriter) error
-}
+// GetOID4VCIIssuerMetadata operation middleware
+func (siw *ServerInterfaceWrapper) GetOID4VCIIssuerMetadata(w http.ResponseWriter, r *http.Request) {
 
-type Agent200JSONResponse AgentResponse
+	var err error
 
-func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type Agent400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOID4VCIIssuerMetadata(w, r, identifier)
+	}))
 
-func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type Agent500JSONResponse struct{ N500JSONResponse }
+// CreateOID4VCICredential operation middleware
+func (siw *ServerInterfaceWrapper) CreateOID4VCICredential(w http.ResponseWriter, r *http.Request) {
 
-func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type AuthCallbackRequestObject struct {
-	Params AuthCallbackParams
-	Body   *AuthCallbackTextRequestBody
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-type AuthCallbackResponseObject interface {
-	VisitAuthCallbackResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateOID4VCICredentialParams
 
-type AuthCallback200Response struct {
-}
+	headers := r.Header
 
-func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
+	// ------------- Optional header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
 
-type AuthCallback400JSONResponse struct{ N400JSONResponse }
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
 
-func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+		params.Authorization = &Authorization
 
-	return json.NewEncoder(w).Encode(response)
-}
+	}
 
-type AuthCallback500JSONResponse struct{ N500JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOID4VCICredential(w, r, identifier, params)
+	}))
 
-func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetAuthenticationConnectionRequestObject struct {
-	Id Id `json:"id"`
-}
+// CreateOID4VCIToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateOID4VCIToken(w http.ResponseWriter, r *http.Request) {
 
-type GetAuthenticationConnectionResponseObject interface {
-	VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type GetAuthenticationConnection200JSONResponse GetAuthenticationConnectionResponse
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-func (response GetAuthenticationConnection200JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOID4VCIToken(w, r, identifier)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetAuthenticationConnection400JSONResponse struct{ N400JSONResponse }
+// GetOID4VPRequestObject operation middleware
+func (siw *ServerInterfaceWrapper) GetOID4VPRequestObject(w http.ResponseWriter, r *http.Request) {
 
-func (response GetAuthenticationConnection400JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetAuthenticationConnection401JSONResponse struct{ N401JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetAuthenticationConnection401JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOID4VPRequestObject(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetAuthenticationConnection404JSONResponse struct{ N404JSONResponse }
+// CreateOID4VPResponse operation middleware
+func (siw *ServerInterfaceWrapper) CreateOID4VPResponse(w http.ResponseWriter, r *http.Request) {
 
-func (response GetAuthenticationConnection404JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetAuthenticationConnection500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetAuthenticationConnection500JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOID4VPResponse(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetIdentitiesRequestObject struct {
+	handler.ServeHTTP(w, r)
 }
 
-type GetIdentitiesResponseObject interface {
-	VisitGetIdentitiesResponse(w http.ResponseWriter) error
-}
+// GetPaymentSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
 
-type GetIdentities200JSONResponse []GetIdentitiesResponse
+	ctx := r.Context()
 
-func (response GetIdentities200JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-type GetIdentities401JSONResponse struct{ N401JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response GetIdentities401JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPaymentSettings(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type GetIdentities500JSONResponse struct{ N500JSONResponse }
+// ProofRequestCallback operation middleware
+func (siw *ServerInterfaceWrapper) ProofRequestCallback(w http.ResponseWriter, r *http.Request) {
 
-func (response GetIdentities500JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ProofRequestCallbackParams
 
-type CreateIdentityRequestObject struct {
-	Body *CreateIdentityJSONRequestBody
-}
+	// ------------- Required query parameter "id" -------------
 
-type CreateIdentityResponseObject interface {
-	VisitCreateIdentityResponse(w http.ResponseWriter) error
-}
+	if paramValue := r.URL.Query().Get("id"); paramValue != "" {
 
-type CreateIdentity201JSONResponse CreateIdentityResponse
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "id"})
+		return
+	}
 
-func (response CreateIdentity201JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindQueryParameter("form", true, true, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProofRequestCallback(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIdentity400JSONResponse struct{ N400JSONResponse }
+// GetQrFromStore operation middleware
+func (siw *ServerInterfaceWrapper) GetQrFromStore(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateIdentity400JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQrFromStoreParams
 
-type CreateIdentity401JSONResponse struct{ N401JSONResponse }
+	// ------------- Optional query parameter "id" -------------
 
-func (response CreateIdentity401JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "issuer" -------------
 
-type CreateIdentity403JSONResponse struct{ N403JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "issuer", r.URL.Query(), &params.Issuer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "issuer", Err: err})
+		return
+	}
 
-func (response CreateIdentity403JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetQrFromStore(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type CreateIdentity409JSONResponse struct{ N409JSONResponse }
+// GetBuiltSchemaContext operation middleware
+func (siw *ServerInterfaceWrapper) GetBuiltSchemaContext(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateIdentity409JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type CreateIdentity500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CreateIdentity500JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuiltSchemaContext(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetIdentityDetailsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
+	handler.ServeHTTP(w, r)
 }
 
-type GetIdentityDetailsResponseObject interface {
-	VisitGetIdentityDetailsResponse(w http.ResponseWriter) error
-}
+// GetBuiltSchema operation middleware
+func (siw *ServerInterfaceWrapper) GetBuiltSchema(w http.ResponseWriter, r *http.Request) {
 
-type GetIdentityDetails200JSONResponse GetIdentityDetailsResponse
+	var err error
 
-func (response GetIdentityDetails200JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-type GetIdentityDetails400JSONResponse struct{ N400JSONResponse }
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBuiltSchema(w, r, id)
+	}))
 
-func (response GetIdentityDetails400JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetIdentityDetails401JSONResponse struct{ N401JSONResponse }
+// GetStatusListCredential operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusListCredential(w http.ResponseWriter, r *http.Request) {
 
-func (response GetIdentityDetails401JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type GetIdentityDetails500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response GetIdentityDetails500JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusListCredential(w, r, id)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UpdateIdentityRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *UpdateIdentityJSONRequestBody
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateIdentityResponseObject interface {
-	VisitUpdateIdentityResponse(w http.ResponseWriter) error
-}
+// GetSupportedNetworks operation middleware
+func (siw *ServerInterfaceWrapper) GetSupportedNetworks(w http.ResponseWriter, r *http.Request) {
 
-type UpdateIdentity200JSONResponse GenericMessage
+	ctx := r.Context()
 
-func (response UpdateIdentity200JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-type UpdateIdentity400JSONResponse struct{ N400JSONResponse }
+	r = r.WithContext(ctx)
 
-func (response UpdateIdentity400JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSupportedNetworks(w, r)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateIdentity401JSONResponse struct{ N401JSONResponse }
+// ReloadSupportedNetworks operation middleware
+func (siw *ServerInterfaceWrapper) ReloadSupportedNetworks(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateIdentity401JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type UpdateIdentity403JSONResponse struct{ N403JSONResponse }
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-func (response UpdateIdentity403JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReloadSupportedNetworks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type UpdateIdentity500JSONResponse struct{ N500CreateIdentityJSONResponse }
+// GetTenants operation middleware
+func (siw *ServerInterfaceWrapper) GetTenants(w http.ResponseWriter, r *http.Request) {
 
-func (response UpdateIdentity500JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetConnectionsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetConnectionsParams
-}
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-type GetConnectionsResponseObject interface {
-	VisitGetConnectionsResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type GetConnections200JSONResponse ConnectionsPaginated
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenants(w, r)
+	}))
 
-func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetConnections400JSONResponse struct{ N400JSONResponse }
+// CreateTenant operation middleware
+func (siw *ServerInterfaceWrapper) CreateTenant(w http.ResponseWriter, r *http.Request) {
 
-func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type GetConnections500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTenant(w, r)
+	}))
 
-type CreateConnectionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateConnectionJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type CreateConnectionResponseObject interface {
-	VisitCreateConnectionResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type CreateConnection201JSONResponse GenericMessage
+// GetTenant operation middleware
+func (siw *ServerInterfaceWrapper) GetTenant(w http.ResponseWriter, r *http.Request) {
 
-func (response CreateConnection201JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type CreateConnection400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response CreateConnection400JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type CreateConnection500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-func (response CreateConnection500JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTenant(w, r, id)
+	}))
 
-type DeleteConnectionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Params     DeleteConnectionParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteConnectionResponseObject interface {
-	VisitDeleteConnectionResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteConnection200JSONResponse GenericMessage
+// UpdateTenant operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTenant(w http.ResponseWriter, r *http.Request) {
 
-func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "id" -------------
+	var id Id
 
-type DeleteConnection400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
 
-func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BasicAuthScopes, []string{})
 
-type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+	ctx = context.WithValue(ctx, ApiKeyScopes, []string{})
 
-func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	r = r.WithContext(ctx)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTenant(w, r, id)
+	}))
 
-type GetConnectionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetConnectionResponseObject interface {
-	VisitGetConnectionResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r)
 }
 
-type GetConnection200JSONResponse GetConnectionResponse
+// Authentication operation middleware
+func (siw *ServerInterfaceWrapper) Authentication(w http.ResponseWriter, r *http.Request) {
 
-func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "identifier" -------------
+	var identifier PathIdentifier
 
-type GetConnection400JSONResponse struct{ N400JSONResponse }
+	err = runtime.BindStyledParameterWithOptions("simple", "identifier", chi.URLParam(r, "identifier"), &identifier, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "identifier", Err: err})
+		return
+	}
 
-func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AuthenticationParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "type" -------------
 
-type GetConnection500JSONResponse struct{ N500JSONResponse }
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
 
-func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Authentication(w, r, identifier, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type DeleteConnectionCredentialsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+	handler.ServeHTTP(w, r)
 }
 
-type DeleteConnectionCredentialsResponseObject interface {
-	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type DeleteConnectionCredentials200JSONResponse GenericMessage
-
-func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type DeleteConnectionCredentials400JSONResponse struct{ N400JSONResponse }
-
-func (response DeleteConnectionCredentials400JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
-
-func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type RevokeConnectionCredentialsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type RevokeConnectionCredentialsResponseObject interface {
-	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type RevokeConnectionCredentials202JSONResponse GenericMessage
-
-func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
-
-	return json.NewEncoder(w).Encode(response)
+type RequiredParamError struct {
+	ParamName string
 }
 
-type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
-
-func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
-
-func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-type CreateAuthCredentialRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Body       *CreateAuthCredentialJSONRequestBody
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
 }
 
-type CreateAuthCredentialResponseObject interface {
-	VisitCreateAuthCredentialResponse(w http.ResponseWriter) error
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-type CreateAuthCredential201JSONResponse struct {
-	// Id The ID of the created Auth Credential
-	Id uuid.UUID `json:"id"`
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
 }
 
-func (response CreateAuthCredential201JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type CreateAuthCredential400JSONResponse struct{ N400JSONResponse }
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
 
-func (response CreateAuthCredential400JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status", wrapper.Health)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v1/agent", wrapper.AgentV1)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v1/{identifier}/claims/revocation/status/{nonce}", wrapper.GetRevocationStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/agent", wrapper.Agent)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/api-keys", wrapper.GetAPIKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/api-keys", wrapper.CreateAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/api-keys/{id}", wrapper.RevokeAPIKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/authentication/callback", wrapper.AuthCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/authentication/sessions/{id}", wrapper.GetAuthenticationConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities", wrapper.GetIdentities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities", wrapper.CreateIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}", wrapper.GetIdentityDetails)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}", wrapper.UpdateIdentity)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/audit", wrapper.GetAuditLog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/audit/verify", wrapper.VerifyAuditLog)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.GetConnections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections", wrapper.CreateConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.DeleteConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections/{id}", wrapper.GetConnection)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/conversations", wrapper.GetConnectionConversations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials", wrapper.DeleteConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/credentials/revoke", wrapper.RevokeConnectionCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/proof-requests", wrapper.GetProofRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/connections/{id}/proof-requests", wrapper.CreateProofRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/create-auth-credential", wrapper.CreateAuthCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.GetCredentials)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials", wrapper.CreateCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.GetLinks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links", wrapper.CreateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/callback", wrapper.CreateLinkQrCodeCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.DeleteLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.GetLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}", wrapper.ActivateLink)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}/offer", wrapper.CreateLinkOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/links/{id}/oid4vci-offer", wrapper.CreateLinkOID4VCIOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/revocation/status/{nonce}", wrapper.GetRevocationStatusV2)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/revoke/{nonce}", wrapper.RevokeCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.DeleteCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}", wrapper.GetCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}/offer", wrapper.GetCredentialOffer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/credentials/{id}/presentation", wrapper.CreateCredentialPresentation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.GetAllDisplayMethods)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/display-method", wrapper.CreateDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.DeleteDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.GetDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/display-method/{id}", wrapper.UpdateDisplayMethod)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.GetKeys)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/keys", wrapper.CreateKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.DeleteKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.GetKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/keys/{id}", wrapper.UpdateKey)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/oid4vp/sessions", wrapper.CreateOID4VPSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/oid4vp/sessions/{id}", wrapper.GetOID4VPSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/onchain-issuers", wrapper.GetOnchainIssuers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/onchain-issuers", wrapper.RegisterOnchainIssuer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/onchain-issuers/{onchainIssuer}/credentials", wrapper.CreateOnchainCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.GetPaymentRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment-request", wrapper.CreatePaymentRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.DeletePaymentRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment-request/{id}", wrapper.GetPaymentRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.GetPaymentOptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/options", wrapper.CreatePaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.DeletePaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.GetPaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/payment/options/{id}", wrapper.UpdatePaymentOption)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/payment/verify/{nonce}", wrapper.VerifyPayment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/schema-builder", wrapper.BuildSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/schema-families", wrapper.GetSchemaFamilies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/schema-families", wrapper.CreateSchemaFamily)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/schema-families/{id}", wrapper.GetSchemaFamily)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/schema-families/{id}/migrations", wrapper.CreateSchemaMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/schema-families/{id}/versions", wrapper.AddSchemaFamilyVersion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/schema-migrations/{id}", wrapper.GetSchemaMigration)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.GetSchemas)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/schemas", wrapper.ImportSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.GetSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}", wrapper.UpdateSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/schemas/{id}/drift", wrapper.CheckSchemaDrift)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/publish", wrapper.PublishIdentityState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/identities/{identifier}/state/retry", wrapper.RetryPublishState)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/status", wrapper.GetStateStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/state/transactions", wrapper.GetStateTransactions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/identities/{identifier}/transactions/costs", wrapper.GetTransactionCosts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/oid4vci/{identifier}/.well-known/oauth-authorization-server", wrapper.GetOID4VCIAuthorizationServerMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/oid4vci/{identifier}/.well-known/openid-credential-issuer", wrapper.GetOID4VCIIssuerMetadata)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/oid4vci/{identifier}/credential", wrapper.CreateOID4VCICredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/oid4vci/{identifier}/token", wrapper.CreateOID4VCIToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/oid4vp/sessions/{id}/request", wrapper.GetOID4VPRequestObject)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/oid4vp/sessions/{id}/response", wrapper.CreateOID4VPResponse)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/payment/settings", wrapper.GetPaymentSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/proof-requests/callback", wrapper.ProofRequestCallback)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/qr-store", wrapper.GetQrFromStore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/schemas/{id}/context.jsonld", wrapper.GetBuiltSchemaContext)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/schemas/{id}/schema.json", wrapper.GetBuiltSchema)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/status-lists/{id}", wrapper.GetStatusListCredential)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/supported-networks", wrapper.GetSupportedNetworks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/supported-networks/reload", wrapper.ReloadSupportedNetworks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tenants", wrapper.GetTenants)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/tenants", wrapper.CreateTenant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/v2/tenants/{id}", wrapper.GetTenant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/v2/tenants/{id}", wrapper.UpdateTenant)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/v2/{identifier}/authentication", wrapper.Authentication)
+	})
+
+	return r
+}
+
+type N400JSONResponse GenericErrorMessage
+
+type N401JSONResponse GenericErrorMessage
+
+type N403JSONResponse GenericErrorMessage
+
+type N404JSONResponse GenericErrorMessage
+
+type N409JSONResponse GenericErrorMessage
+
+type N410JSONResponse GenericErrorMessage
+
+type N422JSONResponse GenericErrorMessage
+
+type N429ResponseHeaders struct {
+	RetryAfter int
+}
+type N429JSONResponse struct {
+	Body GenericErrorMessage
+
+	Headers N429ResponseHeaders
+}
+
+type N500JSONResponse GenericErrorMessage
+
+type N500CreateIdentityJSONResponse struct {
+	Code      *int    `json:"code,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	RequestID *string `json:"requestID,omitempty"`
+}
+
+type HealthRequestObject struct {
+}
+
+type HealthResponseObject interface {
+	VisitHealthResponse(w http.ResponseWriter) error
+}
+
+type Health200JSONResponse Health
+
+func (response Health200JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Health500JSONResponse struct{ N500JSONResponse }
+
+func (response Health500JSONResponse) VisitHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentV1RequestObject struct {
+	Body *AgentV1TextRequestBody
+}
+
+type AgentV1ResponseObject interface {
+	VisitAgentV1Response(w http.ResponseWriter) error
+}
+
+type AgentV1200JSONResponse AgentResponse
+
+func (response AgentV1200JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentV1400JSONResponse struct{ N400JSONResponse }
+
+func (response AgentV1400JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentV1429JSONResponse struct{ N429JSONResponse }
+
+func (response AgentV1429JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AgentV1500JSONResponse struct{ N500JSONResponse }
+
+func (response AgentV1500JSONResponse) VisitAgentV1Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type GetRevocationStatusResponseObject interface {
+	VisitGetRevocationStatusResponse(w http.ResponseWriter) error
+}
+
+type GetRevocationStatus200JSONResponse RevocationStatusResponse
+
+func (response GetRevocationStatus200JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatus400JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatus429JSONResponse struct{ N429JSONResponse }
+
+func (response GetRevocationStatus429JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRevocationStatus500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatus500JSONResponse) VisitGetRevocationStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AgentRequestObject struct {
+	Body *AgentTextRequestBody
+}
+
+type AgentResponseObject interface {
+	VisitAgentResponse(w http.ResponseWriter) error
+}
+
+type Agent200Applicationiden3commEncryptedJsonResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response Agent200Applicationiden3commEncryptedJsonResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/iden3comm-encrypted-json")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type Agent200JSONResponse AgentResponse
+
+func (response Agent200JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent202Response struct {
+}
+
+func (response Agent202Response) VisitAgentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type Agent400JSONResponse struct{ N400JSONResponse }
+
+func (response Agent400JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type Agent429JSONResponse struct{ N429JSONResponse }
+
+func (response Agent429JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Agent500JSONResponse struct{ N500JSONResponse }
+
+func (response Agent500JSONResponse) VisitAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeysRequestObject struct {
+}
+
+type GetAPIKeysResponseObject interface {
+	VisitGetAPIKeysResponse(w http.ResponseWriter) error
+}
+
+type GetAPIKeys200JSONResponse []APIKey
+
+func (response GetAPIKeys200JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeys401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAPIKeys401JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAPIKeys500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAPIKeys500JSONResponse) VisitGetAPIKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKeyRequestObject struct {
+	Body *CreateAPIKeyJSONRequestBody
+}
+
+type CreateAPIKeyResponseObject interface {
+	VisitCreateAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateAPIKey201JSONResponse CreateAPIKeyResponse
+
+func (response CreateAPIKey201JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAPIKey400JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateAPIKey401JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateAPIKey500JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKeyRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type RevokeAPIKeyResponseObject interface {
+	VisitRevokeAPIKeyResponse(w http.ResponseWriter) error
+}
+
+type RevokeAPIKey200JSONResponse GenericMessage
+
+func (response RevokeAPIKey200JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeAPIKey401JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeAPIKey404JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeAPIKey500JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallbackRequestObject struct {
+	Params AuthCallbackParams
+	Body   *AuthCallbackTextRequestBody
+}
+
+type AuthCallbackResponseObject interface {
+	VisitAuthCallbackResponse(w http.ResponseWriter) error
+}
+
+type AuthCallback200Response struct {
+}
+
+func (response AuthCallback200Response) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type AuthCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response AuthCallback400JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthCallback429JSONResponse struct{ N429JSONResponse }
+
+func (response AuthCallback429JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type AuthCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response AuthCallback500JSONResponse) VisitAuthCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnectionRequestObject struct {
+	Id Id `json:"id"`
+}
+
+type GetAuthenticationConnectionResponseObject interface {
+	VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetAuthenticationConnection200JSONResponse GetAuthenticationConnectionResponse
+
+func (response GetAuthenticationConnection200JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAuthenticationConnection400JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAuthenticationConnection401JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAuthenticationConnection404JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuthenticationConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAuthenticationConnection500JSONResponse) VisitGetAuthenticationConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentitiesRequestObject struct {
+}
+
+type GetIdentitiesResponseObject interface {
+	VisitGetIdentitiesResponse(w http.ResponseWriter) error
+}
+
+type GetIdentities200JSONResponse []GetIdentitiesResponse
+
+func (response GetIdentities200JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentities401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIdentities401JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentities500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIdentities500JSONResponse) VisitGetIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentityRequestObject struct {
+	Body *CreateIdentityJSONRequestBody
+}
+
+type CreateIdentityResponseObject interface {
+	VisitCreateIdentityResponse(w http.ResponseWriter) error
+}
+
+type CreateIdentity201JSONResponse CreateIdentityResponse
+
+func (response CreateIdentity201JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateIdentity400JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateIdentity401JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateIdentity403JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateIdentity409JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateIdentity500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateIdentity500JSONResponse) VisitCreateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetailsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetIdentityDetailsResponseObject interface {
+	VisitGetIdentityDetailsResponse(w http.ResponseWriter) error
+}
+
+type GetIdentityDetails200JSONResponse GetIdentityDetailsResponse
+
+func (response GetIdentityDetails200JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetails400JSONResponse struct{ N400JSONResponse }
+
+func (response GetIdentityDetails400JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetails401JSONResponse struct{ N401JSONResponse }
+
+func (response GetIdentityDetails401JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIdentityDetails500JSONResponse struct{ N500JSONResponse }
+
+func (response GetIdentityDetails500JSONResponse) VisitGetIdentityDetailsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentityRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *UpdateIdentityJSONRequestBody
+}
+
+type UpdateIdentityResponseObject interface {
+	VisitUpdateIdentityResponse(w http.ResponseWriter) error
+}
+
+type UpdateIdentity200JSONResponse GenericMessage
+
+func (response UpdateIdentity200JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateIdentity400JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateIdentity401JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity403JSONResponse struct{ N403JSONResponse }
+
+func (response UpdateIdentity403JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateIdentity500JSONResponse struct{ N500CreateIdentityJSONResponse }
+
+func (response UpdateIdentity500JSONResponse) VisitUpdateIdentityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditLogRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Params     GetAuditLogParams
+}
+
+type GetAuditLogResponseObject interface {
+	VisitGetAuditLogResponse(w http.ResponseWriter) error
+}
+
+type GetAuditLog200JSONResponse AuditLogPaginated
+
+func (response GetAuditLog200JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditLog400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAuditLog400JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditLog401JSONResponse struct{ N401JSONResponse }
+
+func (response GetAuditLog401JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditLog500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAuditLog500JSONResponse) VisitGetAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyAuditLogRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+}
+
+type VerifyAuditLogResponseObject interface {
+	VisitVerifyAuditLogResponse(w http.ResponseWriter) error
+}
+
+type VerifyAuditLog200JSONResponse AuditLogVerification
+
+func (response VerifyAuditLog200JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyAuditLog400JSONResponse struct{ N400JSONResponse }
+
+func (response VerifyAuditLog400JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyAuditLog401JSONResponse struct{ N401JSONResponse }
+
+func (response VerifyAuditLog401JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyAuditLog500JSONResponse struct{ N500JSONResponse }
+
+func (response VerifyAuditLog500JSONResponse) VisitVerifyAuditLogResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetConnectionsParams
+}
+
+type GetConnectionsResponseObject interface {
+	VisitGetConnectionsResponse(w http.ResponseWriter) error
+}
+
+type GetConnections200JSONResponse ConnectionsPaginated
+
+func (response GetConnections200JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnections400JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnections500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnections500JSONResponse) VisitGetConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnectionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateConnectionJSONRequestBody
+}
+
+type CreateConnectionResponseObject interface {
+	VisitCreateConnectionResponse(w http.ResponseWriter) error
+}
+
+type CreateConnection201JSONResponse GenericMessage
+
+func (response CreateConnection201JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateConnection400JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateConnection500JSONResponse) VisitCreateConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Params     DeleteConnectionParams
+}
+
+type DeleteConnectionResponseObject interface {
+	VisitDeleteConnectionResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnection200JSONResponse GenericMessage
+
+func (response DeleteConnection200JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteConnection400JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnection500JSONResponse) VisitDeleteConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetConnectionResponseObject interface {
+	VisitGetConnectionResponse(w http.ResponseWriter) error
+}
+
+type GetConnection200JSONResponse GetConnectionResponse
+
+func (response GetConnection200JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnection400JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnection500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnection500JSONResponse) VisitGetConnectionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionConversationsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetConnectionConversationsResponseObject interface {
+	VisitGetConnectionConversationsResponse(w http.ResponseWriter) error
+}
+
+type GetConnectionConversations200JSONResponse []Conversation
+
+func (response GetConnectionConversations200JSONResponse) VisitGetConnectionConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionConversations400JSONResponse struct{ N400JSONResponse }
+
+func (response GetConnectionConversations400JSONResponse) VisitGetConnectionConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionConversations404JSONResponse struct{ N404JSONResponse }
+
+func (response GetConnectionConversations404JSONResponse) VisitGetConnectionConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConnectionConversations500JSONResponse struct{ N500JSONResponse }
+
+func (response GetConnectionConversations500JSONResponse) VisitGetConnectionConversationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteConnectionCredentialsResponseObject interface {
+	VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type DeleteConnectionCredentials200JSONResponse GenericMessage
+
+func (response DeleteConnectionCredentials200JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteConnectionCredentials400JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteConnectionCredentials500JSONResponse) VisitDeleteConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type RevokeConnectionCredentialsResponseObject interface {
+	VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error
+}
+
+type RevokeConnectionCredentials202JSONResponse GenericMessage
+
+func (response RevokeConnectionCredentials202JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeConnectionCredentials400JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeConnectionCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeConnectionCredentials500JSONResponse) VisitRevokeConnectionCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProofRequestsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetProofRequestsResponseObject interface {
+	VisitGetProofRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetProofRequests200JSONResponse []ProofRequest
+
+func (response GetProofRequests200JSONResponse) VisitGetProofRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProofRequests400JSONResponse struct{ N400JSONResponse }
+
+func (response GetProofRequests400JSONResponse) VisitGetProofRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProofRequests404JSONResponse struct{ N404JSONResponse }
+
+func (response GetProofRequests404JSONResponse) VisitGetProofRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProofRequests500JSONResponse struct{ N500JSONResponse }
+
+func (response GetProofRequests500JSONResponse) VisitGetProofRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProofRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *CreateProofRequestJSONRequestBody
+}
+
+type CreateProofRequestResponseObject interface {
+	VisitCreateProofRequestResponse(w http.ResponseWriter) error
+}
+
+type CreateProofRequest201JSONResponse ProofRequest
+
+func (response CreateProofRequest201JSONResponse) VisitCreateProofRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProofRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateProofRequest400JSONResponse) VisitCreateProofRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProofRequest404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateProofRequest404JSONResponse) VisitCreateProofRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProofRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateProofRequest500JSONResponse) VisitCreateProofRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAuthCredentialRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Body       *CreateAuthCredentialJSONRequestBody
+}
+
+type CreateAuthCredentialResponseObject interface {
+	VisitCreateAuthCredentialResponse(w http.ResponseWriter) error
+}
+
+type CreateAuthCredential201JSONResponse struct {
+	// Id The ID of the created Auth Credential
+	Id uuid.UUID `json:"id"`
+}
+
+func (response CreateAuthCredential201JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAuthCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateAuthCredential400JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
 type CreateAuthCredential500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateAuthCredential500JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
+func (response CreateAuthCredential500JSONResponse) VisitCreateAuthCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetCredentialsParams
+}
+
+type GetCredentialsResponseObject interface {
+	VisitGetCredentialsResponse(w http.ResponseWriter) error
+}
+
+type GetCredentials200JSONResponse CredentialsPaginated
+
+func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentials500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateCredentialJSONRequestBody
+}
+
+type CreateCredentialResponseObject interface {
+	VisitCreateCredentialResponse(w http.ResponseWriter) error
+}
+
+type CreateCredential201JSONResponse CreateCredentialResponse
+
+func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateCredential403JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential422JSONResponse struct{ N422JSONResponse }
+
+func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinksRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetLinksParams
+}
+
+type GetLinksResponseObject interface {
+	VisitGetLinksResponse(w http.ResponseWriter) error
+}
+
+type GetLinks200JSONResponse []Link
+
+func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinks500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateLinkJSONRequestBody
+}
+
+type CreateLinkResponseObject interface {
+	VisitCreateLinkResponse(w http.ResponseWriter) error
+}
+
+type CreateLink201JSONResponse UUIDResponse
+
+func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallbackRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     CreateLinkQrCodeCallbackParams
+	Body       *CreateLinkQrCodeCallbackTextRequestBody
+}
+
+type CreateLinkQrCodeCallbackResponseObject interface {
+	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkQrCodeCallback200JSONResponse Offer
+
+func (response CreateLinkQrCodeCallback200JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkQrCodeCallback429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateLinkQrCodeCallback429JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteLinkResponseObject interface {
+	VisitDeleteLinkResponse(w http.ResponseWriter) error
+}
+
+type DeleteLink200JSONResponse GenericMessage
+
+func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteLink500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetLinkResponseObject interface {
+	VisitGetLinkResponse(w http.ResponseWriter) error
+}
+
+type GetLink200JSONResponse Link
+
+func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink400JSONResponse struct{ N400JSONResponse }
+
+func (response GetLink400JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink404JSONResponse struct{ N404JSONResponse }
+
+func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLink500JSONResponse struct{ N500JSONResponse }
+
+func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateLinkRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *ActivateLinkJSONRequestBody
+}
+
+type ActivateLinkResponseObject interface {
+	VisitActivateLinkResponse(w http.ResponseWriter) error
+}
+
+type ActivateLink200JSONResponse GenericMessage
+
+func (response ActivateLink200JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateLink400JSONResponse struct{ N400JSONResponse }
+
+func (response ActivateLink400JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ActivateLink500JSONResponse struct{ N500JSONResponse }
+
+func (response ActivateLink500JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type CreateLinkOfferResponseObject interface {
+	VisitCreateLinkOfferResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkOffer200JSONResponse CredentialLinkQrCodeResponse
+
+func (response CreateLinkOffer200JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkOffer400JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkOffer404JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOffer429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateLinkOffer429JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateLinkOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkOffer500JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOID4VCIOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type CreateLinkOID4VCIOfferResponseObject interface {
+	VisitCreateLinkOID4VCIOfferResponse(w http.ResponseWriter) error
+}
+
+type CreateLinkOID4VCIOffer200JSONResponse OID4VCIOfferResponse
+
+func (response CreateLinkOID4VCIOffer200JSONResponse) VisitCreateLinkOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOID4VCIOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateLinkOID4VCIOffer400JSONResponse) VisitCreateLinkOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOID4VCIOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateLinkOID4VCIOffer404JSONResponse) VisitCreateLinkOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateLinkOID4VCIOffer429JSONResponse struct{ N429JSONResponse }
+
+func (response CreateLinkOID4VCIOffer429JSONResponse) VisitCreateLinkOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateLinkOID4VCIOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateLinkOID4VCIOffer500JSONResponse) VisitCreateLinkOID4VCIOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusV2RequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type GetRevocationStatusV2ResponseObject interface {
+	VisitGetRevocationStatusV2Response(w http.ResponseWriter) error
+}
+
+type GetRevocationStatusV2200JSONResponse RevocationStatusResponse
+
+func (response GetRevocationStatusV2200JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusV2400JSONResponse struct{ N400JSONResponse }
+
+func (response GetRevocationStatusV2400JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRevocationStatusV2429JSONResponse struct{ N429JSONResponse }
+
+func (response GetRevocationStatusV2429JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetRevocationStatusV2500JSONResponse struct{ N500JSONResponse }
+
+func (response GetRevocationStatusV2500JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Nonce      PathNonce      `json:"nonce"`
+}
+
+type RevokeCredentialResponseObject interface {
+	VisitRevokeCredentialResponse(w http.ResponseWriter) error
+}
+
+type RevokeCredential202JSONResponse RevokeClaimResponse
+
+func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response RevokeCredential400JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+}
+
+type DeleteCredentialResponseObject interface {
+	VisitDeleteCredentialResponse(w http.ResponseWriter) error
+}
+
+type DeleteCredential200JSONResponse GenericMessage
+
+func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+	Params     GetCredentialParams
+}
+
+type GetCredentialResponseObject interface {
+	VisitGetCredentialResponse(w http.ResponseWriter) error
+}
+
+type GetCredential200JSONResponse Credential
+
+func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential401JSONResponse struct{ N401JSONResponse }
+
+func (response GetCredential401JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredential404JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOfferRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+	Params     GetCredentialOfferParams
+}
+
+type GetCredentialOfferResponseObject interface {
+	VisitGetCredentialOfferResponse(w http.ResponseWriter) error
+}
+
+type GetCredentialOffer200JSONResponse CredentialOfferResponse
+
+func (response GetCredentialOffer200JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer400JSONResponse struct{ N400JSONResponse }
+
+func (response GetCredentialOffer400JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer404JSONResponse struct{ N404JSONResponse }
+
+func (response GetCredentialOffer404JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer409JSONResponse struct{ N409JSONResponse }
+
+func (response GetCredentialOffer409JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCredentialOffer500JSONResponse struct{ N500JSONResponse }
+
+func (response GetCredentialOffer500JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialPresentationRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         PathClaim      `json:"id"`
+	Body       *CreateCredentialPresentationJSONRequestBody
+}
+
+type CreateCredentialPresentationResponseObject interface {
+	VisitCreateCredentialPresentationResponse(w http.ResponseWriter) error
+}
+
+type CreateCredentialPresentation200JSONResponse CredentialPresentation
+
+func (response CreateCredentialPresentation200JSONResponse) VisitCreateCredentialPresentationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialPresentation400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateCredentialPresentation400JSONResponse) VisitCreateCredentialPresentationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialPresentation404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateCredentialPresentation404JSONResponse) VisitCreateCredentialPresentationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCredentialPresentation500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateCredentialPresentation500JSONResponse) VisitCreateCredentialPresentationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethodsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetAllDisplayMethodsParams
+}
+
+type GetAllDisplayMethodsResponseObject interface {
+	VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error
+}
+
+type GetAllDisplayMethods200JSONResponse DisplayMethodPaginated
+
+func (response GetAllDisplayMethods200JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethods400JSONResponse struct{ N400JSONResponse }
+
+func (response GetAllDisplayMethods400JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethods404JSONResponse struct{ N404JSONResponse }
+
+func (response GetAllDisplayMethods404JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAllDisplayMethods500JSONResponse struct{ N500JSONResponse }
+
+func (response GetAllDisplayMethods500JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateDisplayMethodJSONRequestBody
+}
+
+type CreateDisplayMethodResponseObject interface {
+	VisitCreateDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type CreateDisplayMethod201JSONResponse UUIDResponse
+
+func (response CreateDisplayMethod201JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateDisplayMethod400JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateDisplayMethod500JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeleteDisplayMethodResponseObject interface {
+	VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type DeleteDisplayMethod200JSONResponse GenericMessage
+
+func (response DeleteDisplayMethod200JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteDisplayMethod400JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethod404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteDisplayMethod404JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteDisplayMethod500JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetDisplayMethodResponseObject interface {
+	VisitGetDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type GetDisplayMethod200JSONResponse DisplayMethodEntity
+
+func (response GetDisplayMethod200JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response GetDisplayMethod400JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethod404JSONResponse struct{ N404JSONResponse }
+
+func (response GetDisplayMethod404JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response GetDisplayMethod500JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethodRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *UpdateDisplayMethodJSONRequestBody
+}
+
+type UpdateDisplayMethodResponseObject interface {
+	VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error
+}
+
+type UpdateDisplayMethod200JSONResponse GenericMessage
+
+func (response UpdateDisplayMethod200JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateDisplayMethod400JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethod404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateDisplayMethod404JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateDisplayMethod500JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeysRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Params     GetKeysParams
+}
+
+type GetKeysResponseObject interface {
+	VisitGetKeysResponse(w http.ResponseWriter) error
+}
+
+type GetKeys200JSONResponse KeysPaginated
+
+func (response GetKeys200JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeys400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKeys400JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeys404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKeys404JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeys500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKeys500JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Body       *CreateKeyJSONRequestBody
+}
+
+type CreateKeyResponseObject interface {
+	VisitCreateKeyResponse(w http.ResponseWriter) error
+}
+
+type CreateKey201JSONResponse CreateKeyResponse
+
+func (response CreateKey201JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKey400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateKey400JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKey401JSONResponse struct{ N401JSONResponse }
+
+func (response CreateKey401JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateKey500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateKey500JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         PathKeyID       `json:"id"`
+}
+
+type DeleteKeyResponseObject interface {
+	VisitDeleteKeyResponse(w http.ResponseWriter) error
+}
+
+type DeleteKey200JSONResponse GenericMessage
+
+func (response DeleteKey200JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey400JSONResponse struct{ N400JSONResponse }
+
+func (response DeleteKey400JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey401JSONResponse struct{ N401JSONResponse }
+
+func (response DeleteKey401JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey404JSONResponse struct{ N404JSONResponse }
+
+func (response DeleteKey404JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteKey500JSONResponse struct{ N500JSONResponse }
+
+func (response DeleteKey500JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         PathKeyID       `json:"id"`
+}
+
+type GetKeyResponseObject interface {
+	VisitGetKeyResponse(w http.ResponseWriter) error
+}
+
+type GetKey200JSONResponse Key
+
+func (response GetKey200JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey400JSONResponse struct{ N400JSONResponse }
+
+func (response GetKey400JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey401JSONResponse struct{ N401JSONResponse }
+
+func (response GetKey401JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey404JSONResponse struct{ N404JSONResponse }
+
+func (response GetKey404JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetKey500JSONResponse struct{ N500JSONResponse }
+
+func (response GetKey500JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKeyRequestObject struct {
+	Identifier PathIdentifier2 `json:"identifier"`
+	Id         PathKeyID       `json:"id"`
+	Body       *UpdateKeyJSONRequestBody
+}
+
+type UpdateKeyResponseObject interface {
+	VisitUpdateKeyResponse(w http.ResponseWriter) error
+}
+
+type UpdateKey200JSONResponse GenericMessage
+
+func (response UpdateKey200JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey400JSONResponse struct{ N400JSONResponse }
+
+func (response UpdateKey400JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey401JSONResponse struct{ N401JSONResponse }
+
+func (response UpdateKey401JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey404JSONResponse struct{ N404JSONResponse }
+
+func (response UpdateKey404JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateKey500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateKey500JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VPSessionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateOID4VPSessionJSONRequestBody
+}
+
+type CreateOID4VPSessionResponseObject interface {
+	VisitCreateOID4VPSessionResponse(w http.ResponseWriter) error
+}
+
+type CreateOID4VPSession201JSONResponse CreateOID4VPSessionResponse
+
+func (response CreateOID4VPSession201JSONResponse) VisitCreateOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VPSession400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateOID4VPSession400JSONResponse) VisitCreateOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VPSession404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateOID4VPSession404JSONResponse) VisitCreateOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOID4VPSession500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOID4VPSession500JSONResponse) VisitCreateOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOID4VPSessionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetOID4VPSessionResponseObject interface {
+	VisitGetOID4VPSessionResponse(w http.ResponseWriter) error
+}
+
+type GetOID4VPSession200JSONResponse OID4VPSession
+
+func (response GetOID4VPSession200JSONResponse) VisitGetOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOID4VPSession400JSONResponse struct{ N400JSONResponse }
+
+func (response GetOID4VPSession400JSONResponse) VisitGetOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOID4VPSession404JSONResponse struct{ N404JSONResponse }
+
+func (response GetOID4VPSession404JSONResponse) VisitGetOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOID4VPSession500JSONResponse struct{ N500JSONResponse }
+
+func (response GetOID4VPSession500JSONResponse) VisitGetOID4VPSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOnchainIssuersRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetOnchainIssuersResponseObject interface {
+	VisitGetOnchainIssuersResponse(w http.ResponseWriter) error
+}
+
+type GetOnchainIssuers200JSONResponse []OnchainIssuer
+
+func (response GetOnchainIssuers200JSONResponse) VisitGetOnchainIssuersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOnchainIssuers400JSONResponse struct{ N400JSONResponse }
+
+func (response GetOnchainIssuers400JSONResponse) VisitGetOnchainIssuersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOnchainIssuers500JSONResponse struct{ N500JSONResponse }
+
+func (response GetOnchainIssuers500JSONResponse) VisitGetOnchainIssuersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterOnchainIssuerRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *RegisterOnchainIssuerJSONRequestBody
+}
+
+type RegisterOnchainIssuerResponseObject interface {
+	VisitRegisterOnchainIssuerResponse(w http.ResponseWriter) error
+}
+
+type RegisterOnchainIssuer201JSONResponse OnchainIssuer
+
+func (response RegisterOnchainIssuer201JSONResponse) VisitRegisterOnchainIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterOnchainIssuer400JSONResponse struct{ N400JSONResponse }
+
+func (response RegisterOnchainIssuer400JSONResponse) VisitRegisterOnchainIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterOnchainIssuer404JSONResponse struct{ N404JSONResponse }
+
+func (response RegisterOnchainIssuer404JSONResponse) VisitRegisterOnchainIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterOnchainIssuer409JSONResponse struct{ N409JSONResponse }
+
+func (response RegisterOnchainIssuer409JSONResponse) VisitRegisterOnchainIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterOnchainIssuer500JSONResponse struct{ N500JSONResponse }
+
+func (response RegisterOnchainIssuer500JSONResponse) VisitRegisterOnchainIssuerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOnchainCredentialRequestObject struct {
+	Identifier    PathIdentifier `json:"identifier"`
+	OnchainIssuer string         `json:"onchainIssuer"`
+	Body          *CreateOnchainCredentialJSONRequestBody
+}
+
+type CreateOnchainCredentialResponseObject interface {
+	VisitCreateOnchainCredentialResponse(w http.ResponseWriter) error
+}
+
+type CreateOnchainCredential202JSONResponse OnchainCredential
+
+func (response CreateOnchainCredential202JSONResponse) VisitCreateOnchainCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOnchainCredential400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateOnchainCredential400JSONResponse) VisitCreateOnchainCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOnchainCredential403JSONResponse struct{ N403JSONResponse }
+
+func (response CreateOnchainCredential403JSONResponse) VisitCreateOnchainCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOnchainCredential404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateOnchainCredential404JSONResponse) VisitCreateOnchainCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOnchainCredential500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOnchainCredential500JSONResponse) VisitCreateOnchainCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequestsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetPaymentRequestsParams
+}
+
+type GetPaymentRequestsResponseObject interface {
+	VisitGetPaymentRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentRequests200JSONResponse GetPaymentRequestsResponse
+
+func (response GetPaymentRequests200JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequests400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentRequests400JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequests401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPaymentRequests401JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequests500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentRequests500JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreatePaymentRequestJSONRequestBody
+}
+
+type CreatePaymentRequestResponseObject interface {
+	VisitCreatePaymentRequestResponse(w http.ResponseWriter) error
+}
+
+type CreatePaymentRequest201JSONResponse CreatePaymentRequestResponse
+
+func (response CreatePaymentRequest201JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePaymentRequest400JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequest401JSONResponse struct{ N401JSONResponse }
+
+func (response CreatePaymentRequest401JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePaymentRequest500JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeletePaymentRequestResponseObject interface {
+	VisitDeletePaymentRequestResponse(w http.ResponseWriter) error
+}
+
+type DeletePaymentRequest200JSONResponse GenericMessage
+
+func (response DeletePaymentRequest200JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePaymentRequest400JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePaymentRequest500JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequestRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetPaymentRequestResponseObject interface {
+	VisitGetPaymentRequestResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentRequest200JSONResponse CreatePaymentRequestResponse
+
+func (response GetPaymentRequest200JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequest400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentRequest400JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequest404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPaymentRequest404JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentRequest500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentRequest500JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptionsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetPaymentOptionsResponseObject interface {
+	VisitGetPaymentOptionsResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentOptions200JSONResponse PaymentOptionsPaginated
+
+func (response GetPaymentOptions200JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptions400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentOptions400JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptions401JSONResponse struct{ N401JSONResponse }
+
+func (response GetPaymentOptions401JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptions500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentOptions500JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOptionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreatePaymentOptionJSONRequestBody
+}
+
+type CreatePaymentOptionResponseObject interface {
+	VisitCreatePaymentOptionResponse(w http.ResponseWriter) error
+}
+
+type CreatePaymentOption201JSONResponse UUIDResponse
+
+func (response CreatePaymentOption201JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption400JSONResponse struct{ N400JSONResponse }
+
+func (response CreatePaymentOption400JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption401JSONResponse struct{ N401JSONResponse }
+
+func (response CreatePaymentOption401JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption409JSONResponse struct{ N409JSONResponse }
+
+func (response CreatePaymentOption409JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePaymentOption500JSONResponse struct{ N500JSONResponse }
+
+func (response CreatePaymentOption500JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentOptionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type DeletePaymentOptionResponseObject interface {
+	VisitDeletePaymentOptionResponse(w http.ResponseWriter) error
+}
+
+type DeletePaymentOption200JSONResponse GenericMessage
+
+func (response DeletePaymentOption200JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentOption400JSONResponse struct{ N400JSONResponse }
+
+func (response DeletePaymentOption400JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePaymentOption500JSONResponse struct{ N500JSONResponse }
+
+func (response DeletePaymentOption500JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOptionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetPaymentOptionResponseObject interface {
+	VisitGetPaymentOptionResponse(w http.ResponseWriter) error
+}
+
+type GetPaymentOption200JSONResponse PaymentOption
+
+func (response GetPaymentOption200JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOption400JSONResponse struct{ N400JSONResponse }
+
+func (response GetPaymentOption400JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOption404JSONResponse struct{ N404JSONResponse }
+
+func (response GetPaymentOption404JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPaymentOption500JSONResponse struct{ N500JSONResponse }
+
+func (response GetPaymentOption500JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialsRequestObject struct {
+type UpdatePaymentOptionRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Params     GetCredentialsParams
+	Id         Id             `json:"id"`
+	Body       *UpdatePaymentOptionJSONRequestBody
 }
 
-type GetCredentialsResponseObject interface {
-	VisitGetCredentialsResponse(w http.ResponseWriter) error
+type UpdatePaymentOptionResponseObject interface {
+	VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error
 }
 
-type GetCredentials200JSONResponse CredentialsPaginated
+type UpdatePaymentOption200JSONResponse GenericMessage
 
-func (response GetCredentials200JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+func (response UpdatePaymentOption200JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentials400JSONResponse struct{ N400JSONResponse }
+type UpdatePaymentOption400JSONResponse struct{ N400JSONResponse }
 
-func (response GetCredentials400JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+func (response UpdatePaymentOption400JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentials404JSONResponse struct{ N404JSONResponse }
+type UpdatePaymentOption404JSONResponse struct{ N404JSONResponse }
 
-func (response GetCredentials404JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+func (response UpdatePaymentOption404JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentials500JSONResponse struct{ N500JSONResponse }
+type UpdatePaymentOption500JSONResponse struct{ N500JSONResponse }
 
-func (response GetCredentials500JSONResponse) VisitGetCredentialsResponse(w http.ResponseWriter) error {
+func (response UpdatePaymentOption500JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCredentialRequestObject struct {
+type VerifyPaymentRequestObject struct {
+	Identifier string `json:"identifier"`
+	Nonce      string `json:"nonce"`
+	Body       *VerifyPaymentJSONRequestBody
+}
+
+type VerifyPaymentResponseObject interface {
+	VisitVerifyPaymentResponse(w http.ResponseWriter) error
+}
+
+type VerifyPayment200JSONResponse PaymentStatus
+
+func (response VerifyPayment200JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPayment400JSONResponse struct{ N400JSONResponse }
+
+func (response VerifyPayment400JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPayment401JSONResponse struct{ N401JSONResponse }
+
+func (response VerifyPayment401JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyPayment500JSONResponse struct{ N500JSONResponse }
+
+func (response VerifyPayment500JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BuildSchemaRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateCredentialJSONRequestBody
+	Body       *BuildSchemaJSONRequestBody
 }
 
-type CreateCredentialResponseObject interface {
-	VisitCreateCredentialResponse(w http.ResponseWriter) error
+type BuildSchemaResponseObject interface {
+	VisitBuildSchemaResponse(w http.ResponseWriter) error
 }
 
-type CreateCredential201JSONResponse CreateCredentialResponse
+type BuildSchema201JSONResponse BuildSchemaResponse
 
-func (response CreateCredential201JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+func (response BuildSchema201JSONResponse) VisitBuildSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCredential400JSONResponse struct{ N400JSONResponse }
+type BuildSchema400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateCredential400JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+func (response BuildSchema400JSONResponse) VisitBuildSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCredential401JSONResponse struct{ N401JSONResponse }
+type BuildSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response BuildSchema500JSONResponse) VisitBuildSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaFamiliesRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+}
+
+type GetSchemaFamiliesResponseObject interface {
+	VisitGetSchemaFamiliesResponse(w http.ResponseWriter) error
+}
+
+type GetSchemaFamilies200JSONResponse []SchemaFamily
+
+func (response GetSchemaFamilies200JSONResponse) VisitGetSchemaFamiliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaFamilies400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchemaFamilies400JSONResponse) VisitGetSchemaFamiliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaFamilies500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchemaFamilies500JSONResponse) VisitGetSchemaFamiliesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaFamilyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Body       *CreateSchemaFamilyJSONRequestBody
+}
+
+type CreateSchemaFamilyResponseObject interface {
+	VisitCreateSchemaFamilyResponse(w http.ResponseWriter) error
+}
+
+type CreateSchemaFamily201JSONResponse SchemaFamily
+
+func (response CreateSchemaFamily201JSONResponse) VisitCreateSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaFamily400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateSchemaFamily400JSONResponse) VisitCreateSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaFamily409JSONResponse struct{ N409JSONResponse }
+
+func (response CreateSchemaFamily409JSONResponse) VisitCreateSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaFamily500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateSchemaFamily500JSONResponse) VisitCreateSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaFamilyRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+}
+
+type GetSchemaFamilyResponseObject interface {
+	VisitGetSchemaFamilyResponse(w http.ResponseWriter) error
+}
+
+type GetSchemaFamily200JSONResponse SchemaFamily
+
+func (response GetSchemaFamily200JSONResponse) VisitGetSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaFamily400JSONResponse struct{ N400JSONResponse }
+
+func (response GetSchemaFamily400JSONResponse) VisitGetSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaFamily404JSONResponse struct{ N404JSONResponse }
+
+func (response GetSchemaFamily404JSONResponse) VisitGetSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchemaFamily500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchemaFamily500JSONResponse) VisitGetSchemaFamilyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaMigrationRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *CreateSchemaMigrationJSONRequestBody
+}
+
+type CreateSchemaMigrationResponseObject interface {
+	VisitCreateSchemaMigrationResponse(w http.ResponseWriter) error
+}
+
+type CreateSchemaMigration202JSONResponse SchemaMigration
+
+func (response CreateSchemaMigration202JSONResponse) VisitCreateSchemaMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaMigration400JSONResponse struct{ N400JSONResponse }
+
+func (response CreateSchemaMigration400JSONResponse) VisitCreateSchemaMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaMigration404JSONResponse struct{ N404JSONResponse }
+
+func (response CreateSchemaMigration404JSONResponse) VisitCreateSchemaMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSchemaMigration500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateSchemaMigration500JSONResponse) VisitCreateSchemaMigrationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSchemaFamilyVersionRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Id         Id             `json:"id"`
+	Body       *AddSchemaFamilyVersionJSONRequestBody
+}
+
+type AddSchemaFamilyVersionResponseObject interface {
+	VisitAddSchemaFamilyVersionResponse(w http.ResponseWriter) error
+}
+
+type AddSchemaFamilyVersion201JSONResponse SchemaFamily
+
+func (response AddSchemaFamilyVersion201JSONResponse) VisitAddSchemaFamilyVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSchemaFamilyVersion400JSONResponse struct{ N400JSONResponse }
+
+func (response AddSchemaFamilyVersion400JSONResponse) VisitAddSchemaFamilyVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddSchemaFamilyVersion404JSONResponse struct{ N404JSONResponse }
 
-func (response CreateCredential401JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+func (response AddSchemaFamilyVersion404JSONResponse) VisitAddSchemaFamilyVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCredential422JSONResponse struct{ N422JSONResponse }
+type AddSchemaFamilyVersion409JSONResponse struct{ N409JSONResponse }
 
-func (response CreateCredential422JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+func (response AddSchemaFamilyVersion409JSONResponse) VisitAddSchemaFamilyVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(422)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCredential500JSONResponse struct{ N500JSONResponse }
+type AddSchemaFamilyVersion500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateCredential500JSONResponse) VisitCreateCredentialResponse(w http.ResponseWriter) error {
+func (response AddSchemaFamilyVersion500JSONResponse) VisitAddSchemaFamilyVersionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLinksRequestObject struct {
+type GetSchemaMigrationRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Params     GetLinksParams
+	Id         Id             `json:"id"`
 }
 
-type GetLinksResponseObject interface {
-	VisitGetLinksResponse(w http.ResponseWriter) error
+type GetSchemaMigrationResponseObject interface {
+	VisitGetSchemaMigrationResponse(w http.ResponseWriter) error
 }
 
-type GetLinks200JSONResponse []Link
+type GetSchemaMigration200JSONResponse SchemaMigration
 
-func (response GetLinks200JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+func (response GetSchemaMigration200JSONResponse) VisitGetSchemaMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLinks400JSONResponse struct{ N400JSONResponse }
+type GetSchemaMigration400JSONResponse struct{ N400JSONResponse }
 
-func (response GetLinks400JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+func (response GetSchemaMigration400JSONResponse) VisitGetSchemaMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLinks404JSONResponse struct{ N404JSONResponse }
+type GetSchemaMigration404JSONResponse struct{ N404JSONResponse }
 
-func (response GetLinks404JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+func (response GetSchemaMigration404JSONResponse) VisitGetSchemaMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLinks500JSONResponse struct{ N500JSONResponse }
+type GetSchemaMigration500JSONResponse struct{ N500JSONResponse }
 
-func (response GetLinks500JSONResponse) VisitGetLinksResponse(w http.ResponseWriter) error {
+func (response GetSchemaMigration500JSONResponse) VisitGetSchemaMigrationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkRequestObject struct {
+type GetSchemasRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateLinkJSONRequestBody
+	Params     GetSchemasParams
 }
 
-type CreateLinkResponseObject interface {
-	VisitCreateLinkResponse(w http.ResponseWriter) error
+type GetSchemasResponseObject interface {
+	VisitGetSchemasResponse(w http.ResponseWriter) error
 }
 
-type CreateLink201JSONResponse UUIDResponse
+type GetSchemas200JSONResponse []Schema
 
-func (response CreateLink201JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLink400JSONResponse struct{ N400JSONResponse }
+type GetSchemas400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateLink400JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLink500JSONResponse struct{ N500JSONResponse }
+type GetSchemas500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateLink500JSONResponse) VisitCreateLinkResponse(w http.ResponseWriter) error {
+func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkQrCodeCallbackRequestObject struct {
+type ImportSchemaRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Params     CreateLinkQrCodeCallbackParams
-	Body       *CreateLinkQrCodeCallbackTextRequestBody
+	Body       *ImportSchemaJSONRequestBody
 }
 
-type CreateLinkQrCodeCallbackResponseObject interface {
-	VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error
+type ImportSchemaResponseObject interface {
+	VisitImportSchemaResponse(w http.ResponseWriter) error
 }
 
-type CreateLinkQrCodeCallback200JSONResponse Offer
+type ImportSchema201JSONResponse UUIDResponse
 
-func (response CreateLinkQrCodeCallback200JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkQrCodeCallback400JSONResponse struct{ N400JSONResponse }
+type ImportSchema400JSONResponse struct{ N400JSONResponse }
 
-func (response CreateLinkQrCodeCallback400JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkQrCodeCallback500JSONResponse struct{ N500JSONResponse }
+type ImportSchema500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateLinkQrCodeCallback500JSONResponse) VisitCreateLinkQrCodeCallbackResponse(w http.ResponseWriter) error {
+func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteLinkRequestObject struct {
+type GetSchemaRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
 	Id         Id             `json:"id"`
 }
 
-type DeleteLinkResponseObject interface {
-	VisitDeleteLinkResponse(w http.ResponseWriter) error
+type GetSchemaResponseObject interface {
+	VisitGetSchemaResponse(w http.ResponseWriter) error
 }
 
-type DeleteLink200JSONResponse GenericMessage
+type GetSchema200JSONResponse Schema
 
-func (response DeleteLink200JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteLink400JSONResponse struct{ N400JSONResponse }
+type GetSchema400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteLink400JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteLink500JSONResponse struct{ N500JSONResponse }
+type GetSchema404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteLink500JSONResponse) VisitDeleteLinkResponse(w http.ResponseWriter) error {
+func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLinkRequestObject struct {
+type UpdateSchemaRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
 	Id         Id             `json:"id"`
+	Body       *UpdateSchemaJSONRequestBody
 }
 
-type GetLinkResponseObject interface {
-	VisitGetLinkResponse(w http.ResponseWriter) error
+type UpdateSchemaResponseObject interface {
+	VisitUpdateSchemaResponse(w http.ResponseWriter) error
 }
 
-type GetLink200JSONResponse Link
+type UpdateSchema200JSONResponse GenericMessage
 
-func (response GetLink200JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+func (response UpdateSchema200JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLink400JSONResponse struct{ N400JSONResponse }
+type UpdateSchema400JSONResponse struct{ N400JSONResponse }
 
-func (response GetLink400JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+func (response UpdateSchema400JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLink404JSONResponse struct{ N404JSONResponse }
+type UpdateSchema404JSONResponse struct{ N404JSONResponse }
 
-func (response GetLink404JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+func (response UpdateSchema404JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetLink500JSONResponse struct{ N500JSONResponse }
+type UpdateSchema500JSONResponse struct{ N500JSONResponse }
 
-func (response GetLink500JSONResponse) VisitGetLinkResponse(w http.ResponseWriter) error {
+func (response UpdateSchema500JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ActivateLinkRequestObject struct {
+type CheckSchemaDriftRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
 	Id         Id             `json:"id"`
-	Body       *ActivateLinkJSONRequestBody
 }
 
-type ActivateLinkResponseObject interface {
-	VisitActivateLinkResponse(w http.ResponseWriter) error
+type CheckSchemaDriftResponseObject interface {
+	VisitCheckSchemaDriftResponse(w http.ResponseWriter) error
 }
 
-type ActivateLink200JSONResponse GenericMessage
+type CheckSchemaDrift200JSONResponse Schema
 
-func (response ActivateLink200JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+func (response CheckSchemaDrift200JSONResponse) VisitCheckSchemaDriftResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ActivateLink400JSONResponse struct{ N400JSONResponse }
+type CheckSchemaDrift400JSONResponse struct{ N400JSONResponse }
 
-func (response ActivateLink400JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+func (response CheckSchemaDrift400JSONResponse) VisitCheckSchemaDriftResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ActivateLink500JSONResponse struct{ N500JSONResponse }
+type CheckSchemaDrift404JSONResponse struct{ N404JSONResponse }
 
-func (response ActivateLink500JSONResponse) VisitActivateLinkResponse(w http.ResponseWriter) error {
+func (response CheckSchemaDrift404JSONResponse) VisitCheckSchemaDriftResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CheckSchemaDrift500JSONResponse struct{ N500JSONResponse }
+
+func (response CheckSchemaDrift500JSONResponse) VisitCheckSchemaDriftResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkOfferRequestObject struct {
+type PublishIdentityStateRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+	Params     PublishIdentityStateParams
 }
 
-type CreateLinkOfferResponseObject interface {
-	VisitCreateLinkOfferResponse(w http.ResponseWriter) error
+type PublishIdentityStateResponseObject interface {
+	VisitPublishIdentityStateResponse(w http.ResponseWriter) error
 }
 
-type CreateLinkOffer200JSONResponse CredentialLinkQrCodeResponse
+type PublishIdentityState200JSONResponse GenericMessage
 
-func (response CreateLinkOffer200JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+func (response PublishIdentityState200JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkOffer400JSONResponse struct{ N400JSONResponse }
+type PublishIdentityState202JSONResponse PublishIdentityStateResponse
 
-func (response CreateLinkOffer400JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+func (response PublishIdentityState202JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishIdentityState400JSONResponse struct{ N400JSONResponse }
+
+func (response PublishIdentityState400JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkOffer404JSONResponse struct{ N404JSONResponse }
+type PublishIdentityState401JSONResponse struct{ N401JSONResponse }
 
-func (response CreateLinkOffer404JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+func (response PublishIdentityState401JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateLinkOffer500JSONResponse struct{ N500JSONResponse }
+type PublishIdentityState500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateLinkOffer500JSONResponse) VisitCreateLinkOfferResponse(w http.ResponseWriter) error {
+func (response PublishIdentityState500JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRevocationStatusV2RequestObject struct {
+type RetryPublishStateRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
 }
 
-type GetRevocationStatusV2ResponseObject interface {
-	VisitGetRevocationStatusV2Response(w http.ResponseWriter) error
+type RetryPublishStateResponseObject interface {
+	VisitRetryPublishStateResponse(w http.ResponseWriter) error
 }
 
-type GetRevocationStatusV2200JSONResponse RevocationStatusResponse
+type RetryPublishState202JSONResponse PublishIdentityStateResponse
 
-func (response GetRevocationStatusV2200JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(202)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRevocationStatusV2400JSONResponse struct{ N400JSONResponse }
+type RetryPublishState400JSONResponse struct{ N400JSONResponse }
 
-func (response GetRevocationStatusV2400JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRevocationStatusV2500JSONResponse struct{ N500JSONResponse }
+type RetryPublishState500JSONResponse struct{ N500JSONResponse }
 
-func (response GetRevocationStatusV2500JSONResponse) VisitGetRevocationStatusV2Response(w http.ResponseWriter) error {
+func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeCredentialRequestObject struct {
+type GetStateStatusRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Nonce      PathNonce      `json:"nonce"`
 }
 
-type RevokeCredentialResponseObject interface {
-	VisitRevokeCredentialResponse(w http.ResponseWriter) error
+type GetStateStatusResponseObject interface {
+	VisitGetStateStatusResponse(w http.ResponseWriter) error
 }
 
-type RevokeCredential202JSONResponse RevokeClaimResponse
+type GetStateStatus200JSONResponse StateStatusResponse
 
-func (response RevokeCredential202JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeCredential400JSONResponse struct{ N400JSONResponse }
+type GetStateStatus400JSONResponse struct{ N400JSONResponse }
 
-func (response RevokeCredential400JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+func (response GetStateStatus400JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeCredential401JSONResponse struct{ N401JSONResponse }
+type GetStateStatus500JSONResponse struct{ N500JSONResponse }
 
-func (response RevokeCredential401JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RevokeCredential404JSONResponse struct{ N404JSONResponse }
-
-func (response RevokeCredential404JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type GetStateTransactionsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetStateTransactionsParams
+}
 
-	return json.NewEncoder(w).Encode(response)
+type GetStateTransactionsResponseObject interface {
+	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
 }
 
-type RevokeCredential500JSONResponse struct{ N500JSONResponse }
+type GetStateTransactions200JSONResponse StateTransactionsPaginated
 
-func (response RevokeCredential500JSONResponse) VisitRevokeCredentialResponse(w http.ResponseWriter) error {
+func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCredentialRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
-}
+type GetStateTransactions400JSONResponse struct{ N400JSONResponse }
 
-type DeleteCredentialResponseObject interface {
-	VisitDeleteCredentialResponse(w http.ResponseWriter) error
+func (response GetStateTransactions400JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCredential200JSONResponse GenericMessage
+type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteCredential200JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCredential400JSONResponse struct{ N400JSONResponse }
+type GetTransactionCostsRequestObject struct {
+	Identifier PathIdentifier `json:"identifier"`
+	Params     GetTransactionCostsParams
+}
 
-func (response DeleteCredential400JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+type GetTransactionCostsResponseObject interface {
+	VisitGetTransactionCostsResponse(w http.ResponseWriter) error
+}
+
+type GetTransactionCosts200JSONResponse TransactionCostsResponse
+
+func (response GetTransactionCosts200JSONResponse) VisitGetTransactionCostsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCredential401JSONResponse struct{ N401JSONResponse }
+type GetTransactionCosts400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteCredential401JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+func (response GetTransactionCosts400JSONResponse) VisitGetTransactionCostsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCredential500JSONResponse struct{ N500JSONResponse }
+type GetTransactionCosts500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteCredential500JSONResponse) VisitDeleteCredentialResponse(w http.ResponseWriter) error {
+func (response GetTransactionCosts500JSONResponse) VisitGetTransactionCostsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialRequestObject struct {
+type GetOID4VCIAuthorizationServerMetadataRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
 }
 
-type GetCredentialResponseObject interface {
-	VisitGetCredentialResponse(w http.ResponseWriter) error
+type GetOID4VCIAuthorizationServerMetadataResponseObject interface {
+	VisitGetOID4VCIAuthorizationServerMetadataResponse(w http.ResponseWriter) error
 }
 
-type GetCredential200JSONResponse Credential
+type GetOID4VCIAuthorizationServerMetadata200JSONResponse OID4VCIAuthorizationServerMetadata
 
-func (response GetCredential200JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIAuthorizationServerMetadata200JSONResponse) VisitGetOID4VCIAuthorizationServerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredential400JSONResponse struct{ N400JSONResponse }
-
-func (response GetCredential400JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetCredential401JSONResponse struct{ N401JSONResponse }
+type GetOID4VCIAuthorizationServerMetadata404JSONResponse struct{ N404JSONResponse }
 
-func (response GetCredential401JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIAuthorizationServerMetadata404JSONResponse) VisitGetOID4VCIAuthorizationServerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredential404JSONResponse struct{ N404JSONResponse }
+type GetOID4VCIAuthorizationServerMetadata429JSONResponse struct{ N429JSONResponse }
 
-func (response GetCredential404JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIAuthorizationServerMetadata429JSONResponse) VisitGetOID4VCIAuthorizationServerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetCredential500JSONResponse struct{ N500JSONResponse }
+type GetOID4VCIAuthorizationServerMetadata500JSONResponse struct{ N500JSONResponse }
 
-func (response GetCredential500JSONResponse) VisitGetCredentialResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIAuthorizationServerMetadata500JSONResponse) VisitGetOID4VCIAuthorizationServerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOfferRequestObject struct {
+type GetOID4VCIIssuerMetadataRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Id         PathClaim      `json:"id"`
-	Params     GetCredentialOfferParams
 }
 
-type GetCredentialOfferResponseObject interface {
-	VisitGetCredentialOfferResponse(w http.ResponseWriter) error
+type GetOID4VCIIssuerMetadataResponseObject interface {
+	VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error
 }
 
-type GetCredentialOffer200JSONResponse CredentialOfferResponse
+type GetOID4VCIIssuerMetadata200JSONResponse OID4VCIIssuerMetadata
 
-func (response GetCredentialOffer200JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIIssuerMetadata200JSONResponse) VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOffer400JSONResponse struct{ N400JSONResponse }
-
-func (response GetCredentialOffer400JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetCredentialOffer404JSONResponse struct{ N404JSONResponse }
+type GetOID4VCIIssuerMetadata404JSONResponse struct{ N404JSONResponse }
 
-func (response GetCredentialOffer404JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIIssuerMetadata404JSONResponse) VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCredentialOffer409JSONResponse struct{ N409JSONResponse }
+type GetOID4VCIIssuerMetadata429JSONResponse struct{ N429JSONResponse }
 
-func (response GetCredentialOffer409JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIIssuerMetadata429JSONResponse) VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetCredentialOffer500JSONResponse struct{ N500JSONResponse }
+type GetOID4VCIIssuerMetadata500JSONResponse struct{ N500JSONResponse }
 
-func (response GetCredentialOffer500JSONResponse) VisitGetCredentialOfferResponse(w http.ResponseWriter) error {
+func (response GetOID4VCIIssuerMetadata500JSONResponse) VisitGetOID4VCIIssuerMetadataResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllDisplayMethodsRequestObject struct {
+type CreateOID4VCICredentialRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Params     GetAllDisplayMethodsParams
+	Params     CreateOID4VCICredentialParams
+	Body       *CreateOID4VCICredentialJSONRequestBody
 }
 
-type GetAllDisplayMethodsResponseObject interface {
-	VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error
+type CreateOID4VCICredentialResponseObject interface {
+	VisitCreateOID4VCICredentialResponse(w http.ResponseWriter) error
 }
 
-type GetAllDisplayMethods200JSONResponse DisplayMethodPaginated
+type CreateOID4VCICredential200JSONResponse OID4VCICredentialResponse
 
-func (response GetAllDisplayMethods200JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+func (response CreateOID4VCICredential200JSONResponse) VisitCreateOID4VCICredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllDisplayMethods400JSONResponse struct{ N400JSONResponse }
+type CreateOID4VCICredential400JSONResponse OID4VCIError
 
-func (response GetAllDisplayMethods400JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+func (response CreateOID4VCICredential400JSONResponse) VisitCreateOID4VCICredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllDisplayMethods404JSONResponse struct{ N404JSONResponse }
+type CreateOID4VCICredential401JSONResponse OID4VCIError
 
-func (response GetAllDisplayMethods404JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+func (response CreateOID4VCICredential401JSONResponse) VisitCreateOID4VCICredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAllDisplayMethods500JSONResponse struct{ N500JSONResponse }
+type CreateOID4VCICredential429JSONResponse struct{ N429JSONResponse }
 
-func (response GetAllDisplayMethods500JSONResponse) VisitGetAllDisplayMethodsResponse(w http.ResponseWriter) error {
+func (response CreateOID4VCICredential429JSONResponse) VisitCreateOID4VCICredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOID4VCICredential500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOID4VCICredential500JSONResponse) VisitCreateOID4VCICredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDisplayMethodRequestObject struct {
+type CreateOID4VCITokenRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreateDisplayMethodJSONRequestBody
+	Body       *CreateOID4VCITokenFormdataRequestBody
 }
 
-type CreateDisplayMethodResponseObject interface {
-	VisitCreateDisplayMethodResponse(w http.ResponseWriter) error
+type CreateOID4VCITokenResponseObject interface {
+	VisitCreateOID4VCITokenResponse(w http.ResponseWriter) error
 }
 
-type CreateDisplayMethod201JSONResponse UUIDResponse
+type CreateOID4VCIToken200JSONResponse OID4VCITokenResponse
 
-func (response CreateDisplayMethod201JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response CreateOID4VCIToken200JSONResponse) VisitCreateOID4VCITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type CreateOID4VCIToken400JSONResponse OID4VCIError
 
-func (response CreateDisplayMethod400JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response CreateOID4VCIToken400JSONResponse) VisitCreateOID4VCITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type CreateOID4VCIToken429JSONResponse struct{ N429JSONResponse }
 
-func (response CreateDisplayMethod500JSONResponse) VisitCreateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response CreateOID4VCIToken429JSONResponse) VisitCreateOID4VCITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOID4VCIToken500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOID4VCIToken500JSONResponse) VisitCreateOID4VCITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDisplayMethodRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+type GetOID4VPRequestObjectRequestObject struct {
+	Id Id `json:"id"`
 }
 
-type DeleteDisplayMethodResponseObject interface {
-	VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error
+type GetOID4VPRequestObjectResponseObject interface {
+	VisitGetOID4VPRequestObjectResponse(w http.ResponseWriter) error
 }
 
-type DeleteDisplayMethod200JSONResponse GenericMessage
+type GetOID4VPRequestObject200ApplicationoauthAuthzReqJwtResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response DeleteDisplayMethod200JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response GetOID4VPRequestObject200ApplicationoauthAuthzReqJwtResponse) VisitGetOID4VPRequestObjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/oauth-authz-req+jwt")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type DeleteDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type GetOID4VPRequestObject400JSONResponse struct{ N400JSONResponse }
 
-func (response DeleteDisplayMethod400JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetOID4VPRequestObject400JSONResponse) VisitGetOID4VPRequestObjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDisplayMethod404JSONResponse struct{ N404JSONResponse }
+type GetOID4VPRequestObject404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteDisplayMethod404JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetOID4VPRequestObject404JSONResponse) VisitGetOID4VPRequestObjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type GetOID4VPRequestObject429JSONResponse struct{ N429JSONResponse }
 
-func (response DeleteDisplayMethod500JSONResponse) VisitDeleteDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetOID4VPRequestObject429JSONResponse) VisitGetOID4VPRequestObjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetOID4VPRequestObject500JSONResponse struct{ N500JSONResponse }
+
+func (response GetOID4VPRequestObject500JSONResponse) VisitGetOID4VPRequestObjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDisplayMethodRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+type CreateOID4VPResponseRequestObject struct {
+	Id   Id `json:"id"`
+	Body *CreateOID4VPResponseFormdataRequestBody
 }
 
-type GetDisplayMethodResponseObject interface {
-	VisitGetDisplayMethodResponse(w http.ResponseWriter) error
+type CreateOID4VPResponseResponseObject interface {
+	VisitCreateOID4VPResponseResponse(w http.ResponseWriter) error
 }
 
-type GetDisplayMethod200JSONResponse DisplayMethodEntity
+type CreateOID4VPResponse200Response struct {
+}
 
-func (response GetDisplayMethod200JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+func (response CreateOID4VPResponse200Response) VisitCreateOID4VPResponseResponse(w http.ResponseWriter) error {
 	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+	return nil
 }
 
-type GetDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type CreateOID4VPResponse400JSONResponse OID4VPError
 
-func (response GetDisplayMethod400JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+func (response CreateOID4VPResponse400JSONResponse) VisitCreateOID4VPResponseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDisplayMethod404JSONResponse struct{ N404JSONResponse }
+type CreateOID4VPResponse404JSONResponse struct{ N404JSONResponse }
 
-func (response GetDisplayMethod404JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+func (response CreateOID4VPResponse404JSONResponse) VisitCreateOID4VPResponseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type CreateOID4VPResponse429JSONResponse struct{ N429JSONResponse }
 
-func (response GetDisplayMethod500JSONResponse) VisitGetDisplayMethodResponse(w http.ResponseWriter) error {
+func (response CreateOID4VPResponse429JSONResponse) VisitCreateOID4VPResponseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CreateOID4VPResponse500JSONResponse struct{ N500JSONResponse }
+
+func (response CreateOID4VPResponse500JSONResponse) VisitCreateOID4VPResponseResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethodRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Body       *UpdateDisplayMethodJSONRequestBody
+type GetPaymentSettingsRequestObject struct {
 }
 
-type UpdateDisplayMethodResponseObject interface {
-	VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error
+type GetPaymentSettingsResponseObject interface {
+	VisitGetPaymentSettingsResponse(w http.ResponseWriter) error
 }
 
-type UpdateDisplayMethod200JSONResponse GenericMessage
+type GetPaymentSettings200JSONResponse PaymentsConfiguration
 
-func (response UpdateDisplayMethod200JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response GetPaymentSettings200JSONResponse) VisitGetPaymentSettingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethod400JSONResponse struct{ N400JSONResponse }
+type ProofRequestCallbackRequestObject struct {
+	Params ProofRequestCallbackParams
+	Body   *ProofRequestCallbackTextRequestBody
+}
 
-func (response UpdateDisplayMethod400JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+type ProofRequestCallbackResponseObject interface {
+	VisitProofRequestCallbackResponse(w http.ResponseWriter) error
+}
+
+type ProofRequestCallback200Response struct {
+}
+
+func (response ProofRequestCallback200Response) VisitProofRequestCallbackResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type ProofRequestCallback400JSONResponse struct{ N400JSONResponse }
+
+func (response ProofRequestCallback400JSONResponse) VisitProofRequestCallbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethod404JSONResponse struct{ N404JSONResponse }
+type ProofRequestCallback404JSONResponse struct{ N404JSONResponse }
 
-func (response UpdateDisplayMethod404JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response ProofRequestCallback404JSONResponse) VisitProofRequestCallbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateDisplayMethod500JSONResponse struct{ N500JSONResponse }
+type ProofRequestCallback429JSONResponse struct{ N429JSONResponse }
 
-func (response UpdateDisplayMethod500JSONResponse) VisitUpdateDisplayMethodResponse(w http.ResponseWriter) error {
+func (response ProofRequestCallback429JSONResponse) VisitProofRequestCallbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ProofRequestCallback500JSONResponse struct{ N500JSONResponse }
+
+func (response ProofRequestCallback500JSONResponse) VisitProofRequestCallbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeysRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Params     GetKeysParams
+type GetQrFromStoreRequestObject struct {
+	Params GetQrFromStoreParams
 }
 
-type GetKeysResponseObject interface {
-	VisitGetKeysResponse(w http.ResponseWriter) error
+type GetQrFromStoreResponseObject interface {
+	VisitGetQrFromStoreResponse(w http.ResponseWriter) error
 }
 
-type GetKeys200JSONResponse KeysPaginated
+type GetQrFromStore200JSONResponse map[string]interface{}
 
-func (response GetKeys200JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response GetQrFromStore200JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys400JSONResponse struct{ N400JSONResponse }
+type GetQrFromStore400JSONResponse struct{ N400JSONResponse }
 
-func (response GetKeys400JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response GetQrFromStore400JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys404JSONResponse struct{ N404JSONResponse }
+type GetQrFromStore404JSONResponse struct{ N404JSONResponse }
 
-func (response GetKeys404JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response GetQrFromStore404JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeys500JSONResponse struct{ N500JSONResponse }
+type GetQrFromStore410JSONResponse struct{ N410JSONResponse }
 
-func (response GetKeys500JSONResponse) VisitGetKeysResponse(w http.ResponseWriter) error {
+func (response GetQrFromStore410JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(410)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Body       *CreateKeyJSONRequestBody
-}
+type GetQrFromStore429JSONResponse struct{ N429JSONResponse }
 
-type CreateKeyResponseObject interface {
-	VisitCreateKeyResponse(w http.ResponseWriter) error
+func (response GetQrFromStore429JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreateKey201JSONResponse CreateKeyResponse
+type GetQrFromStore500JSONResponse struct{ N500JSONResponse }
 
-func (response CreateKey201JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+func (response GetQrFromStore500JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateKey400JSONResponse struct{ N400JSONResponse }
-
-func (response CreateKey400JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type GetBuiltSchemaContextRequestObject struct {
+	Id Id `json:"id"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type GetBuiltSchemaContextResponseObject interface {
+	VisitGetBuiltSchemaContextResponse(w http.ResponseWriter) error
 }
 
-type CreateKey401JSONResponse struct{ N401JSONResponse }
+type GetBuiltSchemaContext200ApplicationLdPlusJSONResponse map[string]interface{}
 
-func (response CreateKey401JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+func (response GetBuiltSchemaContext200ApplicationLdPlusJSONResponse) VisitGetBuiltSchemaContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/ld+json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateKey500JSONResponse struct{ N500JSONResponse }
+type GetBuiltSchemaContext404JSONResponse struct{ N404JSONResponse }
 
-func (response CreateKey500JSONResponse) VisitCreateKeyResponse(w http.ResponseWriter) error {
+func (response GetBuiltSchemaContext404JSONResponse) VisitGetBuiltSchemaContextResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Id         PathKeyID       `json:"id"`
-}
+type GetBuiltSchemaContext429JSONResponse struct{ N429JSONResponse }
 
-type DeleteKeyResponseObject interface {
-	VisitDeleteKeyResponse(w http.ResponseWriter) error
+func (response GetBuiltSchemaContext429JSONResponse) VisitGetBuiltSchemaContextResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type DeleteKey200JSONResponse GenericMessage
+type GetBuiltSchemaContext500JSONResponse struct{ N500JSONResponse }
 
-func (response DeleteKey200JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetBuiltSchemaContext500JSONResponse) VisitGetBuiltSchemaContextResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKey400JSONResponse struct{ N400JSONResponse }
-
-func (response DeleteKey400JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type GetBuiltSchemaRequestObject struct {
+	Id Id `json:"id"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type GetBuiltSchemaResponseObject interface {
+	VisitGetBuiltSchemaResponse(w http.ResponseWriter) error
 }
 
-type DeleteKey401JSONResponse struct{ N401JSONResponse }
+type GetBuiltSchema200ApplicationSchemaPlusJSONResponse map[string]interface{}
 
-func (response DeleteKey401JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+func (response GetBuiltSchema200ApplicationSchemaPlusJSONResponse) VisitGetBuiltSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/schema+json")
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKey404JSONResponse struct{ N404JSONResponse }
+type GetBuiltSchema404JSONResponse struct{ N404JSONResponse }
 
-func (response DeleteKey404JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetBuiltSchema404JSONResponse) VisitGetBuiltSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteKey500JSONResponse struct{ N500JSONResponse }
+type GetBuiltSchema429JSONResponse struct{ N429JSONResponse }
 
-func (response DeleteKey500JSONResponse) VisitDeleteKeyResponse(w http.ResponseWriter) error {
+func (response GetBuiltSchema429JSONResponse) VisitGetBuiltSchemaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetBuiltSchema500JSONResponse struct{ N500JSONResponse }
+
+func (response GetBuiltSchema500JSONResponse) VisitGetBuiltSchemaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Id         PathKeyID       `json:"id"`
+type GetStatusListCredentialRequestObject struct {
+	Id Id `json:"id"`
 }
 
-type GetKeyResponseObject interface {
-	VisitGetKeyResponse(w http.ResponseWriter) error
+type GetStatusListCredentialResponseObject interface {
+	VisitGetStatusListCredentialResponse(w http.ResponseWriter) error
 }
 
-type GetKey200JSONResponse Key
-
-func (response GetKey200JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+type GetStatusListCredential200ApplicationvcJwtResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-type GetKey400JSONResponse struct{ N400JSONResponse }
-
-func (response GetKey400JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+func (response GetStatusListCredential200ApplicationvcJwtResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/vc+jwt")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type GetKey401JSONResponse struct{ N401JSONResponse }
+type GetStatusListCredential404JSONResponse struct{ N404JSONResponse }
 
-func (response GetKey401JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response GetStatusListCredential404JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetKey404JSONResponse struct{ N404JSONResponse }
+type GetStatusListCredential429JSONResponse struct{ N429JSONResponse }
 
-func (response GetKey404JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response GetStatusListCredential429JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetKey500JSONResponse struct{ N500JSONResponse }
+type GetStatusListCredential500JSONResponse struct{ N500JSONResponse }
 
-func (response GetKey500JSONResponse) VisitGetKeyResponse(w http.ResponseWriter) error {
+func (response GetStatusListCredential500JSONResponse) VisitGetStatusListCredentialResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKeyRequestObject struct {
-	Identifier PathIdentifier2 `json:"identifier"`
-	Id         PathKeyID       `json:"id"`
-	Body       *UpdateKeyJSONRequestBody
+type GetSupportedNetworksRequestObject struct {
 }
 
-type UpdateKeyResponseObject interface {
-	VisitUpdateKeyResponse(w http.ResponseWriter) error
+type GetSupportedNetworksResponseObject interface {
+	VisitGetSupportedNetworksResponse(w http.ResponseWriter) error
 }
 
-type UpdateKey200JSONResponse GenericMessage
+type GetSupportedNetworks200JSONResponse []SupportedNetworks
 
-func (response UpdateKey200JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks200JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey400JSONResponse struct{ N400JSONResponse }
+type GetSupportedNetworks400JSONResponse struct{ N400JSONResponse }
 
-func (response UpdateKey400JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks400JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey401JSONResponse struct{ N401JSONResponse }
+type GetSupportedNetworks401JSONResponse struct{ N401JSONResponse }
 
-func (response UpdateKey401JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks401JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey404JSONResponse struct{ N404JSONResponse }
+type GetSupportedNetworks404JSONResponse struct{ N404JSONResponse }
 
-func (response UpdateKey404JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks404JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateKey500JSONResponse struct{ N500JSONResponse }
+type GetSupportedNetworks500JSONResponse struct{ N500JSONResponse }
 
-func (response UpdateKey500JSONResponse) VisitUpdateKeyResponse(w http.ResponseWriter) error {
+func (response GetSupportedNetworks500JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequestsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetPaymentRequestsParams
+type ReloadSupportedNetworksRequestObject struct {
 }
 
-type GetPaymentRequestsResponseObject interface {
-	VisitGetPaymentRequestsResponse(w http.ResponseWriter) error
+type ReloadSupportedNetworksResponseObject interface {
+	VisitReloadSupportedNetworksResponse(w http.ResponseWriter) error
 }
 
-type GetPaymentRequests200JSONResponse GetPaymentRequestsResponse
+type ReloadSupportedNetworks200JSONResponse ReloadSupportedNetworksResponse
 
-func (response GetPaymentRequests200JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+func (response ReloadSupportedNetworks200JSONResponse) VisitReloadSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequests400JSONResponse struct{ N400JSONResponse }
+type ReloadSupportedNetworks400JSONResponse struct{ N400JSONResponse }
 
-func (response GetPaymentRequests400JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+func (response ReloadSupportedNetworks400JSONResponse) VisitReloadSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequests401JSONResponse struct{ N401JSONResponse }
+type ReloadSupportedNetworks401JSONResponse struct{ N401JSONResponse }
 
-func (response GetPaymentRequests401JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+func (response ReloadSupportedNetworks401JSONResponse) VisitReloadSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequests500JSONResponse struct{ N500JSONResponse }
+type ReloadSupportedNetworks409JSONResponse struct{ N409JSONResponse }
 
-func (response GetPaymentRequests500JSONResponse) VisitGetPaymentRequestsResponse(w http.ResponseWriter) error {
+func (response ReloadSupportedNetworks409JSONResponse) VisitReloadSupportedNetworksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReloadSupportedNetworks500JSONResponse struct{ N500JSONResponse }
+
+func (response ReloadSupportedNetworks500JSONResponse) VisitReloadSupportedNetworksResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequestRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreatePaymentRequestJSONRequestBody
+type GetTenantsRequestObject struct {
 }
 
-type CreatePaymentRequestResponseObject interface {
-	VisitCreatePaymentRequestResponse(w http.ResponseWriter) error
+type GetTenantsResponseObject interface {
+	VisitGetTenantsResponse(w http.ResponseWriter) error
 }
 
-type CreatePaymentRequest201JSONResponse CreatePaymentRequestResponse
+type GetTenants200JSONResponse []Tenant
 
-func (response CreatePaymentRequest201JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetTenants200JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequest400JSONResponse struct{ N400JSONResponse }
+type GetTenants401JSONResponse struct{ N401JSONResponse }
 
-func (response CreatePaymentRequest400JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetTenants401JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequest401JSONResponse struct{ N401JSONResponse }
+type GetTenants500JSONResponse struct{ N500JSONResponse }
 
-func (response CreatePaymentRequest401JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetTenants500JSONResponse) VisitGetTenantsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentRequest500JSONResponse struct{ N500JSONResponse }
+type CreateTenantRequestObject struct {
+	Body *CreateTenantJSONRequestBody
+}
 
-func (response CreatePaymentRequest500JSONResponse) VisitCreatePaymentRequestResponse(w http.ResponseWriter) error {
+type CreateTenantResponseObject interface {
+	VisitCreateTenantResponse(w http.ResponseWriter) error
+}
+
+type CreateTenant201JSONResponse Tenant
+
+func (response CreateTenant201JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePaymentRequestRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+type CreateTenant400JSONResponse struct{ N400JSONResponse }
 
-type DeletePaymentRequestResponseObject interface {
-	VisitDeletePaymentRequestResponse(w http.ResponseWriter) error
+func (response CreateTenant400JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePaymentRequest200JSONResponse GenericMessage
+type CreateTenant401JSONResponse struct{ N401JSONResponse }
 
-func (response DeletePaymentRequest200JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+func (response CreateTenant401JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePaymentRequest400JSONResponse struct{ N400JSONResponse }
+type CreateTenant409JSONResponse struct{ N409JSONResponse }
 
-func (response DeletePaymentRequest400JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+func (response CreateTenant409JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeletePaymentRequest500JSONResponse struct{ N500JSONResponse }
+type CreateTenant500JSONResponse struct{ N500JSONResponse }
 
-func (response DeletePaymentRequest500JSONResponse) VisitDeletePaymentRequestResponse(w http.ResponseWriter) error {
+func (response CreateTenant500JSONResponse) VisitCreateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequestRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+type GetTenantRequestObject struct {
+	Id Id `json:"id"`
 }
 
-type GetPaymentRequestResponseObject interface {
-	VisitGetPaymentRequestResponse(w http.ResponseWriter) error
+type GetTenantResponseObject interface {
+	VisitGetTenantResponse(w http.ResponseWriter) error
 }
 
-type GetPaymentRequest200JSONResponse CreatePaymentRequestResponse
+type GetTenant200JSONResponse Tenant
 
-func (response GetPaymentRequest200JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetTenant200JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequest400JSONResponse struct{ N400JSONResponse }
+type GetTenant401JSONResponse struct{ N401JSONResponse }
 
-func (response GetPaymentRequest400JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetTenant401JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequest404JSONResponse struct{ N404JSONResponse }
+type GetTenant404JSONResponse struct{ N404JSONResponse }
 
-func (response GetPaymentRequest404JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetTenant404JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentRequest500JSONResponse struct{ N500JSONResponse }
+type GetTenant500JSONResponse struct{ N500JSONResponse }
 
-func (response GetPaymentRequest500JSONResponse) VisitGetPaymentRequestResponse(w http.ResponseWriter) error {
+func (response GetTenant500JSONResponse) VisitGetTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentOptionsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
+type UpdateTenantRequestObject struct {
+	Id   Id `json:"id"`
+	Body *UpdateTenantJSONRequestBody
 }
 
-type GetPaymentOptionsResponseObject interface {
-	VisitGetPaymentOptionsResponse(w http.ResponseWriter) error
+type UpdateTenantResponseObject interface {
+	VisitUpdateTenantResponse(w http.ResponseWriter) error
 }
 
-type GetPaymentOptions200JSONResponse PaymentOptionsPaginated
+type UpdateTenant200JSONResponse Tenant
 
-func (response GetPaymentOptions200JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+func (response UpdateTenant200JSONResponse) VisitUpdateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentOptions400JSONResponse struct{ N400JSONResponse }
+type UpdateTenant400JSONResponse struct{ N400JSONResponse }
 
-func (response GetPaymentOptions400JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+func (response UpdateTenant400JSONResponse) VisitUpdateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentOptions401JSONResponse struct{ N401JSONResponse }
+type UpdateTenant401JSONResponse struct{ N401JSONResponse }
 
-func (response GetPaymentOptions401JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+func (response UpdateTenant401JSONResponse) VisitUpdateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetPaymentOptions500JSONResponse struct{ N500JSONResponse }
+type UpdateTenant404JSONResponse struct{ N404JSONResponse }
 
-func (response GetPaymentOptions500JSONResponse) VisitGetPaymentOptionsResponse(w http.ResponseWriter) error {
+func (response UpdateTenant404JSONResponse) VisitUpdateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenant409JSONResponse struct{ N409JSONResponse }
+
+func (response UpdateTenant409JSONResponse) VisitUpdateTenantResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTenant500JSONResponse struct{ N500JSONResponse }
+
+func (response UpdateTenant500JSONResponse) VisitUpdateTenantResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentOptionRequestObject struct {
+type AuthenticationRequestObject struct {
 	Identifier PathIdentifier `json:"identifier"`
-	Body       *CreatePaymentOptionJSONRequestBody
+	Params     AuthenticationParams
 }
 
-type CreatePaymentOptionResponseObject interface {
-	VisitCreatePaymentOptionResponse(w http.ResponseWriter) error
+type AuthenticationResponseObject interface {
+	VisitAuthenticationResponse(w http.ResponseWriter) error
 }
 
-type CreatePaymentOption201JSONResponse UUIDResponse
+type Authentication200JSONResponse AuthenticationResponse
 
-func (response CreatePaymentOption201JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+func (response Authentication200JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentOption400JSONResponse struct{ N400JSONResponse }
+type Authentication400JSONResponse struct{ N400JSONResponse }
 
-func (response CreatePaymentOption400JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+func (response Authentication400JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentOption401JSONResponse struct{ N401JSONResponse }
+type Authentication429JSONResponse struct{ N429JSONResponse }
 
-func (response CreatePaymentOption401JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+func (response Authentication429JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.Header().Set("Retry-After", fmt.Sprint(response.Headers.RetryAfter))
+	w.WriteHeader(429)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type CreatePaymentOption409JSONResponse struct{ N409JSONResponse }
+type Authentication500JSONResponse struct{ N500JSONResponse }
 
-func (response CreatePaymentOption409JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
+func (response Authentication500JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePaymentOption500JSONResponse struct{ N500JSONResponse }
-
-func (response CreatePaymentOption500JSONResponse) VisitCreatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Healthcheck
+	// (GET /status)
+	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
+	// Agent V1
+	// (POST /v1/agent)
+	AgentV1(ctx context.Context, request AgentV1RequestObject) (AgentV1ResponseObject, error)
+	// Get Revocation Status V1
+	// (GET /v1/{identifier}/claims/revocation/status/{nonce})
+	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
+	// Agent
+	// (POST /v2/agent)
+	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
+	// Get API Keys
+	// (GET /v2/api-keys)
+	GetAPIKeys(ctx context.Context, request GetAPIKeysRequestObject) (GetAPIKeysResponseObject, error)
+	// Create API Key
+	// (POST /v2/api-keys)
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error)
+	// Revoke API Key
+	// (DELETE /v2/api-keys/{id})
+	RevokeAPIKey(ctx context.Context, request RevokeAPIKeyRequestObject) (RevokeAPIKeyResponseObject, error)
+	// Authentication Callback
+	// (POST /v2/authentication/callback)
+	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
+	// Get Authentication Connection
+	// (GET /v2/authentication/sessions/{id})
+	GetAuthenticationConnection(ctx context.Context, request GetAuthenticationConnectionRequestObject) (GetAuthenticationConnectionResponseObject, error)
+	// Get Identities
+	// (GET /v2/identities)
+	GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error)
+	// Create Identity
+	// (POST /v2/identities)
+	CreateIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error)
+	// Get Identity Detail
+	// (GET /v2/identities/{identifier})
+	GetIdentityDetails(ctx context.Context, request GetIdentityDetailsRequestObject) (GetIdentityDetailsResponseObject, error)
+	// Update Identity
+	// (PATCH /v2/identities/{identifier})
+	UpdateIdentity(ctx context.Context, request UpdateIdentityRequestObject) (UpdateIdentityResponseObject, error)
+	// Get Audit Log
+	// (GET /v2/identities/{identifier}/audit)
+	GetAuditLog(ctx context.Context, request GetAuditLogRequestObject) (GetAuditLogResponseObject, error)
+	// Verify Audit Log
+	// (GET /v2/identities/{identifier}/audit/verify)
+	VerifyAuditLog(ctx context.Context, request VerifyAuditLogRequestObject) (VerifyAuditLogResponseObject, error)
+	// Get Connections
+	// (GET /v2/identities/{identifier}/connections)
+	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
+	// Create Connection
+	// (POST /v2/identities/{identifier}/connections)
+	CreateConnection(ctx context.Context, request CreateConnectionRequestObject) (CreateConnectionResponseObject, error)
+	// Delete Connection
+	// (DELETE /v2/identities/{identifier}/connections/{id})
+	DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error)
+	// Get Connection
+	// (GET /v2/identities/{identifier}/connections/{id})
+	GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error)
+	// Get Connection Conversations
+	// (GET /v2/identities/{identifier}/connections/{id}/conversations)
+	GetConnectionConversations(ctx context.Context, request GetConnectionConversationsRequestObject) (GetConnectionConversationsResponseObject, error)
+	// Delete Connection Credentials
+	// (DELETE /v2/identities/{identifier}/connections/{id}/credentials)
+	DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error)
+	// Revoke Connection Credentials
+	// (POST /v2/identities/{identifier}/connections/{id}/credentials/revoke)
+	RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error)
+	// Get Proof Requests
+	// (GET /v2/identities/{identifier}/connections/{id}/proof-requests)
+	GetProofRequests(ctx context.Context, request GetProofRequestsRequestObject) (GetProofRequestsResponseObject, error)
+	// Create Proof Request
+	// (POST /v2/identities/{identifier}/connections/{id}/proof-requests)
+	CreateProofRequest(ctx context.Context, request CreateProofRequestRequestObject) (CreateProofRequestResponseObject, error)
+	// Create Auth Credential
+	// (POST /v2/identities/{identifier}/create-auth-credential)
+	CreateAuthCredential(ctx context.Context, request CreateAuthCredentialRequestObject) (CreateAuthCredentialResponseObject, error)
+	// Get Credentials
+	// (GET /v2/identities/{identifier}/credentials)
+	GetCredentials(ctx context.Context, request GetCredentialsRequestObject) (GetCredentialsResponseObject, error)
+	// Create Credential
+	// (POST /v2/identities/{identifier}/credentials)
+	CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error)
+	// Get Links
+	// (GET /v2/identities/{identifier}/credentials/links)
+	GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error)
+	// Create Link
+	// (POST /v2/identities/{identifier}/credentials/links)
+	CreateLink(ctx context.Context, request CreateLinkRequestObject) (CreateLinkResponseObject, error)
+	// Create Link QR Code Callback
+	// (POST /v2/identities/{identifier}/credentials/links/callback)
+	CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error)
+	// Delete Link
+	// (DELETE /v2/identities/{identifier}/credentials/links/{id})
+	DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error)
+	// Get Link
+	// (GET /v2/identities/{identifier}/credentials/links/{id})
+	GetLink(ctx context.Context, request GetLinkRequestObject) (GetLinkResponseObject, error)
+	// Activate | Deactivate Link
+	// (PATCH /v2/identities/{identifier}/credentials/links/{id})
+	ActivateLink(ctx context.Context, request ActivateLinkRequestObject) (ActivateLinkResponseObject, error)
+	// Create a credential offer for a link
+	// (POST /v2/identities/{identifier}/credentials/links/{id}/offer)
+	CreateLinkOffer(ctx context.Context, request CreateLinkOfferRequestObject) (CreateLinkOfferResponseObject, error)
+	// Create an OpenID4VCI credential offer for a link
+	// (POST /v2/identities/{identifier}/credentials/links/{id}/oid4vci-offer)
+	CreateLinkOID4VCIOffer(ctx context.Context, request CreateLinkOID4VCIOfferRequestObject) (CreateLinkOID4VCIOfferResponseObject, error)
+	// Get Revocation Status
+	// (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
+	GetRevocationStatusV2(ctx context.Context, request GetRevocationStatusV2RequestObject) (GetRevocationStatusV2ResponseObject, error)
+	// Revoke Credential
+	// (POST /v2/identities/{identifier}/credentials/revoke/{nonce})
+	RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error)
+	// Delete Credential
+	// (DELETE /v2/identities/{identifier}/credentials/{id})
+	DeleteCredential(ctx context.Context, request DeleteCredentialRequestObject) (DeleteCredentialResponseObject, error)
+	// Get Credential
+	// (GET /v2/identities/{identifier}/credentials/{id})
+	GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error)
+	// Get Credentials Offer
+	// (GET /v2/identities/{identifier}/credentials/{id}/offer)
+	GetCredentialOffer(ctx context.Context, request GetCredentialOfferRequestObject) (GetCredentialOfferResponseObject, error)
+	// Create Credential Presentation
+	// (POST /v2/identities/{identifier}/credentials/{id}/presentation)
+	CreateCredentialPresentation(ctx context.Context, request CreateCredentialPresentationRequestObject) (CreateCredentialPresentationResponseObject, error)
+	// Get All Display Methods
+	// (GET /v2/identities/{identifier}/display-method)
+	GetAllDisplayMethods(ctx context.Context, request GetAllDisplayMethodsRequestObject) (GetAllDisplayMethodsResponseObject, error)
+	// Create Display Method
+	// (POST /v2/identities/{identifier}/display-method)
+	CreateDisplayMethod(ctx context.Context, request CreateDisplayMethodRequestObject) (CreateDisplayMethodResponseObject, error)
+	// Delete Display Method
+	// (DELETE /v2/identities/{identifier}/display-method/{id})
+	DeleteDisplayMethod(ctx context.Context, request DeleteDisplayMethodRequestObject) (DeleteDisplayMethodResponseObject, error)
+	// Get Display Method
+	// (GET /v2/identities/{identifier}/display-method/{id})
+	GetDisplayMethod(ctx context.Context, request GetDisplayMethodRequestObject) (GetDisplayMethodResponseObject, error)
+	// Update Display Method
+	// (PATCH /v2/identities/{identifier}/display-method/{id})
+	UpdateDisplayMethod(ctx context.Context, request UpdateDisplayMethodRequestObject) (UpdateDisplayMethodResponseObject, error)
+	// Get Keys
+	// (GET /v2/identities/{identifier}/keys)
+	GetKeys(ctx context.Context, request GetKeysRequestObject) (GetKeysResponseObject, error)
+	// Create a Key
+	// (POST /v2/identities/{identifier}/keys)
+	CreateKey(ctx context.Context, request CreateKeyRequestObject) (CreateKeyResponseObject, error)
+	// Delete Key
+	// (DELETE /v2/identities/{identifier}/keys/{id})
+	DeleteKey(ctx context.Context, request DeleteKeyRequestObject) (DeleteKeyResponseObject, error)
+	// Get a Key
+	// (GET /v2/identities/{identifier}/keys/{id})
+	GetKey(ctx context.Context, request GetKeyRequestObject) (GetKeyResponseObject, error)
+	// Update a Key
+	// (PATCH /v2/identities/{identifier}/keys/{id})
+	UpdateKey(ctx context.Context, request UpdateKeyRequestObject) (UpdateKeyResponseObject, error)
+	// Create an OpenID4VP presentation session
+	// (POST /v2/identities/{identifier}/oid4vp/sessions)
+	CreateOID4VPSession(ctx context.Context, request CreateOID4VPSessionRequestObject) (CreateOID4VPSessionResponseObject, error)
+	// Get an OpenID4VP presentation session
+	// (GET /v2/identities/{identifier}/oid4vp/sessions/{id})
+	GetOID4VPSession(ctx context.Context, request GetOID4VPSessionRequestObject) (GetOID4VPSessionResponseObject, error)
+	// Get Onchain Issuers
+	// (GET /v2/identities/{identifier}/onchain-issuers)
+	GetOnchainIssuers(ctx context.Context, request GetOnchainIssuersRequestObject) (GetOnchainIssuersResponseObject, error)
+	// Register Onchain Issuer
+	// (POST /v2/identities/{identifier}/onchain-issuers)
+	RegisterOnchainIssuer(ctx context.Context, request RegisterOnchainIssuerRequestObject) (RegisterOnchainIssuerResponseObject, error)
+	// Create Onchain Credential
+	// (POST /v2/identities/{identifier}/onchain-issuers/{onchainIssuer}/credentials)
+	CreateOnchainCredential(ctx context.Context, request CreateOnchainCredentialRequestObject) (CreateOnchainCredentialResponseObject, error)
+	// Get Payment Requests
+	// (GET /v2/identities/{identifier}/payment-request)
+	GetPaymentRequests(ctx context.Context, request GetPaymentRequestsRequestObject) (GetPaymentRequestsResponseObject, error)
+	// Create Payment Request
+	// (POST /v2/identities/{identifier}/payment-request)
+	CreatePaymentRequest(ctx context.Context, request CreatePaymentRequestRequestObject) (CreatePaymentRequestResponseObject, error)
+	// Delete Payment Request
+	// (DELETE /v2/identities/{identifier}/payment-request/{id})
+	DeletePaymentRequest(ctx context.Context, request DeletePaymentRequestRequestObject) (DeletePaymentRequestResponseObject, error)
+	// Get Payment Request
+	// (GET /v2/identities/{identifier}/payment-request/{id})
+	GetPaymentRequest(ctx context.Context, request GetPaymentRequestRequestObject) (GetPaymentRequestResponseObject, error)
+	// Get Payment Options
+	// (GET /v2/identities/{identifier}/payment/options)
+	GetPaymentOptions(ctx context.Context, request GetPaymentOptionsRequestObject) (GetPaymentOptionsResponseObject, error)
+	// Create Payment Option
+	// (POST /v2/identities/{identifier}/payment/options)
+	CreatePaymentOption(ctx context.Context, request CreatePaymentOptionRequestObject) (CreatePaymentOptionResponseObject, error)
+	// Delete Payment Option
+	// (DELETE /v2/identities/{identifier}/payment/options/{id})
+	DeletePaymentOption(ctx context.Context, request DeletePaymentOptionRequestObject) (DeletePaymentOptionResponseObject, error)
+	// Get Payment Option
+	// (GET /v2/identities/{identifier}/payment/options/{id})
+	GetPaymentOption(ctx context.Context, request GetPaymentOptionRequestObject) (GetPaymentOptionResponseObject, error)
+	// Update Payment Option
+	// (PATCH /v2/identities/{identifier}/payment/options/{id})
+	UpdatePaymentOption(ctx context.Context, request UpdatePaymentOptionRequestObject) (UpdatePaymentOptionResponseObject, error)
+	// Verify Payment
+	// (POST /v2/identities/{identifier}/payment/verify/{nonce})
+	VerifyPayment(ctx context.Context, request VerifyPaymentRequestObject) (VerifyPaymentResponseObject, error)
+	// Build JSON schema
+	// (POST /v2/identities/{identifier}/schema-builder)
+	BuildSchema(ctx context.Context, request BuildSchemaRequestObject) (BuildSchemaResponseObject, error)
+	// Get Schema Families
+	// (GET /v2/identities/{identifier}/schema-families)
+	GetSchemaFamilies(ctx context.Context, request GetSchemaFamiliesRequestObject) (GetSchemaFamiliesResponseObject, error)
+	// Create Schema Family
+	// (POST /v2/identities/{identifier}/schema-families)
+	CreateSchemaFamily(ctx context.Context, request CreateSchemaFamilyRequestObject) (CreateSchemaFamilyResponseObject, error)
+	// Get Schema Family
+	// (GET /v2/identities/{identifier}/schema-families/{id})
+	GetSchemaFamily(ctx context.Context, request GetSchemaFamilyRequestObject) (GetSchemaFamilyResponseObject, error)
+	// Create Schema Migration
+	// (POST /v2/identities/{identifier}/schema-families/{id}/migrations)
+	CreateSchemaMigration(ctx context.Context, request CreateSchemaMigrationRequestObject) (CreateSchemaMigrationResponseObject, error)
+	// Add Schema Family Version
+	// (POST /v2/identities/{identifier}/schema-families/{id}/versions)
+	AddSchemaFamilyVersion(ctx context.Context, request AddSchemaFamilyVersionRequestObject) (AddSchemaFamilyVersionResponseObject, error)
+	// Get Schema Migration
+	// (GET /v2/identities/{identifier}/schema-migrations/{id})
+	GetSchemaMigration(ctx context.Context, request GetSchemaMigrationRequestObject) (GetSchemaMigrationResponseObject, error)
+	// Get Schemas
+	// (GET /v2/identities/{identifier}/schemas)
+	GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error)
+	// Import JSON schema
+	// (POST /v2/identities/{identifier}/schemas)
+	ImportSchema(ctx context.Context, request ImportSchemaRequestObject) (ImportSchemaResponseObject, error)
+	// Get Schema
+	// (GET /v2/identities/{identifier}/schemas/{id})
+	GetSchema(ctx context.Context, request GetSchemaRequestObject) (GetSchemaResponseObject, error)
+	// Update Schema
+	// (PATCH /v2/identities/{identifier}/schemas/{id})
+	UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error)
+	// Check Schema Drift
+	// (POST /v2/identities/{identifier}/schemas/{id}/drift)
+	CheckSchemaDrift(ctx context.Context, request CheckSchemaDriftRequestObject) (CheckSchemaDriftResponseObject, error)
+	// Publish Identity State
+	// (POST /v2/identities/{identifier}/state/publish)
+	PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error)
+	// Retry Publish Identity State
+	// (POST /v2/identities/{identifier}/state/retry)
+	RetryPublishState(ctx context.Context, request RetryPublishStateRequestObject) (RetryPublishStateResponseObject, error)
+	// Get Identity State Status
+	// (GET /v2/identities/{identifier}/state/status)
+	GetStateStatus(ctx context.Context, request GetStateStatusRequestObject) (GetStateStatusResponseObject, error)
+	// Get Identity State Transactions
+	// (GET /v2/identities/{identifier}/state/transactions)
+	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
+	// Get Identity Transaction Costs
+	// (GET /v2/identities/{identifier}/transactions/costs)
+	GetTransactionCosts(ctx context.Context, request GetTransactionCostsRequestObject) (GetTransactionCostsResponseObject, error)
+	// Get OpenID4VCI authorization server metadata
+	// (GET /v2/oid4vci/{identifier}/.well-known/oauth-authorization-server)
+	GetOID4VCIAuthorizationServerMetadata(ctx context.Context, request GetOID4VCIAuthorizationServerMetadataRequestObject) (GetOID4VCIAuthorizationServerMetadataResponseObject, error)
+	// Get OpenID4VCI issuer metadata
+	// (GET /v2/oid4vci/{identifier}/.well-known/openid-credential-issuer)
+	GetOID4VCIIssuerMetadata(ctx context.Context, request GetOID4VCIIssuerMetadataRequestObject) (GetOID4VCIIssuerMetadataResponseObject, error)
+	// OpenID4VCI credential endpoint
+	// (POST /v2/oid4vci/{identifier}/credential)
+	CreateOID4VCICredential(ctx context.Context, request CreateOID4VCICredentialRequestObject) (CreateOID4VCICredentialResponseObject, error)
+	// OpenID4VCI token endpoint
+	// (POST /v2/oid4vci/{identifier}/token)
+	CreateOID4VCIToken(ctx context.Context, request CreateOID4VCITokenRequestObject) (CreateOID4VCITokenResponseObject, error)
+	// Get the OpenID4VP request object
+	// (GET /v2/oid4vp/sessions/{id}/request)
+	GetOID4VPRequestObject(ctx context.Context, request GetOID4VPRequestObjectRequestObject) (GetOID4VPRequestObjectResponseObject, error)
+	// OpenID4VP response endpoint
+	// (POST /v2/oid4vp/sessions/{id}/response)
+	CreateOID4VPResponse(ctx context.Context, request CreateOID4VPResponseRequestObject) (CreateOID4VPResponseResponseObject, error)
+	// Payments Configuration
+	// (GET /v2/payment/settings)
+	GetPaymentSettings(ctx context.Context, request GetPaymentSettingsRequestObject) (GetPaymentSettingsResponseObject, error)
+	// Proof Request Callback
+	// (POST /v2/proof-requests/callback)
+	ProofRequestCallback(ctx context.Context, request ProofRequestCallbackRequestObject) (ProofRequestCallbackResponseObject, error)
+	// Get QrCode from store
+	// (GET /v2/qr-store)
+	GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error)
+	// Get Built JSON-LD Context
+	// (GET /v2/schemas/{id}/context.jsonld)
+	GetBuiltSchemaContext(ctx context.Context, request GetBuiltSchemaContextRequestObject) (GetBuiltSchemaContextResponseObject, error)
+	// Get Built JSON Schema
+	// (GET /v2/schemas/{id}/schema.json)
+	GetBuiltSchema(ctx context.Context, request GetBuiltSchemaRequestObject) (GetBuiltSchemaResponseObject, error)
+	// Get Status List Credential
+	// (GET /v2/status-lists/{id})
+	GetStatusListCredential(ctx context.Context, request GetStatusListCredentialRequestObject) (GetStatusListCredentialResponseObject, error)
+	// Get Supported Networks
+	// (GET /v2/supported-networks)
+	GetSupportedNetworks(ctx context.Context, request GetSupportedNetworksRequestObject) (GetSupportedNetworksResponseObject, error)
+	// Reload Supported Networks
+	// (POST /v2/supported-networks/reload)
+	ReloadSupportedNetworks(ctx context.Context, request ReloadSupportedNetworksRequestObject) (ReloadSupportedNetworksResponseObject, error)
+	// Get Tenants
+	// (GET /v2/tenants)
+	GetTenants(ctx context.Context, request GetTenantsRequestObject) (GetTenantsResponseObject, error)
+	// Create Tenant
+	// (POST /v2/tenants)
+	CreateTenant(ctx context.Context, request CreateTenantRequestObject) (CreateTenantResponseObject, error)
+	// Get Tenant
+	// (GET /v2/tenants/{id})
+	GetTenant(ctx context.Context, request GetTenantRequestObject) (GetTenantResponseObject, error)
+	// Update Tenant
+	// (PUT /v2/tenants/{id})
+	UpdateTenant(ctx context.Context, request UpdateTenantRequestObject) (UpdateTenantResponseObject, error)
+	// Get Authentication Message
+	// (POST /v2/{identifier}/authentication)
+	Authentication(ctx context.Context, request AuthenticationRequestObject) (AuthenticationResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
 
-type DeletePaymentOptionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type DeletePaymentOptionResponseObject interface {
-	VisitDeletePaymentOptionResponse(w http.ResponseWriter) error
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
 }
 
-type DeletePaymentOption200JSONResponse GenericMessage
-
-func (response DeletePaymentOption200JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type DeletePaymentOption400JSONResponse struct{ N400JSONResponse }
-
-func (response DeletePaymentOption400JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
 }
 
-type DeletePaymentOption500JSONResponse struct{ N500JSONResponse }
-
-func (response DeletePaymentOption500JSONResponse) VisitDeletePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// Health operation middleware
+func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
+	var request HealthRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Health")
+	}
 
-type GetPaymentOptionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetPaymentOptionResponseObject interface {
-	VisitGetPaymentOptionResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HealthResponseObject); ok {
+		if err := validResponse.VisitHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPaymentOption200JSONResponse PaymentOption
-
-func (response GetPaymentOption200JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// AgentV1 operation middleware
+func (sh *strictHandler) AgentV1(w http.ResponseWriter, r *http.Request) {
+	var request AgentV1RequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AgentV1TextRequestBody(data)
+	request.Body = &body
 
-type GetPaymentOption400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AgentV1(ctx, request.(AgentV1RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AgentV1")
+	}
 
-func (response GetPaymentOption400JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentV1ResponseObject); ok {
+		if err := validResponse.VisitAgentV1Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPaymentOption404JSONResponse struct{ N404JSONResponse }
-
-func (response GetPaymentOption404JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// GetRevocationStatus operation middleware
+func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request GetRevocationStatusRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Nonce = nonce
 
-type GetPaymentOption500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationStatus")
+	}
 
-func (response GetPaymentOption500JSONResponse) VisitGetPaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePaymentOptionRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Body       *UpdatePaymentOptionJSONRequestBody
-}
+// Agent operation middleware
+func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
+	var request AgentRequestObject
 
-type UpdatePaymentOptionResponseObject interface {
-	VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error
-}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AgentTextRequestBody(data)
+	request.Body = &body
 
-type UpdatePaymentOption200JSONResponse GenericMessage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Agent")
+	}
 
-func (response UpdatePaymentOption200JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AgentResponseObject); ok {
+		if err := validResponse.VisitAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdatePaymentOption400JSONResponse struct{ N400JSONResponse }
+// GetAPIKeys operation middleware
+func (sh *strictHandler) GetAPIKeys(w http.ResponseWriter, r *http.Request) {
+	var request GetAPIKeysRequestObject
 
-func (response UpdatePaymentOption400JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAPIKeys(ctx, request.(GetAPIKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAPIKeys")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type UpdatePaymentOption404JSONResponse struct{ N404JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAPIKeysResponseObject); ok {
+		if err := validResponse.VisitGetAPIKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response UpdatePaymentOption404JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// CreateAPIKey operation middleware
+func (sh *strictHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPIKeyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdatePaymentOption500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIKey(ctx, request.(CreateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIKey")
+	}
 
-func (response UpdatePaymentOption500JSONResponse) VisitUpdatePaymentOptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitCreateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type VerifyPaymentRequestObject struct {
-	Identifier string `json:"identifier"`
-	Nonce      string `json:"nonce"`
-	Body       *VerifyPaymentJSONRequestBody
-}
+// RevokeAPIKey operation middleware
+func (sh *strictHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request, id Id) {
+	var request RevokeAPIKeyRequestObject
 
-type VerifyPaymentResponseObject interface {
-	VisitVerifyPaymentResponse(w http.ResponseWriter) error
-}
+	request.Id = id
 
-type VerifyPayment200JSONResponse PaymentStatus
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAPIKey(ctx, request.(RevokeAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAPIKey")
+	}
 
-func (response VerifyPayment200JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeAPIKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type VerifyPayment400JSONResponse struct{ N400JSONResponse }
+// AuthCallback operation middleware
+func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
+	var request AuthCallbackRequestObject
 
-func (response VerifyPayment400JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := AuthCallbackTextRequestBody(data)
+	request.Body = &body
 
-type VerifyPayment401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AuthCallback")
+	}
 
-func (response VerifyPayment401JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
+		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type VerifyPayment500JSONResponse struct{ N500JSONResponse }
+// GetAuthenticationConnection operation middleware
+func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetAuthenticationConnectionRequestObject
 
-func (response VerifyPayment500JSONResponse) VisitVerifyPaymentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthenticationConnection(ctx, request.(GetAuthenticationConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthenticationConnection")
+	}
 
-type GetSchemasRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetSchemasParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchemasResponseObject interface {
-	VisitGetSchemasResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthenticationConnectionResponseObject); ok {
+		if err := validResponse.VisitGetAuthenticationConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchemas200JSONResponse []Schema
+// GetIdentities operation middleware
+func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
+	var request GetIdentitiesRequestObject
 
-func (response GetSchemas200JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIdentities(ctx, request.(GetIdentitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIdentities")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchemas400JSONResponse struct{ N400JSONResponse }
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIdentitiesResponseObject); ok {
+		if err := validResponse.VisitGetIdentitiesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetSchemas400JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// CreateIdentity operation middleware
+func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request) {
+	var request CreateIdentityRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSchemas500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateIdentity(ctx, request.(CreateIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateIdentity")
+	}
 
-func (response GetSchemas500JSONResponse) VisitGetSchemasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateIdentityResponseObject); ok {
+		if err := validResponse.VisitCreateIdentityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ImportSchemaRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Body       *ImportSchemaJSONRequestBody
-}
+// GetIdentityDetails operation middleware
+func (sh *strictHandler) GetIdentityDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetIdentityDetailsRequestObject
 
-type ImportSchemaResponseObject interface {
-	VisitImportSchemaResponse(w http.ResponseWriter) error
-}
+	request.Identifier = identifier
 
-type ImportSchema201JSONResponse UUIDResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIdentityDetails(ctx, request.(GetIdentityDetailsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIdentityDetails")
+	}
 
-func (response ImportSchema201JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIdentityDetailsResponseObject); ok {
+		if err := validResponse.VisitGetIdentityDetailsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ImportSchema400JSONResponse struct{ N400JSONResponse }
-
-func (response ImportSchema400JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// UpdateIdentity operation middleware
+func (sh *strictHandler) UpdateIdentity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request UpdateIdentityRequestObject
 
-type ImportSchema500JSONResponse struct{ N500JSONResponse }
+	request.Identifier = identifier
 
-func (response ImportSchema500JSONResponse) VisitImportSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body UpdateIdentityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateIdentity(ctx, request.(UpdateIdentityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateIdentity")
+	}
 
-type GetSchemaRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSchemaResponseObject interface {
-	VisitGetSchemaResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateIdentityResponseObject); ok {
+		if err := validResponse.VisitUpdateIdentityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchema200JSONResponse Schema
-
-func (response GetSchema200JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetAuditLog operation middleware
+func (sh *strictHandler) GetAuditLog(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetAuditLogParams) {
+	var request GetAuditLogRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Params = params
 
-type GetSchema400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuditLog(ctx, request.(GetAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuditLog")
+	}
 
-func (response GetSchema400JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditLogResponseObject); ok {
+		if err := validResponse.VisitGetAuditLogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSchema404JSONResponse struct{ N404JSONResponse }
-
-func (response GetSchema404JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// VerifyAuditLog operation middleware
+func (sh *strictHandler) VerifyAuditLog(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	var request VerifyAuditLogRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
 
-type GetSchema500JSONResponse struct{ N500JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyAuditLog(ctx, request.(VerifyAuditLogRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyAuditLog")
+	}
 
-func (response GetSchema500JSONResponse) VisitGetSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyAuditLogResponseObject); ok {
+		if err := validResponse.VisitVerifyAuditLogResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSchemaRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Id         Id             `json:"id"`
-	Body       *UpdateSchemaJSONRequestBody
-}
+// GetConnections operation middleware
+func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetConnectionsParams) {
+	var request GetConnectionsRequestObject
 
-type UpdateSchemaResponseObject interface {
-	VisitUpdateSchemaResponse(w http.ResponseWriter) error
-}
+	request.Identifier = identifier
+	request.Params = params
 
-type UpdateSchema200JSONResponse GenericMessage
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnections")
+	}
 
-func (response UpdateSchema200JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSchema400JSONResponse struct{ N400JSONResponse }
+// CreateConnection operation middleware
+func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateConnectionRequestObject
 
-func (response UpdateSchema400JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateConnectionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type UpdateSchema404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateConnection(ctx, request.(CreateConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateConnection")
+	}
 
-func (response UpdateSchema404JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateConnectionResponseObject); ok {
+		if err := validResponse.VisitCreateConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type UpdateSchema500JSONResponse struct{ N500JSONResponse }
+// DeleteConnection operation middleware
+func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params DeleteConnectionParams) {
+	var request DeleteConnectionRequestObject
 
-func (response UpdateSchema500JSONResponse) VisitUpdateSchemaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnection")
+	}
 
-type PublishIdentityStateRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PublishIdentityStateResponseObject interface {
-	VisitPublishIdentityStateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState200JSONResponse GenericMessage
-
-func (response PublishIdentityState200JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetConnection operation middleware
+func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetConnectionRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type PublishIdentityState202JSONResponse PublishIdentityStateResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnection")
+	}
 
-func (response PublishIdentityState202JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
+		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState400JSONResponse struct{ N400JSONResponse }
-
-func (response PublishIdentityState400JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// GetConnectionConversations operation middleware
+func (sh *strictHandler) GetConnectionConversations(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetConnectionConversationsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type PublishIdentityState401JSONResponse struct{ N401JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConnectionConversations(ctx, request.(GetConnectionConversationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConnectionConversations")
+	}
 
-func (response PublishIdentityState401JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConnectionConversationsResponseObject); ok {
+		if err := validResponse.VisitGetConnectionConversationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PublishIdentityState500JSONResponse struct{ N500JSONResponse }
+// DeleteConnectionCredentials operation middleware
+func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteConnectionCredentialsRequestObject
 
-func (response PublishIdentityState500JSONResponse) VisitPublishIdentityStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConnectionCredentials")
+	}
 
-type RetryPublishStateRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type RetryPublishStateResponseObject interface {
-	VisitRetryPublishStateResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RetryPublishState202JSONResponse PublishIdentityStateResponse
-
-func (response RetryPublishState202JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+// RevokeConnectionCredentials operation middleware
+func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request RevokeConnectionCredentialsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type RetryPublishState400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeConnectionCredentials")
+	}
 
-func (response RetryPublishState400JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
+		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type RetryPublishState500JSONResponse struct{ N500JSONResponse }
+// GetProofRequests operation middleware
+func (sh *strictHandler) GetProofRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetProofRequestsRequestObject
 
-func (response RetryPublishState500JSONResponse) VisitRetryPublishStateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProofRequests(ctx, request.(GetProofRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProofRequests")
+	}
 
-type GetStateStatusRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetStateStatusResponseObject interface {
-	VisitGetStateStatusResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetProofRequestsResponseObject); ok {
+		if err := validResponse.VisitGetProofRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateStatus200JSONResponse StateStatusResponse
+// CreateProofRequest operation middleware
+func (sh *strictHandler) CreateProofRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request CreateProofRequestRequestObject
 
-func (response GetStateStatus200JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateProofRequestJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetStateStatus400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateProofRequest(ctx, request.(CreateProofRequestRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateProofRequest")
+	}
 
-func (response GetStateStatus400JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateProofRequestResponseObject); ok {
+		if err := validResponse.VisitCreateProofRequestResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateStatus500JSONResponse struct{ N500JSONResponse }
+// CreateAuthCredential operation middleware
+func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	var request CreateAuthCredentialRequestObject
 
-func (response GetStateStatus500JSONResponse) VisitGetStateStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateAuthCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetStateTransactionsRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     GetStateTransactionsParams
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAuthCredential(ctx, request.(CreateAuthCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAuthCredential")
+	}
 
-type GetStateTransactionsResponseObject interface {
-	VisitGetStateTransactionsResponse(w http.ResponseWriter) error
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetStateTransactions200JSONResponse StateTransactionsPaginated
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAuthCredentialResponseObject); ok {
+		if err := validResponse.VisitCreateAuthCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response GetStateTransactions200JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetCredentials operation middleware
+func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialsParams) {
+	var request GetCredentialsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Params = params
 
-type GetStateTransactions400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCredentials")
+	}
 
-func (response GetStateTransactions400JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
+		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetStateTransactions500JSONResponse struct{ N500JSONResponse }
+// CreateCredential operation middleware
+func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateCredentialRequestObject
+
+	request.Identifier = identifier
 
-func (response GetStateTransactions500JSONResponse) VisitGetStateTransactionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var body CreateCredentialJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCredential")
+	}
 
-type GetPaymentSettingsRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetPaymentSettingsResponseObject interface {
-	VisitGetPaymentSettingsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetPaymentSettings200JSONResponse PaymentsConfiguration
+// GetLinks operation middleware
+func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetLinksParams) {
+	var request GetLinksRequestObject
 
-func (response GetPaymentSettings200JSONResponse) VisitGetPaymentSettingsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLinks")
+	}
 
-type GetQrFromStoreRequestObject struct {
-	Params GetQrFromStoreParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetQrFromStoreResponseObject interface {
-	VisitGetQrFromStoreResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
+		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore200JSONResponse map[string]interface{}
+// CreateLink operation middleware
+func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateLinkRequestObject
 
-func (response GetQrFromStore200JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.Identifier = identifier
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body CreateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetQrFromStore400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLink")
+	}
 
-func (response GetQrFromStore400JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
+		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore404JSONResponse struct{ N404JSONResponse }
+// CreateLinkQrCodeCallback operation middleware
+func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkQrCodeCallbackParams) {
+	var request CreateLinkQrCodeCallbackRequestObject
 
-func (response GetQrFromStore404JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Identifier = identifier
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := CreateLinkQrCodeCallbackTextRequestBody(data)
+	request.Body = &body
 
-type GetQrFromStore410JSONResponse struct{ N410JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkQrCodeCallback")
+	}
 
-func (response GetQrFromStore410JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(410)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
+		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetQrFromStore500JSONResponse struct{ N500JSONResponse }
+// DeleteLink operation middleware
+func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteLinkRequestObject
 
-func (response GetQrFromStore500JSONResponse) VisitGetQrFromStoreResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteLink")
+	}
 
-type GetSupportedNetworksRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type GetSupportedNetworksResponseObject interface {
-	VisitGetSupportedNetworksResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
+		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSupportedNetworks200JSONResponse []SupportedNetworks
-
-func (response GetSupportedNetworks200JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetLink operation middleware
+func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetLinkRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type GetSupportedNetworks400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLink")
+	}
 
-func (response GetSupportedNetworks400JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
+		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSupportedNetworks401JSONResponse struct{ N401JSONResponse }
+// ActivateLink operation middleware
+func (sh *strictHandler) ActivateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request ActivateLinkRequestObject
 
-func (response GetSupportedNetworks401JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body ActivateLinkJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
-type GetSupportedNetworks404JSONResponse struct{ N404JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ActivateLink(ctx, request.(ActivateLinkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ActivateLink")
+	}
 
-func (response GetSupportedNetworks404JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ActivateLinkResponseObject); ok {
+		if err := validResponse.VisitActivateLinkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetSupportedNetworks500JSONResponse struct{ N500JSONResponse }
+// CreateLinkOffer operation middleware
+func (sh *strictHandler) CreateLinkOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request CreateLinkOfferRequestObject
 
-func (response GetSupportedNetworks500JSONResponse) VisitGetSupportedNetworksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Identifier = identifier
+	request.Id = id
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkOffer(ctx, request.(CreateLinkOfferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkOffer")
+	}
 
-type AuthenticationRequestObject struct {
-	Identifier PathIdentifier `json:"identifier"`
-	Params     AuthenticationParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type AuthenticationResponseObject interface {
-	VisitAuthenticationResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkOfferResponseObject); ok {
+		if err := validResponse.VisitCreateLinkOfferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Authentication200JSONResponse AuthenticationResponse
-
-func (response Authentication200JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateLinkOID4VCIOffer operation middleware
+func (sh *strictHandler) CreateLinkOID4VCIOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request CreateLinkOID4VCIOfferRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Id = id
 
-type Authentication400JSONResponse struct{ N400JSONResponse }
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateLinkOID4VCIOffer(ctx, request.(CreateLinkOID4VCIOfferRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateLinkOID4VCIOffer")
+	}
 
-func (response Authentication400JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateLinkOID4VCIOfferResponseObject); ok {
+		if err := validResponse.VisitCreateLinkOID4VCIOfferResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type Authentication500JSONResponse struct{ N500JSONResponse }
-
-func (response Authentication500JSONResponse) VisitAuthenticationResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// GetRevocationStatusV2 operation middleware
+func (sh *strictHandler) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request GetRevocationStatusV2RequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Identifier = identifier
+	request.Nonce = nonce
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Healthcheck
-	// (GET /status)
-	Health(ctx context.Context, request HealthRequestObject) (HealthResponseObject, error)
-	// Agent V1
-	// (POST /v1/agent)
-	AgentV1(ctx context.Context, request AgentV1RequestObject) (AgentV1ResponseObject, error)
-	// Get Revocation Status V1
-	// (GET /v1/{identifier}/claims/revocation/status/{nonce})
-	GetRevocationStatus(ctx context.Context, request GetRevocationStatusRequestObject) (GetRevocationStatusResponseObject, error)
-	// Agent
-	// (POST /v2/agent)
-	Agent(ctx context.Context, request AgentRequestObject) (AgentResponseObject, error)
-	// Authentication Callback
-	// (POST /v2/authentication/callback)
-	AuthCallback(ctx context.Context, request AuthCallbackRequestObject) (AuthCallbackResponseObject, error)
-	// Get Authentication Connection
-	// (GET /v2/authentication/sessions/{id})
-	GetAuthenticationConnection(ctx context.Context, request GetAuthenticationConnectionRequestObject) (GetAuthenticationConnectionResponseObject, error)
-	// Get Identities
-	// (GET /v2/identities)
-	GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error)
-	// Create Identity
-	// (POST /v2/identities)
-	CreateIdentity(ctx context.Context, request CreateIdentityRequestObject) (CreateIdentityResponseObject, error)
-	// Get Identity Detail
-	// (GET /v2/identities/{identifier})
-	GetIdentityDetails(ctx context.Context, request GetIdentityDetailsRequestObject) (GetIdentityDetailsResponseObject, error)
-	// Update Identity
-	// (PATCH /v2/identities/{identifier})
-	UpdateIdentity(ctx context.Context, request UpdateIdentityRequestObject) (UpdateIdentityResponseObject, error)
-	// Get Connections
-	// (GET /v2/identities/{identifier}/connections)
-	GetConnections(ctx context.Context, request GetConnectionsRequestObject) (GetConnectionsResponseObject, error)
-	// Create Connection
-	// (POST /v2/identities/{identifier}/connections)
-	CreateConnection(ctx context.Context, request CreateConnectionRequestObject) (CreateConnectionResponseObject, error)
-	// Delete Connection
-	// (DELETE /v2/identities/{identifier}/connections/{id})
-	DeleteConnection(ctx context.Context, request DeleteConnectionRequestObject) (DeleteConnectionResponseObject, error)
-	// Get Connection
-	// (GET /v2/identities/{identifier}/connections/{id})
-	GetConnection(ctx context.Context, request GetConnectionRequestObject) (GetConnectionResponseObject, error)
-	// Delete Connection Credentials
-	// (DELETE /v2/identities/{identifier}/connections/{id}/credentials)
-	DeleteConnectionCredentials(ctx context.Context, request DeleteConnectionCredentialsRequestObject) (DeleteConnectionCredentialsResponseObject, error)
-	// Revoke Connection Credentials
-	// (POST /v2/identities/{identifier}/connections/{id}/credentials/revoke)
-	RevokeConnectionCredentials(ctx context.Context, request RevokeConnectionCredentialsRequestObject) (RevokeConnectionCredentialsResponseObject, error)
-	// Create Auth Credential
-	// (POST /v2/identities/{identifier}/create-auth-credential)
-	CreateAuthCredential(ctx context.Context, request CreateAuthCredentialRequestObject) (CreateAuthCredentialResponseObject, error)
-	// Get Credentials
-	// (GET /v2/identities/{identifier}/credentials)
-	GetCredentials(ctx context.Context, request GetCredentialsRequestObject) (GetCredentialsResponseObject, error)
-	// Create Credential
-	// (POST /v2/identities/{identifier}/credentials)
-	CreateCredential(ctx context.Context, request CreateCredentialRequestObject) (CreateCredentialResponseObject, error)
-	// Get Links
-	// (GET /v2/identities/{identifier}/credentials/links)
-	GetLinks(ctx context.Context, request GetLinksRequestObject) (GetLinksResponseObject, error)
-	// Create Link
-	// (POST /v2/identities/{identifier}/credentials/links)
-	CreateLink(ctx context.Context, request CreateLinkRequestObject) (CreateLinkResponseObject, error)
-	// Create Link QR Code Callback
-	// (POST /v2/identities/{identifier}/credentials/links/callback)
-	CreateLinkQrCodeCallback(ctx context.Context, request CreateLinkQrCodeCallbackRequestObject) (CreateLinkQrCodeCallbackResponseObject, error)
-	// Delete Link
-	// (DELETE /v2/identities/{identifier}/credentials/links/{id})
-	DeleteLink(ctx context.Context, request DeleteLinkRequestObject) (DeleteLinkResponseObject, error)
-	// Get Link
-	// (GET /v2/identities/{identifier}/credentials/links/{id})
-	GetLink(ctx context.Context, request GetLinkRequestObject) (GetLinkResponseObject, error)
-	// Activate | Deactivate Link
-	// (PATCH /v2/identities/{identifier}/credentials/links/{id})
-	ActivateLink(ctx context.Context, request ActivateLinkRequestObject) (ActivateLinkResponseObject, error)
-	// Create a credential offer for a link
-	// (POST /v2/identities/{identifier}/credentials/links/{id}/offer)
-	CreateLinkOffer(ctx context.Context, request CreateLinkOfferRequestObject) (CreateLinkOfferResponseObject, error)
-	// Get Revocation Status
-	// (GET /v2/identities/{identifier}/credentials/revocation/status/{nonce})
-	GetRevocationStatusV2(ctx context.Context, request GetRevocationStatusV2RequestObject) (GetRevocationStatusV2ResponseObject, error)
-	// Revoke Credential
-	// (POST /v2/identities/{identifier}/credentials/revoke/{nonce})
-	RevokeCredential(ctx context.Context, request RevokeCredentialRequestObject) (RevokeCredentialResponseObject, error)
-	// Delete Credential
-	// (DELETE /v2/identities/{identifier}/credentials/{id})
-	DeleteCredential(ctx context.Context, request DeleteCredentialRequestObject) (DeleteCredentialResponseObject, error)
-	// Get Credential
-	// (GET /v2/identities/{identifier}/credentials/{id})
-	GetCredential(ctx context.Context, request GetCredentialRequestObject) (GetCredentialResponseObject, error)
-	// Get Credentials Offer
-	// (GET /v2/identities/{identifier}/credentials/{id}/offer)
-	GetCredentialOffer(ctx context.Context, request GetCredentialOfferRequestObject) (GetCredentialOfferResponseObject, error)
-	// Get All Display Methods
-	// (GET /v2/identities/{identifier}/display-method)
-	GetAllDisplayMethods(ctx context.Context, request GetAllDisplayMethodsRequestObject) (GetAllDisplayMethodsResponseObject, error)
-	// Create Display Method
-	// (POST /v2/identities/{identifier}/display-method)
-	CreateDisplayMethod(ctx context.Context, request CreateDisplayMethodRequestObject) (CreateDisplayMethodResponseObject, error)
-	// Delete Display Method
-	// (DELETE /v2/identities/{identifier}/display-method/{id})
-	DeleteDisplayMethod(ctx context.Context, request DeleteDisplayMethodRequestObject) (DeleteDisplayMethodResponseObject, error)
-	// Get Display Method
-	// (GET /v2/identities/{identifier}/display-method/{id})
-	GetDisplayMethod(ctx context.Context, request GetDisplayMethodRequestObject) (GetDisplayMethodResponseObject, error)
-	// Update Display Method
-	// (PATCH /v2/identities/{identifier}/display-method/{id})
-	UpdateDisplayMethod(ctx context.Context, request UpdateDisplayMethodRequestObject) (UpdateDisplayMethodResponseObject, error)
-	// Get Keys
-	// (GET /v2/identities/{identifier}/keys)
-	GetKeys(ctx context.Context, request GetKeysRequestObject) (GetKeysResponseObject, error)
-	// Create a Key
-	// (POST /v2/identities/{identifier}/keys)
-	CreateKey(ctx context.Context, request CreateKeyRequestObject) (CreateKeyResponseObject, error)
-	// Delete Key
-	// (DELETE /v2/identities/{identifier}/keys/{id})
-	DeleteKey(ctx context.Context, request DeleteKeyRequestObject) (DeleteKeyResponseObject, error)
-	// Get a Key
-	// (GET /v2/identities/{identifier}/keys/{id})
-	GetKey(ctx context.Context, request GetKeyRequestObject) (GetKeyResponseObject, error)
-	// Update a Key
-	// (PATCH /v2/identities/{identifier}/keys/{id})
-	UpdateKey(ctx context.Context, request UpdateKeyRequestObject) (UpdateKeyResponseObject, error)
-	// Get Payment Requests
-	// (GET /v2/identities/{identifier}/payment-request)
-	GetPaymentRequests(ctx context.Context, request GetPaymentRequestsRequestObject) (GetPaymentRequestsResponseObject, error)
-	// Create Payment Request
-	// (POST /v2/identities/{identifier}/payment-request)
-	CreatePaymentRequest(ctx context.Context, request CreatePaymentRequestRequestObject) (CreatePaymentRequestResponseObject, error)
-	// Delete Payment Request
-	// (DELETE /v2/identities/{identifier}/payment-request/{id})
-	DeletePaymentRequest(ctx context.Context, request DeletePaymentRequestRequestObject) (DeletePaymentRequestResponseObject, error)
-	// Get Payment Request
-	// (GET /v2/identities/{identifier}/payment-request/{id})
-	GetPaymentRequest(ctx context.Context, request GetPaymentRequestRequestObject) (GetPaymentRequestResponseObject, error)
-	// Get Payment Options
-	// (GET /v2/identities/{identifier}/payment/options)
-	GetPaymentOptions(ctx context.Context, request GetPaymentOptionsRequestObject) (GetPaymentOptionsResponseObject, error)
-	// Create Payment Option
-	// (POST /v2/identities/{identifier}/payment/options)
-	CreatePaymentOption(ctx context.Context, request CreatePaymentOptionRequestObject) (CreatePaymentOptionResponseObject, error)
-	// Delete Payment Option
-	// (DELETE /v2/identities/{identifier}/payment/options/{id})
-	DeletePaymentOption(ctx context.Context, request DeletePaymentOptionRequestObject) (DeletePaymentOptionResponseObject, error)
-	// Get Payment Option
-	// (GET /v2/identities/{identifier}/payment/options/{id})
-	GetPaymentOption(ctx context.Context, request GetPaymentOptionRequestObject) (GetPaymentOptionResponseObject, error)
-	// Update Payment Option
-	// (PATCH /v2/identities/{identifier}/payment/options/{id})
-	UpdatePaymentOption(ctx context.Context, request UpdatePaymentOptionRequestObject) (UpdatePaymentOptionResponseObject, error)
-	// Verify Payment
-	// (POST /v2/identities/{identifier}/payment/verify/{nonce})
-	VerifyPayment(ctx context.Context, request VerifyPaymentRequestObject) (VerifyPaymentResponseObject, error)
-	// Get Schemas
-	// (GET /v2/identities/{identifier}/schemas)
-	GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error)
-	// Import JSON schema
-	// (POST /v2/identities/{identifier}/schemas)
-	ImportSchema(ctx context.Context, request ImportSchemaRequestObject) (ImportSchemaResponseObject, error)
-	// Get Schema
-	// (GET /v2/identities/{identifier}/schemas/{id})
-	GetSchema(ctx context.Context, request GetSchemaRequestObject) (GetSchemaResponseObject, error)
-	// Update Schema
-	// (PATCH /v2/identities/{identifier}/schemas/{id})
-	UpdateSchema(ctx context.Context, request UpdateSchemaRequestObject) (UpdateSchemaResponseObject, error)
-	// Publish Identity State
-	// (POST /v2/identities/{identifier}/state/publish)
-	PublishIdentityState(ctx context.Context, request PublishIdentityStateRequestObject) (PublishIdentityStateResponseObject, error)
-	// Retry Publish Identity State
-	// (POST /v2/identities/{identifier}/state/retry)
-	RetryPublishState(ctx context.Context, request RetryPublishStateRequestObject) (RetryPublishStateResponseObject, error)
-	// Get Identity State Status
-	// (GET /v2/identities/{identifier}/state/status)
-	GetStateStatus(ctx context.Context, request GetStateStatusRequestObject) (GetStateStatusResponseObject, error)
-	// Get Identity State Transactions
-	// (GET /v2/identities/{identifier}/state/transactions)
-	GetStateTransactions(ctx context.Context, request GetStateTransactionsRequestObject) (GetStateTransactionsResponseObject, error)
-	// Payments Configuration
-	// (GET /v2/payment/settings)
-	GetPaymentSettings(ctx context.Context, request GetPaymentSettingsRequestObject) (GetPaymentSettingsResponseObject, error)
-	// Get QrCode from store
-	// (GET /v2/qr-store)
-	GetQrFromStore(ctx context.Context, request GetQrFromStoreRequestObject) (GetQrFromStoreResponseObject, error)
-	// Get Supported Networks
-	// (GET /v2/supported-networks)
-	GetSupportedNetworks(ctx context.Context, request GetSupportedNetworksRequestObject) (GetSupportedNetworksResponseObject, error)
-	// Get Authentication Message
-	// (POST /v2/{identifier}/authentication)
-	Authentication(ctx context.Context, request AuthenticationRequestObject) (AuthenticationResponseObject, error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRevocationStatusV2(ctx, request.(GetRevocationStatusV2RequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRevocationStatusV2")
+	}
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	response, err := handler(r.Context(), w, r, request)
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRevocationStatusV2ResponseObject); ok {
+		if err := validResponse.VisitGetRevocationStatusV2Response(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+// RevokeCredential operation middleware
+func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
+	var request RevokeCredentialRequestObject
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	request.Identifier = identifier
+	request.Nonce = nonce
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeCredential")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
+		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// Health operation middleware
-func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
-	var request HealthRequestObject
+// DeleteCredential operation middleware
+func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request DeleteCredentialRequestObject
+
+	request.Identifier = identifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Health(ctx, request.(HealthRequestObject))
+		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Health")
+		handler = middleware(handler, "DeleteCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(HealthResponseObject); ok {
-		if err := validResponse.VisitHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
+		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7337,31 +13302,27 @@ func (sh *strictHandler) Health(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AgentV1 operation middleware
-func (sh *strictHandler) AgentV1(w http.ResponseWriter, r *http.Request) {
-	var request AgentV1RequestObject
+// GetCredential operation middleware
+func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialParams) {
+	var request GetCredentialRequestObject
 
-	data, err := io.ReadAll(r.Body)
-	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
-	}
-	body := AgentV1TextRequestBody(data)
-	request.Body = &body
+	request.Identifier = identifier
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AgentV1(ctx, request.(AgentV1RequestObject))
+		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AgentV1")
+		handler = middleware(handler, "GetCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentV1ResponseObject); ok {
-		if err := validResponse.VisitAgentV1Response(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
+		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7369,26 +13330,27 @@ func (sh *strictHandler) AgentV1(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetRevocationStatus operation middleware
-func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request GetRevocationStatusRequestObject
+// GetCredentialOffer operation middleware
+func (sh *strictHandler) GetCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialOfferParams) {
+	var request GetCredentialOfferRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRevocationStatus(ctx, request.(GetRevocationStatusRequestObject))
+		return sh.ssi.GetCredentialOffer(ctx, request.(GetCredentialOfferRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRevocationStatus")
+		handler = middleware(handler, "GetCredentialOffer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRevocationStatusResponseObject); ok {
-		if err := validResponse.VisitGetRevocationStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetCredentialOfferResponseObject); ok {
+		if err := validResponse.VisitGetCredentialOfferResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7396,31 +13358,33 @@ func (sh *strictHandler) GetRevocationStatus(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// Agent operation middleware
-func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
-	var request AgentRequestObject
+// CreateCredentialPresentation operation middleware
+func (sh *strictHandler) CreateCredentialPresentation(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
+	var request CreateCredentialPresentationRequestObject
 
-	data, err := io.ReadAll(r.Body)
-	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+	request.Identifier = identifier
+	request.Id = id
+
+	var body CreateCredentialPresentationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
-	body := AgentTextRequestBody(data)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Agent(ctx, request.(AgentRequestObject))
+		return sh.ssi.CreateCredentialPresentation(ctx, request.(CreateCredentialPresentationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Agent")
+		handler = middleware(handler, "CreateCredentialPresentation")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AgentResponseObject); ok {
-		if err := validResponse.VisitAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateCredentialPresentationResponseObject); ok {
+		if err := validResponse.VisitCreateCredentialPresentationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7428,33 +13392,59 @@ func (sh *strictHandler) Agent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// AuthCallback operation middleware
-func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, params AuthCallbackParams) {
-	var request AuthCallbackRequestObject
+// GetAllDisplayMethods operation middleware
+func (sh *strictHandler) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams) {
+	var request GetAllDisplayMethodsRequestObject
 
+	request.Identifier = identifier
 	request.Params = params
 
-	data, err := io.ReadAll(r.Body)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAllDisplayMethods(ctx, request.(GetAllDisplayMethodsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAllDisplayMethods")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
 	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAllDisplayMethodsResponseObject); ok {
+		if err := validResponse.VisitGetAllDisplayMethodsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateDisplayMethod operation middleware
+func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateDisplayMethodRequestObject
+
+	request.Identifier = identifier
+
+	var body CreateDisplayMethodJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
 	}
-	body := AuthCallbackTextRequestBody(data)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthCallback(ctx, request.(AuthCallbackRequestObject))
+		return sh.ssi.CreateDisplayMethod(ctx, request.(CreateDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthCallback")
+		handler = middleware(handler, "CreateDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(AuthCallbackResponseObject); ok {
-		if err := validResponse.VisitAuthCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitCreateDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7462,25 +13452,26 @@ func (sh *strictHandler) AuthCallback(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// GetAuthenticationConnection operation middleware
-func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *http.Request, id Id) {
-	var request GetAuthenticationConnectionRequestObject
+// DeleteDisplayMethod operation middleware
+func (sh *strictHandler) DeleteDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeleteDisplayMethodRequestObject
 
+	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthenticationConnection(ctx, request.(GetAuthenticationConnectionRequestObject))
+		return sh.ssi.DeleteDisplayMethod(ctx, request.(DeleteDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthenticationConnection")
+		handler = middleware(handler, "DeleteDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAuthenticationConnectionResponseObject); ok {
-		if err := validResponse.VisitGetAuthenticationConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitDeleteDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7488,23 +13479,26 @@ func (sh *strictHandler) GetAuthenticationConnection(w http.ResponseWriter, r *h
 	}
 }
 
-// GetIdentities operation middleware
-func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
-	var request GetIdentitiesRequestObject
+// GetDisplayMethod operation middleware
+func (sh *strictHandler) GetDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetDisplayMethodRequestObject
+
+	request.Identifier = identifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIdentities(ctx, request.(GetIdentitiesRequestObject))
+		return sh.ssi.GetDisplayMethod(ctx, request.(GetDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIdentities")
+		handler = middleware(handler, "GetDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIdentitiesResponseObject); ok {
-		if err := validResponse.VisitGetIdentitiesResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitGetDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7512,11 +13506,14 @@ func (sh *strictHandler) GetIdentities(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// CreateIdentity operation middleware
-func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request) {
-	var request CreateIdentityRequestObject
+// UpdateDisplayMethod operation middleware
+func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdateDisplayMethodRequestObject
 
-	var body CreateIdentityJSONRequestBody
+	request.Identifier = identifier
+	request.Id = id
+
+	var body UpdateDisplayMethodJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7524,18 +13521,18 @@ func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request)
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateIdentity(ctx, request.(CreateIdentityRequestObject))
+		return sh.ssi.UpdateDisplayMethod(ctx, request.(UpdateDisplayMethodRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateIdentity")
+		handler = middleware(handler, "UpdateDisplayMethod")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateIdentityResponseObject); ok {
-		if err := validResponse.VisitCreateIdentityResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateDisplayMethodResponseObject); ok {
+		if err := validResponse.VisitUpdateDisplayMethodResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7543,25 +13540,59 @@ func (sh *strictHandler) CreateIdentity(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// GetIdentityDetails operation middleware
-func (sh *strictHandler) GetIdentityDetails(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request GetIdentityDetailsRequestObject
+// GetKeys operation middleware
+func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetKeysParams) {
+	var request GetKeysRequestObject
+
+	request.Identifier = identifier
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetKeys(ctx, request.(GetKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetKeysResponseObject); ok {
+		if err := validResponse.VisitGetKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateKey operation middleware
+func (sh *strictHandler) CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
+	var request CreateKeyRequestObject
 
 	request.Identifier = identifier
 
+	var body CreateKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetIdentityDetails(ctx, request.(GetIdentityDetailsRequestObject))
+		return sh.ssi.CreateKey(ctx, request.(CreateKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetIdentityDetails")
+		handler = middleware(handler, "CreateKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetIdentityDetailsResponseObject); ok {
-		if err := validResponse.VisitGetIdentityDetailsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateKeyResponseObject); ok {
+		if err := validResponse.VisitCreateKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7569,32 +13600,26 @@ func (sh *strictHandler) GetIdentityDetails(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// UpdateIdentity operation middleware
-func (sh *strictHandler) UpdateIdentity(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request UpdateIdentityRequestObject
+// DeleteKey operation middleware
+func (sh *strictHandler) DeleteKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
+	var request DeleteKeyRequestObject
 
 	request.Identifier = identifier
-
-	var body UpdateIdentityJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateIdentity(ctx, request.(UpdateIdentityRequestObject))
+		return sh.ssi.DeleteKey(ctx, request.(DeleteKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateIdentity")
+		handler = middleware(handler, "DeleteKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateIdentityResponseObject); ok {
-		if err := validResponse.VisitUpdateIdentityResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteKeyResponseObject); ok {
+		if err := validResponse.VisitDeleteKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7602,26 +13627,26 @@ func (sh *strictHandler) UpdateIdentity(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetConnections operation middleware
-func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetConnectionsParams) {
-	var request GetConnectionsRequestObject
+// GetKey operation middleware
+func (sh *strictHandler) GetKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
+	var request GetKeyRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnections(ctx, request.(GetConnectionsRequestObject))
+		return sh.ssi.GetKey(ctx, request.(GetKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnections")
+		handler = middleware(handler, "GetKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionsResponseObject); ok {
-		if err := validResponse.VisitGetConnectionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetKeyResponseObject); ok {
+		if err := validResponse.VisitGetKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7629,13 +13654,14 @@ func (sh *strictHandler) GetConnections(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateConnection operation middleware
-func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateConnectionRequestObject
+// UpdateKey operation middleware
+func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
+	var request UpdateKeyRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
-	var body CreateConnectionJSONRequestBody
+	var body UpdateKeyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7643,18 +13669,18 @@ func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateConnection(ctx, request.(CreateConnectionRequestObject))
+		return sh.ssi.UpdateKey(ctx, request.(UpdateKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateConnection")
+		handler = middleware(handler, "UpdateKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateConnectionResponseObject); ok {
-		if err := validResponse.VisitCreateConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateKeyResponseObject); ok {
+		if err := validResponse.VisitUpdateKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7662,27 +13688,32 @@ func (sh *strictHandler) CreateConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteConnection operation middleware
-func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id, params DeleteConnectionParams) {
-	var request DeleteConnectionRequestObject
+// CreateOID4VPSession operation middleware
+func (sh *strictHandler) CreateOID4VPSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateOID4VPSessionRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
-	request.Params = params
+
+	var body CreateOID4VPSessionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnection(ctx, request.(DeleteConnectionRequestObject))
+		return sh.ssi.CreateOID4VPSession(ctx, request.(CreateOID4VPSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnection")
+		handler = middleware(handler, "CreateOID4VPSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateOID4VPSessionResponseObject); ok {
+		if err := validResponse.VisitCreateOID4VPSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7690,26 +13721,26 @@ func (sh *strictHandler) DeleteConnection(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetConnection operation middleware
-func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetConnectionRequestObject
+// GetOID4VPSession operation middleware
+func (sh *strictHandler) GetOID4VPSession(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetOID4VPSessionRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConnection(ctx, request.(GetConnectionRequestObject))
+		return sh.ssi.GetOID4VPSession(ctx, request.(GetOID4VPSessionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConnection")
+		handler = middleware(handler, "GetOID4VPSession")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConnectionResponseObject); ok {
-		if err := validResponse.VisitGetConnectionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOID4VPSessionResponseObject); ok {
+		if err := validResponse.VisitGetOID4VPSessionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7717,26 +13748,25 @@ func (sh *strictHandler) GetConnection(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// DeleteConnectionCredentials operation middleware
-func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeleteConnectionCredentialsRequestObject
+// GetOnchainIssuers operation middleware
+func (sh *strictHandler) GetOnchainIssuers(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetOnchainIssuersRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConnectionCredentials(ctx, request.(DeleteConnectionCredentialsRequestObject))
+		return sh.ssi.GetOnchainIssuers(ctx, request.(GetOnchainIssuersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConnectionCredentials")
+		handler = middleware(handler, "GetOnchainIssuers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitDeleteConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOnchainIssuersResponseObject); ok {
+		if err := validResponse.VisitGetOnchainIssuersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7744,26 +13774,32 @@ func (sh *strictHandler) DeleteConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// RevokeConnectionCredentials operation middleware
-func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request RevokeConnectionCredentialsRequestObject
+// RegisterOnchainIssuer operation middleware
+func (sh *strictHandler) RegisterOnchainIssuer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request RegisterOnchainIssuerRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+
+	var body RegisterOnchainIssuerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeConnectionCredentials(ctx, request.(RevokeConnectionCredentialsRequestObject))
+		return sh.ssi.RegisterOnchainIssuer(ctx, request.(RegisterOnchainIssuerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeConnectionCredentials")
+		handler = middleware(handler, "RegisterOnchainIssuer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeConnectionCredentialsResponseObject); ok {
-		if err := validResponse.VisitRevokeConnectionCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(RegisterOnchainIssuerResponseObject); ok {
+		if err := validResponse.VisitRegisterOnchainIssuerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7771,13 +13807,14 @@ func (sh *strictHandler) RevokeConnectionCredentials(w http.ResponseWriter, r *h
 	}
 }
 
-// CreateAuthCredential operation middleware
-func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
-	var request CreateAuthCredentialRequestObject
+// CreateOnchainCredential operation middleware
+func (sh *strictHandler) CreateOnchainCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, onchainIssuer string) {
+	var request CreateOnchainCredentialRequestObject
 
 	request.Identifier = identifier
+	request.OnchainIssuer = onchainIssuer
 
-	var body CreateAuthCredentialJSONRequestBody
+	var body CreateOnchainCredentialJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7785,18 +13822,18 @@ func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Req
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAuthCredential(ctx, request.(CreateAuthCredentialRequestObject))
+		return sh.ssi.CreateOnchainCredential(ctx, request.(CreateOnchainCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAuthCredential")
+		handler = middleware(handler, "CreateOnchainCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAuthCredentialResponseObject); ok {
-		if err := validResponse.VisitCreateAuthCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateOnchainCredentialResponseObject); ok {
+		if err := validResponse.VisitCreateOnchainCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7804,26 +13841,26 @@ func (sh *strictHandler) CreateAuthCredential(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetCredentials operation middleware
-func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetCredentialsParams) {
-	var request GetCredentialsRequestObject
+// GetPaymentRequests operation middleware
+func (sh *strictHandler) GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams) {
+	var request GetPaymentRequestsRequestObject
 
 	request.Identifier = identifier
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentials(ctx, request.(GetCredentialsRequestObject))
+		return sh.ssi.GetPaymentRequests(ctx, request.(GetPaymentRequestsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentials")
+		handler = middleware(handler, "GetPaymentRequests")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialsResponseObject); ok {
-		if err := validResponse.VisitGetCredentialsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentRequestsResponseObject); ok {
+		if err := validResponse.VisitGetPaymentRequestsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7831,13 +13868,13 @@ func (sh *strictHandler) GetCredentials(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// CreateCredential operation middleware
-func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateCredentialRequestObject
+// CreatePaymentRequest operation middleware
+func (sh *strictHandler) CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreatePaymentRequestRequestObject
 
 	request.Identifier = identifier
 
-	var body CreateCredentialJSONRequestBody
+	var body CreatePaymentRequestJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -7845,18 +13882,18 @@ func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCredential(ctx, request.(CreateCredentialRequestObject))
+		return sh.ssi.CreatePaymentRequest(ctx, request.(CreatePaymentRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCredential")
+		handler = middleware(handler, "CreatePaymentRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCredentialResponseObject); ok {
-		if err := validResponse.VisitCreateCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePaymentRequestResponseObject); ok {
+		if err := validResponse.VisitCreatePaymentRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7864,26 +13901,26 @@ func (sh *strictHandler) CreateCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetLinks operation middleware
-func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetLinksParams) {
-	var request GetLinksRequestObject
+// DeletePaymentRequest operation middleware
+func (sh *strictHandler) DeletePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeletePaymentRequestRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLinks(ctx, request.(GetLinksRequestObject))
+		return sh.ssi.DeletePaymentRequest(ctx, request.(DeletePaymentRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLinks")
+		handler = middleware(handler, "DeletePaymentRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinksResponseObject); ok {
-		if err := validResponse.VisitGetLinksResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePaymentRequestResponseObject); ok {
+		if err := validResponse.VisitDeletePaymentRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7891,32 +13928,26 @@ func (sh *strictHandler) GetLinks(w http.ResponseWriter, r *http.Request, identi
 	}
 }
 
-// CreateLink operation middleware
-func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateLinkRequestObject
+// GetPaymentRequest operation middleware
+func (sh *strictHandler) GetPaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetPaymentRequestRequestObject
 
 	request.Identifier = identifier
-
-	var body CreateLinkJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLink(ctx, request.(CreateLinkRequestObject))
+		return sh.ssi.GetPaymentRequest(ctx, request.(GetPaymentRequestRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLink")
+		handler = middleware(handler, "GetPaymentRequest")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkResponseObject); ok {
-		if err := validResponse.VisitCreateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentRequestResponseObject); ok {
+		if err := validResponse.VisitGetPaymentRequestResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7924,34 +13955,25 @@ func (sh *strictHandler) CreateLink(w http.ResponseWriter, r *http.Request, iden
 	}
 }
 
-// CreateLinkQrCodeCallback operation middleware
-func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateLinkQrCodeCallbackParams) {
-	var request CreateLinkQrCodeCallbackRequestObject
+// GetPaymentOptions operation middleware
+func (sh *strictHandler) GetPaymentOptions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetPaymentOptionsRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
-
-	data, err := io.ReadAll(r.Body)
-	if err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
-		return
-	}
-	body := CreateLinkQrCodeCallbackTextRequestBody(data)
-	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkQrCodeCallback(ctx, request.(CreateLinkQrCodeCallbackRequestObject))
+		return sh.ssi.GetPaymentOptions(ctx, request.(GetPaymentOptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkQrCodeCallback")
+		handler = middleware(handler, "GetPaymentOptions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkQrCodeCallbackResponseObject); ok {
-		if err := validResponse.VisitCreateLinkQrCodeCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentOptionsResponseObject); ok {
+		if err := validResponse.VisitGetPaymentOptionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7959,26 +13981,32 @@ func (sh *strictHandler) CreateLinkQrCodeCallback(w http.ResponseWriter, r *http
 	}
 }
 
-// DeleteLink operation middleware
-func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeleteLinkRequestObject
+// CreatePaymentOption operation middleware
+func (sh *strictHandler) CreatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreatePaymentOptionRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+
+	var body CreatePaymentOptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteLink(ctx, request.(DeleteLinkRequestObject))
+		return sh.ssi.CreatePaymentOption(ctx, request.(CreatePaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteLink")
+		handler = middleware(handler, "CreatePaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteLinkResponseObject); ok {
-		if err := validResponse.VisitDeleteLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreatePaymentOptionResponseObject); ok {
+		if err := validResponse.VisitCreatePaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -7986,26 +14014,26 @@ func (sh *strictHandler) DeleteLink(w http.ResponseWriter, r *http.Request, iden
 	}
 }
 
-// GetLink operation middleware
-func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetLinkRequestObject
+// DeletePaymentOption operation middleware
+func (sh *strictHandler) DeletePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request DeletePaymentOptionRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetLink(ctx, request.(GetLinkRequestObject))
+		return sh.ssi.DeletePaymentOption(ctx, request.(DeletePaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetLink")
+		handler = middleware(handler, "DeletePaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetLinkResponseObject); ok {
-		if err := validResponse.VisitGetLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePaymentOptionResponseObject); ok {
+		if err := validResponse.VisitDeletePaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8013,33 +14041,26 @@ func (sh *strictHandler) GetLink(w http.ResponseWriter, r *http.Request, identif
 	}
 }
 
-// ActivateLink operation middleware
-func (sh *strictHandler) ActivateLink(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request ActivateLinkRequestObject
+// GetPaymentOption operation middleware
+func (sh *strictHandler) GetPaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetPaymentOptionRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
-	var body ActivateLinkJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ActivateLink(ctx, request.(ActivateLinkRequestObject))
+		return sh.ssi.GetPaymentOption(ctx, request.(GetPaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ActivateLink")
+		handler = middleware(handler, "GetPaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ActivateLinkResponseObject); ok {
-		if err := validResponse.VisitActivateLinkResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentOptionResponseObject); ok {
+		if err := validResponse.VisitGetPaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8047,26 +14068,33 @@ func (sh *strictHandler) ActivateLink(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// CreateLinkOffer operation middleware
-func (sh *strictHandler) CreateLinkOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request CreateLinkOfferRequestObject
+// UpdatePaymentOption operation middleware
+func (sh *strictHandler) UpdatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdatePaymentOptionRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
+	var body UpdatePaymentOptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateLinkOffer(ctx, request.(CreateLinkOfferRequestObject))
+		return sh.ssi.UpdatePaymentOption(ctx, request.(UpdatePaymentOptionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateLinkOffer")
+		handler = middleware(handler, "UpdatePaymentOption")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateLinkOfferResponseObject); ok {
-		if err := validResponse.VisitCreateLinkOfferResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdatePaymentOptionResponseObject); ok {
+		if err := validResponse.VisitUpdatePaymentOptionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8074,26 +14102,33 @@ func (sh *strictHandler) CreateLinkOffer(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetRevocationStatusV2 operation middleware
-func (sh *strictHandler) GetRevocationStatusV2(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request GetRevocationStatusV2RequestObject
+// VerifyPayment operation middleware
+func (sh *strictHandler) VerifyPayment(w http.ResponseWriter, r *http.Request, identifier string, nonce string) {
+	var request VerifyPaymentRequestObject
 
 	request.Identifier = identifier
 	request.Nonce = nonce
 
+	var body VerifyPaymentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRevocationStatusV2(ctx, request.(GetRevocationStatusV2RequestObject))
+		return sh.ssi.VerifyPayment(ctx, request.(VerifyPaymentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRevocationStatusV2")
+		handler = middleware(handler, "VerifyPayment")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRevocationStatusV2ResponseObject); ok {
-		if err := validResponse.VisitGetRevocationStatusV2Response(w); err != nil {
+	} else if validResponse, ok := response.(VerifyPaymentResponseObject); ok {
+		if err := validResponse.VisitVerifyPaymentResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8101,26 +14136,32 @@ func (sh *strictHandler) GetRevocationStatusV2(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// RevokeCredential operation middleware
-func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, nonce PathNonce) {
-	var request RevokeCredentialRequestObject
+// BuildSchema operation middleware
+func (sh *strictHandler) BuildSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request BuildSchemaRequestObject
 
 	request.Identifier = identifier
-	request.Nonce = nonce
+
+	var body BuildSchemaJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RevokeCredential(ctx, request.(RevokeCredentialRequestObject))
+		return sh.ssi.BuildSchema(ctx, request.(BuildSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RevokeCredential")
+		handler = middleware(handler, "BuildSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RevokeCredentialResponseObject); ok {
-		if err := validResponse.VisitRevokeCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(BuildSchemaResponseObject); ok {
+		if err := validResponse.VisitBuildSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8128,26 +14169,25 @@ func (sh *strictHandler) RevokeCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// DeleteCredential operation middleware
-func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
-	var request DeleteCredentialRequestObject
+// GetSchemaFamilies operation middleware
+func (sh *strictHandler) GetSchemaFamilies(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetSchemaFamiliesRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCredential(ctx, request.(DeleteCredentialRequestObject))
+		return sh.ssi.GetSchemaFamilies(ctx, request.(GetSchemaFamiliesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCredential")
+		handler = middleware(handler, "GetSchemaFamilies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCredentialResponseObject); ok {
-		if err := validResponse.VisitDeleteCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaFamiliesResponseObject); ok {
+		if err := validResponse.VisitGetSchemaFamiliesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8155,26 +14195,32 @@ func (sh *strictHandler) DeleteCredential(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// GetCredential operation middleware
-func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim) {
-	var request GetCredentialRequestObject
+// CreateSchemaFamily operation middleware
+func (sh *strictHandler) CreateSchemaFamily(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateSchemaFamilyRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+
+	var body CreateSchemaFamilyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredential(ctx, request.(GetCredentialRequestObject))
+		return sh.ssi.CreateSchemaFamily(ctx, request.(CreateSchemaFamilyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredential")
+		handler = middleware(handler, "CreateSchemaFamily")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialResponseObject); ok {
-		if err := validResponse.VisitGetCredentialResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSchemaFamilyResponseObject); ok {
+		if err := validResponse.VisitCreateSchemaFamilyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8182,27 +14228,26 @@ func (sh *strictHandler) GetCredential(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetCredentialOffer operation middleware
-func (sh *strictHandler) GetCredentialOffer(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id PathClaim, params GetCredentialOfferParams) {
-	var request GetCredentialOfferRequestObject
+// GetSchemaFamily operation middleware
+func (sh *strictHandler) GetSchemaFamily(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetSchemaFamilyRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCredentialOffer(ctx, request.(GetCredentialOfferRequestObject))
+		return sh.ssi.GetSchemaFamily(ctx, request.(GetSchemaFamilyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCredentialOffer")
+		handler = middleware(handler, "GetSchemaFamily")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCredentialOfferResponseObject); ok {
-		if err := validResponse.VisitGetCredentialOfferResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaFamilyResponseObject); ok {
+		if err := validResponse.VisitGetSchemaFamilyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8210,26 +14255,33 @@ func (sh *strictHandler) GetCredentialOffer(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetAllDisplayMethods operation middleware
-func (sh *strictHandler) GetAllDisplayMethods(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetAllDisplayMethodsParams) {
-	var request GetAllDisplayMethodsRequestObject
+// CreateSchemaMigration operation middleware
+func (sh *strictHandler) CreateSchemaMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request CreateSchemaMigrationRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
+
+	var body CreateSchemaMigrationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAllDisplayMethods(ctx, request.(GetAllDisplayMethodsRequestObject))
+		return sh.ssi.CreateSchemaMigration(ctx, request.(CreateSchemaMigrationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAllDisplayMethods")
+		handler = middleware(handler, "CreateSchemaMigration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAllDisplayMethodsResponseObject); ok {
-		if err := validResponse.VisitGetAllDisplayMethodsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSchemaMigrationResponseObject); ok {
+		if err := validResponse.VisitCreateSchemaMigrationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8237,13 +14289,14 @@ func (sh *strictHandler) GetAllDisplayMethods(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// CreateDisplayMethod operation middleware
-func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreateDisplayMethodRequestObject
+// AddSchemaFamilyVersion operation middleware
+func (sh *strictHandler) AddSchemaFamilyVersion(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request AddSchemaFamilyVersionRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
-	var body CreateDisplayMethodJSONRequestBody
+	var body AddSchemaFamilyVersionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8251,18 +14304,18 @@ func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateDisplayMethod(ctx, request.(CreateDisplayMethodRequestObject))
+		return sh.ssi.AddSchemaFamilyVersion(ctx, request.(AddSchemaFamilyVersionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateDisplayMethod")
+		handler = middleware(handler, "AddSchemaFamilyVersion")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitCreateDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddSchemaFamilyVersionResponseObject); ok {
+		if err := validResponse.VisitAddSchemaFamilyVersionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8270,26 +14323,26 @@ func (sh *strictHandler) CreateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeleteDisplayMethod operation middleware
-func (sh *strictHandler) DeleteDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeleteDisplayMethodRequestObject
+// GetSchemaMigration operation middleware
+func (sh *strictHandler) GetSchemaMigration(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetSchemaMigrationRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteDisplayMethod(ctx, request.(DeleteDisplayMethodRequestObject))
+		return sh.ssi.GetSchemaMigration(ctx, request.(GetSchemaMigrationRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteDisplayMethod")
+		handler = middleware(handler, "GetSchemaMigration")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitDeleteDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaMigrationResponseObject); ok {
+		if err := validResponse.VisitGetSchemaMigrationResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8297,26 +14350,26 @@ func (sh *strictHandler) DeleteDisplayMethod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetDisplayMethod operation middleware
-func (sh *strictHandler) GetDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetDisplayMethodRequestObject
+// GetSchemas operation middleware
+func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetSchemasParams) {
+	var request GetSchemasRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetDisplayMethod(ctx, request.(GetDisplayMethodRequestObject))
+		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetDisplayMethod")
+		handler = middleware(handler, "GetSchemas")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitGetDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
+		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8324,14 +14377,13 @@ func (sh *strictHandler) GetDisplayMethod(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// UpdateDisplayMethod operation middleware
-func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request UpdateDisplayMethodRequestObject
+// ImportSchema operation middleware
+func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request ImportSchemaRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
-	var body UpdateDisplayMethodJSONRequestBody
+	var body ImportSchemaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8339,18 +14391,18 @@ func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateDisplayMethod(ctx, request.(UpdateDisplayMethodRequestObject))
+		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateDisplayMethod")
+		handler = middleware(handler, "ImportSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateDisplayMethodResponseObject); ok {
-		if err := validResponse.VisitUpdateDisplayMethodResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
+		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8358,26 +14410,26 @@ func (sh *strictHandler) UpdateDisplayMethod(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetKeys operation middleware
-func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, params GetKeysParams) {
-	var request GetKeysRequestObject
+// GetSchema operation middleware
+func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request GetSchemaRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKeys(ctx, request.(GetKeysRequestObject))
+		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKeys")
+		handler = middleware(handler, "GetSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeysResponseObject); ok {
-		if err := validResponse.VisitGetKeysResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
+		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8385,13 +14437,14 @@ func (sh *strictHandler) GetKeys(w http.ResponseWriter, r *http.Request, identif
 	}
 }
 
-// CreateKey operation middleware
-func (sh *strictHandler) CreateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2) {
-	var request CreateKeyRequestObject
+// UpdateSchema operation middleware
+func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request UpdateSchemaRequestObject
 
 	request.Identifier = identifier
+	request.Id = id
 
-	var body CreateKeyJSONRequestBody
+	var body UpdateSchemaJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8399,18 +14452,18 @@ func (sh *strictHandler) CreateKey(w http.ResponseWriter, r *http.Request, ident
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateKey(ctx, request.(CreateKeyRequestObject))
+		return sh.ssi.UpdateSchema(ctx, request.(UpdateSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateKey")
+		handler = middleware(handler, "UpdateSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateKeyResponseObject); ok {
-		if err := validResponse.VisitCreateKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateSchemaResponseObject); ok {
+		if err := validResponse.VisitUpdateSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8418,26 +14471,26 @@ func (sh *strictHandler) CreateKey(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// DeleteKey operation middleware
-func (sh *strictHandler) DeleteKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
-	var request DeleteKeyRequestObject
+// CheckSchemaDrift operation middleware
+func (sh *strictHandler) CheckSchemaDrift(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
+	var request CheckSchemaDriftRequestObject
 
 	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteKey(ctx, request.(DeleteKeyRequestObject))
+		return sh.ssi.CheckSchemaDrift(ctx, request.(CheckSchemaDriftRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteKey")
+		handler = middleware(handler, "CheckSchemaDrift")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteKeyResponseObject); ok {
-		if err := validResponse.VisitDeleteKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(CheckSchemaDriftResponseObject); ok {
+		if err := validResponse.VisitCheckSchemaDriftResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8445,26 +14498,26 @@ func (sh *strictHandler) DeleteKey(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// GetKey operation middleware
-func (sh *strictHandler) GetKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
-	var request GetKeyRequestObject
+// PublishIdentityState operation middleware
+func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params PublishIdentityStateParams) {
+	var request PublishIdentityStateRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetKey(ctx, request.(GetKeyRequestObject))
+		return sh.ssi.PublishIdentityState(ctx, request.(PublishIdentityStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetKey")
+		handler = middleware(handler, "PublishIdentityState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetKeyResponseObject); ok {
-		if err := validResponse.VisitGetKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(PublishIdentityStateResponseObject); ok {
+		if err := validResponse.VisitPublishIdentityStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8472,33 +14525,25 @@ func (sh *strictHandler) GetKey(w http.ResponseWriter, r *http.Request, identifi
 	}
 }
 
-// UpdateKey operation middleware
-func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, identifier PathIdentifier2, id PathKeyID) {
-	var request UpdateKeyRequestObject
+// RetryPublishState operation middleware
+func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request RetryPublishStateRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
-
-	var body UpdateKeyJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateKey(ctx, request.(UpdateKeyRequestObject))
+		return sh.ssi.RetryPublishState(ctx, request.(RetryPublishStateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateKey")
+		handler = middleware(handler, "RetryPublishState")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateKeyResponseObject); ok {
-		if err := validResponse.VisitUpdateKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(RetryPublishStateResponseObject); ok {
+		if err := validResponse.VisitRetryPublishStateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8506,26 +14551,25 @@ func (sh *strictHandler) UpdateKey(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// GetPaymentRequests operation middleware
-func (sh *strictHandler) GetPaymentRequests(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetPaymentRequestsParams) {
-	var request GetPaymentRequestsRequestObject
+// GetStateStatus operation middleware
+func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetStateStatusRequestObject
 
 	request.Identifier = identifier
-	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentRequests(ctx, request.(GetPaymentRequestsRequestObject))
+		return sh.ssi.GetStateStatus(ctx, request.(GetStateStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentRequests")
+		handler = middleware(handler, "GetStateStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentRequestsResponseObject); ok {
-		if err := validResponse.VisitGetPaymentRequestsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateStatusResponseObject); ok {
+		if err := validResponse.VisitGetStateStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8533,32 +14577,26 @@ func (sh *strictHandler) GetPaymentRequests(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// CreatePaymentRequest operation middleware
-func (sh *strictHandler) CreatePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreatePaymentRequestRequestObject
+// GetStateTransactions operation middleware
+func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateTransactionsParams) {
+	var request GetStateTransactionsRequestObject
 
 	request.Identifier = identifier
-
-	var body CreatePaymentRequestJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePaymentRequest(ctx, request.(CreatePaymentRequestRequestObject))
+		return sh.ssi.GetStateTransactions(ctx, request.(GetStateTransactionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePaymentRequest")
+		handler = middleware(handler, "GetStateTransactions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePaymentRequestResponseObject); ok {
-		if err := validResponse.VisitCreatePaymentRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStateTransactionsResponseObject); ok {
+		if err := validResponse.VisitGetStateTransactionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8566,26 +14604,26 @@ func (sh *strictHandler) CreatePaymentRequest(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// DeletePaymentRequest operation middleware
-func (sh *strictHandler) DeletePaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeletePaymentRequestRequestObject
+// GetTransactionCosts operation middleware
+func (sh *strictHandler) GetTransactionCosts(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetTransactionCostsParams) {
+	var request GetTransactionCostsRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePaymentRequest(ctx, request.(DeletePaymentRequestRequestObject))
+		return sh.ssi.GetTransactionCosts(ctx, request.(GetTransactionCostsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePaymentRequest")
+		handler = middleware(handler, "GetTransactionCosts")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePaymentRequestResponseObject); ok {
-		if err := validResponse.VisitDeletePaymentRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTransactionCostsResponseObject); ok {
+		if err := validResponse.VisitGetTransactionCostsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8593,26 +14631,25 @@ func (sh *strictHandler) DeletePaymentRequest(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetPaymentRequest operation middleware
-func (sh *strictHandler) GetPaymentRequest(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetPaymentRequestRequestObject
+// GetOID4VCIAuthorizationServerMetadata operation middleware
+func (sh *strictHandler) GetOID4VCIAuthorizationServerMetadata(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetOID4VCIAuthorizationServerMetadataRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentRequest(ctx, request.(GetPaymentRequestRequestObject))
+		return sh.ssi.GetOID4VCIAuthorizationServerMetadata(ctx, request.(GetOID4VCIAuthorizationServerMetadataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentRequest")
+		handler = middleware(handler, "GetOID4VCIAuthorizationServerMetadata")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentRequestResponseObject); ok {
-		if err := validResponse.VisitGetPaymentRequestResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOID4VCIAuthorizationServerMetadataResponseObject); ok {
+		if err := validResponse.VisitGetOID4VCIAuthorizationServerMetadataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8620,25 +14657,25 @@ func (sh *strictHandler) GetPaymentRequest(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetPaymentOptions operation middleware
-func (sh *strictHandler) GetPaymentOptions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request GetPaymentOptionsRequestObject
+// GetOID4VCIIssuerMetadata operation middleware
+func (sh *strictHandler) GetOID4VCIIssuerMetadata(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request GetOID4VCIIssuerMetadataRequestObject
 
 	request.Identifier = identifier
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentOptions(ctx, request.(GetPaymentOptionsRequestObject))
+		return sh.ssi.GetOID4VCIIssuerMetadata(ctx, request.(GetOID4VCIIssuerMetadataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentOptions")
+		handler = middleware(handler, "GetOID4VCIIssuerMetadata")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentOptionsResponseObject); ok {
-		if err := validResponse.VisitGetPaymentOptionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOID4VCIIssuerMetadataResponseObject); ok {
+		if err := validResponse.VisitGetOID4VCIIssuerMetadataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8646,13 +14683,14 @@ func (sh *strictHandler) GetPaymentOptions(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// CreatePaymentOption operation middleware
-func (sh *strictHandler) CreatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request CreatePaymentOptionRequestObject
+// CreateOID4VCICredential operation middleware
+func (sh *strictHandler) CreateOID4VCICredential(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params CreateOID4VCICredentialParams) {
+	var request CreateOID4VCICredentialRequestObject
 
 	request.Identifier = identifier
+	request.Params = params
 
-	var body CreatePaymentOptionJSONRequestBody
+	var body CreateOID4VCICredentialJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8660,18 +14698,18 @@ func (sh *strictHandler) CreatePaymentOption(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreatePaymentOption(ctx, request.(CreatePaymentOptionRequestObject))
+		return sh.ssi.CreateOID4VCICredential(ctx, request.(CreateOID4VCICredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreatePaymentOption")
+		handler = middleware(handler, "CreateOID4VCICredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreatePaymentOptionResponseObject); ok {
-		if err := validResponse.VisitCreatePaymentOptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateOID4VCICredentialResponseObject); ok {
+		if err := validResponse.VisitCreateOID4VCICredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8679,26 +14717,36 @@ func (sh *strictHandler) CreatePaymentOption(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeletePaymentOption operation middleware
-func (sh *strictHandler) DeletePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request DeletePaymentOptionRequestObject
+// CreateOID4VCIToken operation middleware
+func (sh *strictHandler) CreateOID4VCIToken(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
+	var request CreateOID4VCITokenRequestObject
 
 	request.Identifier = identifier
-	request.Id = id
+
+	if err := r.ParseForm(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
+		return
+	}
+	var body CreateOID4VCITokenFormdataRequestBody
+	if err := runtime.BindForm(&body, r.Form, nil, nil); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePaymentOption(ctx, request.(DeletePaymentOptionRequestObject))
+		return sh.ssi.CreateOID4VCIToken(ctx, request.(CreateOID4VCITokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePaymentOption")
+		handler = middleware(handler, "CreateOID4VCIToken")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeletePaymentOptionResponseObject); ok {
-		if err := validResponse.VisitDeletePaymentOptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateOID4VCITokenResponseObject); ok {
+		if err := validResponse.VisitCreateOID4VCITokenResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8706,26 +14754,25 @@ func (sh *strictHandler) DeletePaymentOption(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// GetPaymentOption operation middleware
-func (sh *strictHandler) GetPaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetPaymentOptionRequestObject
+// GetOID4VPRequestObject operation middleware
+func (sh *strictHandler) GetOID4VPRequestObject(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetOID4VPRequestObjectRequestObject
 
-	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentOption(ctx, request.(GetPaymentOptionRequestObject))
+		return sh.ssi.GetOID4VPRequestObject(ctx, request.(GetOID4VPRequestObjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentOption")
+		handler = middleware(handler, "GetOID4VPRequestObject")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentOptionResponseObject); ok {
-		if err := validResponse.VisitGetPaymentOptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOID4VPRequestObjectResponseObject); ok {
+		if err := validResponse.VisitGetOID4VPRequestObjectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8733,33 +14780,36 @@ func (sh *strictHandler) GetPaymentOption(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// UpdatePaymentOption operation middleware
-func (sh *strictHandler) UpdatePaymentOption(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request UpdatePaymentOptionRequestObject
+// CreateOID4VPResponse operation middleware
+func (sh *strictHandler) CreateOID4VPResponse(w http.ResponseWriter, r *http.Request, id Id) {
+	var request CreateOID4VPResponseRequestObject
 
-	request.Identifier = identifier
 	request.Id = id
 
-	var body UpdatePaymentOptionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+	if err := r.ParseForm(); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode formdata: %w", err))
+		return
+	}
+	var body CreateOID4VPResponseFormdataRequestBody
+	if err := runtime.BindForm(&body, r.Form, nil, nil); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't bind formdata: %w", err))
 		return
 	}
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdatePaymentOption(ctx, request.(UpdatePaymentOptionRequestObject))
+		return sh.ssi.CreateOID4VPResponse(ctx, request.(CreateOID4VPResponseRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdatePaymentOption")
+		handler = middleware(handler, "CreateOID4VPResponse")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdatePaymentOptionResponseObject); ok {
-		if err := validResponse.VisitUpdatePaymentOptionResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateOID4VPResponseResponseObject); ok {
+		if err := validResponse.VisitCreateOID4VPResponseResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8767,33 +14817,23 @@ func (sh *strictHandler) UpdatePaymentOption(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// VerifyPayment operation middleware
-func (sh *strictHandler) VerifyPayment(w http.ResponseWriter, r *http.Request, identifier string, nonce string) {
-	var request VerifyPaymentRequestObject
-
-	request.Identifier = identifier
-	request.Nonce = nonce
-
-	var body VerifyPaymentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+// GetPaymentSettings operation middleware
+func (sh *strictHandler) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
+	var request GetPaymentSettingsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.VerifyPayment(ctx, request.(VerifyPaymentRequestObject))
+		return sh.ssi.GetPaymentSettings(ctx, request.(GetPaymentSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "VerifyPayment")
+		handler = middleware(handler, "GetPaymentSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(VerifyPaymentResponseObject); ok {
-		if err := validResponse.VisitVerifyPaymentResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPaymentSettingsResponseObject); ok {
+		if err := validResponse.VisitGetPaymentSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8801,26 +14841,33 @@ func (sh *strictHandler) VerifyPayment(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetSchemas operation middleware
-func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetSchemasParams) {
-	var request GetSchemasRequestObject
+// ProofRequestCallback operation middleware
+func (sh *strictHandler) ProofRequestCallback(w http.ResponseWriter, r *http.Request, params ProofRequestCallbackParams) {
+	var request ProofRequestCallbackRequestObject
 
-	request.Identifier = identifier
 	request.Params = params
 
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't read body: %w", err))
+		return
+	}
+	body := ProofRequestCallbackTextRequestBody(data)
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchemas(ctx, request.(GetSchemasRequestObject))
+		return sh.ssi.ProofRequestCallback(ctx, request.(ProofRequestCallbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchemas")
+		handler = middleware(handler, "ProofRequestCallback")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemasResponseObject); ok {
-		if err := validResponse.VisitGetSchemasResponse(w); err != nil {
+	} else if validResponse, ok := response.(ProofRequestCallbackResponseObject); ok {
+		if err := validResponse.VisitProofRequestCallbackResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8828,32 +14875,25 @@ func (sh *strictHandler) GetSchemas(w http.ResponseWriter, r *http.Request, iden
 	}
 }
 
-// ImportSchema operation middleware
-func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request ImportSchemaRequestObject
-
-	request.Identifier = identifier
+// GetQrFromStore operation middleware
+func (sh *strictHandler) GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams) {
+	var request GetQrFromStoreRequestObject
 
-	var body ImportSchemaJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportSchema(ctx, request.(ImportSchemaRequestObject))
+		return sh.ssi.GetQrFromStore(ctx, request.(GetQrFromStoreRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportSchema")
+		handler = middleware(handler, "GetQrFromStore")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportSchemaResponseObject); ok {
-		if err := validResponse.VisitImportSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetQrFromStoreResponseObject); ok {
+		if err := validResponse.VisitGetQrFromStoreResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8861,26 +14901,25 @@ func (sh *strictHandler) ImportSchema(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// GetSchema operation middleware
-func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request GetSchemaRequestObject
+// GetBuiltSchemaContext operation middleware
+func (sh *strictHandler) GetBuiltSchemaContext(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetBuiltSchemaContextRequestObject
 
-	request.Identifier = identifier
 	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSchema(ctx, request.(GetSchemaRequestObject))
+		return sh.ssi.GetBuiltSchemaContext(ctx, request.(GetBuiltSchemaContextRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSchema")
+		handler = middleware(handler, "GetBuiltSchemaContext")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSchemaResponseObject); ok {
-		if err := validResponse.VisitGetSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetBuiltSchemaContextResponseObject); ok {
+		if err := validResponse.VisitGetBuiltSchemaContextResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8888,33 +14927,25 @@ func (sh *strictHandler) GetSchema(w http.ResponseWriter, r *http.Request, ident
 	}
 }
 
-// UpdateSchema operation middleware
-func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, id Id) {
-	var request UpdateSchemaRequestObject
+// GetBuiltSchema operation middleware
+func (sh *strictHandler) GetBuiltSchema(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetBuiltSchemaRequestObject
 
-	request.Identifier = identifier
 	request.Id = id
 
-	var body UpdateSchemaJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
-
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateSchema(ctx, request.(UpdateSchemaRequestObject))
+		return sh.ssi.GetBuiltSchema(ctx, request.(GetBuiltSchemaRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateSchema")
+		handler = middleware(handler, "GetBuiltSchema")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateSchemaResponseObject); ok {
-		if err := validResponse.VisitUpdateSchemaResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetBuiltSchemaResponseObject); ok {
+		if err := validResponse.VisitGetBuiltSchemaResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8922,25 +14953,25 @@ func (sh *strictHandler) UpdateSchema(w http.ResponseWriter, r *http.Request, id
 	}
 }
 
-// PublishIdentityState operation middleware
-func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request PublishIdentityStateRequestObject
+// GetStatusListCredential operation middleware
+func (sh *strictHandler) GetStatusListCredential(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetStatusListCredentialRequestObject
 
-	request.Identifier = identifier
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PublishIdentityState(ctx, request.(PublishIdentityStateRequestObject))
+		return sh.ssi.GetStatusListCredential(ctx, request.(GetStatusListCredentialRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PublishIdentityState")
+		handler = middleware(handler, "GetStatusListCredential")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PublishIdentityStateResponseObject); ok {
-		if err := validResponse.VisitPublishIdentityStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetStatusListCredentialResponseObject); ok {
+		if err := validResponse.VisitGetStatusListCredentialResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8948,25 +14979,23 @@ func (sh *strictHandler) PublishIdentityState(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// RetryPublishState operation middleware
-func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request RetryPublishStateRequestObject
-
-	request.Identifier = identifier
+// GetSupportedNetworks operation middleware
+func (sh *strictHandler) GetSupportedNetworks(w http.ResponseWriter, r *http.Request) {
+	var request GetSupportedNetworksRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RetryPublishState(ctx, request.(RetryPublishStateRequestObject))
+		return sh.ssi.GetSupportedNetworks(ctx, request.(GetSupportedNetworksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RetryPublishState")
+		handler = middleware(handler, "GetSupportedNetworks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RetryPublishStateResponseObject); ok {
-		if err := validResponse.VisitRetryPublishStateResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSupportedNetworksResponseObject); ok {
+		if err := validResponse.VisitGetSupportedNetworksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8974,25 +15003,23 @@ func (sh *strictHandler) RetryPublishState(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetStateStatus operation middleware
-func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request, identifier PathIdentifier) {
-	var request GetStateStatusRequestObject
-
-	request.Identifier = identifier
+// ReloadSupportedNetworks operation middleware
+func (sh *strictHandler) ReloadSupportedNetworks(w http.ResponseWriter, r *http.Request) {
+	var request ReloadSupportedNetworksRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStateStatus(ctx, request.(GetStateStatusRequestObject))
+		return sh.ssi.ReloadSupportedNetworks(ctx, request.(ReloadSupportedNetworksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStateStatus")
+		handler = middleware(handler, "ReloadSupportedNetworks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStateStatusResponseObject); ok {
-		if err := validResponse.VisitGetStateStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReloadSupportedNetworksResponseObject); ok {
+		if err := validResponse.VisitReloadSupportedNetworksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9000,26 +15027,23 @@ func (sh *strictHandler) GetStateStatus(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetStateTransactions operation middleware
-func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Request, identifier PathIdentifier, params GetStateTransactionsParams) {
-	var request GetStateTransactionsRequestObject
-
-	request.Identifier = identifier
-	request.Params = params
+// GetTenants operation middleware
+func (sh *strictHandler) GetTenants(w http.ResponseWriter, r *http.Request) {
+	var request GetTenantsRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetStateTransactions(ctx, request.(GetStateTransactionsRequestObject))
+		return sh.ssi.GetTenants(ctx, request.(GetTenantsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetStateTransactions")
+		handler = middleware(handler, "GetTenants")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetStateTransactionsResponseObject); ok {
-		if err := validResponse.VisitGetStateTransactionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTenantsResponseObject); ok {
+		if err := validResponse.VisitGetTenantsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9027,23 +15051,30 @@ func (sh *strictHandler) GetStateTransactions(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// GetPaymentSettings operation middleware
-func (sh *strictHandler) GetPaymentSettings(w http.ResponseWriter, r *http.Request) {
-	var request GetPaymentSettingsRequestObject
+// CreateTenant operation middleware
+func (sh *strictHandler) CreateTenant(w http.ResponseWriter, r *http.Request) {
+	var request CreateTenantRequestObject
+
+	var body CreateTenantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPaymentSettings(ctx, request.(GetPaymentSettingsRequestObject))
+		return sh.ssi.CreateTenant(ctx, request.(CreateTenantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPaymentSettings")
+		handler = middleware(handler, "CreateTenant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPaymentSettingsResponseObject); ok {
-		if err := validResponse.VisitGetPaymentSettingsResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateTenantResponseObject); ok {
+		if err := validResponse.VisitCreateTenantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9051,25 +15082,25 @@ func (sh *strictHandler) GetPaymentSettings(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// GetQrFromStore operation middleware
-func (sh *strictHandler) GetQrFromStore(w http.ResponseWriter, r *http.Request, params GetQrFromStoreParams) {
-	var request GetQrFromStoreRequestObject
+// GetTenant operation middleware
+func (sh *strictHandler) GetTenant(w http.ResponseWriter, r *http.Request, id Id) {
+	var request GetTenantRequestObject
 
-	request.Params = params
+	request.Id = id
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetQrFromStore(ctx, request.(GetQrFromStoreRequestObject))
+		return sh.ssi.GetTenant(ctx, request.(GetTenantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetQrFromStore")
+		handler = middleware(handler, "GetTenant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetQrFromStoreResponseObject); ok {
-		if err := validResponse.VisitGetQrFromStoreResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetTenantResponseObject); ok {
+		if err := validResponse.VisitGetTenantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9077,23 +15108,32 @@ func (sh *strictHandler) GetQrFromStore(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetSupportedNetworks operation middleware
-func (sh *strictHandler) GetSupportedNetworks(w http.ResponseWriter, r *http.Request) {
-	var request GetSupportedNetworksRequestObject
+// UpdateTenant operation middleware
+func (sh *strictHandler) UpdateTenant(w http.ResponseWriter, r *http.Request, id Id) {
+	var request UpdateTenantRequestObject
+
+	request.Id = id
+
+	var body UpdateTenantJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSupportedNetworks(ctx, request.(GetSupportedNetworksRequestObject))
+		return sh.ssi.UpdateTenant(ctx, request.(UpdateTenantRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSupportedNetworks")
+		handler = middleware(handler, "UpdateTenant")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSupportedNetworksResponseObject); ok {
-		if err := validResponse.VisitGetSupportedNetworksResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateTenantResponseObject); ok {
+		if err := validResponse.VisitUpdateTenantResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
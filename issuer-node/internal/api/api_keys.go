@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GetAPIKeys returns the API keys of the node
+func (s *Server) GetAPIKeys(ctx context.Context, _ GetAPIKeysRequestObject) (GetAPIKeysResponseObject, error) {
+	keys, err := s.apiKeyService.GetAll(ctx, callerTenant(ctx))
+	if err != nil {
+		log.Error(ctx, "loading api keys", "err", err)
+		return GetAPIKeys500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	res := make(GetAPIKeys200JSONResponse, len(keys))
+	for i := range keys {
+		res[i] = apiKeyResponse(&keys[i])
+	}
+	return res, nil
+}
+
+// CreateAPIKey creates an API key and returns it. It is the only time the key is returned.
+func (s *Server) CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error) {
+	name := strings.TrimSpace(request.Body.Name)
+	if name == "" {
+		return CreateAPIKey400JSONResponse{N400JSONResponse{Message: "name is required"}}, nil
+	}
+	tenantID, err := requestTenant(ctx, request.Body.TenantId)
+	if err != nil {
+		return CreateAPIKey400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	issuerDIDs := make([]w3c.DID, len(request.Body.Issuers))
+	for i, issuer := range request.Body.Issuers {
+		issuerDID, err := w3c.ParseDID(issuer)
+		if err != nil {
+			log.Error(ctx, "parsing issuer did", "err", err, "did", issuer)
+			return CreateAPIKey400JSONResponse{N400JSONResponse{Message: "invalid issuer did: " + issuer}}, nil
+		}
+		issuerDIDs[i] = *issuerDID
+	}
+
+	key, plain, err := s.apiKeyService.Create(ctx, name, domain.Role(request.Body.Role), tenantID, issuerDIDs, request.Body.ExpiresAt)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidAPIKey) || errors.Is(err, services.ErrTenantNotFound) {
+			return CreateAPIKey400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateAPIKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	res := apiKeyResponse(key)
+	return CreateAPIKey201JSONResponse{
+		Id:        res.Id,
+		Name:      res.Name,
+		Role:      res.Role,
+		Issuers:   res.Issuers,
+		ExpiresAt: res.ExpiresAt,
+		CreatedAt: res.CreatedAt,
+		TenantId:  res.TenantId,
+		Key:       plain,
+	}, nil
+}
+
+// RevokeAPIKey revokes an API key
+func (s *Server) RevokeAPIKey(ctx context.Context, request RevokeAPIKeyRequestObject) (RevokeAPIKeyResponseObject, error) {
+	if err := s.apiKeyService.Revoke(ctx, request.Id, callerTenant(ctx)); err != nil {
+		if errors.Is(err, services.ErrAPIKeyNotFound) {
+			return RevokeAPIKey404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "revoking api key", "err", err, "id", request.Id)
+		return RevokeAPIKey500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return RevokeAPIKey200JSONResponse{Message: "api key revoked"}, nil
+}
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db/tests"
+)
+
+func TestServer_APIKeys(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, nil)
+	issuer, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: "iden3", Blockchain: "privado", Network: "main", KeyType: "BJJ"})
+	require.NoError(t, err)
+	other, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: "iden3", Blockchain: "privado", Network: "main", KeyType: "BJJ"})
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+	do := func(t *testing.T, method string, path string, body any, apiKey string) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(method, path, tests.JSONBody(t, body))
+		require.NoError(t, err)
+		if apiKey != "" {
+			req.Header.Set("X-API-Key", apiKey)
+		} else {
+			req.SetBasicAuth(authOk())
+		}
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+	create := func(t *testing.T, request CreateAPIKeyRequest) CreateAPIKey201JSONResponse {
+		t.Helper()
+		rr := do(t, "POST", "/v2/api-keys", request, "")
+		require.Equal(t, http.StatusCreated, rr.Code, rr.Body.String())
+		var response CreateAPIKey201JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		return response
+	}
+
+	readOnly := create(t, CreateAPIKeyRequest{Name: "dashboard", Role: APIKeyRoleReadOnly, Issuers: []string{issuer.Identifier}})
+	assert.Equal(t, []string{issuer.Identifier}, readOnly.Issuers)
+	assert.NotEmpty(t, readOnly.Key)
+	admin := create(t, CreateAPIKeyRequest{Name: "operator", Role: APIKeyRoleAdmin})
+
+	t.Run("should reject invalid keys", func(t *testing.T) {
+		rr := do(t, "POST", "/v2/api-keys", CreateAPIKeyRequest{Name: "dashboard", Role: APIKeyRoleIssuer}, "")
+		assert.Equal(t, http.StatusBadRequest, rr.Code, "only admin keys are valid for every issuer")
+		rr = do(t, "POST", "/v2/api-keys", CreateAPIKeyRequest{Name: "dashboard", Role: APIKeyRoleIssuer, Issuers: []string{"did:invalid"}}, "")
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should allow the operations of the role on the issuers of the key", func(t *testing.T) {
+		rr := do(t, "GET", fmt.Sprintf("/v2/identities/%s/schemas", issuer.Identifier), nil, readOnly.Key)
+		assert.Equal(t, http.StatusOK, rr.Code)
+		rr = do(t, "POST", fmt.Sprintf("/v2/identities/%s/schema-families", issuer.Identifier), CreateSchemaFamilyJSONRequestBody{Name: "Recruiter"}, readOnly.Key)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		rr = do(t, "GET", fmt.Sprintf("/v2/identities/%s/schemas", other.Identifier), nil, readOnly.Key)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		rr = do(t, "GET", "/v2/api-keys", nil, readOnly.Key)
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		rr = do(t, "GET", fmt.Sprintf("/v2/identities/%s/schemas", other.Identifier), nil, admin.Key)
+		assert.Equal(t, http.StatusOK, rr.Code)
+	})
+
+	t.Run("should reject unknown keys", func(t *testing.T) {
+		rr := do(t, "GET", fmt.Sprintf("/v2/identities/%s/schemas", issuer.Identifier), nil, readOnly.Key+"0")
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		rr = do(t, "GET", fmt.Sprintf("/v2/identities/%s/schemas", issuer.Identifier), nil, "isk_unknown_key")
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("should reject revoked keys", func(t *testing.T) {
+		rr := do(t, "GET", "/v2/api-keys", nil, admin.Key)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var keys GetAPIKeys200JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &keys))
+		assert.GreaterOrEqual(t, len(keys), 2)
+
+		rr = do(t, "DELETE", fmt.Sprintf("/v2/api-keys/%s", readOnly.Id), nil, admin.Key)
+		require.Equal(t, http.StatusOK, rr.Code)
+		rr = do(t, "GET", fmt.Sprintf("/v2/identities/%s/schemas", issuer.Identifier), nil, readOnly.Key)
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+}
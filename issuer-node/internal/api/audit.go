@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GetAuditLog returns a page of the audit log of the identity
+func (s *Server) GetAuditLog(ctx context.Context, request GetAuditLogRequestObject) (GetAuditLogResponseObject, error) {
+	const (
+		defaultMaxResults = 50
+		defaultPage       = 1
+		minimumMaxResults = 10
+	)
+	filter := ports.AuditFilter{
+		EntityID:   request.Params.EntityId,
+		Actor:      request.Params.Actor,
+		From:       request.Params.From,
+		To:         request.Params.To,
+		MaxResults: defaultMaxResults,
+		Page:       defaultPage,
+	}
+	if request.Params.Entity != nil {
+		filter.Entity = common.ToPointer(domain.AuditEntity(*request.Params.Entity))
+	}
+	if request.Params.Operation != nil {
+		filter.Operation = common.ToPointer(domain.AuditOperation(*request.Params.Operation))
+	}
+	if filter.From != nil && filter.To != nil && !filter.From.Before(*filter.To) {
+		return GetAuditLog400JSONResponse{N400JSONResponse{Message: "from must be before to"}}, nil
+	}
+	if request.Params.MaxResults != nil {
+		if *request.Params.MaxResults < minimumMaxResults {
+			filter.MaxResults = minimumMaxResults
+		} else {
+			filter.MaxResults = *request.Params.MaxResults
+		}
+	}
+	if request.Params.Page != nil {
+		if *request.Params.Page < 1 {
+			return GetAuditLog400JSONResponse{N400JSONResponse{Message: "page must be greater than zero"}}, nil
+		}
+		filter.Page = *request.Params.Page
+	}
+
+	entries, total, err := s.auditService.GetAll(ctx, *request.Identifier.did(), filter)
+	if err != nil {
+		log.Error(ctx, "getting audit log", "err", err)
+		return GetAuditLog500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	items := make([]AuditEntry, len(entries))
+	for i := range entries {
+		items[i] = auditEntryResponse(&entries[i])
+	}
+	return GetAuditLog200JSONResponse{
+		Items: items,
+		Meta: PaginatedMetadata{
+			Page:       filter.Page,
+			MaxResults: filter.MaxResults,
+			Total:      total,
+		},
+	}, nil
+}
+
+// VerifyAuditLog checks the hash chain of the audit log of the identity
+func (s *Server) VerifyAuditLog(ctx context.Context, request VerifyAuditLogRequestObject) (VerifyAuditLogResponseObject, error) {
+	status, err := s.auditService.Verify(ctx, *request.Identifier.did())
+	if err != nil {
+		log.Error(ctx, "verifying audit log", "err", err)
+		return VerifyAuditLog500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return VerifyAuditLog200JSONResponse{
+		Valid:    status.BrokenAt == nil,
+		Entries:  status.Entries,
+		BrokenAt: status.BrokenAt,
+	}, nil
+}
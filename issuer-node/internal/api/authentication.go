@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/polygonid/sh-id-platform/internal/auth"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/log"
 )
@@ -57,7 +59,12 @@ func (s *Server) Authentication(ctx context.Context, req AuthenticationRequestOb
 
 // GetAuthenticationConnection returns the connection related to a given session
 func (s *Server) GetAuthenticationConnection(ctx context.Context, req GetAuthenticationConnectionRequestObject) (GetAuthenticationConnectionResponseObject, error) {
-	conn, err := s.connectionsService.GetByUserSessionID(ctx, req.Id)
+	// The session has no issuer in the path, so the principals of a tenant can only see the ones of their issuers
+	var tenantID *uuid.UUID
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		tenantID = principal.TenantID
+	}
+	conn, err := s.connectionsService.GetByUserSessionID(ctx, req.Id, tenantID)
 	if err != nil {
 		log.Error(ctx, "get authentication connection", "err", err, "req", req)
 		if errors.Is(err, services.ErrConnectionDoesNotExist) {
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GetConnectionConversations returns the iden3comm messages exchanged with the user of the connection, grouped by thread
+func (s *Server) GetConnectionConversations(ctx context.Context, request GetConnectionConversationsRequestObject) (GetConnectionConversationsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetConnectionConversations400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	conversations, err := s.messageService.GetConversations(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetConnectionConversations404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get connection conversations", "err", err, "req", request)
+		return GetConnectionConversations500JSONResponse{N500JSONResponse{"There was an error retrieving the conversations"}}, nil
+	}
+
+	resp, err := conversationsResponse(conversations)
+	if err != nil {
+		log.Error(ctx, "get connection conversations parsing messages", "err", err)
+		return GetConnectionConversations500JSONResponse{N500JSONResponse{"There was an error parsing the conversations"}}, nil
+	}
+	return resp, nil
+}
+
+func conversationsResponse(conversations []domain.Conversation) (GetConnectionConversations200JSONResponse, error) {
+	resp := make(GetConnectionConversations200JSONResponse, len(conversations))
+	for i, conversation := range conversations {
+		messages := make([]ConversationMessage, len(conversation.Messages))
+		for j, msg := range conversation.Messages {
+			var message map[string]interface{}
+			if err := json.Unmarshal(msg.Message, &message); err != nil {
+				return nil, err
+			}
+			messages[j] = ConversationMessage{
+				Id:         msg.ID,
+				MessageID:  msg.MessageID,
+				Direction:  ConversationMessageDirection(msg.Direction),
+				Type:       msg.Type,
+				Status:     ConversationMessageStatus(msg.Status),
+				Attempts:   msg.Attempts,
+				LastError:  msg.LastError,
+				Message:    message,
+				CreatedAt:  TimeUTC(msg.CreatedAt),
+				ModifiedAt: TimeUTC(msg.ModifiedAt),
+			}
+			if msg.NextAttemptAt != nil {
+				nextAttemptAt := TimeUTC(*msg.NextAttemptAt)
+				messages[j].NextAttemptAt = &nextAttemptAt
+			}
+		}
+		resp[i] = Conversation{ThreadID: conversation.ThreadID, Messages: messages}
+	}
+	return resp, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/schema"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/statuslist"
 )
 
 // DeleteCredential deletes a credential
@@ -97,6 +98,14 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		return CreateCredential400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("Credential Status Type '%s' is not supported by the issuer", *credentialStatusType)}}, nil
 	}
 
+	credentialFormat := domain.CredentialFormatIden3
+	if request.Body.CredentialFormat != nil {
+		credentialFormat = domain.CredentialFormat(*request.Body.CredentialFormat)
+	}
+	if err := s.credentialFormats.Validate(ctx, *did, credentialFormat); err != nil {
+		return CreateCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+
 	req := ports.NewCreateClaimRequest(did, request.Body.ClaimID, request.Body.CredentialSchema, request.Body.CredentialSubject, expiration, request.Body.Type, request.Body.Version, request.Body.SubjectPosition, request.Body.MerklizedRootPosition, claimRequestProofs, nil, false, *credentialStatusType, toVerifiableRefreshService(request.Body.RefreshService), request.Body.RevNonce,
 		toVerifiableDisplayMethod(request.Body.DisplayMethod))
 
@@ -105,6 +114,9 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		if errors.Is(err, services.ErrLoadingSchema) {
 			return CreateCredential422JSONResponse{N422JSONResponse{Message: err.Error()}}, nil
 		}
+		if errors.Is(err, services.ErrCredentialQuotaExceeded) {
+			return CreateCredential403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return CreateCredential500JSONResponse{N500JSONResponse{Message: "if this identity has keyType=ETH you must to publish the state first"}}, nil
 		}
@@ -121,6 +133,7 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 			services.ErrDisplayMethodLacksURL,
 			services.ErrUnsupportedDisplayMethodType,
 			services.ErrWrongCredentialSubjectID,
+			services.ErrStatusListUnsupportedIssuer,
 			&schema.ParseClaimError{},
 		}
 		for _, e := range errs {
@@ -130,6 +143,12 @@ func (s *Server) CreateCredential(ctx context.Context, request CreateCredentialR
 		}
 		return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
 	}
+	if credentialFormat != domain.CredentialFormatIden3 {
+		if _, err := s.credentialFormats.Encode(ctx, resp, credentialFormat); err != nil {
+			log.Error(ctx, "encoding credential", "err", err, "id", resp.ID, "format", credentialFormat)
+			return CreateCredential500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+		}
+	}
 	return CreateCredential201JSONResponse{Id: resp.ID.String()}, nil
 }
 
@@ -297,7 +316,31 @@ func (s *Server) GetCredential(ctx context.Context, request GetCredentialRequest
 		return GetCredential500JSONResponse{N500JSONResponse{"invalid claim format"}}, nil
 	}
 
-	return GetCredential200JSONResponse(toGetCredential200Response(w3c, claim)), nil
+	var credentialFormat domain.CredentialFormat
+	if request.Params.Format != nil {
+		credentialFormat = domain.CredentialFormat(*request.Params.Format)
+	} else {
+		credentialFormat, err = s.credentialFormats.DefaultFormat(ctx, claim)
+		if err != nil {
+			log.Error(ctx, "getting credential format", "err", err, "id", claim.ID)
+			return GetCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+		}
+	}
+
+	response := toGetCredential200Response(w3c, claim)
+	response.CredentialFormat = common.ToPointer(CredentialFormat(credentialFormat))
+	if credentialFormat != domain.CredentialFormatIden3 {
+		encoded, err := s.credentialFormats.Encode(ctx, claim, credentialFormat)
+		if err != nil {
+			if errors.Is(err, services.ErrCredentialFormatUnsupported) || errors.Is(err, services.ErrCredentialFormatUnsupportedIssuer) {
+				return GetCredential400JSONResponse{N400JSONResponse{err.Error()}}, nil
+			}
+			return GetCredential500JSONResponse{N500JSONResponse{err.Error()}}, nil
+		}
+		response.EncodedCredential = common.ToPointer(encoded.Credential)
+	}
+
+	return GetCredential200JSONResponse(response), nil
 }
 
 // GetCredentialOffer returns a GetCredentialQrCodeResponseObject universalLink, raw or deeplink type based on query parameter `type`
@@ -350,14 +393,30 @@ func (s *Server) GetCredentialOffer(ctx context.Context, request GetCredentialOf
 	}, nil
 }
 
+// GetStatusListCredential returns the signed bitstring status list credential
+func (s *Server) GetStatusListCredential(ctx context.Context, request GetStatusListCredentialRequestObject) (GetStatusListCredentialResponseObject, error) {
+	credential, err := s.statusListService.GetCredential(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.StatusListNotFoundErr) {
+			return GetStatusListCredential404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting status list credential", "err", err, "id", request.Id)
+		return GetStatusListCredential500JSONResponse{N500JSONResponse{Message: "There was an error getting the status list"}}, nil
+	}
+	return GetStatusListCredential200ApplicationvcJwtResponse{
+		Body:          strings.NewReader(credential),
+		ContentLength: int64(len(credential)),
+	}, nil
+}
+
 // validateStatusType - validate credential status type.
 // If credentialStatusTypeRequest is nil or empty, it will return the credential status type from the auth claim (first non revoked).
 func (s *Server) validateStatusType(ctx context.Context, did *w3c.DID, credentialStatusTypeRequest *string) (*verifiable.CredentialStatusType, error) {
 	var credentialStatusType verifiable.CredentialStatusType
 	if credentialStatusTypeRequest != nil && *credentialStatusTypeRequest != "" {
-		allowedCredentialStatuses := []string{string(verifiable.Iden3commRevocationStatusV1), string(verifiable.Iden3ReverseSparseMerkleTreeProof), string(verifiable.Iden3OnchainSparseMerkleTreeProof2023)}
+		allowedCredentialStatuses := []string{string(verifiable.Iden3commRevocationStatusV1), string(verifiable.Iden3ReverseSparseMerkleTreeProof), string(verifiable.Iden3OnchainSparseMerkleTreeProof2023), string(statuslist.BitstringStatusListEntry)}
 		if !slices.Contains(allowedCredentialStatuses, *credentialStatusTypeRequest) {
-			return nil, fmt.Errorf("Invalid Credential Status Type '%s'. Allowed Iden3commRevocationStatusV1.0, Iden3ReverseSparseMerkleTreeProof or Iden3OnchainSparseMerkleTreeProof2023.", *credentialStatusTypeRequest)
+			return nil, fmt.Errorf("Invalid Credential Status Type '%s'. Allowed Iden3commRevocationStatusV1.0, Iden3ReverseSparseMerkleTreeProof, Iden3OnchainSparseMerkleTreeProof2023 or BitstringStatusListEntry.", *credentialStatusTypeRequest)
 		}
 		credentialStatusType = (verifiable.CredentialStatusType)(*credentialStatusTypeRequest)
 	} else {
@@ -52,6 +52,11 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		}, nil
 	}
 
+	tenantID, err := requestTenant(ctx, request.Body.TenantId)
+	if err != nil {
+		return CreateIdentity400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+
 	rhsSettings, err := s.networkResolver.GetRhsSettingsForBlockchainAndNetwork(ctx, blockchain, network)
 	if err != nil {
 		return CreateIdentity400JSONResponse{N400JSONResponse{Message: fmt.Sprintf("error getting reverse hash service settings: %s", err.Error())}}, nil
@@ -69,9 +74,10 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 		KeyType:              kms.KeyType(keyType),
 		AuthCredentialStatus: *credentialStatusType,
 		DisplayName:          request.Body.DisplayName,
+		TenantID:             tenantID,
 	})
 	if err != nil {
-		if errors.Is(err, services.ErrWrongDIDMetada) {
+		if errors.Is(err, services.ErrWrongDIDMetada) || errors.Is(err, services.ErrTenantNotFound) {
 			return CreateIdentity400JSONResponse{
 				N400JSONResponse{
 					Message: err.Error(),
@@ -93,6 +99,9 @@ func (s *Server) CreateIdentity(ctx context.Context, request CreateIdentityReque
 				},
 			}, nil
 		}
+		if errors.Is(err, services.ErrIdentityQuotaExceeded) {
+			return CreateIdentity403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+		}
 		if errors.Is(err, services.ErrIdentityDisplayNameDuplicated) {
 			return CreateIdentity409JSONResponse{
 				N409JSONResponse{
@@ -186,7 +195,7 @@ func (s *Server) UpdateIdentity(ctx context.Context, request UpdateIdentityReque
 func (s *Server) GetIdentities(ctx context.Context, request GetIdentitiesRequestObject) (GetIdentitiesResponseObject, error) {
 	var err error
 	var response GetIdentities200JSONResponse
-	identities, err := s.identityService.Get(ctx)
+	identities, err := s.identityService.Get(ctx, callerTenant(ctx))
 	if err != nil {
 		return GetIdentities500JSONResponse{N500JSONResponse{
 			Message: err.Error(),
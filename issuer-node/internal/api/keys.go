@@ -15,11 +15,11 @@ import (
 
 // CreateKey is the handler for the POST /keys endpoint.
 func (s *Server) CreateKey(ctx context.Context, request CreateKeyRequestObject) (CreateKeyResponseObject, error) {
-	if string(request.Body.KeyType) != string(KeyKeyTypeBabyjubJub) && string(request.Body.KeyType) != string(KeyKeyTypeSecp256k1) {
-		log.Error(ctx, "invalid key type. babyjujJub and secp256k1 keys are supported")
+	if string(request.Body.KeyType) != string(KeyKeyTypeBabyjubJub) && string(request.Body.KeyType) != string(KeyKeyTypeSecp256k1) && string(request.Body.KeyType) != string(KeyKeyTypeX25519) {
+		log.Error(ctx, "invalid key type. babyjujJub, secp256k1 and x25519 keys are supported")
 		return CreateKey400JSONResponse{
 			N400JSONResponse{
-				Message: "invalid key type. babyjujJub and secp256k1 keys are supported",
+				Message: "invalid key type. babyjujJub, secp256k1 and x25519 keys are supported",
 			},
 		}, nil
 	}
@@ -166,19 +166,21 @@ func (s *Server) GetKeys(ctx context.Context, request GetKeysRequestObject) (Get
 	}
 
 	if request.Params.Type != nil {
-		if string(*request.Params.Type) != string(KeyKeyTypeBabyjubJub) && string(*request.Params.Type) != string(KeyKeyTypeSecp256k1) {
-			log.Error(ctx, "invalid key type. babyjubJub and secp256k1 keys are supported")
+		switch string(*request.Params.Type) {
+		case string(KeyKeyTypeBabyjubJub):
+			filter.KeyType = common.ToPointer(kms.KeyTypeBabyJubJub)
+		case string(KeyKeyTypeSecp256k1):
+			filter.KeyType = common.ToPointer(kms.KeyTypeEthereum)
+		case string(KeyKeyTypeX25519):
+			filter.KeyType = common.ToPointer(kms.KeyTypeX25519)
+		default:
+			log.Error(ctx, "invalid key type. babyjubJub, secp256k1 and x25519 keys are supported")
 			return GetKeys400JSONResponse{
 				N400JSONResponse{
-					Message: "invalid key type. babyjubJub and secp256k1 keys are supported",
+					Message: "invalid key type. babyjubJub, secp256k1 and x25519 keys are supported",
 				},
 			}, nil
 		}
-		if string(*request.Params.Type) == string(KeyKeyTypeBabyjubJub) {
-			filter.KeyType = common.ToPointer(kms.KeyTypeBabyJubJub)
-		} else {
-			filter.KeyType = common.ToPointer(kms.KeyTypeEthereum)
-		}
 	}
 
 	if request.Params.MaxResults != nil {
@@ -279,15 +281,21 @@ func (s *Server) DeleteKey(ctx context.Context, request DeleteKeyRequestObject)
 }
 
 func convertKeyTypeToResponse(keyType kms.KeyType) KeyKeyType {
-	if keyType == "BJJ" {
+	switch keyType {
+	case kms.KeyTypeBabyJubJub:
 		return KeyKeyTypeBabyjubJub
+	case kms.KeyTypeX25519:
+		return KeyKeyTypeX25519
 	}
 	return KeyKeyTypeSecp256k1
 }
 
 func convertKeyTypeFromRequest(keyType CreateKeyRequestKeyType) kms.KeyType {
-	if string(keyType) == string(KeyKeyTypeBabyjubJub) {
-		return "BJJ"
+	switch string(keyType) {
+	case string(KeyKeyTypeBabyjubJub):
+		return kms.KeyTypeBabyJubJub
+	case string(KeyKeyTypeX25519):
+		return kms.KeyTypeX25519
 	}
-	return "ETH"
+	return kms.KeyTypeEthereum
 }
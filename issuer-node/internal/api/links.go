@@ -73,7 +73,21 @@ func (s *Server) CreateLink(ctx context.Context, request CreateLinkRequestObject
 		expirationDate = request.Body.CredentialExpiration
 	}
 
-	createdLink, err := s.linkService.Save(ctx, *issuerDID, request.Body.LimitedClaims, request.Body.Expiration, request.Body.SchemaID, expirationDate, request.Body.SignatureProof, request.Body.MtProof, credSubject, toVerifiableRefreshService(request.Body.RefreshService), toDisplayMethodService(request.Body.DisplayMethod))
+	credentialFormat := domain.CredentialFormatIden3
+	if request.Body.CredentialFormat != nil {
+		credentialFormat = domain.CredentialFormat(*request.Body.CredentialFormat)
+	}
+
+	var credentialStatusType verifiable.CredentialStatusType
+	if request.Body.CredentialStatusType != nil {
+		statusType, err := s.validateStatusType(ctx, issuerDID, (*string)(request.Body.CredentialStatusType))
+		if err != nil {
+			return CreateLink400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		credentialStatusType = *statusType
+	}
+
+	createdLink, err := s.linkService.Save(ctx, *issuerDID, request.Body.LimitedClaims, request.Body.Expiration, request.Body.SchemaID, expirationDate, request.Body.SignatureProof, request.Body.MtProof, credSubject, toVerifiableRefreshService(request.Body.RefreshService), toDisplayMethodService(request.Body.DisplayMethod), credentialFormat, credentialStatusType)
 	if err != nil {
 		log.Error(ctx, "error saving the link", "err", err.Error())
 		if errors.Is(err, services.ErrLoadingSchema) {
@@ -201,10 +215,11 @@ func (s *Server) CreateLinkOffer(ctx context.Context, req CreateLinkOfferRequest
 		return CreateLinkOffer500JSONResponse{N500JSONResponse{"Unexpected error while creating qr code"}}, nil
 	}
 
+	issuerName, issuerLogo := s.issuerDisplay(ctx, *issuerDID)
 	return CreateLinkOffer200JSONResponse{
 		Issuer: IssuerDescription{
-			DisplayName: s.cfg.IssuerName,
-			Logo:        s.cfg.IssuerLogo,
+			DisplayName: issuerName,
+			Logo:        issuerLogo,
 		},
 		DeepLink:      createLinkQrCodeResponse.DeepLink,
 		UniversalLink: createLinkQrCodeResponse.UniversalLink,
@@ -213,6 +228,18 @@ func (s *Server) CreateLinkOffer(ctx context.Context, req CreateLinkOfferRequest
 	}, nil
 }
 
+// issuerDisplay returns the name and the logo of the issuer, the ones of its tenant or of the node configuration
+func (s *Server) issuerDisplay(ctx context.Context, issuerDID w3c.DID) (string, string) {
+	tenant, err := s.tenantService.GetByIssuer(ctx, issuerDID)
+	if err != nil {
+		if !errors.Is(err, services.ErrTenantNotFound) {
+			log.Warn(ctx, "loading tenant of issuer", "err", err, "did", issuerDID.String())
+		}
+		return s.cfg.IssuerName, s.cfg.IssuerLogo
+	}
+	return tenant.IssuerDisplay(s.cfg.IssuerName, s.cfg.IssuerLogo)
+}
+
 func toDisplayMethodService(s *DisplayMethod) *verifiable.DisplayMethod {
 	if s == nil {
 		return nil
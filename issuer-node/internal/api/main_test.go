@@ -23,6 +23,8 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/db/tests"
 	"github.com/polygonid/sh-id-platform/internal/errors"
+	"github.com/polygonid/sh-id-platform/internal/gateways"
+	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -146,9 +148,11 @@ func getHandler(ctx context.Context, server StrictServerInterface) http.Handler
 
 func middlewares(ctx context.Context) []StrictMiddlewareFunc {
 	usr, pass := authOk()
+	tenantService := services.NewTenant(repositories.NewTenant(*storage), storage)
 	return []StrictMiddlewareFunc{
 		LogMiddleware(ctx),
-		BasicAuthMiddleware(ctx, usr, pass),
+		TenantMiddleware(ctx, tenantService),
+		APIKeyAuthMiddleware(ctx, services.NewAPIKey(repositories.NewAPIKey(*storage), tenantService), usr, pass),
 	}
 }
 
@@ -241,6 +245,17 @@ type repos struct {
 	revocation     ports.RevocationRepository
 	displayMethod  ports.DisplayMethodRepository
 	keyRepository  ports.KeyRepository
+	transactions   ports.TransactionRepository
+	messages       ports.MessageRepository
+	proofRequests  ports.ProofRequestRepository
+	onchainIssuers ports.OnchainIssuerRepository
+	encodings      ports.EncodedCredentialRepository
+	oid4vciOffers  ports.OID4VCIOfferRepository
+	statusLists    ports.StatusListRepository
+	schemaDocs     ports.SchemaDocumentRepository
+	schemaFamilies ports.SchemaFamilyRepository
+	migrations     ports.SchemaMigrationRepository
+	apiKeys        ports.APIKeyRepository
 }
 
 type servicex struct {
@@ -252,6 +267,7 @@ type servicex struct {
 	qrs           ports.QrStoreService
 	displayMethod ports.DisplayMethodService
 	keyService    ports.KeyService
+	transactions  ports.TransactionHistoryService
 }
 
 type infra struct {
@@ -284,6 +300,17 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 		revocation:     repositories.NewRevocation(),
 		displayMethod:  repositories.NewDisplayMethod(*st),
 		keyRepository:  repositories.NewKey(*st),
+		transactions:   repositories.NewTransaction(*st),
+		messages:       repositories.NewMessage(*st),
+		proofRequests:  repositories.NewProofRequest(*st),
+		onchainIssuers: repositories.NewOnchainIssuer(*st),
+		encodings:      repositories.NewEncodedCredential(*st),
+		oid4vciOffers:  repositories.NewOID4VCIOffer(*st),
+		statusLists:    repositories.NewStatusList(),
+		schemaDocs:     repositories.NewSchemaDocument(*st),
+		schemaFamilies: repositories.NewSchemaFamily(*st),
+		migrations:     repositories.NewSchemaMigration(*st),
+		apiKeys:        repositories.NewAPIKey(*st),
 	}
 
 	pubSub := pubsub.NewMock()
@@ -351,29 +378,41 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 	mtService := services.NewIdentityMerkleTrees(repos.idenMerkleTree)
 	qrService := services.NewQrStoreService(cachex)
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
-	identityService := services.NewIdentity(keyStore, repos.identity, repos.idenMerkleTree, repos.identityState, mtService, qrService, repos.claims, repos.revocation, repos.connection, st, nil, repos.sessions, pubSub, *networkResolver, rhsFactory, revocationStatusResolver, repos.keyRepository)
+	tenantService := services.NewTenant(repositories.NewTenant(*st), st)
+	identityService := services.NewIdentity(keyStore, repos.identity, repos.idenMerkleTree, repos.identityState, mtService, qrService, repos.claims, repos.revocation, repos.connection, st, nil, repos.sessions, pubSub, *networkResolver, rhsFactory, revocationStatusResolver, repos.keyRepository, tenantService)
 	connectionService := services.NewConnection(repos.connection, repos.claims, st)
 	displayMethodService := services.NewDisplayMethod(repos.displayMethod)
-	schemaService := services.NewSchema(repos.schemas, schemaLoader, displayMethodService)
-	paymentService, err := services.NewPaymentService(repos.payments, *networkResolver, schemaService, paymentSettings, keyStore)
+	auditService := services.NewAudit(repositories.NewAudit(*st))
+	schemaService := services.NewSchema(repos.schemas, schemaLoader, displayMethodService, repositories.NewSchemaSnapshot(*st), loader.MultiProtocolFactory(ipfsGatewayURL), st, auditService)
+	transactionHistoryService := services.NewTransactionHistory(repos.transactions)
+	messageService := services.NewMessage(repos.messages, connectionService, gateways.NewMessageClient(httpPkg.DefaultHTTPClientWithRetry, gateways.NewPushNotificationClient(httpPkg.DefaultHTTPClientWithRetry), keyStore), cfg.Messages)
+	paymentService, err := services.NewPaymentService(repos.payments, *networkResolver, schemaService, transactionHistoryService, paymentSettings, keyStore, st, auditService)
 	require.NoError(t, err)
 	mediaTypeManager := services.NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
-			protocol.CredentialFetchRequestMessageType:  {string(packers.MediaTypeZKPMessage)},
-			protocol.RevocationStatusRequestMessageType: {"*"},
-			protocol.DiscoverFeatureQueriesMessageType:  {"*"},
+			protocol.DiscoverFeatureQueriesMessageType: {"*"},
 		},
 		true,
 	)
 
 	packageManager, err := NewPackageManagerMock()
 	require.NoError(t, err)
-	claimsService := services.NewClaim(repos.claims, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	statusListService := services.NewStatusList(repos.statusLists, st, keyStore, cfg.ServerUrl)
+	claimsService := services.NewClaim(repos.claims, identityService, qrService, mtService, repos.identityState, schemaLoader, st, cfg.ServerUrl, pubSub, ipfsGatewayURL, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, statusListService, auditService, tenantService)
 	accountService := services.NewAccountService(*networkResolver)
-	linkService := services.NewLinkService(storage, claimsService, qrService, repos.claims, repos.links, repos.schemas, schemaLoader, repos.sessions, pubSub, identityService, *networkResolver, cfg.UniversalLinks)
-	keyService := services.NewKey(keyStore, claimsService, repos.keyRepository)
+	linkService := services.NewLinkService(storage, claimsService, qrService, repos.claims, repos.links, repos.schemas, schemaLoader, repos.sessions, pubSub, identityService, *networkResolver, cfg.UniversalLinks, auditService, tenantService)
+	keyService := services.NewKey(keyStore, claimsService, repos.keyRepository, st, auditService)
+	agentRouter := services.NewAgentRouter(mediaTypeManager)
+	agentRouter.Register(protocol.CredentialFetchRequestMessageType, []string{string(packers.MediaTypeZKPMessage)}, claimsService.Agent)
+	agentRouter.Register(protocol.RevocationStatusRequestMessageType, []string{"*"}, claimsService.Agent)
+	agentRouter.Register(protocol.CredentialProposalRequestMessageType, []string{string(packers.MediaTypeZKPMessage)}, paymentService.Agent)
+	agentRouter.Register(protocol.CredentialPaymentMessageType, []string{string(packers.MediaTypeZKPMessage)}, paymentService.Agent)
 	discoveryService := services.NewDiscovery(mediaTypeManager, packageManager, mediaTypeManager.GetSupportedProtocolMessages())
-	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, NewPublisherMock(), packageManager, *networkResolver, nil, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService)
+	agentRouter.Register(protocol.DiscoverFeatureQueriesMessageType, nil, func(ctx context.Context, req *ports.AgentRequest, _ iden3comm.MediaType) (*iden3comm.BasicMessage, error) {
+		return discoveryService.Agent(ctx, req)
+	})
+	credentialFormatService := services.NewCredentialFormat(repos.encodings, repos.links, keyStore)
+	server := NewServer(&cfg, identityService, accountService, connectionService, claimsService, qrService, NewPublisherMock(), packageManager, *networkResolver, nil, schemaService, linkService, displayMethodService, keyService, paymentService, discoveryService, nil, transactionHistoryService, nil, agentRouter, services.NewAgentResponsePacker(packageManager, keyStore), messageService, services.NewProofRequest(repos.proofRequests, connectionService, messageService, nil, cfg.ServerUrl), services.NewOnchainIssuer(repos.onchainIssuers, repos.claims, identityService, gateways.NewOnchainIdentityGateway(*networkResolver, keyStore), transactionHistoryService, messageService, schemaLoader, st, auditService, tenantService), services.NewPresentation(claimsService, identityService, keyStore, schemaLoader), credentialFormatService, services.NewOID4VCI(repos.oid4vciOffers, linkService, repos.links, schemaService, identityService, credentialFormatService, cfg.ServerUrl), services.NewOID4VP(repos.sessions, schemaService, claimsService, schemaLoader, keyStore, cfg.ServerUrl), statusListService, services.NewSchemaBuilder(repos.schemaDocs, nil, cfg.ServerUrl), services.NewSchemaFamily(repos.schemaFamilies, repos.migrations, repos.schemas, claimsService, schemaLoader), services.NewAPIKey(repos.apiKeys, tenantService), auditService, tenantService)
 
 	return &testServer{
 		Server: server,
@@ -387,6 +426,7 @@ func newTestServer(t *testing.T, st *db.Storage) *testServer {
 			schema:        schemaService,
 			displayMethod: displayMethodService,
 			keyService:    keyService,
+			transactions:  transactionHistoryService,
 		},
 		Infra: infra{
 			db:     st,
@@ -4,24 +4,102 @@ import (
 	"context"
 	"crypto/subtle"
 	"errors"
+	"net"
 	"net/http"
+	"net/netip"
+	"strings"
 
+	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/polygonid/sh-id-platform/internal/auth"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
 	apiErrors "github.com/polygonid/sh-id-platform/internal/errors"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/ratelimit"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
-// LogMiddleware returns a middleware that adds general log configuration to each context request
+// LogMiddleware returns a middleware that adds general log configuration to each context request.
+// The principal of the request, if any, is kept in the context of the handler and added to its logs, along with the
+// request id and the source ip for the audit log.
 func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
 			if reqID := middleware.GetReqID(ctxReq); reqID != "" {
 				log.With("req-id", reqID)
 			}
-			return f(ctx, w, r, args)
+			ctxHandler := auth.WithOrigin(ctx, auth.Origin{RequestID: middleware.GetReqID(ctxReq), SourceIP: sourceIP(r)})
+			if principal := auth.PrincipalFromContext(ctxReq); principal != nil {
+				ctxHandler = log.WithAttrs(auth.WithPrincipal(ctxHandler, principal), "principal", principal.ID, "auth", principal.Method)
+			}
+			if span := trace.SpanFromContext(ctxReq); span.SpanContext().IsValid() {
+				ctxHandler = log.WithAttrs(trace.ContextWithSpan(ctxHandler, span), "trace-id", span.SpanContext().TraceID().String())
+			}
+			return f(ctxHandler, w, r, args)
+		}
+	}
+}
+
+// TelemetryMiddleware returns a middleware that names the trace and the metrics of the request after its operation.
+// It must be the outermost middleware so the requests rejected by the others are named too.
+func TelemetryMiddleware() StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			telemetry.SetOperation(ctxReq, operationID)
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// sourceIP returns the ip address of the client of the request, without the port
+func sourceIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// RealIPMiddleware sets the remote address of the requests sent by a trusted proxy to the address of the client: the
+// last one in X-Forwarded-For that is not a trusted proxy. The header of other requests is ignored, so clients can't
+// spoof their address to the rate limits and the audit log.
+func RealIPMiddleware(trusted config.TrustedProxies) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if client, ok := forwardedClient(r, trusted); ok {
+				r.RemoteAddr = client
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// forwardedClient returns the address of the client of a request sent by a trusted proxy
+func forwardedClient(r *http.Request, trusted config.TrustedProxies) (string, bool) {
+	if len(trusted) == 0 {
+		return "", false
+	}
+	peer, err := netip.ParseAddr(sourceIP(r))
+	if err != nil || !trusted.Contains(peer) {
+		return "", false
+	}
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			return "", false
+		}
+		if !trusted.Contains(addr) {
+			return addr.Unmap().String(), true
 		}
 	}
+	return "", false
 }
 
 // BasicAuthMiddleware returns a middleware that performs an http basic authorization for endpoints configured with
@@ -31,7 +109,7 @@ func LogMiddleware(ctx context.Context) StrictMiddlewareFunc {
 func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewareFunc {
 	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
 		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
-			if ctxReq.Value(BasicAuthScopes) != nil && user != "" && pass != "" {
+			if ctxReq.Value(BasicAuthScopes) != nil && user != "" && pass != "" && auth.PrincipalFromContext(ctxReq) == nil {
 				userReq, passReq, ok := r.BasicAuth()
 				if !ok {
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
@@ -39,8 +117,193 @@ func BasicAuthMiddleware(ctx context.Context, user, pass string) StrictMiddlewar
 				if subtle.ConstantTimeCompare([]byte(user), []byte(userReq)) != 1 || subtle.ConstantTimeCompare([]byte(pass), []byte(passReq)) != 1 {
 					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
 				}
+				ctxReq = authenticated(ctxReq, &domain.Principal{ID: user, Name: user, Method: domain.AuthMethodBasic, Grants: []domain.Grant{{Role: domain.RoleAdmin}}})
 			}
-			return f(ctx, w, r, args)
+			return f(ctxReq, w, r, args)
 		}
 	}
 }
+
+// apiKeyHeader is the header with the API key of the caller
+const apiKeyHeader = "X-API-Key"
+
+// revokeOperations are the operations that revoke credentials, allowed to the revoker keys
+var revokeOperations = map[string]bool{
+	"RevokeCredential":            true,
+	"revokeConnectionCredentials": true,
+}
+
+// adminOperations are the operations that manage the API keys, the tenants and the node, only allowed to the admin keys
+var adminOperations = map[string]bool{
+	"GetAPIKeys":              true,
+	"CreateAPIKey":            true,
+	"RevokeAPIKey":            true,
+	"GetTenants":              true,
+	"CreateTenant":            true,
+	"GetTenant":               true,
+	"UpdateTenant":            true,
+	"ReloadSupportedNetworks": true,
+}
+
+// nodeOperations are the admin operations on the whole node, not allowed to the principals of a tenant.
+// The read only settings of the node, like GetSupportedNetworks or GetPaymentSettings, are allowed to every principal.
+var nodeOperations = map[string]bool{
+	"GetTenants":              true,
+	"CreateTenant":            true,
+	"GetTenant":               true,
+	"UpdateTenant":            true,
+	"ReloadSupportedNetworks": true,
+}
+
+// APIKeyAuthMiddleware returns a middleware that authorizes the endpoints configured with basic auth in the api spec
+// with the API key sent in the X-API-Key header. The key must be valid for the issuer of the {identifier} path param,
+// or for every issuer on the endpoints of the node, and its role must allow the operation.
+// Requests without API key fall back to the http basic authorization with user and pass.
+func APIKeyAuthMiddleware(ctx context.Context, apiKeyService ports.APIKeyService, user, pass string) StrictMiddlewareFunc {
+	basicAuth := BasicAuthMiddleware(ctx, user, pass)
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		fallback := basicAuth(f, operationID)
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			plain := r.Header.Get(apiKeyHeader)
+			if ctxReq.Value(BasicAuthScopes) == nil || plain == "" || auth.PrincipalFromContext(ctxReq) != nil {
+				return fallback(ctxReq, w, r, args)
+			}
+			key, err := apiKeyService.Authenticate(ctxReq, plain)
+			if err != nil {
+				if errors.Is(err, services.ErrAPIKeyUnauthorized) {
+					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+				}
+				return nil, err
+			}
+			principal := &domain.Principal{ID: key.ID.String(), Name: key.Name, Method: domain.AuthMethodAPIKey, TenantID: key.TenantID, Grants: []domain.Grant{key.Grant()}}
+			if err := authorize(ctxReq, principal, operationID, r); err != nil {
+				return nil, err
+			}
+			return f(authenticated(ctxReq, principal), w, r, args)
+		}
+	}
+}
+
+// TenantMiddleware returns a middleware that only lets the principals of a tenant act on the issuers of their tenant,
+// the {identifier} path param of the endpoints. It must run after the authentication middlewares.
+func TenantMiddleware(ctx context.Context, tenantService ports.TenantService) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			principal := auth.PrincipalFromContext(ctxReq)
+			identifier := chi.URLParam(r, "identifier")
+			if principal == nil || principal.TenantID == nil || identifier == "" {
+				return f(ctxReq, w, r, args)
+			}
+			issuerDID, err := w3c.ParseDID(identifier)
+			if err != nil {
+				return f(ctxReq, w, r, args)
+			}
+			tenant, err := tenantService.GetByIssuer(ctxReq, *issuerDID)
+			if err != nil && !errors.Is(err, services.ErrTenantNotFound) {
+				log.Error(ctxReq, "loading tenant of issuer", "err", err, "identifier", identifier)
+				return nil, err
+			}
+			if tenant == nil || !principal.InTenant(tenant.ID) {
+				log.Warn(ctxReq, "issuer of another tenant", "principal", principal.ID, "tenant", principal.TenantID, "operation", operationID, "identifier", identifier)
+				return nil, apiErrors.ForbiddenError{Err: errors.New("forbidden")}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// RateLimitMiddleware returns a middleware that throttles the clients of the public endpoints, the ones without basic
+// auth in the api spec, and of the operations with their own limits. Throttled requests get a 429 with the seconds
+// to wait in the Retry-After header. It must run after the authentication middlewares to limit by API key.
+func RateLimitMiddleware(ctx context.Context, limiter *ratelimit.Limiter) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			limit, ok := limiter.Limit(operationID, ctxReq.Value(BasicAuthScopes) == nil)
+			if !ok {
+				return f(ctxReq, w, r, args)
+			}
+			client := rateLimitClient(ctxReq, r, limit.Key)
+			if allowed, retryAfter := limiter.Allow(ctxReq, operationID, client, limit); !allowed {
+				log.Warn(ctxReq, "rate limit exceeded", "operation", operationID, "client", client, "retryAfter", retryAfter)
+				return nil, apiErrors.TooManyRequestsError{Err: errors.New("too many requests"), RetryAfter: retryAfter}
+			}
+			return f(ctxReq, w, r, args)
+		}
+	}
+}
+
+// rateLimitClient returns the client of the request for the key of the limit, the source ip when the request has no
+// DID or authenticated caller
+func rateLimitClient(ctx context.Context, r *http.Request, key ratelimit.Key) string {
+	switch key {
+	case ratelimit.KeyDID:
+		if identifier := chi.URLParam(r, "identifier"); identifier != "" {
+			return identifier
+		}
+	case ratelimit.KeyAPIKey:
+		if principal := auth.PrincipalFromContext(ctx); principal != nil {
+			return principal.Method + ":" + principal.ID
+		}
+	}
+	return "ip:" + sourceIP(r)
+}
+
+// bearerPrefix is the scheme of the Authorization header with an OIDC token
+const bearerPrefix = "Bearer "
+
+// JWTAuthMiddleware returns a middleware that authorizes the endpoints configured with basic auth in the api spec
+// with the OIDC bearer token sent in the Authorization header. The claims of the token must grant the permission of
+// the operation on the issuer of the {identifier} path param, like API keys.
+// Requests without bearer token fall back to the next authentication middleware.
+func JWTAuthMiddleware(ctx context.Context, verifier *auth.Verifier) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(ctxReq context.Context, w http.ResponseWriter, r *http.Request, args interface{}) (interface{}, error) {
+			header := r.Header.Get("Authorization")
+			if ctxReq.Value(BasicAuthScopes) == nil || !strings.HasPrefix(header, bearerPrefix) {
+				return f(ctxReq, w, r, args)
+			}
+			principal, err := verifier.Verify(ctxReq, strings.TrimPrefix(header, bearerPrefix))
+			if err != nil {
+				if errors.Is(err, auth.ErrInvalidToken) {
+					log.Warn(ctxReq, "invalid bearer token", "err", err, "operation", operationID)
+					return nil, apiErrors.AuthError{Err: errors.New("unauthorized")}
+				}
+				log.Error(ctxReq, "verifying bearer token", "err", err)
+				return nil, err
+			}
+			if err := authorize(ctxReq, principal, operationID, r); err != nil {
+				return nil, err
+			}
+			return f(authenticated(ctxReq, principal), w, r, args)
+		}
+	}
+}
+
+// authorize checks that the principal has the permission of the operation on the issuer of the request
+func authorize(ctx context.Context, principal *domain.Principal, operationID string, r *http.Request) error {
+	identifier := chi.URLParam(r, "identifier")
+	if !principal.Allows(identifier, operationPermission(operationID, r.Method)) || (principal.TenantID != nil && nodeOperations[operationID]) {
+		log.Warn(ctx, "operation not allowed", "principal", principal.ID, "auth", principal.Method, "operation", operationID, "identifier", identifier)
+		return apiErrors.ForbiddenError{Err: errors.New("forbidden")}
+	}
+	return nil
+}
+
+// authenticated returns a copy of ctx with the principal of the request, and adds it to the log of the request
+func authenticated(ctx context.Context, principal *domain.Principal) context.Context {
+	log.AddRequestAttrs(ctx, "principal", principal.ID, "auth", principal.Method)
+	return auth.WithPrincipal(ctx, principal)
+}
+
+// operationPermission returns the permission a caller needs to perform the operation
+func operationPermission(operationID string, method string) domain.Permission {
+	switch {
+	case adminOperations[operationID]:
+		return domain.PermissionAdmin
+	case revokeOperations[operationID]:
+		return domain.PermissionRevoke
+	case method == http.MethodGet || method == http.MethodHead:
+		return domain.PermissionRead
+	}
+	return domain.PermissionWrite
+}
@@ -0,0 +1,45 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+func TestRealIPMiddleware(t *testing.T) {
+	var trusted config.TrustedProxies
+	require.NoError(t, trusted.UnmarshalText([]byte("10.0.0.0/8")))
+
+	type testConfig struct {
+		name         string
+		trusted      config.TrustedProxies
+		remoteAddr   string
+		forwardedFor string
+		expectedIP   string
+	}
+	for _, tc := range []testConfig{
+		{name: "trusted proxy", trusted: trusted, remoteAddr: "10.0.0.2:1234", forwardedFor: "1.2.3.4", expectedIP: "1.2.3.4"},
+		{name: "spoofed hop before the client", trusted: trusted, remoteAddr: "10.0.0.2:1234", forwardedFor: "6.6.6.6, 1.2.3.4", expectedIP: "1.2.3.4"},
+		{name: "chain of trusted proxies", trusted: trusted, remoteAddr: "10.0.0.2:1234", forwardedFor: "1.2.3.4, 10.0.0.5", expectedIP: "1.2.3.4"},
+		{name: "untrusted peer", trusted: trusted, remoteAddr: "8.8.8.8:1234", forwardedFor: "1.2.3.4", expectedIP: "8.8.8.8"},
+		{name: "no trusted proxies", remoteAddr: "10.0.0.2:1234", forwardedFor: "1.2.3.4", expectedIP: "10.0.0.2"},
+		{name: "invalid header", trusted: trusted, remoteAddr: "10.0.0.2:1234", forwardedFor: "garbage", expectedIP: "10.0.0.2"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var ip string
+			handler := RealIPMiddleware(tc.trusted)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ip = sourceIP(r)
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/v2/agent", nil)
+			req.RemoteAddr = tc.remoteAddr
+			req.Header.Set("X-Forwarded-For", tc.forwardedFor)
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+			assert.Equal(t, tc.expectedIP, ip)
+		})
+	}
+}
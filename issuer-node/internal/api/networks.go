@@ -2,6 +2,10 @@ package api
 
 import (
 	"context"
+	"errors"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
 )
 
 // GetSupportedNetworks is the controller to get supported networks
@@ -37,3 +41,20 @@ func (s *Server) supportedNetworksData(ctx context.Context, blockchain string, n
 	}
 	return nd
 }
+
+// ReloadSupportedNetworks is the controller to reload the resolver settings
+func (s *Server) ReloadSupportedNetworks(ctx context.Context, _ ReloadSupportedNetworksRequestObject) (ReloadSupportedNetworksResponseObject, error) {
+	result, err := s.networkService.ReloadSettings(ctx)
+	if err != nil {
+		log.Error(ctx, "reloading resolver settings", "err", err)
+		if errors.Is(err, network.ErrNetworkInUse) {
+			return ReloadSupportedNetworks409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		return ReloadSupportedNetworks400JSONResponse{N400JSONResponse{Message: "cannot reload resolver settings: " + err.Error()}}, nil
+	}
+	return ReloadSupportedNetworks200JSONResponse{
+		Added:   result.Added,
+		Updated: result.Updated,
+		Removed: result.Removed,
+	}, nil
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vci"
+)
+
+// CreateLinkOID4VCIOffer creates an OpenID4VCI offer of the link with a pre-authorized code
+func (s *Server) CreateLinkOID4VCIOffer(ctx context.Context, request CreateLinkOID4VCIOfferRequestObject) (CreateLinkOID4VCIOfferResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CreateLinkOID4VCIOffer400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	offer, err := s.oid4vciService.CreateOffer(ctx, *issuerDID, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrLinkNotFound):
+			return CreateLinkOID4VCIOffer404JSONResponse{N404JSONResponse{Message: "link not found"}}, nil
+		case errors.Is(err, services.ErrOID4VCIUnsupportedLinkFormat),
+			errors.Is(err, services.ErrLinkAlreadyExpired),
+			errors.Is(err, services.ErrLinkMaxExceeded),
+			errors.Is(err, services.ErrLinkInactive):
+			return CreateLinkOID4VCIOffer400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating oid4vci offer", "err", err, "link", request.Id)
+		return CreateLinkOID4VCIOffer500JSONResponse{N500JSONResponse{Message: "There was an error creating the offer"}}, nil
+	}
+	uri, err := offer.URI()
+	if err != nil {
+		return CreateLinkOID4VCIOffer500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateLinkOID4VCIOffer200JSONResponse{CredentialOffer: *offer, CredentialOfferUri: uri}, nil
+}
+
+// GetOID4VCIIssuerMetadata returns the OpenID4VCI credential issuer metadata of the identity
+func (s *Server) GetOID4VCIIssuerMetadata(ctx context.Context, request GetOID4VCIIssuerMetadataRequestObject) (GetOID4VCIIssuerMetadataResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetOID4VCIIssuerMetadata404JSONResponse{N404JSONResponse{Message: "issuer not found"}}, nil
+	}
+	metadata, err := s.oid4vciService.IssuerMetadata(ctx, *issuerDID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrIdentityNotFound) || errors.Is(err, services.ErrCredentialFormatUnsupportedIssuer) {
+			return GetOID4VCIIssuerMetadata404JSONResponse{N404JSONResponse{Message: "issuer not found"}}, nil
+		}
+		log.Error(ctx, "getting oid4vci issuer metadata", "err", err, "did", issuerDID)
+		return GetOID4VCIIssuerMetadata500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetOID4VCIIssuerMetadata200JSONResponse(*metadata), nil
+}
+
+// GetOID4VCIAuthorizationServerMetadata returns the OAuth metadata of the identity
+func (s *Server) GetOID4VCIAuthorizationServerMetadata(ctx context.Context, request GetOID4VCIAuthorizationServerMetadataRequestObject) (GetOID4VCIAuthorizationServerMetadataResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetOID4VCIAuthorizationServerMetadata404JSONResponse{N404JSONResponse{Message: "issuer not found"}}, nil
+	}
+	metadata, err := s.oid4vciService.AuthorizationServerMetadata(ctx, *issuerDID)
+	if err != nil {
+		if errors.Is(err, services.ErrCredentialFormatUnsupportedIssuer) {
+			return GetOID4VCIAuthorizationServerMetadata404JSONResponse{N404JSONResponse{Message: "issuer not found"}}, nil
+		}
+		return GetOID4VCIAuthorizationServerMetadata500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetOID4VCIAuthorizationServerMetadata200JSONResponse(*metadata), nil
+}
+
+// CreateOID4VCIToken is the OpenID4VCI token endpoint of the pre-authorized code flow
+func (s *Server) CreateOID4VCIToken(ctx context.Context, request CreateOID4VCITokenRequestObject) (CreateOID4VCITokenResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateOID4VCIToken400JSONResponse(*oid4vci.NewError(oid4vci.ErrorInvalidRequest, "invalid issuer did")), nil
+	}
+	if request.Body == nil {
+		return CreateOID4VCIToken400JSONResponse(*oid4vci.NewError(oid4vci.ErrorInvalidRequest, "empty body")), nil
+	}
+	var code string
+	if request.Body.PreAuthorizedCode != nil {
+		code = *request.Body.PreAuthorizedCode
+	}
+
+	token, err := s.oid4vciService.Token(ctx, *issuerDID, request.Body.GrantType, code)
+	if err != nil {
+		var oid4vciErr *oid4vci.Error
+		if errors.As(err, &oid4vciErr) {
+			return CreateOID4VCIToken400JSONResponse(*oid4vciErr), nil
+		}
+		log.Error(ctx, "creating oid4vci token", "err", err, "did", issuerDID)
+		return CreateOID4VCIToken500JSONResponse{N500JSONResponse{Message: "There was an error creating the token"}}, nil
+	}
+	return CreateOID4VCIToken200JSONResponse(*token), nil
+}
+
+// CreateOID4VCICredential is the OpenID4VCI credential endpoint
+func (s *Server) CreateOID4VCICredential(ctx context.Context, request CreateOID4VCICredentialRequestObject) (CreateOID4VCICredentialResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return CreateOID4VCICredential400JSONResponse(*oid4vci.NewError(oid4vci.ErrorInvalidRequest, "invalid issuer did")), nil
+	}
+	var accessToken string
+	if request.Params.Authorization != nil {
+		accessToken, _ = strings.CutPrefix(*request.Params.Authorization, oid4vci.TokenTypeBearer+" ")
+	}
+	if accessToken == "" {
+		return CreateOID4VCICredential401JSONResponse(*oid4vci.NewError(oid4vci.ErrorInvalidToken, "a bearer access token is required")), nil
+	}
+	if request.Body == nil {
+		return CreateOID4VCICredential400JSONResponse(*oid4vci.NewError(oid4vci.ErrorInvalidCredentialRequest, "empty body")), nil
+	}
+
+	credential, err := s.oid4vciService.Credential(ctx, *issuerDID, accessToken, request.Body)
+	if err != nil {
+		var oid4vciErr *oid4vci.Error
+		if errors.As(err, &oid4vciErr) {
+			if oid4vciErr.Code == oid4vci.ErrorInvalidToken {
+				return CreateOID4VCICredential401JSONResponse(*oid4vciErr), nil
+			}
+			return CreateOID4VCICredential400JSONResponse(*oid4vciErr), nil
+		}
+		log.Error(ctx, "issuing oid4vci credential", "err", err, "did", issuerDID)
+		return CreateOID4VCICredential500JSONResponse{N500JSONResponse{Message: "There was an error issuing the credential"}}, nil
+	}
+	return CreateOID4VCICredential200JSONResponse(*credential), nil
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vp"
+)
+
+// CreateOID4VPSession creates a presentation session of a credential of the schema with the requested fields
+func (s *Server) CreateOID4VPSession(ctx context.Context, request CreateOID4VPSessionRequestObject) (CreateOID4VPSessionResponseObject, error) {
+	verifierDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing verifier did", "err", err, "did", request.Identifier)
+		return CreateOID4VPSession400JSONResponse{N400JSONResponse{Message: "invalid verifier did"}}, nil
+	}
+	if request.Body == nil {
+		return CreateOID4VPSession400JSONResponse{N400JSONResponse{Message: "empty body"}}, nil
+	}
+
+	req := &ports.CreateOID4VPSessionRequest{
+		SchemaID: request.Body.SchemaID,
+		Fields:   make([]ports.OID4VPField, len(request.Body.Fields)),
+	}
+	if request.Body.CredentialFormat != nil {
+		req.Format = domain.CredentialFormat(*request.Body.CredentialFormat)
+	}
+	if request.Body.Purpose != nil {
+		req.Purpose = *request.Body.Purpose
+	}
+	for i, field := range request.Body.Fields {
+		req.Fields[i] = ports.OID4VPField{Name: field.Name}
+		if field.Filter != nil {
+			req.Fields[i].Filter = *field.Filter
+		}
+		if field.Optional != nil {
+			req.Fields[i].Optional = *field.Optional
+		}
+	}
+
+	session, requestURI, err := s.oid4vpService.CreateSession(ctx, *verifierDID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrSchemaNotFound):
+			return CreateOID4VPSession404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		case errors.Is(err, services.ErrOID4VPInvalidFields), errors.Is(err, services.ErrCredentialFormatUnsupported):
+			return CreateOID4VPSession400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating oid4vp session", "err", err, "did", verifierDID)
+		return CreateOID4VPSession500JSONResponse{N500JSONResponse{Message: "There was an error creating the presentation session"}}, nil
+	}
+	return CreateOID4VPSession201JSONResponse{
+		Id:                     session.ID,
+		RequestUri:             requestURI,
+		PresentationDefinition: session.PresentationDefinition,
+	}, nil
+}
+
+// GetOID4VPSession returns the presentation session with the verification result
+func (s *Server) GetOID4VPSession(ctx context.Context, request GetOID4VPSessionRequestObject) (GetOID4VPSessionResponseObject, error) {
+	verifierDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing verifier did", "err", err, "did", request.Identifier)
+		return GetOID4VPSession400JSONResponse{N400JSONResponse{Message: "invalid verifier did"}}, nil
+	}
+	session, err := s.oid4vpService.GetSession(ctx, *verifierDID, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.OID4VPSessionNotFoundErr) {
+			return GetOID4VPSession404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting oid4vp session", "err", err, "id", request.Id)
+		return GetOID4VPSession500JSONResponse{N500JSONResponse{Message: "There was an error getting the presentation session"}}, nil
+	}
+	return GetOID4VPSession200JSONResponse(oid4vpSessionResponse(session)), nil
+}
+
+// GetOID4VPRequestObject returns the request object of the session to the wallet
+func (s *Server) GetOID4VPRequestObject(ctx context.Context, request GetOID4VPRequestObjectRequestObject) (GetOID4VPRequestObjectResponseObject, error) {
+	requestObject, err := s.oid4vpService.RequestObject(ctx, request.Id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.OID4VPSessionNotFoundErr):
+			return GetOID4VPRequestObject404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrOID4VPSessionNotPending):
+			return GetOID4VPRequestObject400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "getting oid4vp request object", "err", err, "id", request.Id)
+		return GetOID4VPRequestObject500JSONResponse{N500JSONResponse{Message: "There was an error creating the request object"}}, nil
+	}
+	return GetOID4VPRequestObject200ApplicationoauthAuthzReqJwtResponse{
+		Body:          strings.NewReader(requestObject),
+		ContentLength: int64(len(requestObject)),
+	}, nil
+}
+
+// CreateOID4VPResponse receives the vp_token posted by the wallet and verifies it
+func (s *Server) CreateOID4VPResponse(ctx context.Context, request CreateOID4VPResponseRequestObject) (CreateOID4VPResponseResponseObject, error) {
+	if request.Body == nil {
+		return CreateOID4VPResponse400JSONResponse(*oid4vp.NewError(oid4vp.ErrorInvalidRequest, "empty body")), nil
+	}
+	var submission oid4vp.PresentationSubmission
+	if err := json.Unmarshal([]byte(request.Body.PresentationSubmission), &submission); err != nil {
+		return CreateOID4VPResponse400JSONResponse(*oid4vp.NewError(oid4vp.ErrorInvalidRequest, "malformed presentation_submission")), nil
+	}
+
+	_, err := s.oid4vpService.Response(ctx, request.Id, request.Body.State, request.Body.VpToken, &submission)
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.OID4VPSessionNotFoundErr):
+			return CreateOID4VPResponse404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrOID4VPSessionNotPending), errors.Is(err, services.ErrOID4VPInvalidState):
+			return CreateOID4VPResponse400JSONResponse(*oid4vp.NewError(oid4vp.ErrorInvalidRequest, err.Error())), nil
+		case errors.Is(err, services.ErrOID4VPVerification):
+			return CreateOID4VPResponse400JSONResponse(*oid4vp.NewError(oid4vp.ErrorAccessDenied, err.Error())), nil
+		}
+		log.Error(ctx, "oid4vp response", "err", err, "id", request.Id)
+		return CreateOID4VPResponse500JSONResponse{N500JSONResponse{Message: "There was an error processing the presentation"}}, nil
+	}
+	return CreateOID4VPResponse200Response{}, nil
+}
+
+func oid4vpSessionResponse(session *domain.OID4VPSession) OID4VPSession {
+	resp := OID4VPSession{
+		Id:                     session.ID,
+		Status:                 OID4VPSessionStatus(session.Status),
+		CredentialFormat:       CredentialFormat(session.Format),
+		PresentationDefinition: session.PresentationDefinition,
+		Holder:                 session.Holder,
+		Credentials:            make([]OID4VPCredential, len(session.Credentials)),
+		Error:                  session.Error,
+		CreatedAt:              TimeUTC(session.CreatedAt),
+	}
+	for i, credential := range session.Credentials {
+		resp.Credentials[i] = OID4VPCredential{
+			InputDescriptorId: credential.InputDescriptorID,
+			Id:                credential.ID,
+			Issuer:            credential.Issuer,
+			Type:              credential.Type,
+			CredentialFormat:  CredentialFormat(credential.Format),
+			Claims:            credential.Claims,
+		}
+	}
+	if session.VerifiedAt != nil {
+		verifiedAt := TimeUTC(*session.VerifiedAt)
+		resp.VerifiedAt = &verifiedAt
+	}
+	return resp
+}
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// RegisterOnchainIssuer registers an identity contract owned by the identity as an onchain issuer
+func (s *Server) RegisterOnchainIssuer(ctx context.Context, request RegisterOnchainIssuerRequestObject) (RegisterOnchainIssuerResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing did", "err", err, "did", request.Identifier)
+		return RegisterOnchainIssuer400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+	if request.Body == nil || !ethCommon.IsHexAddress(request.Body.ContractAddress) {
+		return RegisterOnchainIssuer400JSONResponse{N400JSONResponse{Message: "invalid contract address"}}, nil
+	}
+
+	onchainIssuer, err := s.onchainIssuerService.Register(ctx, *did, ethCommon.HexToAddress(request.Body.ContractAddress))
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ErrIdentityNotFound):
+			return RegisterOnchainIssuer404JSONResponse{N404JSONResponse{"identity not found"}}, nil
+		case errors.Is(err, services.ErrOnchainIssuerController), errors.Is(err, services.ErrOnchainIssuerOwner):
+			return RegisterOnchainIssuer400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, repositories.ErrOnchainIssuerDuplicated):
+			return RegisterOnchainIssuer409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "registering onchain issuer", "err", err, "did", did)
+		return RegisterOnchainIssuer500JSONResponse{N500JSONResponse{"There was an error registering the onchain issuer"}}, nil
+	}
+	return RegisterOnchainIssuer201JSONResponse(onchainIssuerResponse(onchainIssuer)), nil
+}
+
+// GetOnchainIssuers returns the onchain issuers controlled by the identity
+func (s *Server) GetOnchainIssuers(ctx context.Context, request GetOnchainIssuersRequestObject) (GetOnchainIssuersResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing did", "err", err, "did", request.Identifier)
+		return GetOnchainIssuers400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+
+	onchainIssuers, err := s.onchainIssuerService.GetByController(ctx, *did)
+	if err != nil {
+		log.Error(ctx, "getting onchain issuers", "err", err, "did", did)
+		return GetOnchainIssuers500JSONResponse{N500JSONResponse{"There was an error getting the onchain issuers"}}, nil
+	}
+
+	resp := make(GetOnchainIssuers200JSONResponse, len(onchainIssuers))
+	for i := range onchainIssuers {
+		resp[i] = onchainIssuerResponse(&onchainIssuers[i])
+	}
+	return resp, nil
+}
+
+// CreateOnchainCredential adds a credential to the claims tree of the onchain issuer contract
+func (s *Server) CreateOnchainCredential(ctx context.Context, request CreateOnchainCredentialRequestObject) (CreateOnchainCredentialResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing did", "err", err, "did", request.Identifier)
+		return CreateOnchainCredential400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+	onchainIssuerDID, err := w3c.ParseDID(request.OnchainIssuer)
+	if err != nil {
+		log.Error(ctx, "parsing onchain issuer did", "err", err, "did", request.OnchainIssuer)
+		return CreateOnchainCredential400JSONResponse{N400JSONResponse{Message: "invalid onchain issuer did"}}, nil
+	}
+	if request.Body == nil {
+		return CreateOnchainCredential400JSONResponse{N400JSONResponse{Message: "empty body"}}, nil
+	}
+
+	req := &ports.CreateOnchainCredentialRequest{
+		ControllerDID:     *did,
+		OnchainIssuerDID:  *onchainIssuerDID,
+		Schema:            request.Body.CredentialSchema,
+		CredentialSubject: request.Body.CredentialSubject,
+		Type:              request.Body.Type,
+	}
+	if request.Body.Expiration != nil {
+		req.Expiration = common.ToPointer(time.Unix(*request.Body.Expiration, 0))
+	}
+	if request.Body.Version != nil {
+		req.Version = *request.Body.Version
+	}
+	if request.Body.SubjectPosition != nil {
+		req.SubjectPos = *request.Body.SubjectPosition
+	}
+	if request.Body.MerklizedRootPosition != nil {
+		req.MerklizedRootPosition = *request.Body.MerklizedRootPosition
+	}
+
+	_, credential, err := s.onchainIssuerService.CreateCredential(ctx, req)
+	if err != nil {
+		if errors.Is(err, repositories.OnchainIssuerNotFoundErr) {
+			return CreateOnchainCredential404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrCredentialQuotaExceeded) {
+			return CreateOnchainCredential403JSONResponse{N403JSONResponse{Message: err.Error()}}, nil
+		}
+		var validationErrors = []error{
+			services.ErrMalformedURL,
+			services.ErrWrongCredentialSubjectID,
+			services.ErrLoadingSchema,
+			services.ErrProcessSchema,
+			services.ErrJSONLdContext,
+			services.ErrParseClaim,
+			services.ErrInvalidCredentialSubject,
+		}
+		for _, validationErr := range validationErrors {
+			if errors.Is(err, validationErr) {
+				return CreateOnchainCredential400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+			}
+		}
+		log.Error(ctx, "creating onchain credential", "err", err, "onchainIssuer", request.OnchainIssuer)
+		return CreateOnchainCredential500JSONResponse{N500JSONResponse{"There was an error issuing the onchain credential"}}, nil
+	}
+
+	return CreateOnchainCredential202JSONResponse{
+		Id:     credential.ID,
+		TxID:   credential.TxID,
+		Status: OnchainCredentialStatus(credential.Status),
+	}, nil
+}
+
+func onchainIssuerResponse(onchainIssuer *domain.OnchainIssuer) OnchainIssuer {
+	return OnchainIssuer{
+		Id:              onchainIssuer.ID,
+		Identifier:      onchainIssuer.Identifier,
+		ContractAddress: onchainIssuer.ContractAddress,
+		ChainID:         onchainIssuer.ChainID,
+		Blockchain:      onchainIssuer.Blockchain,
+		Network:         onchainIssuer.Network,
+		CreatedAt:       TimeUTC(onchainIssuer.CreatedAt),
+	}
+}
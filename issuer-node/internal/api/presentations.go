@@ -0,0 +1,43 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/presentation"
+)
+
+// CreateCredentialPresentation builds a presentation of the credential that only discloses the requested fields
+func (s *Server) CreateCredentialPresentation(ctx context.Context, request CreateCredentialPresentationRequestObject) (CreateCredentialPresentationResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing did", "err", err, "did", request.Identifier)
+		return CreateCredentialPresentation400JSONResponse{N400JSONResponse{Message: "invalid did"}}, nil
+	}
+	claimID, err := uuid.Parse(request.Id)
+	if err != nil {
+		return CreateCredentialPresentation400JSONResponse{N400JSONResponse{Message: "invalid claim id"}}, nil
+	}
+	if request.Body == nil || len(request.Body.Fields) == 0 {
+		return CreateCredentialPresentation400JSONResponse{N400JSONResponse{Message: presentation.ErrNoFieldsToDisclose.Error()}}, nil
+	}
+
+	vp, err := s.presentationService.Create(ctx, *did, claimID, request.Body.Fields)
+	if err != nil {
+		switch {
+		case errors.Is(err, services.ErrCredentialNotFound):
+			return CreateCredentialPresentation404JSONResponse{N404JSONResponse{"credential not found"}}, nil
+		case errors.Is(err, services.ErrPresentationRevokedCredential),
+			errors.Is(err, presentation.ErrFieldNotFound):
+			return CreateCredentialPresentation400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "creating credential presentation", "err", err, "did", did, "id", claimID)
+		return CreateCredentialPresentation500JSONResponse{N500JSONResponse{"There was an error creating the presentation"}}, nil
+	}
+	return CreateCredentialPresentation200JSONResponse(*vp), nil
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// CreateProofRequest sends a proof request to the user of an existing connection
+func (s *Server) CreateProofRequest(ctx context.Context, request CreateProofRequestRequestObject) (CreateProofRequestResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CreateProofRequest400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	if request.Body == nil {
+		return CreateProofRequest400JSONResponse{N400JSONResponse{Message: "empty body"}}, nil
+	}
+
+	var reason string
+	if request.Body.Reason != nil {
+		reason = *request.Body.Reason
+	}
+	proofRequest, err := s.proofRequestService.Create(ctx, *issuerDID, request.Id, reason, request.Body.Scope)
+	if err != nil {
+		if errors.Is(err, services.ErrProofRequestInvalidScope) {
+			return CreateProofRequest400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return CreateProofRequest404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "create proof request", "err", err, "req", request)
+		return CreateProofRequest500JSONResponse{N500JSONResponse{"There was an error sending the proof request"}}, nil
+	}
+
+	resp, err := proofRequestResponse(proofRequest)
+	if err != nil {
+		log.Error(ctx, "create proof request parsing response", "err", err)
+		return CreateProofRequest500JSONResponse{N500JSONResponse{"There was an error parsing the proof request"}}, nil
+	}
+	return CreateProofRequest201JSONResponse(resp), nil
+}
+
+// GetProofRequests returns the proof requests sent to the user of the connection
+func (s *Server) GetProofRequests(ctx context.Context, request GetProofRequestsRequestObject) (GetProofRequestsResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetProofRequests400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+
+	proofRequests, err := s.proofRequestService.GetByConnection(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrConnectionDoesNotExist) {
+			return GetProofRequests404JSONResponse{N404JSONResponse{"The given connection does not exist"}}, nil
+		}
+		log.Error(ctx, "get proof requests", "err", err, "req", request)
+		return GetProofRequests500JSONResponse{N500JSONResponse{"There was an error retrieving the proof requests"}}, nil
+	}
+
+	resp := make(GetProofRequests200JSONResponse, len(proofRequests))
+	for i := range proofRequests {
+		if resp[i], err = proofRequestResponse(&proofRequests[i]); err != nil {
+			log.Error(ctx, "get proof requests parsing response", "err", err)
+			return GetProofRequests500JSONResponse{N500JSONResponse{"There was an error parsing the proof requests"}}, nil
+		}
+	}
+	return resp, nil
+}
+
+// ProofRequestCallback receives and verifies the user response to a proof request
+func (s *Server) ProofRequestCallback(ctx context.Context, request ProofRequestCallbackRequestObject) (ProofRequestCallbackResponseObject, error) {
+	if request.Body == nil || *request.Body == "" {
+		log.Debug(ctx, "empty request body proof request callback")
+		return ProofRequestCallback400JSONResponse{N400JSONResponse{"Cannot proceed with empty body"}}, nil
+	}
+
+	_, err := s.proofRequestService.Callback(ctx, request.Params.Id, *request.Body)
+	if err != nil {
+		switch {
+		case errors.Is(err, repositories.ProofRequestNotFoundErr):
+			return ProofRequestCallback404JSONResponse{N404JSONResponse{err.Error()}}, nil
+		case errors.Is(err, services.ErrProofRequestNotPending), errors.Is(err, services.ErrProofRequestVerification):
+			return ProofRequestCallback400JSONResponse{N400JSONResponse{err.Error()}}, nil
+		}
+		log.Error(ctx, "proof request callback", "err", err, "id", request.Params.Id)
+		return ProofRequestCallback500JSONResponse{N500JSONResponse{"There was an error processing the proof request response"}}, nil
+	}
+	return ProofRequestCallback200Response{}, nil
+}
+
+func proofRequestResponse(proofRequest *domain.ProofRequest) (ProofRequest, error) {
+	var authRequest protocol.AuthorizationRequestMessage
+	if err := json.Unmarshal(proofRequest.Request, &authRequest); err != nil {
+		return ProofRequest{}, err
+	}
+	resp := ProofRequest{
+		Id:           proofRequest.ID,
+		ConnectionID: proofRequest.ConnectionID,
+		ThreadID:     proofRequest.ThreadID,
+		Reason:       proofRequest.Reason,
+		Status:       ProofRequestStatus(proofRequest.Status),
+		Scope:        authRequest.Body.Scope,
+		Error:        proofRequest.Error,
+		CreatedAt:    TimeUTC(proofRequest.CreatedAt),
+		ModifiedAt:   TimeUTC(proofRequest.ModifiedAt),
+	}
+	if proofRequest.Response != nil {
+		var proofs []protocol.ZeroKnowledgeProofResponse
+		if err := json.Unmarshal(proofRequest.Response, &proofs); err != nil {
+			return ProofRequest{}, err
+		}
+		resp.Proofs = &proofs
+	}
+	if proofRequest.VerifiedAt != nil {
+		verifiedAt := TimeUTC(*proofRequest.VerifiedAt)
+		resp.VerifiedAt = &verifiedAt
+	}
+	return resp, nil
+}
@@ -44,16 +44,16 @@ func TestServer_GetQrFromStore(t *testing.T) {
 	tomorrow := time.Now().Add(24 * time.Hour)
 	yesterday := time.Now().Add(-24 * time.Hour)
 
-	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &tomorrow, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, domain.CredentialFormatIden3, "")
 	require.NoError(t, err)
 
 	_, err = server.Services.links.CreateQRCode(ctx, *did, link.ID, "https://privado.id")
 	require.NoError(t, err)
 
-	linkExpired, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	linkExpired, err := server.Services.links.Save(ctx, *did, common.ToPointer(10), &yesterday, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, domain.CredentialFormatIden3, "")
 	require.NoError(t, err)
 
-	linkMaxIssuance, err := server.Services.links.Save(ctx, *did, common.ToPointer(0), &yesterday, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	linkMaxIssuance, err := server.Services.links.Save(ctx, *did, common.ToPointer(0), &yesterday, importedSchema.ID, common.ToPointer(tomorrow), true, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, domain.CredentialFormatIden3, "")
 	require.NoError(t, err)
 
 	handler := getHandler(ctx, server)
@@ -94,6 +94,8 @@ func getLinkResponse(link *domain.Link) Link {
 		CredentialExpiration: credentialExpiration,
 		RefreshService:       refreshService,
 		DisplayMethod:        displayMethod,
+		CredentialFormat:     common.ToPointer(CredentialFormat(link.CredentialFormat)),
+		CredentialStatusType: (*string)(link.CredentialStatusType),
 		DeepLink:             link.DeepLink,
 		UniversalLink:        link.UniversalLink,
 	}
@@ -150,6 +152,141 @@ func schemaResponse(s *domain.Schema) Schema {
 		Title:           s.Title,
 		Description:     s.Description,
 		DisplayMethodID: s.DisplayMethodID,
+		Snapshots:       schemaSnapshotsResponse(s.Snapshots),
+	}
+}
+
+func schemaSnapshotsResponse(snapshots []domain.SchemaSnapshot) *[]SchemaSnapshot {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	res := make([]SchemaSnapshot, len(snapshots))
+	for i, snapshot := range snapshots {
+		res[i] = SchemaSnapshot{
+			Url:          snapshot.URL,
+			Digest:       snapshot.Digest,
+			RemoteDigest: snapshot.RemoteDigest,
+			Drift:        snapshot.Drifted(),
+			CreatedAt:    TimeUTC(snapshot.CreatedAt),
+		}
+		if snapshot.CheckedAt != nil {
+			res[i].CheckedAt = common.ToPointer(TimeUTC(*snapshot.CheckedAt))
+		}
+	}
+	return &res
+}
+
+func schemaFamilyResponse(family *domain.SchemaFamily) SchemaFamily {
+	versions := make([]SchemaFamilyVersion, len(family.Versions))
+	for i, version := range family.Versions {
+		mapping := make([]SchemaMappingRule, len(version.Mapping))
+		for j, rule := range version.Mapping {
+			mapping[j] = SchemaMappingRule{Op: SchemaMappingRuleOp(rule.Op), Field: rule.Field, Value: rule.Value}
+			if rule.To != "" {
+				mapping[j].To = common.ToPointer(rule.To)
+			}
+			if rule.Transform != "" {
+				mapping[j].Transform = common.ToPointer(SchemaMappingRuleTransform(rule.Transform))
+			}
+			if len(rule.Values) > 0 {
+				mapping[j].Values = common.ToPointer(rule.Values)
+			}
+		}
+		versions[i] = SchemaFamilyVersion{
+			SchemaId:  version.SchemaID,
+			Position:  version.Position,
+			Mapping:   mapping,
+			CreatedAt: TimeUTC(version.CreatedAt),
+		}
+	}
+	return SchemaFamily{
+		Id:        family.ID,
+		Name:      family.Name,
+		Versions:  versions,
+		CreatedAt: TimeUTC(family.CreatedAt),
+	}
+}
+
+func schemaMigrationResponse(migration *domain.SchemaMigration) SchemaMigration {
+	failures := make([]SchemaMigrationFailure, len(migration.Failures))
+	for i, failure := range migration.Failures {
+		failures[i] = SchemaMigrationFailure{CredentialId: failure.CredentialID, Subject: failure.Subject, Error: failure.Error}
+	}
+	return SchemaMigration{
+		Id:           migration.ID,
+		FamilyId:     migration.FamilyID,
+		FromSchemaId: migration.FromSchemaID,
+		ToSchemaId:   migration.ToSchemaID,
+		DryRun:       migration.DryRun,
+		Status:       SchemaMigrationStatus(migration.Status),
+		Total:        migration.Total,
+		Migrated:     migration.Migrated,
+		Failures:     failures,
+		Error:        migration.Error,
+		CreatedAt:    TimeUTC(migration.CreatedAt),
+		ModifiedAt:   TimeUTC(migration.ModifiedAt),
+	}
+}
+
+func apiKeyResponse(key *domain.APIKey) APIKey {
+	res := APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Role:      APIKeyRole(key.Role),
+		TenantId:  key.TenantID,
+		Issuers:   key.IssuerDIDs,
+		CreatedAt: TimeUTC(key.CreatedAt),
+	}
+	if res.Issuers == nil {
+		res.Issuers = []string{}
+	}
+	if key.ExpiresAt != nil {
+		res.ExpiresAt = common.ToPointer(TimeUTC(*key.ExpiresAt))
+	}
+	if key.RevokedAt != nil {
+		res.RevokedAt = common.ToPointer(TimeUTC(*key.RevokedAt))
+	}
+	return res
+}
+
+func tenantResponse(tenant *domain.Tenant) Tenant {
+	return Tenant{
+		Id:                     tenant.ID,
+		Name:                   tenant.Name,
+		MaxIdentities:          tenant.MaxIdentities,
+		MaxCredentialsPerMonth: tenant.MaxCredentialsPerMonth,
+		IssuerName:             tenant.IssuerName,
+		IssuerLogo:             tenant.IssuerLogo,
+		CreatedAt:              TimeUTC(tenant.CreatedAt),
+	}
+}
+
+func auditEntryResponse(entry *domain.AuditEntry) AuditEntry {
+	diff := make(map[string]AuditFieldChange, len(entry.Diff))
+	for name, change := range entry.Diff {
+		var field AuditFieldChange
+		if change.Before != nil {
+			field.Before = common.ToPointer(change.Before)
+		}
+		if change.After != nil {
+			field.After = common.ToPointer(change.After)
+		}
+		diff[name] = field
+	}
+	return AuditEntry{
+		IssuerDID:   entry.IssuerDID,
+		Seq:         entry.Seq,
+		Actor:       entry.Actor,
+		ActorMethod: entry.ActorMethod,
+		Entity:      AuditEntity(entry.Entity),
+		Operation:   AuditOperation(entry.Operation),
+		EntityId:    entry.EntityID,
+		Diff:        diff,
+		RequestId:   entry.RequestID,
+		SourceIp:    entry.SourceIP,
+		CreatedAt:   TimeUTC(entry.CreatedAt),
+		PrevHash:    entry.PrevHash,
+		Hash:        entry.Hash,
 	}
 }
 
@@ -258,6 +395,32 @@ func stateTransactionsPaginatedResponse(idState []domain.IdentityState, pagFilte
 	return statesPag
 }
 
+func transactionCostsResponse(filter ports.TransactionCostFilter, totals []domain.TransactionCostTotal) TransactionCostsResponse {
+	resp := TransactionCostsResponse{
+		From:   filter.From,
+		To:     filter.To,
+		Totals: make([]TransactionCostTotal, 0, len(totals)),
+	}
+	for _, total := range totals {
+		gasUsed, fee := "0", "0"
+		if total.GasUsed != nil {
+			gasUsed = total.GasUsed.String()
+		}
+		if total.Fee != nil {
+			fee = total.Fee.String()
+		}
+		resp.Totals = append(resp.Totals, TransactionCostTotal{
+			Network:      total.Network,
+			Type:         TransactionCostTotalType(total.Type),
+			Transactions: total.Transactions,
+			Replacements: total.Replacements,
+			GasUsed:      gasUsed,
+			Fee:          fee,
+		})
+	}
+	return resp
+}
+
 func toStateTransaction(state domain.IdentityState) StateTransaction {
 	var stateTran, txID string
 
@@ -0,0 +1,146 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// GetSchemaFamilies returns the schema families of the identity
+func (s *Server) GetSchemaFamilies(ctx context.Context, request GetSchemaFamiliesRequestObject) (GetSchemaFamiliesResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetSchemaFamilies400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	families, err := s.schemaFamilyService.GetAll(ctx, *issuerDID)
+	if err != nil {
+		log.Error(ctx, "loading schema families", "err", err)
+		return GetSchemaFamilies500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	res := make(GetSchemaFamilies200JSONResponse, len(families))
+	for i := range families {
+		res[i] = schemaFamilyResponse(&families[i])
+	}
+	return res, nil
+}
+
+// CreateSchemaFamily creates a schema family without versions
+func (s *Server) CreateSchemaFamily(ctx context.Context, request CreateSchemaFamilyRequestObject) (CreateSchemaFamilyResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CreateSchemaFamily400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	name := strings.TrimSpace(request.Body.Name)
+	if name == "" {
+		return CreateSchemaFamily400JSONResponse{N400JSONResponse{Message: "name is required"}}, nil
+	}
+	family, err := s.schemaFamilyService.Create(ctx, *issuerDID, name)
+	if err != nil {
+		if errors.Is(err, repositories.SchemaFamilyDuplicatedErr) {
+			return CreateSchemaFamily409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateSchemaFamily500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateSchemaFamily201JSONResponse(schemaFamilyResponse(family)), nil
+}
+
+// GetSchemaFamily returns a schema family with its versions
+func (s *Server) GetSchemaFamily(ctx context.Context, request GetSchemaFamilyRequestObject) (GetSchemaFamilyResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetSchemaFamily400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	family, err := s.schemaFamilyService.GetByID(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaFamilyNotFound) {
+			return GetSchemaFamily404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "loading schema family", "err", err, "id", request.Id)
+		return GetSchemaFamily500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetSchemaFamily200JSONResponse(schemaFamilyResponse(family)), nil
+}
+
+// AddSchemaFamilyVersion adds an imported schema as the newest version of a family
+func (s *Server) AddSchemaFamilyVersion(ctx context.Context, request AddSchemaFamilyVersionRequestObject) (AddSchemaFamilyVersionResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return AddSchemaFamilyVersion400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	rules := common.Deref(request.Body.Mapping)
+	mapping := make(domain.SchemaMapping, len(rules))
+	for i, rule := range rules {
+		mapping[i] = domain.SchemaMappingRule{
+			Op:        domain.SchemaMappingOp(rule.Op),
+			Field:     rule.Field,
+			To:        common.Deref(rule.To),
+			Value:     rule.Value,
+			Transform: domain.SchemaMappingTransformFunc(common.Deref(rule.Transform)),
+			Values:    common.Deref(rule.Values),
+		}
+	}
+
+	family, err := s.schemaFamilyService.AddVersion(ctx, *issuerDID, request.Id, request.Body.SchemaId, mapping)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidSchemaMapping):
+			return AddSchemaFamilyVersion400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrSchemaFamilyNotFound), errors.Is(err, services.ErrSchemaNotFound):
+			return AddSchemaFamilyVersion404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, repositories.SchemaFamilyVersionDuplicatedErr):
+			return AddSchemaFamilyVersion409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		return AddSchemaFamilyVersion500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return AddSchemaFamilyVersion201JSONResponse(schemaFamilyResponse(family)), nil
+}
+
+// CreateSchemaMigration schedules the migration of the credentials of a version of a family to a newer one
+func (s *Server) CreateSchemaMigration(ctx context.Context, request CreateSchemaMigrationRequestObject) (CreateSchemaMigrationResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CreateSchemaMigration400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	migration, err := s.schemaFamilyService.CreateMigration(ctx, *issuerDID, request.Id, request.Body.FromSchemaId, request.Body.ToSchemaId, common.Deref(request.Body.DryRun))
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidSchemaMapping):
+			return CreateSchemaMigration400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrSchemaFamilyNotFound):
+			return CreateSchemaMigration404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateSchemaMigration500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateSchemaMigration202JSONResponse(schemaMigrationResponse(migration)), nil
+}
+
+// GetSchemaMigration returns a schema migration with its report
+func (s *Server) GetSchemaMigration(ctx context.Context, request GetSchemaMigrationRequestObject) (GetSchemaMigrationResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return GetSchemaMigration400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	migration, err := s.schemaFamilyService.GetMigration(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaMigrationNotFound) {
+			return GetSchemaMigration404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "loading schema migration", "err", err, "id", request.Id)
+		return GetSchemaMigration500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetSchemaMigration200JSONResponse(schemaMigrationResponse(migration)), nil
+}
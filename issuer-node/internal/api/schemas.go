@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -9,9 +10,11 @@ import (
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 )
@@ -62,6 +65,25 @@ func (s *Server) GetSchema(ctx context.Context, request GetSchemaRequestObject)
 	return GetSchema200JSONResponse(schemaResponse(schema)), nil
 }
 
+// CheckSchemaDrift checks whether the remote documents of the schema changed since they were imported
+func (s *Server) CheckSchemaDrift(ctx context.Context, request CheckSchemaDriftRequestObject) (CheckSchemaDriftResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return CheckSchemaDrift400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	schema, err := s.schemaService.CheckDrift(ctx, *issuerDID, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrSchemaNotFound) {
+			log.Error(ctx, "schema not found", "id", request.Id)
+			return CheckSchemaDrift404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		log.Error(ctx, "checking schema drift", "err", err, "id", request.Id)
+		return CheckSchemaDrift500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CheckSchemaDrift200JSONResponse(schemaResponse(schema)), nil
+}
+
 // GetSchemas returns the list of schemas that match the request.Params.Query filter. If param query is nil it will return all
 func (s *Server) GetSchemas(ctx context.Context, request GetSchemasRequestObject) (GetSchemasResponseObject, error) {
 	issuerDID, err := w3c.ParseDID(request.Identifier)
@@ -107,6 +129,85 @@ func (s *Server) UpdateSchema(ctx context.Context, request UpdateSchemaRequestOb
 	}, nil
 }
 
+// BuildSchema builds the JSON schema and JSON-LD context of a new credential type
+func (s *Server) BuildSchema(ctx context.Context, request BuildSchemaRequestObject) (BuildSchemaResponseObject, error) {
+	issuerDID, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		log.Error(ctx, "parsing issuer did", "err", err, "did", request.Identifier)
+		return BuildSchema400JSONResponse{N400JSONResponse{Message: "invalid issuer did"}}, nil
+	}
+	req := request.Body
+	def := jsonschema.Definition{
+		Type:        req.SchemaType,
+		Version:     req.Version,
+		Title:       common.Deref(req.Title),
+		Description: common.Deref(req.Description),
+		Attributes:  make([]jsonschema.DefinitionAttribute, len(req.Attributes)),
+	}
+	for i, attr := range req.Attributes {
+		def.Attributes[i] = jsonschema.DefinitionAttribute{
+			Name:        attr.Name,
+			Type:        jsonschema.AttributeType(attr.Type),
+			Title:       common.Deref(attr.Title),
+			Description: common.Deref(attr.Description),
+			Enum:        common.Deref(attr.Enum),
+			Required:    common.Deref(attr.Required),
+		}
+	}
+
+	doc, err := s.schemaBuilder.Build(ctx, *issuerDID, def)
+	if err != nil {
+		if errors.Is(err, jsonschema.ErrInvalidDefinition) {
+			return BuildSchema400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "building schema", "err", err, "type", req.SchemaType)
+		return BuildSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return BuildSchema201JSONResponse{
+		Id:         doc.ID,
+		Url:        doc.URL,
+		ContextUrl: doc.ContextURL,
+		SchemaType: doc.Type,
+		Version:    doc.Version,
+	}, nil
+}
+
+// GetBuiltSchema returns the JSON schema built by the node
+func (s *Server) GetBuiltSchema(ctx context.Context, request GetBuiltSchemaRequestObject) (GetBuiltSchemaResponseObject, error) {
+	doc, err := s.schemaBuilder.GetByID(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.SchemaDocumentNotFoundErr) {
+			return GetBuiltSchema404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		log.Error(ctx, "getting schema document", "err", err, "id", request.Id)
+		return GetBuiltSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	var content GetBuiltSchema200ApplicationSchemaPlusJSONResponse
+	if err := json.Unmarshal(doc.JSONSchema, &content); err != nil {
+		log.Error(ctx, "decoding schema document", "err", err, "id", request.Id)
+		return GetBuiltSchema500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return content, nil
+}
+
+// GetBuiltSchemaContext returns the JSON-LD context of a JSON schema built by the node
+func (s *Server) GetBuiltSchemaContext(ctx context.Context, request GetBuiltSchemaContextRequestObject) (GetBuiltSchemaContextResponseObject, error) {
+	doc, err := s.schemaBuilder.GetByID(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, repositories.SchemaDocumentNotFoundErr) {
+			return GetBuiltSchemaContext404JSONResponse{N404JSONResponse{Message: "schema not found"}}, nil
+		}
+		log.Error(ctx, "getting schema document", "err", err, "id", request.Id)
+		return GetBuiltSchemaContext500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	var content GetBuiltSchemaContext200ApplicationLdPlusJSONResponse
+	if err := json.Unmarshal(doc.JSONLdContext, &content); err != nil {
+		log.Error(ctx, "decoding schema document context", "err", err, "id", request.Id)
+		return GetBuiltSchemaContext500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return content, nil
+}
+
 func guardImportSchemaReq(req *ImportSchemaJSONRequestBody) error {
 	if req == nil {
 		return errors.New("empty body")
@@ -499,6 +499,79 @@ func TestServer_ImportSchemaIPFS(t *testing.T) {
 	}
 }
 
+func TestServer_BuildSchema(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, nil)
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: "iden3", Blockchain: "privado", Network: "main", KeyType: "BJJ"})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	handler := getHandler(ctx, server)
+
+	build := func(t *testing.T, request *BuildSchemaJSONRequestBody) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("POST", fmt.Sprintf("/v2/identities/%s/schema-builder", did), tests.JSONBody(t, request))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	t.Run("should build and serve the schema and its context", func(t *testing.T) {
+		rr := build(t, &BuildSchemaRequest{
+			SchemaType: "Recruiter",
+			Version:    "1.0.0",
+			Title:      common.ToPointer("Recruiter"),
+			Attributes: []SchemaAttribute{
+				{Name: "company", Type: String, Required: common.ToPointer(true)},
+				{Name: "seniority", Type: String, Enum: &[]interface{}{"junior", "senior"}},
+				{Name: "since", Type: Date},
+			},
+		})
+		require.Equal(t, http.StatusCreated, rr.Code)
+		var response BuildSchema201JSONResponse
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+		assert.Equal(t, fmt.Sprintf("%s/v2/schemas/%s/schema.json", cfg.ServerUrl, response.Id), response.Url)
+		assert.Equal(t, "Recruiter", response.SchemaType)
+
+		rr = httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/v2/schemas/%s/schema.json", response.Id), nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		var schema map[string]interface{}
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &schema))
+		assert.Equal(t, map[string]interface{}{"jsonLdContext": response.ContextUrl, "jsonSchema": response.Url}, schema["$metadata"].(map[string]interface{})["uris"])
+
+		rr = httptest.NewRecorder()
+		req, err = http.NewRequest("GET", fmt.Sprintf("/v2/schemas/%s/context.jsonld", response.Id), nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "application/ld+json", rr.Header().Get("Content-Type"))
+	})
+
+	t.Run("should reject invalid attributes", func(t *testing.T) {
+		rr := build(t, &BuildSchemaRequest{
+			SchemaType: "Recruiter",
+			Version:    "1.0.0",
+			Attributes: []SchemaAttribute{{Name: "company", Type: Integer, Enum: &[]interface{}{"acme"}}},
+		})
+		require.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("should not find unknown schemas", func(t *testing.T) {
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest("GET", fmt.Sprintf("/v2/schemas/%s/schema.json", uuid.New()), nil)
+		require.NoError(t, err)
+		handler.ServeHTTP(rr, req)
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
 func TestServer_UpdateSchema(t *testing.T) {
 	ctx := context.Background()
 	server := newTestServer(t, nil)
@@ -641,3 +714,96 @@ func TestServer_UpdateSchema(t *testing.T) {
 		})
 	}
 }
+
+func TestServer_SchemaFamilies(t *testing.T) {
+	ctx := context.Background()
+
+	server := newTestServer(t, nil)
+	iden, err := server.Services.identity.Create(ctx, "http://issuer-node", &ports.DIDCreationOptions{Method: "iden3", Blockchain: "privado", Network: "main", KeyType: "BJJ"})
+	require.NoError(t, err)
+	did, err := w3c.ParseDID(iden.Identifier)
+	require.NoError(t, err)
+
+	fixture := repositories.NewFixture(storage)
+	schemas := make([]*domain.Schema, 2)
+	for i := range schemas {
+		schemas[i] = &domain.Schema{
+			ID:        uuid.New(),
+			IssuerDID: *did,
+			URL:       fmt.Sprintf("https://domain.org/recruiter/%d.json", i+1),
+			Type:      "Recruiter",
+			Version:   fmt.Sprintf("%d.0.0", i+1),
+			Words:     domain.SchemaWordsFromString("company, seniority"),
+			CreatedAt: time.Now(),
+		}
+		schemas[i].Hash = common.CreateSchemaHash([]byte(schemas[i].URL + "#" + schemas[i].Type))
+		fixture.CreateSchema(t, ctx, schemas[i])
+	}
+
+	handler := getHandler(ctx, server)
+	do := func(t *testing.T, method string, path string, body any) *httptest.ResponseRecorder {
+		t.Helper()
+		rr := httptest.NewRecorder()
+		req, err := http.NewRequest(method, fmt.Sprintf("/v2/identities/%s/%s", did, path), tests.JSONBody(t, body))
+		require.NoError(t, err)
+		req.SetBasicAuth(authOk())
+		handler.ServeHTTP(rr, req)
+		return rr
+	}
+
+	rr := do(t, "POST", "schema-families", CreateSchemaFamilyJSONRequestBody{Name: "Recruiter"})
+	require.Equal(t, http.StatusCreated, rr.Code)
+	var family CreateSchemaFamily201JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &family))
+	assert.Equal(t, "Recruiter", family.Name)
+	assert.Empty(t, family.Versions)
+
+	rr = do(t, "POST", "schema-families", CreateSchemaFamilyJSONRequestBody{Name: "Recruiter"})
+	assert.Equal(t, http.StatusConflict, rr.Code)
+
+	versions := fmt.Sprintf("schema-families/%s/versions", family.Id)
+	rr = do(t, "POST", versions, AddSchemaFamilyVersionJSONRequestBody{SchemaId: schemas[0].ID})
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	t.Run("should reject invalid versions", func(t *testing.T) {
+		rr := do(t, "POST", versions, AddSchemaFamilyVersionJSONRequestBody{
+			SchemaId: schemas[1].ID,
+			Mapping:  &[]SchemaMappingRule{{Op: Rename, Field: "company"}},
+		})
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+		rr = do(t, "POST", versions, AddSchemaFamilyVersionJSONRequestBody{SchemaId: uuid.New()})
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+		rr = do(t, "POST", fmt.Sprintf("schema-families/%s/versions", uuid.New()), AddSchemaFamilyVersionJSONRequestBody{SchemaId: schemas[1].ID})
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	rr = do(t, "POST", versions, AddSchemaFamilyVersionJSONRequestBody{
+		SchemaId: schemas[1].ID,
+		Mapping:  &[]SchemaMappingRule{{Op: Rename, Field: "company", To: common.ToPointer("employer")}},
+	})
+	require.Equal(t, http.StatusCreated, rr.Code)
+
+	rr = do(t, "GET", fmt.Sprintf("schema-families/%s", family.Id), nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	var saved GetSchemaFamily200JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &saved))
+	require.Len(t, saved.Versions, 2)
+	assert.Equal(t, schemas[1].ID, saved.Versions[1].SchemaId)
+	assert.Len(t, saved.Versions[1].Mapping, 1)
+
+	migrations := fmt.Sprintf("schema-families/%s/migrations", family.Id)
+	rr = do(t, "POST", migrations, CreateSchemaMigrationJSONRequestBody{FromSchemaId: schemas[1].ID, ToSchemaId: schemas[0].ID})
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+
+	rr = do(t, "POST", migrations, CreateSchemaMigrationJSONRequestBody{FromSchemaId: schemas[0].ID, ToSchemaId: schemas[1].ID, DryRun: common.ToPointer(true)})
+	require.Equal(t, http.StatusAccepted, rr.Code)
+	var migration CreateSchemaMigration202JSONResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &migration))
+	assert.True(t, migration.DryRun)
+	assert.Equal(t, SchemaMigrationStatusPending, migration.Status)
+
+	rr = do(t, "GET", fmt.Sprintf("schema-migrations/%s", migration.Id), nil)
+	require.Equal(t, http.StatusOK, rr.Code)
+	rr = do(t, "GET", fmt.Sprintf("schema-migrations/%s", uuid.New()), nil)
+	assert.Equal(t, http.StatusNotFound, rr.Code)
+}
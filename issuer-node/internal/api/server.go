@@ -34,10 +34,27 @@ type Server struct {
 	keyService           ports.KeyService
 	discoveryService     ports.DiscoveryService
 	verificationService  ports.VerificationService
+	transactionHistory   ports.TransactionHistoryService
+	networkService       ports.NetworkService
+	agentRouter          ports.AgentRouter
+	agentResponsePacker  ports.AgentResponsePacker
+	messageService       ports.MessageService
+	proofRequestService  ports.ProofRequestService
+	onchainIssuerService ports.OnchainIssuerService
+	presentationService  ports.PresentationService
+	credentialFormats    ports.CredentialFormatService
+	oid4vciService       ports.OID4VCIService
+	oid4vpService        ports.OID4VPService
+	statusListService    ports.StatusListService
+	schemaBuilder        ports.SchemaBuilderService
+	schemaFamilyService  ports.SchemaFamilyService
+	apiKeyService        ports.APIKeyService
+	auditService         ports.AuditService
+	tenantService        ports.TenantService
 }
 
 // NewServer is a Server constructor
-func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService, displayMethodService ports.DisplayMethodService, keyService ports.KeyService, paymentService ports.PaymentService, discoveryService ports.DiscoveryService, verificationService ports.VerificationService) *Server {
+func NewServer(cfg *config.Configuration, identityService ports.IdentityService, accountService ports.AccountService, connectionsService ports.ConnectionService, claimsService ports.ClaimService, qrService ports.QrStoreService, publisherGateway ports.Publisher, packageManager *iden3comm.PackageManager, networkResolver network.Resolver, health *health.Status, schemaService ports.SchemaService, linkService ports.LinkService, displayMethodService ports.DisplayMethodService, keyService ports.KeyService, paymentService ports.PaymentService, discoveryService ports.DiscoveryService, verificationService ports.VerificationService, transactionHistoryService ports.TransactionHistoryService, networkService ports.NetworkService, agentRouter ports.AgentRouter, agentResponsePacker ports.AgentResponsePacker, messageService ports.MessageService, proofRequestService ports.ProofRequestService, onchainIssuerService ports.OnchainIssuerService, presentationService ports.PresentationService, credentialFormatService ports.CredentialFormatService, oid4vciService ports.OID4VCIService, oid4vpService ports.OID4VPService, statusListService ports.StatusListService, schemaBuilder ports.SchemaBuilderService, schemaFamilyService ports.SchemaFamilyService, apiKeyService ports.APIKeyService, auditService ports.AuditService, tenantService ports.TenantService) *Server {
 	return &Server{
 		cfg:                  cfg,
 		accountService:       accountService,
@@ -56,6 +73,23 @@ func NewServer(cfg *config.Configuration, identityService ports.IdentityService,
 		discoveryService:     discoveryService,
 		paymentService:       paymentService,
 		verificationService:  verificationService,
+		transactionHistory:   transactionHistoryService,
+		networkService:       networkService,
+		agentRouter:          agentRouter,
+		agentResponsePacker:  agentResponsePacker,
+		messageService:       messageService,
+		proofRequestService:  proofRequestService,
+		onchainIssuerService: onchainIssuerService,
+		presentationService:  presentationService,
+		credentialFormats:    credentialFormatService,
+		oid4vciService:       oid4vciService,
+		oid4vpService:        oid4vpService,
+		statusListService:    statusListService,
+		schemaBuilder:        schemaBuilder,
+		schemaFamilyService:  schemaFamilyService,
+		apiKeyService:        apiKeyService,
+		auditService:         auditService,
+		tenantService:        tenantService,
 	}
 }
 
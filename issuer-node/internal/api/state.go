@@ -8,6 +8,7 @@ import (
 
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 	"github.com/polygonid/sh-id-platform/internal/core/services"
 	"github.com/polygonid/sh-id-platform/internal/gateways"
@@ -22,11 +23,20 @@ func (s *Server) PublishIdentityState(ctx context.Context, request PublishIdenti
 		return PublishIdentityState400JSONResponse{N400JSONResponse{"invalid did"}}, nil
 	}
 
-	publishedState, err := s.publisherGateway.PublishState(ctx, did)
+	var publishedState *domain.PublishedState
+	scheduler, canDefer := s.publisherGateway.(ports.PublishingScheduler)
+	if canDefer && request.Params.Urgent != nil && !*request.Params.Urgent {
+		publishedState, err = scheduler.SchedulePublishState(ctx, did)
+	} else {
+		publishedState, err = s.publisherGateway.PublishState(ctx, did)
+	}
 	if err != nil {
-		if errors.Is(err, gateways.ErrNoStatesToProcess) || errors.Is(err, gateways.ErrStateIsBeingProcessed) {
+		if errors.Is(err, gateways.ErrNoStatesToProcess) || errors.Is(err, gateways.ErrStateIsBeingProcessed) || errors.Is(err, gateways.ErrStateTransitionDeferred) {
 			return PublishIdentityState200JSONResponse{Message: err.Error()}, nil
 		}
+		if errors.Is(err, gateways.ErrRelayerUnsupportedIdentity) {
+			return PublishIdentityState400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
 
 		var customErr *services.PublishingStateError
 		if errors.As(err, &customErr) {
@@ -55,7 +65,7 @@ func (s *Server) RetryPublishState(ctx context.Context, request RetryPublishStat
 	publishedState, err := s.publisherGateway.RetryPublishState(ctx, did)
 	if err != nil {
 		log.Error(ctx, "error retrying the publishing the state", "err", err)
-		if errors.Is(err, gateways.ErrStateIsBeingProcessed) || errors.Is(err, gateways.ErrNoFailedStatesToProcess) {
+		if errors.Is(err, gateways.ErrStateIsBeingProcessed) || errors.Is(err, gateways.ErrNoFailedStatesToProcess) || errors.Is(err, gateways.ErrRelayerUnsupportedIdentity) {
 			return RetryPublishState400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
 		}
 		return RetryPublishState500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
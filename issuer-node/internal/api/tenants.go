@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/auth"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GetTenants returns the tenants of the node
+func (s *Server) GetTenants(ctx context.Context, _ GetTenantsRequestObject) (GetTenantsResponseObject, error) {
+	tenants, err := s.tenantService.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "loading tenants", "err", err)
+		return GetTenants500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	res := make(GetTenants200JSONResponse, len(tenants))
+	for i := range tenants {
+		res[i] = tenantResponse(&tenants[i])
+	}
+	return res, nil
+}
+
+// CreateTenant creates a tenant
+func (s *Server) CreateTenant(ctx context.Context, request CreateTenantRequestObject) (CreateTenantResponseObject, error) {
+	tenant, err := domain.NewTenant(request.Body.Name)
+	if err != nil {
+		return CreateTenant400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+	}
+	setTenant(tenant, request.Body)
+	if err := s.tenantService.Create(ctx, tenant); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTenant):
+			return CreateTenant400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrTenantDuplicated):
+			return CreateTenant409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		return CreateTenant500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return CreateTenant201JSONResponse(tenantResponse(tenant)), nil
+}
+
+// GetTenant returns a tenant
+func (s *Server) GetTenant(ctx context.Context, request GetTenantRequestObject) (GetTenantResponseObject, error) {
+	tenant, err := s.tenantService.GetByID(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrTenantNotFound) {
+			return GetTenant404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "loading tenant", "err", err, "id", request.Id)
+		return GetTenant500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return GetTenant200JSONResponse(tenantResponse(tenant)), nil
+}
+
+// UpdateTenant replaces the name, the quotas and the configuration overrides of a tenant
+func (s *Server) UpdateTenant(ctx context.Context, request UpdateTenantRequestObject) (UpdateTenantResponseObject, error) {
+	tenant, err := s.tenantService.GetByID(ctx, request.Id)
+	if err != nil {
+		if errors.Is(err, services.ErrTenantNotFound) {
+			return UpdateTenant404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "loading tenant", "err", err, "id", request.Id)
+		return UpdateTenant500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	tenant.Name = strings.TrimSpace(request.Body.Name)
+	setTenant(tenant, request.Body)
+	if err := s.tenantService.Update(ctx, tenant); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTenant):
+			return UpdateTenant400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrTenantNotFound):
+			return UpdateTenant404JSONResponse{N404JSONResponse{Message: err.Error()}}, nil
+		case errors.Is(err, services.ErrTenantDuplicated):
+			return UpdateTenant409JSONResponse{N409JSONResponse{Message: err.Error()}}, nil
+		}
+		return UpdateTenant500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+	return UpdateTenant200JSONResponse(tenantResponse(tenant)), nil
+}
+
+// setTenant sets the quotas and the configuration overrides of the request to the tenant
+func setTenant(tenant *domain.Tenant, request *TenantRequest) {
+	tenant.MaxIdentities = request.MaxIdentities
+	tenant.MaxCredentialsPerMonth = request.MaxCredentialsPerMonth
+	tenant.IssuerName = request.IssuerName
+	tenant.IssuerLogo = request.IssuerLogo
+}
+
+// errForeignTenant is returned when a caller of a tenant requests another tenant
+var errForeignTenant = errors.New("callers of a tenant can only use their own tenant")
+
+// callerTenant returns the tenant of the principal of the request, nil when the caller acts on the whole node
+func callerTenant(ctx context.Context) *uuid.UUID {
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		return principal.TenantID
+	}
+	return nil
+}
+
+// requestTenant returns the tenant of the entities created by the request: the tenant of the caller, or the
+// requested one when the caller acts on the whole node
+func requestTenant(ctx context.Context, requested *uuid.UUID) (*uuid.UUID, error) {
+	tenantID := callerTenant(ctx)
+	if tenantID == nil {
+		return requested, nil
+	}
+	if requested != nil && *requested != *tenantID {
+		return nil, errForeignTenant
+	}
+	return tenantID, nil
+}
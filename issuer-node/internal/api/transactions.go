@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// GetTransactionCosts - returns the on-chain costs of the identity grouped by network and transaction type
+func (s *Server) GetTransactionCosts(ctx context.Context, request GetTransactionCostsRequestObject) (GetTransactionCostsResponseObject, error) {
+	did, err := w3c.ParseDID(request.Identifier)
+	if err != nil {
+		return GetTransactionCosts400JSONResponse{N400JSONResponse{"invalid did"}}, nil
+	}
+
+	filter := ports.TransactionCostFilter{
+		From:    request.Params.From,
+		To:      request.Params.To,
+		Network: request.Params.Network,
+	}
+	totals, err := s.transactionHistory.GetCostTotals(ctx, *did, filter)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidTransactionDateRange) {
+			return GetTransactionCosts400JSONResponse{N400JSONResponse{Message: err.Error()}}, nil
+		}
+		log.Error(ctx, "get transaction costs", "err", err, "did", did.String())
+		return GetTransactionCosts500JSONResponse{N500JSONResponse{Message: err.Error()}}, nil
+	}
+
+	return GetTransactionCosts200JSONResponse(transactionCostsResponse(filter, totals)), nil
+}
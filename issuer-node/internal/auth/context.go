@@ -0,0 +1,40 @@
+// Package auth authenticates the callers of the API.
+package auth
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx with the authenticated caller of the request
+func WithPrincipal(ctx context.Context, principal *domain.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated caller of the request, or nil when it is anonymous
+func PrincipalFromContext(ctx context.Context) *domain.Principal {
+	principal, _ := ctx.Value(principalKey{}).(*domain.Principal)
+	return principal
+}
+
+// Origin identifies the http request of a caller
+type Origin struct {
+	RequestID string
+	SourceIP  string
+}
+
+type originKey struct{}
+
+// WithOrigin returns a copy of ctx with the origin of the request
+func WithOrigin(ctx context.Context, origin Origin) context.Context {
+	return context.WithValue(ctx, originKey{}, origin)
+}
+
+// OriginFromContext returns the origin of the request, empty when ctx is not a request
+func OriginFromContext(ctx context.Context) Origin {
+	origin, _ := ctx.Value(originKey{}).(Origin)
+	return origin
+}
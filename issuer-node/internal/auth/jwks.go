@@ -0,0 +1,138 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	jwksFetchTimeout = 10 * time.Second
+	// jwksMinRefresh is the min time between two fetches of the key set, so tokens with random key ids can't flood
+	// the identity provider
+	jwksMinRefresh = 30 * time.Second
+	// jwksFailureTTL is the time a failed fetch is cached before trying again, shorter than jwksMinRefresh so the
+	// tokens signed by rotated keys are accepted soon after the identity provider is back
+	jwksFailureTTL = 5 * time.Second
+)
+
+// ErrUnknownKey is returned when the key set doesn't have the key that signed a token
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// JWKS is the key set of an identity provider. It is cached for ttl, and fetched again before that when a token
+// is signed by an unknown key, so the keys of the provider can be rotated. The key set is fetched at most once
+// every jwksMinRefresh, and a failed fetch is returned for jwksFailureTTL without calling the provider again.
+type JWKS struct {
+	url       string
+	ttl       time.Duration
+	client    *http.Client
+	fetches   singleflight.Group
+	mutex     sync.RWMutex
+	keys      jose.JSONWebKeySet
+	fetchedAt time.Time
+	// attemptedAt is the time of the last fetch and fetchErr its error, if it failed
+	attemptedAt time.Time
+	fetchErr    error
+}
+
+// NewJWKS returns the key set published at url
+func NewJWKS(url string, ttl time.Duration) *JWKS {
+	return &JWKS{url: url, ttl: ttl, client: &http.Client{Timeout: jwksFetchTimeout}}
+}
+
+// Key returns the key with the key id. An empty key id matches the key set with a single key.
+func (j *JWKS) Key(ctx context.Context, kid string) (*jose.JSONWebKey, error) {
+	j.mutex.RLock()
+	key, fresh := j.find(kid), time.Since(j.fetchedAt) < j.ttl
+	sinceAttempt, fetchErr := time.Since(j.attemptedAt), j.fetchErr
+	j.mutex.RUnlock()
+	if key != nil && fresh {
+		return key, nil
+	}
+	switch {
+	case fetchErr != nil && sinceAttempt < jwksFailureTTL:
+		if key != nil {
+			return key, nil
+		}
+		return nil, fetchErr
+	case fetchErr == nil && sinceAttempt < jwksMinRefresh:
+		if key != nil {
+			return key, nil
+		}
+		return nil, ErrUnknownKey
+	}
+
+	// the failures are cached, so the fetch is not canceled with the request that happens to trigger it
+	fetch := func() (any, error) { return nil, j.fetch(context.WithoutCancel(ctx)) }
+	if _, err, _ := j.fetches.Do(j.url, fetch); err != nil {
+		if key != nil {
+			log.Warn(ctx, "fetching jwks, using cached keys", "err", err, "url", j.url)
+			return key, nil
+		}
+		return nil, err
+	}
+
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+	if key = j.find(kid); key == nil {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// find returns the key with the key id from the cached key set. The caller holds the mutex.
+func (j *JWKS) find(kid string) *jose.JSONWebKey {
+	if kid == "" {
+		if len(j.keys.Keys) == 1 {
+			return &j.keys.Keys[0]
+		}
+		return nil
+	}
+	if keys := j.keys.Key(kid); len(keys) > 0 {
+		return &keys[0]
+	}
+	return nil
+}
+
+// fetch gets the key set from the identity provider and records the attempt, failed or not
+func (j *JWKS) fetch(ctx context.Context) error {
+	keys, err := j.get(ctx)
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.attemptedAt, j.fetchErr = time.Now(), err
+	if err != nil {
+		return err
+	}
+	j.keys, j.fetchedAt = *keys, j.attemptedAt
+	return nil
+}
+
+func (j *JWKS) get(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, j.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := j.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching jwks: %w", err)
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching jwks: unexpected status code %d", res.StatusCode)
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.NewDecoder(res.Body).Decode(&keys); err != nil {
+		return nil, fmt.Errorf("decoding jwks: %w", err)
+	}
+	return &keys, nil
+}
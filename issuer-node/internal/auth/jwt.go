@@ -0,0 +1,151 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const (
+	defaultClaim = "groups"
+	// leeway is the clock skew allowed between the identity provider and the node
+	leeway = time.Minute
+)
+
+var (
+	// ErrInvalidToken is returned when a bearer token is malformed, not signed by the identity provider or expired
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrInvalidClaimMapping is returned when a claim mapping of the configuration is not valid
+	ErrInvalidClaimMapping = errors.New("invalid claim mapping")
+	// ErrMissingIssuerOrAudience is returned when the verifier is created without the issuer or the audience of the tokens
+	ErrMissingIssuerOrAudience = errors.New("the issuer and the audience of the tokens are required")
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// Verifier authenticates the callers of the API with the bearer tokens of an OpenID Connect identity provider
+type Verifier struct {
+	jwks        *JWKS
+	issuer      string
+	audience    string
+	mappings    []config.OIDCClaimMapping
+	tenantClaim string
+}
+
+// NewVerifier returns a verifier of the tokens signed by the keys of jwks for the audience. The claim mappings grant
+// roles on the issuers of the node to the tokens. When tenantClaim is set, the tokens must have the id of the tenant
+// of the caller in that claim. The issuer and the audience are required, otherwise the tokens the identity provider
+// signs for any other application would be accepted.
+func NewVerifier(jwks *JWKS, issuer string, audience string, mappings []config.OIDCClaimMapping, tenantClaim string) (*Verifier, error) {
+	if issuer == "" || audience == "" {
+		return nil, ErrMissingIssuerOrAudience
+	}
+	for i := range mappings {
+		role := domain.Role(mappings[i].Role)
+		if !role.Valid() || mappings[i].Value == "" {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidClaimMapping, mappings[i].Value)
+		}
+		if len(mappings[i].Issuers) == 0 && role != domain.RoleAdmin && tenantClaim == "" {
+			return nil, fmt.Errorf("%w: %q: only the admin role can be granted on every issuer", ErrInvalidClaimMapping, mappings[i].Value)
+		}
+	}
+	return &Verifier{jwks: jwks, issuer: issuer, audience: audience, mappings: mappings, tenantClaim: tenantClaim}, nil
+}
+
+// Verify validates the token and returns the principal with the grants of its claims
+func (v *Verifier) Verify(ctx context.Context, token string) (*domain.Principal, error) {
+	tok, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil || len(tok.Headers) != 1 {
+		return nil, ErrInvalidToken
+	}
+	key, err := v.jwks.Key(ctx, tok.Headers[0].KeyID)
+	if err != nil {
+		if errors.Is(err, ErrUnknownKey) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	var (
+		std    jwt.Claims
+		claims map[string]any
+	)
+	if err := tok.Claims(key.Key, &std, &claims); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if std.Expiry == nil || std.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	expected := jwt.Expected{Issuer: v.issuer, AnyAudience: jwt.Audience{v.audience}, Time: time.Now()}
+	if err := std.ValidateWithLeeway(expected, leeway); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	principal := &domain.Principal{ID: std.Subject, Name: claimString(claims, "preferred_username"), Method: domain.AuthMethodJWT}
+	if principal.Name == "" {
+		principal.Name = claimString(claims, "email")
+	}
+	if v.tenantClaim != "" {
+		tenantID, err := uuid.Parse(claimString(claims, v.tenantClaim))
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid tenant claim", ErrInvalidToken)
+		}
+		principal.TenantID = &tenantID
+	}
+	for _, mapping := range v.mappings {
+		if hasClaimValue(claims, mapping.Claim, mapping.Value) {
+			principal.Grants = append(principal.Grants, domain.Grant{Role: domain.Role(mapping.Role), IssuerDIDs: mapping.Issuers})
+		}
+	}
+	return principal, nil
+}
+
+// claim returns the claim at the dot separated path
+func claim(claims map[string]any, path string) any {
+	var value any = claims
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
+func claimString(claims map[string]any, path string) string {
+	s, _ := claim(claims, path).(string)
+	return s
+}
+
+// hasClaimValue tells whether the claim at path is the value or a list that contains it
+func hasClaimValue(claims map[string]any, path string, value string) bool {
+	if path == "" {
+		path = defaultClaim
+	}
+	switch c := claim(claims, path).(type) {
+	case string:
+		return c == value
+	case []any:
+		for _, item := range c {
+			if s, ok := item.(string); ok && s == value {
+				return true
+			}
+		}
+	}
+	return false
+}
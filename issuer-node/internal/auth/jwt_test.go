@@ -0,0 +1,256 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+const (
+	testIssuer   = "https://idp.example.com"
+	testAudience = "issuer-node"
+	issuerDID    = "did:iden3:privado:main:2Scn2RfosbkQDMQzQM5nCz3Nk5GnbzZCWzGCd3tc2G"
+)
+
+// testIDP is an identity provider that publishes its keys in a local JWKS
+type testIDP struct {
+	t       *testing.T
+	mutex   sync.Mutex
+	keys    []jose.JSONWebKey
+	fetches atomic.Int32
+	server  *httptest.Server
+}
+
+func newTestIDP(t *testing.T) *testIDP {
+	t.Helper()
+	idp := &testIDP{t: t}
+	idp.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		idp.fetches.Add(1)
+		idp.mutex.Lock()
+		defer idp.mutex.Unlock()
+		keys := jose.JSONWebKeySet{}
+		for _, key := range idp.keys {
+			keys.Keys = append(keys.Keys, key.Public())
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(keys))
+	}))
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+// rotate adds a new signing key to the key set and returns it
+func (idp *testIDP) rotate(kid string) jose.JSONWebKey {
+	idp.t.Helper()
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(idp.t, err)
+	key := jose.JSONWebKey{Key: private, KeyID: kid, Algorithm: string(jose.ES256), Use: "sig"}
+	idp.mutex.Lock()
+	defer idp.mutex.Unlock()
+	idp.keys = append(idp.keys, key)
+	return key
+}
+
+func (idp *testIDP) token(key jose.JSONWebKey, claims jwt.Claims, custom map[string]any) string {
+	idp.t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: key}, (&jose.SignerOptions{}).WithType("JWT"))
+	require.NoError(idp.t, err)
+	token, err := jwt.Signed(signer).Claims(claims).Claims(custom).Serialize()
+	require.NoError(idp.t, err)
+	return token
+}
+
+func validClaims() jwt.Claims {
+	return jwt.Claims{
+		Issuer:   testIssuer,
+		Subject:  "alice",
+		Audience: jwt.Audience{testAudience},
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+		Expiry:   jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	ctx := context.Background()
+	idp := newTestIDP(t)
+	key := idp.rotate("key-1")
+	verifier, err := NewVerifier(NewJWKS(idp.server.URL, time.Hour), testIssuer, testAudience, []config.OIDCClaimMapping{
+		{Value: "issuer-admins", Role: string(domain.RoleAdmin)},
+		{Claim: "realm_access.roles", Value: "auditor", Role: string(domain.RoleReadOnly), Issuers: []string{issuerDID}},
+	}, "")
+	require.NoError(t, err)
+
+	t.Run("should map the claims to grants", func(t *testing.T) {
+		principal, err := verifier.Verify(ctx, idp.token(key, validClaims(), map[string]any{
+			"preferred_username": "Alice",
+			"groups":             []string{"issuer-admins", "developers"},
+			"realm_access":       map[string]any{"roles": []string{"auditor"}},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, "alice", principal.ID)
+		assert.Equal(t, "Alice", principal.Name)
+		assert.Equal(t, domain.AuthMethodJWT, principal.Method)
+		assert.Equal(t, []domain.Grant{{Role: domain.RoleAdmin}, {Role: domain.RoleReadOnly, IssuerDIDs: []string{issuerDID}}}, principal.Grants)
+
+		principal, err = verifier.Verify(ctx, idp.token(key, validClaims(), map[string]any{"realm_access": map[string]any{"roles": "auditor"}}))
+		require.NoError(t, err)
+		assert.True(t, principal.Allows(issuerDID, domain.PermissionRead))
+		assert.False(t, principal.Allows(issuerDID, domain.PermissionWrite))
+		assert.False(t, principal.Allows("", domain.PermissionRead))
+	})
+
+	t.Run("should reject invalid tokens", func(t *testing.T) {
+		for name, claims := range map[string]func(c *jwt.Claims){
+			"issuer":     func(c *jwt.Claims) { c.Issuer = "https://other.example.com" },
+			"audience":   func(c *jwt.Claims) { c.Audience = jwt.Audience{"other"} },
+			"expired":    func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour)) },
+			"no expiry":  func(c *jwt.Claims) { c.Expiry = nil },
+			"no subject": func(c *jwt.Claims) { c.Subject = "" },
+		} {
+			c := validClaims()
+			claims(&c)
+			_, err := verifier.Verify(ctx, idp.token(key, c, nil))
+			assert.ErrorIs(t, err, ErrInvalidToken, name)
+		}
+
+		_, err := verifier.Verify(ctx, "not a token")
+		assert.ErrorIs(t, err, ErrInvalidToken)
+
+		foreign := validClaims()
+		foreign.Audience = jwt.Audience{"another-app", "other"}
+		_, err = verifier.Verify(ctx, idp.token(key, foreign, map[string]any{"groups": []string{"issuer-admins"}}))
+		assert.ErrorIs(t, err, ErrInvalidToken, "tokens minted for other applications of the identity provider")
+
+		forged := jose.JSONWebKey{Key: idp.rotate("key-2").Key, KeyID: key.KeyID}
+		_, err = verifier.Verify(ctx, idp.token(forged, validClaims(), nil))
+		assert.ErrorIs(t, err, ErrInvalidToken, "signed by another key with the same key id")
+	})
+
+	t.Run("should require the issuer and the audience", func(t *testing.T) {
+		_, err := NewVerifier(nil, "", testAudience, nil, "")
+		assert.ErrorIs(t, err, ErrMissingIssuerOrAudience)
+		_, err = NewVerifier(nil, testIssuer, "", nil, "")
+		assert.ErrorIs(t, err, ErrMissingIssuerOrAudience)
+	})
+
+	t.Run("should reject invalid claim mappings", func(t *testing.T) {
+		_, err := NewVerifier(nil, testIssuer, testAudience, []config.OIDCClaimMapping{{Value: "developers", Role: string(domain.RoleIssuer)}}, "")
+		assert.ErrorIs(t, err, ErrInvalidClaimMapping, "only admin can be granted on every issuer")
+		_, err = NewVerifier(nil, testIssuer, testAudience, []config.OIDCClaimMapping{{Value: "developers", Role: "owner", Issuers: []string{issuerDID}}}, "")
+		assert.ErrorIs(t, err, ErrInvalidClaimMapping)
+	})
+
+	t.Run("should scope the principal to the tenant of the token", func(t *testing.T) {
+		verifier, err := NewVerifier(NewJWKS(idp.server.URL, time.Hour), testIssuer, testAudience, []config.OIDCClaimMapping{
+			{Value: "developers", Role: string(domain.RoleIssuer)},
+		}, "tenant_id")
+		require.NoError(t, err, "mappings without issuers grant the role on the issuers of the tenant")
+
+		principal, err := verifier.Verify(ctx, idp.token(key, validClaims(), map[string]any{"tenant_id": domain.DefaultTenantID.String(), "groups": []string{"developers"}}))
+		require.NoError(t, err)
+		require.NotNil(t, principal.TenantID)
+		assert.Equal(t, domain.DefaultTenantID, *principal.TenantID)
+		assert.True(t, principal.Allows(issuerDID, domain.PermissionWrite))
+
+		_, err = verifier.Verify(ctx, idp.token(key, validClaims(), map[string]any{"groups": []string{"developers"}}))
+		assert.ErrorIs(t, err, ErrInvalidToken, "tokens without tenant")
+	})
+}
+
+func TestJWKS_Key(t *testing.T) {
+	ctx := context.Background()
+	idp := newTestIDP(t)
+	idp.rotate("key-1")
+	jwks := NewJWKS(idp.server.URL, time.Hour)
+
+	key, err := jwks.Key(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, "key-1", key.KeyID)
+	_, err = jwks.Key(ctx, "key-1")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), idp.fetches.Load(), "the key set is cached")
+
+	_, err = jwks.Key(ctx, "unknown")
+	assert.ErrorIs(t, err, ErrUnknownKey)
+	assert.Equal(t, int32(1), idp.fetches.Load(), "unknown keys right after a fetch don't trigger another one")
+
+	t.Run("should fetch the rotated keys", func(t *testing.T) {
+		idp.rotate("key-2")
+		jwks.mutex.Lock()
+		jwks.fetchedAt = time.Now().Add(-jwksMinRefresh)
+		jwks.attemptedAt = jwks.fetchedAt
+		jwks.mutex.Unlock()
+
+		key, err := jwks.Key(ctx, "key-2")
+		require.NoError(t, err)
+		assert.Equal(t, "key-2", key.KeyID)
+		assert.Equal(t, int32(2), idp.fetches.Load())
+	})
+
+	t.Run("should not fetch the expired keys more than once every min refresh", func(t *testing.T) {
+		jwks.mutex.Lock()
+		jwks.fetchedAt = time.Now().Add(-2 * time.Hour)
+		jwks.attemptedAt = time.Now()
+		jwks.mutex.Unlock()
+
+		for i := 0; i < 3; i++ {
+			_, err := jwks.Key(ctx, "unknown")
+			assert.ErrorIs(t, err, ErrUnknownKey)
+		}
+		key, err := jwks.Key(ctx, "key-1")
+		require.NoError(t, err)
+		assert.Equal(t, "key-1", key.KeyID)
+		assert.Equal(t, int32(2), idp.fetches.Load())
+	})
+
+	t.Run("should keep the cached keys when the identity provider is down", func(t *testing.T) {
+		jwks.mutex.Lock()
+		jwks.fetchedAt = time.Now().Add(-2 * time.Hour)
+		jwks.attemptedAt = jwks.fetchedAt
+		jwks.mutex.Unlock()
+		idp.server.Close()
+
+		key, err := jwks.Key(ctx, "key-1")
+		require.NoError(t, err)
+		assert.Equal(t, "key-1", key.KeyID)
+	})
+
+	t.Run("should cache the fetch failures", func(t *testing.T) {
+		down := NewJWKS(idp.server.URL, time.Hour)
+		_, err := down.Key(ctx, "key-1")
+		require.Error(t, err)
+		down.mutex.RLock()
+		attemptedAt := down.attemptedAt
+		down.mutex.RUnlock()
+
+		_, err2 := down.Key(ctx, "key-1")
+		assert.Equal(t, err, err2)
+		down.mutex.RLock()
+		assert.Equal(t, attemptedAt, down.attemptedAt, "the identity provider is not called again")
+		down.mutex.RUnlock()
+
+		down.mutex.Lock()
+		down.attemptedAt = time.Now().Add(-jwksFailureTTL)
+		down.mutex.Unlock()
+		_, err = down.Key(ctx, "key-1")
+		require.Error(t, err)
+		down.mutex.RLock()
+		assert.WithinDuration(t, time.Now(), down.attemptedAt, time.Second, "the fetch is retried after the failure ttl")
+		down.mutex.RUnlock()
+	})
+}
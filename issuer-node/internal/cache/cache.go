@@ -28,6 +28,12 @@ type Cache interface {
 	Delete(ctx context.Context, key string) error
 }
 
+// Scripter is implemented by the shared caches, that run Lua scripts atomically in the cache server
+type Scripter interface {
+	// Eval runs the script with the given keys and args and returns its result, a list of integers
+	Eval(ctx context.Context, script string, keys []string, args ...string) ([]int64, error)
+}
+
 // NewCacheClient - creates a new cache client based on the configuration
 func NewCacheClient(ctx context.Context, cfg config.Configuration) (Cache, error) {
 	var cachex Cache
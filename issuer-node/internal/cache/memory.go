@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/patrickmn/go-cache"
+
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 const (
@@ -36,9 +38,11 @@ func (m *memory) Get(_ context.Context, key string, value any) bool {
 	mVal, exists := m.c.Get(key)
 	if exists && (reflect.TypeOf(value).Elem() == reflect.TypeOf(mVal)) {
 		reflect.ValueOf(value).Elem().Set(reflect.ValueOf(mVal))
+		telemetry.ObserveCacheLookup(true)
 		return true
 	}
 
+	telemetry.ObserveCacheLookup(false)
 	return false
 }
 
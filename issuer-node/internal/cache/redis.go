@@ -6,16 +6,19 @@ import (
 
 	"github.com/go-redis/cache/v8"
 	"github.com/go-redis/redis/v8"
+
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 type redisCache struct {
-	redis *cache.Cache
+	redis  *cache.Cache
+	client *redis.Client
 }
 
 // NewRedisCache returns a new cache based on Redis
 func NewRedisCache(client *redis.Client) Cache {
 	myc := cache.New(&cache.Options{Redis: client})
-	return &redisCache{redis: myc}
+	return &redisCache{redis: myc, client: client}
 }
 
 // Set sets a new entry in redis cache
@@ -34,9 +37,11 @@ func (c *redisCache) Set(ctx context.Context, key string, value any, ttl time.Du
 // value must be passed as reference as the cached value will be stored there
 func (c *redisCache) Get(ctx context.Context, key string, value any) bool {
 	if err := c.redis.Get(ctx, key, &value); err != nil {
+		telemetry.ObserveCacheLookup(false)
 		return false
 	}
 
+	telemetry.ObserveCacheLookup(true)
 	return true
 }
 
@@ -45,6 +50,15 @@ func (c *redisCache) Exists(ctx context.Context, key string) bool {
 	return c.redis.Exists(ctx, key)
 }
 
+// Eval runs a Lua script atomically in redis
+func (c *redisCache) Eval(ctx context.Context, script string, keys []string, args ...string) ([]int64, error) {
+	argv := make([]interface{}, len(args))
+	for i, arg := range args {
+		argv[i] = arg
+	}
+	return redis.NewScript(script).Run(ctx, c.client, keys, argv...).Int64Slice()
+}
+
 // Delete removes an entry from redis
 func (c *redisCache) Delete(ctx context.Context, key string) error {
 	return c.redis.Delete(ctx, key)
@@ -8,6 +8,7 @@ import (
 	"github.com/valkey-io/valkey-go"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 type valKeyCache struct {
@@ -30,7 +31,8 @@ func (v valKeyCache) Set(ctx context.Context, key string, value any, ttl time.Du
 	return v.client.Do(ctx, v.client.B().Set().Key(key).Value(string(val)).Px(ttl).Build()).Error()
 }
 
-func (v valKeyCache) Get(ctx context.Context, key string, value any) bool {
+func (v valKeyCache) Get(ctx context.Context, key string, value any) (found bool) {
+	defer func() { telemetry.ObserveCacheLookup(found) }()
 	result := v.client.Do(ctx, v.client.B().Get().Key(key).Build())
 	if result.Error() != nil {
 		log.Error(ctx, "error getting value", "err:", result.Error())
@@ -64,6 +66,11 @@ func (v valKeyCache) Exists(ctx context.Context, key string) bool {
 	return ok == 1
 }
 
+// Eval runs a Lua script atomically in valkey
+func (v valKeyCache) Eval(ctx context.Context, script string, keys []string, args ...string) ([]int64, error) {
+	return valkey.NewLuaScript(script).Exec(ctx, v.client, keys, args).AsIntSlice()
+}
+
 func (v valKeyCache) Delete(ctx context.Context, key string) error {
 	err := v.client.Do(ctx, v.client.B().Del().Key(key).Build()).Error()
 	return err
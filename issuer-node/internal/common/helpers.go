@@ -9,6 +9,15 @@ func ToPointer[T any](p T) *T {
 	return &p
 }
 
+// Deref returns the value p points to, or the zero value of T when p is nil
+func Deref[T any](p *T) T {
+	var zero T
+	if p == nil {
+		return zero
+	}
+	return *p
+}
+
 // CopyMap returns a deep copy of the input map
 func CopyMap(m map[string]interface{}) map[string]interface{} {
 	cp := make(map[string]interface{})
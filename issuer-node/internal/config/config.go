@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/netip"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -43,18 +44,24 @@ const (
 
 // Configuration holds the project configuration
 type Configuration struct {
-	ServerUrl                   string        `env:"ISSUER_SERVER_URL" envDefault:"http://localhost"`
-	ServerPort                  int           `env:"ISSUER_SERVER_PORT" envDefault:"3001"`
-	PublishingKeyPath           string        `env:"ISSUER_PUBLISH_KEY_PATH" envDefault:"pbkey"`
-	SchemaCache                 bool          `env:"ISSUER_SCHEMA_CACHE" envDefault:"false"`
-	OnChainCheckStatusFrequency time.Duration `env:"ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY"`
-	NetworkResolverPath         string        `env:"ISSUER_RESOLVER_PATH"`
-	NetworkResolverFile         *string       `env:"ISSUER_RESOLVER_FILE"`
-	IssuerName                  string        `env:"ISSUER_ISSUER_NAME"`
-	IssuerLogo                  string        `env:"ISSUER_ISSUER_LOGO"`
+	ServerUrl                   string         `env:"ISSUER_SERVER_URL" envDefault:"http://localhost"`
+	ServerPort                  int            `env:"ISSUER_SERVER_PORT" envDefault:"3001"`
+	PublishingKeyPath           string         `env:"ISSUER_PUBLISH_KEY_PATH" envDefault:"pbkey"`
+	SchemaCache                 bool           `env:"ISSUER_SCHEMA_CACHE" envDefault:"false"`
+	OnChainCheckStatusFrequency time.Duration  `env:"ISSUER_ONCHAIN_CHECK_STATUS_FREQUENCY"`
+	PublishSchedulerPeriod      time.Duration  `env:"ISSUER_PUBLISH_SCHEDULER_PERIOD" envDefault:"1m"`
+	NetworkResolverPath         string         `env:"ISSUER_RESOLVER_PATH"`
+	NetworkResolverFile         *string        `env:"ISSUER_RESOLVER_FILE"`
+	IssuerName                  string         `env:"ISSUER_ISSUER_NAME"`
+	IssuerLogo                  string         `env:"ISSUER_ISSUER_LOGO"`
+	TrustedProxies              TrustedProxies `env:"ISSUER_TRUSTED_PROXIES"`
 	Database                    Database
 	Cache                       Cache
+	SchemaLoader                SchemaLoader
+	RateLimit                   RateLimit
+	Telemetry                   Telemetry
 	HTTPBasicAuth               HTTPBasicAuth
+	OIDC                        OIDC
 	KeyStore                    KeyStore
 	Log                         Log
 	Ethereum                    Ethereum
@@ -65,6 +72,46 @@ type Configuration struct {
 	UniversalLinks              UniversalLinks
 	UniversalDIDResolver        UniversalDIDResolver
 	Payments                    Payments
+	Messages                    Messages
+}
+
+// OIDC configures the authentication of the operators with the bearer tokens of an OpenID Connect identity provider.
+// It is enabled when JWKSURL is set.
+type OIDC struct {
+	JWKSURL       string            `env:"ISSUER_OIDC_JWKS_URL"`
+	Issuer        string            `env:"ISSUER_OIDC_ISSUER"`
+	Audience      string            `env:"ISSUER_OIDC_AUDIENCE"`
+	JWKSCacheTTL  time.Duration     `env:"ISSUER_OIDC_JWKS_CACHE_TTL" envDefault:"1h"`
+	ClaimMappings OIDCClaimMappings `env:"ISSUER_OIDC_CLAIM_MAPPINGS"`
+	// TenantClaim is the claim with the id of the tenant of the caller. When it is set every token must have it, the
+	// callers only act on the issuers of their tenant, and the mappings without issuers grant the role on all of them.
+	TenantClaim string `env:"ISSUER_OIDC_TENANT_CLAIM"`
+}
+
+// OIDCClaimMapping grants a role on some issuers to the tokens that have the value in the claim. Claim is a dot
+// separated path to a string or a list of strings, groups by default. Without issuers the role is granted on all of
+// them, which is only allowed for the admin role.
+// Example: ISSUER_OIDC_CLAIM_MAPPINGS='[{"value":"issuer-admins","role":"admin"},{"claim":"realm_access.roles","value":"pool-a","role":"issuer","issuers":["did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"]}]'
+type OIDCClaimMapping struct {
+	Claim   string   `json:"claim"`
+	Value   string   `json:"value"`
+	Role    string   `json:"role"`
+	Issuers []string `json:"issuers"`
+}
+
+// OIDCClaimMappings is the list of claim mappings, encoded as json in the environment
+type OIDCClaimMappings []OIDCClaimMapping
+
+// UnmarshalText decodes the json encoded claim mappings
+func (m *OIDCClaimMappings) UnmarshalText(text []byte) error {
+	return json.Unmarshal(text, (*[]OIDCClaimMapping)(m))
+}
+
+// Messages configures the delivery of iden3comm messages to the holders
+type Messages struct {
+	DeliveryPeriod time.Duration `env:"ISSUER_MESSAGES_DELIVERY_PERIOD" envDefault:"30s"`
+	RetryBackoff   time.Duration `env:"ISSUER_MESSAGES_RETRY_BACKOFF" envDefault:"1m"`
+	MaxAttempts    int           `env:"ISSUER_MESSAGES_MAX_ATTEMPTS" envDefault:"5"`
 }
 
 // Payments configurations
@@ -85,9 +132,89 @@ type Cache struct {
 	Url      string `env:"ISSUER_CACHE_URL"`
 }
 
+// SchemaLoader configures the cache of the JSON-LD documents shared by the processes, used when SchemaCache is enabled
+type SchemaLoader struct {
+	CacheTTL         time.Duration `env:"ISSUER_SCHEMA_CACHE_TTL" envDefault:"30m"`
+	CacheNotFoundTTL time.Duration `env:"ISSUER_SCHEMA_CACHE_NOT_FOUND_TTL" envDefault:"5m"`
+	HostRate         float64       `env:"ISSUER_SCHEMA_LOADER_HOST_RATE" envDefault:"10"` // fetches per second to each host
+	HostBurst        int           `env:"ISSUER_SCHEMA_LOADER_HOST_BURST" envDefault:"20"`
+}
+
+// RateLimit configures the token buckets that throttle the clients of the API, shared by the instances of the node
+// through the cache. Rate and Burst limit every public endpoint per client IP, a rate of 0 disables them. Routes
+// override the limits of some operations, public or not.
+type RateLimit struct {
+	Rate   float64         `env:"ISSUER_RATE_LIMIT_RATE" envDefault:"10"` // requests per second of each client
+	Burst  int             `env:"ISSUER_RATE_LIMIT_BURST" envDefault:"20"`
+	Routes RateLimitRoutes `env:"ISSUER_RATE_LIMIT_ROUTES"`
+}
+
+// RateLimitRoute limits an operation of the API per client. Key tells how clients are told apart: ip (default), did
+// for the {identifier} of the path, or apikey for the authenticated caller. Clients without DID or authentication
+// are limited by IP. A rate of 0 disables the limit of the operation.
+// Example: ISSUER_RATE_LIMIT_ROUTES='[{"operation":"Agent","rate":2,"burst":5},{"operation":"CreateCredential","rate":5,"burst":10,"key":"apikey"}]'
+type RateLimitRoute struct {
+	Operation string  `json:"operation"`
+	Rate      float64 `json:"rate"`
+	Burst     int     `json:"burst"`
+	Key       string  `json:"key"`
+}
+
+// RateLimitRoutes is the list of route limits, encoded as json in the environment
+type RateLimitRoutes []RateLimitRoute
+
+// UnmarshalText decodes the json encoded route limits
+func (r *RateLimitRoutes) UnmarshalText(text []byte) error {
+	return json.Unmarshal(text, (*[]RateLimitRoute)(r))
+}
+
+// TrustedProxies are the addresses, or CIDR ranges, of the proxies in front of the node whose X-Forwarded-For header
+// is trusted to tell the address of the client. Example: ISSUER_TRUSTED_PROXIES=10.0.0.0/8,192.168.1.10
+type TrustedProxies []netip.Prefix
+
+// UnmarshalText decodes the comma separated list of addresses and CIDR ranges
+func (t *TrustedProxies) UnmarshalText(text []byte) error {
+	*t = nil
+	for _, entry := range strings.Split(string(text), ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			addr, errAddr := netip.ParseAddr(entry)
+			if errAddr != nil {
+				return fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+			}
+			prefix = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		*t = append(*t, prefix.Masked())
+	}
+	return nil
+}
+
+// Contains tells whether the address is the one of a trusted proxy
+func (t TrustedProxies) Contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Telemetry configures the OpenTelemetry traces of the node, exported with OTLP over http when TracesEndpoint is
+// set. Prometheus metrics are always served in /metrics.
+type Telemetry struct {
+	TracesEndpoint    string  `env:"ISSUER_TELEMETRY_TRACES_ENDPOINT"` // e.g. http://otel-collector:4318/v1/traces
+	TracesSampleRatio float64 `env:"ISSUER_TELEMETRY_TRACES_SAMPLE_RATIO" envDefault:"1"`
+}
+
 // IPFS configurations
 type IPFS struct {
 	GatewayURL string `env:"ISSUER_IPFS_GATEWAY_URL" envDefault:"https://cloudflare-ipfs.com"`
+	APIURL     string `env:"ISSUER_IPFS_API_URL"` // schemas built by the node are pinned here when set
 }
 
 // Ethereum struct
@@ -144,6 +271,7 @@ type KeyStore struct {
 	PluginIden3MountPath         string `env:"ISSUER_KEY_STORE_PLUGIN_IDEN3_MOUNT_PATH"`
 	BJJProvider                  string `env:"ISSUER_KMS_BJJ_PROVIDER"`
 	ETHProvider                  string `env:"ISSUER_KMS_ETH_PROVIDER"`
+	X25519Provider               string `env:"ISSUER_KMS_X25519_PROVIDER"`
 	ProviderLocalStorageFilePath string `env:"ISSUER_KMS_PROVIDER_LOCAL_STORAGE_FILE_PATH"`
 	AWSAccessKey                 string `env:"ISSUER_KMS_AWS_ACCESS_KEY"`
 	AWSSecretKey                 string `env:"ISSUER_KMS_AWS_SECRET_KEY"`
@@ -322,6 +450,11 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 		return errors.New("ISSUER_CACHE_URL value is missing")
 	}
 
+	if cfg.OIDC.JWKSURL != "" && (cfg.OIDC.Issuer == "" || cfg.OIDC.Audience == "") {
+		log.Error(ctx, "ISSUER_OIDC_ISSUER and ISSUER_OIDC_AUDIENCE are required when ISSUER_OIDC_JWKS_URL is set")
+		return errors.New("ISSUER_OIDC_ISSUER and ISSUER_OIDC_AUDIENCE are required when ISSUER_OIDC_JWKS_URL is set")
+	}
+
 	if cfg.MediaTypeManager.Enabled == nil {
 		log.Info(ctx, "ISSUER_MEDIA_TYPE_MANAGER_ENABLED is missing and the server set up it as true")
 		cfg.MediaTypeManager.Enabled = common.ToPointer(true)
@@ -355,12 +488,22 @@ func checkEnvVars(ctx context.Context, cfg *Configuration) error {
 		cfg.KeyStore.ETHProvider = LocalStorage
 	}
 
-	if (cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage) && cfg.KeyStore.ProviderLocalStorageFilePath == "" {
+	if cfg.KeyStore.X25519Provider == "" && (cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.BJJProvider == AWSSM) {
+		log.Info(ctx, "ISSUER_KMS_X25519_PROVIDER value is missing, using the BJJ provider", "provider", cfg.KeyStore.BJJProvider)
+		cfg.KeyStore.X25519Provider = cfg.KeyStore.BJJProvider
+	}
+
+	if cfg.KeyStore.X25519Provider == Vault {
+		log.Error(ctx, "X25519 keys can't be stored in vault, ISSUER_KMS_X25519_PROVIDER must be localstorage or aws-sm")
+		return errors.New("X25519 keys can't be stored in vault")
+	}
+
+	if (cfg.KeyStore.BJJProvider == LocalStorage || cfg.KeyStore.ETHProvider == LocalStorage || cfg.KeyStore.X25519Provider == LocalStorage) && cfg.KeyStore.ProviderLocalStorageFilePath == "" {
 		log.Info(ctx, "ISSUER_KMS_PLUGIN_LOCAL_STORAGE_FOLDER value is missing, using default value: ./localstoragekeys")
 		cfg.KeyStore.ProviderLocalStorageFilePath = "./localstoragekeys"
 	}
 
-	if cfg.KeyStore.ETHProvider == AWSSM || cfg.KeyStore.ETHProvider == AWSKMS || cfg.KeyStore.BJJProvider == AWSSM {
+	if cfg.KeyStore.ETHProvider == AWSSM || cfg.KeyStore.ETHProvider == AWSKMS || cfg.KeyStore.BJJProvider == AWSSM || cfg.KeyStore.X25519Provider == AWSSM {
 		if cfg.KeyStore.AWSAccessKey == "" {
 			log.Error(ctx, "ISSUER_AWS_KEY_ID value is missing")
 			return errors.New("ISSUER_AWS_KEY_ID value is missing")
@@ -408,6 +551,7 @@ func KeyStoreConfig(ctx context.Context, cfg *Configuration, vaultCfg providers.
 	kmsConfig := kms.Config{
 		BJJKeyProvider:           kms.ConfigProvider(cfg.KeyStore.BJJProvider),
 		ETHKeyProvider:           kms.ConfigProvider(cfg.KeyStore.ETHProvider),
+		X25519KeyProvider:        kms.ConfigProvider(cfg.KeyStore.X25519Provider),
 		AWSAccessKey:             cfg.KeyStore.AWSAccessKey,
 		AWSSecretKey:             cfg.KeyStore.AWSSecretKey,
 		AWSRegion:                cfg.KeyStore.AWSRegion,
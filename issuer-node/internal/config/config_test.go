@@ -1,11 +1,13 @@
 package config
 
 import (
+	"net/netip"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type envVarsT map[string]string
@@ -239,6 +241,18 @@ func TestLoadCacheProvider(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestTrustedProxies(t *testing.T) {
+	var trusted TrustedProxies
+	require.NoError(t, trusted.UnmarshalText([]byte("10.0.0.0/8, 192.168.1.10,2001:db8::/32")))
+	require.Len(t, trusted, 3)
+	assert.True(t, trusted.Contains(netip.MustParseAddr("10.1.2.3")))
+	assert.True(t, trusted.Contains(netip.MustParseAddr("::ffff:192.168.1.10")))
+	assert.True(t, trusted.Contains(netip.MustParseAddr("2001:db8::1")))
+	assert.False(t, trusted.Contains(netip.MustParseAddr("192.168.1.11")))
+
+	assert.Error(t, trusted.UnmarshalText([]byte("10.0.0.0/8,proxy.example.com")))
+}
+
 func initVariables(t *testing.T) envVarsT {
 	t.Helper()
 	envVars := map[string]string{
@@ -1,5 +1,7 @@
 package bus
 
+import "context"
+
 // EventBus defines the interface for a generic event bus.
 type EventBus interface {
 	Publish(topic string, data ...interface{}) error
@@ -7,3 +9,17 @@ type EventBus interface {
 	Unsubscribe(topic string, handler interface{}) error
 	Close() error
 }
+
+// ContextPublisher is implemented by the event buses that send the context of the publisher, like its trace, to the
+// subscribers
+type ContextPublisher interface {
+	PublishContext(ctx context.Context, topic string, data ...interface{}) error
+}
+
+// Publish publishes the data in the topic of the bus, with the context of the caller when the bus supports it
+func Publish(ctx context.Context, eventBus EventBus, topic string, data ...interface{}) error {
+	if publisher, ok := eventBus.(ContextPublisher); ok {
+		return publisher.PublishContext(ctx, topic, data...)
+	}
+	return eventBus.Publish(topic, data...)
+}
@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const apiKeyPrefix = "isk"
+
+// ErrInvalidAPIKey is returned when an API key is malformed or has an invalid configuration
+var ErrInvalidAPIKey = errors.New("invalid api key")
+
+// APIKey is a secret that grants a role on some issuers of the node. Only the hash of the secret is stored.
+// Keys without issuers are valid for all the issuers of their tenant, or for all the issuers and for the endpoints of
+// the node when they don't belong to a tenant.
+type APIKey struct {
+	ID         uuid.UUID
+	Name       string
+	Prefix     string
+	Hash       string
+	Role       Role
+	TenantID   *uuid.UUID
+	IssuerDIDs []string
+	ExpiresAt  *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// NewAPIKey creates an API key and returns it with the secret that must be handed to its owner
+func NewAPIKey(name string, role Role, tenantID *uuid.UUID, issuerDIDs []string, expiresAt *time.Time) (*APIKey, string, error) {
+	if !role.Valid() {
+		return nil, "", fmt.Errorf("%w: unknown role %q", ErrInvalidAPIKey, role)
+	}
+	if len(issuerDIDs) == 0 && role != RoleAdmin && tenantID == nil {
+		return nil, "", fmt.Errorf("%w: only admin and tenant keys can be valid for all the issuers", ErrInvalidAPIKey)
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", fmt.Errorf("%w: expiration must be in the future", ErrInvalidAPIKey)
+	}
+
+	prefix := make([]byte, 6)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+	key := &APIKey{
+		ID:         uuid.New(),
+		Name:       name,
+		Prefix:     hex.EncodeToString(prefix),
+		Role:       role,
+		TenantID:   tenantID,
+		IssuerDIDs: issuerDIDs,
+		ExpiresAt:  expiresAt,
+		CreatedAt:  time.Now(),
+	}
+	plain := fmt.Sprintf("%s_%s_%s", apiKeyPrefix, key.Prefix, hex.EncodeToString(secret))
+	key.Hash = hashAPIKey(plain)
+	return key, plain, nil
+}
+
+// ParseAPIKeyPrefix returns the prefix of a plain API key, used to look it up
+func ParseAPIKeyPrefix(plain string) (string, error) {
+	parts := strings.Split(plain, "_")
+	if len(parts) != 3 || parts[0] != apiKeyPrefix || parts[1] == "" || parts[2] == "" {
+		return "", ErrInvalidAPIKey
+	}
+	return parts[1], nil
+}
+
+// Matches tells whether plain is the secret of the key
+func (k *APIKey) Matches(plain string) bool {
+	return subtle.ConstantTimeCompare([]byte(k.Hash), []byte(hashAPIKey(plain))) == 1
+}
+
+// Active tells whether the key is neither revoked nor expired
+func (k *APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
+
+// Grant returns the role of the key on its issuers
+func (k *APIKey) Grant() Grant {
+	return Grant{Role: k.Role, IssuerDIDs: k.IssuerDIDs}
+}
+
+func hashAPIKey(plain string) string {
+	hash := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	const issuer = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+	key, plain, err := NewAPIKey("operator", RoleIssuer, nil, []string{issuer}, nil)
+	require.NoError(t, err)
+	prefix, err := ParseAPIKeyPrefix(plain)
+	require.NoError(t, err)
+	assert.Equal(t, key.Prefix, prefix)
+	assert.NotContains(t, key.Hash, plain)
+	assert.True(t, key.Matches(plain))
+	assert.False(t, key.Matches(plain+"x"))
+
+	t.Run("should reject invalid keys", func(t *testing.T) {
+		_, _, err := NewAPIKey("operator", "owner", nil, []string{issuer}, nil)
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+		_, _, err = NewAPIKey("operator", RoleIssuer, nil, nil, nil)
+		assert.ErrorIs(t, err, ErrInvalidAPIKey, "only admin keys are valid for every issuer")
+		_, _, err = NewAPIKey("operator", RoleAdmin, nil, nil, common.ToPointer(time.Now().Add(-time.Hour)))
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+		_, err = ParseAPIKeyPrefix("Basic dXNlcjpwYXNz")
+		assert.ErrorIs(t, err, ErrInvalidAPIKey)
+	})
+
+	t.Run("should be valid for all the issuers of its tenant", func(t *testing.T) {
+		key, _, err := NewAPIKey("operator", RoleIssuer, &DefaultTenantID, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, DefaultTenantID, *key.TenantID)
+		assert.True(t, key.Grant().Allows(issuer, PermissionWrite))
+	})
+
+	t.Run("should be inactive once revoked or expired", func(t *testing.T) {
+		now := time.Now()
+		assert.True(t, key.Active(now))
+		assert.False(t, (&APIKey{ExpiresAt: common.ToPointer(now.Add(-time.Second))}).Active(now))
+		assert.False(t, (&APIKey{RevokedAt: &now}).Active(now))
+	})
+}
+
+func TestGrant_Allows(t *testing.T) {
+	const issuer, other = "did:iden3:issuer", "did:iden3:other"
+	type check struct {
+		issuer     string
+		permission Permission
+		allowed    bool
+	}
+	for _, tc := range []struct {
+		key    APIKey
+		checks []check
+	}{
+		{
+			key: APIKey{Role: RoleReadOnly, IssuerDIDs: []string{issuer}},
+			checks: []check{
+				{issuer, PermissionRead, true},
+				{issuer, PermissionRevoke, false},
+				{issuer, PermissionWrite, false},
+				{other, PermissionRead, false},
+				{"", PermissionRead, false},
+			},
+		},
+		{
+			key: APIKey{Role: RoleRevoker, IssuerDIDs: []string{issuer}},
+			checks: []check{
+				{issuer, PermissionRevoke, true},
+				{issuer, PermissionWrite, false},
+			},
+		},
+		{
+			key: APIKey{Role: RoleIssuer, IssuerDIDs: []string{issuer, other}},
+			checks: []check{
+				{issuer, PermissionWrite, true},
+				{other, PermissionRevoke, true},
+				{"", PermissionAdmin, false},
+			},
+		},
+		{
+			key: APIKey{Role: RoleAdmin},
+			checks: []check{
+				{issuer, PermissionWrite, true},
+				{"", PermissionAdmin, true},
+			},
+		},
+		{
+			key: APIKey{Role: RoleAdmin, IssuerDIDs: []string{issuer}},
+			checks: []check{
+				{issuer, PermissionWrite, true},
+				{"", PermissionAdmin, false},
+			},
+		},
+	} {
+		for _, c := range tc.checks {
+			assert.Equal(t, c.allowed, tc.key.Grant().Allows(c.issuer, c.permission), "%s %s %s", tc.key.Role, c.issuer, c.permission)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package domain
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// AuditEntity is the kind of entity changed by an audited operation
+type AuditEntity string
+
+// Audited entities
+const (
+	AuditEntityCredential    AuditEntity = "credential"
+	AuditEntityLink          AuditEntity = "link"
+	AuditEntityKey           AuditEntity = "key"
+	AuditEntitySchema        AuditEntity = "schema"
+	AuditEntityPaymentOption AuditEntity = "payment-option"
+)
+
+// AuditOperation is the state-changing operation performed on an entity
+type AuditOperation string
+
+// Audited operations
+const (
+	AuditOperationCreate AuditOperation = "create"
+	AuditOperationUpdate AuditOperation = "update"
+	AuditOperationRevoke AuditOperation = "revoke"
+	AuditOperationDelete AuditOperation = "delete"
+)
+
+// AuditActorSystem is the actor of the operations performed by the node itself, without a caller
+const AuditActorSystem = "system"
+
+// AuditFieldChange is the value of a field before and after an operation. Before is empty for new fields and after
+// is empty for removed fields.
+type AuditFieldChange struct {
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditDiff are the changed fields of an entity, by json field name
+type AuditDiff map[string]AuditFieldChange
+
+// AuditChange is a state-changing operation on an entity of an issuer
+type AuditChange struct {
+	Entity    AuditEntity
+	Operation AuditOperation
+	EntityID  string
+	Diff      AuditDiff
+}
+
+// NewAuditChange returns the change of the entity from before to after, as they are encoded in json. Before is nil
+// for created entities and after is nil for deleted ones.
+// It must be called before the entity is modified when before and after are the same value.
+func NewAuditChange(entity AuditEntity, operation AuditOperation, entityID string, before, after any) (*AuditChange, error) {
+	diff, err := newAuditDiff(before, after)
+	if err != nil {
+		return nil, fmt.Errorf("diffing %s %s: %w", entity, entityID, err)
+	}
+	return &AuditChange{Entity: entity, Operation: operation, EntityID: entityID, Diff: diff}, nil
+}
+
+func newAuditDiff(before, after any) (AuditDiff, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+	diff := AuditDiff{}
+	for name, value := range beforeFields {
+		if !bytes.Equal(value, afterFields[name]) {
+			diff[name] = AuditFieldChange{Before: value, After: afterFields[name]}
+		}
+	}
+	for name, value := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			diff[name] = AuditFieldChange{After: value}
+		}
+	}
+	return diff, nil
+}
+
+// auditFields returns the compacted json fields of the entity
+func auditFields(entity any) (map[string]json.RawMessage, error) {
+	if entity == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range fields {
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, value); err != nil {
+			return nil, err
+		}
+		if bytes.Equal(compacted.Bytes(), []byte("null")) {
+			delete(fields, name)
+			continue
+		}
+		fields[name] = compacted.Bytes()
+	}
+	return fields, nil
+}
+
+// AuditEntry is an entry of the audit log of an issuer. The entries of each issuer are numbered from 1 and chained:
+// the hash of an entry covers its content and the hash of the previous entry, so a modified, inserted or removed
+// entry breaks the chain.
+type AuditEntry struct {
+	IssuerDID   string
+	Seq         int64
+	Actor       string
+	ActorMethod string
+	Entity      AuditEntity
+	Operation   AuditOperation
+	EntityID    string
+	Diff        AuditDiff
+	RequestID   string
+	SourceIP    string
+	CreatedAt   time.Time
+	PrevHash    string
+	Hash        string
+}
+
+// Chain numbers the entry after prev, the last entry of the issuer or nil for the first one, and computes its hash
+func (e *AuditEntry) Chain(prev *AuditEntry) error {
+	e.Seq, e.PrevHash = 1, ""
+	if prev != nil {
+		e.Seq, e.PrevHash = prev.Seq+1, prev.Hash
+	}
+	// postgres keeps microseconds, the hash of the stored entry must be the same
+	e.CreatedAt = e.CreatedAt.UTC().Truncate(time.Microsecond)
+	hash, err := e.computeHash()
+	if err != nil {
+		return err
+	}
+	e.Hash = hash
+	return nil
+}
+
+// Follows tells whether the entry is the valid successor of prev, the previous entry of the issuer or nil for the
+// first one.
+func (e *AuditEntry) Follows(prev *AuditEntry) bool {
+	if prev == nil {
+		if e.Seq != 1 || e.PrevHash != "" {
+			return false
+		}
+	} else if e.IssuerDID != prev.IssuerDID || e.Seq != prev.Seq+1 || e.PrevHash != prev.Hash {
+		return false
+	}
+	hash, err := e.computeHash()
+	return err == nil && hash == e.Hash
+}
+
+// AuditHead is the last entry of the audit log of an issuer. It is stored apart from the log, so removing entries at
+// the end of the log, which leaves a valid chain, is detected.
+type AuditHead struct {
+	IssuerDID string
+	Seq       int64
+	Hash      string
+}
+
+func (e *AuditEntry) computeHash() (string, error) {
+	content, err := json.Marshal(struct {
+		IssuerDID   string         `json:"issuerDID"`
+		Seq         int64          `json:"seq"`
+		Actor       string         `json:"actor"`
+		ActorMethod string         `json:"actorMethod"`
+		Entity      AuditEntity    `json:"entity"`
+		Operation   AuditOperation `json:"operation"`
+		EntityID    string         `json:"entityID"`
+		Diff        AuditDiff      `json:"diff"`
+		RequestID   string         `json:"requestID"`
+		SourceIP    string         `json:"sourceIP"`
+		CreatedAt   string         `json:"createdAt"`
+		PrevHash    string         `json:"prevHash"`
+	}{e.IssuerDID, e.Seq, e.Actor, e.ActorMethod, e.Entity, e.Operation, e.EntityID, e.Diff, e.RequestID, e.SourceIP, e.CreatedAt.UTC().Format(time.RFC3339Nano), e.PrevHash})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:]), nil
+}
@@ -0,0 +1,106 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuditChange(t *testing.T) {
+	type entity struct {
+		Name   string  `json:"name"`
+		Active bool    `json:"active"`
+		Note   *string `json:"note"`
+	}
+	note := "first"
+
+	change, err := NewAuditChange(AuditEntityLink, AuditOperationCreate, "1", nil, entity{Name: "link", Active: true})
+	require.NoError(t, err)
+	assert.Equal(t, AuditDiff{
+		"name":   {After: json.RawMessage(`"link"`)},
+		"active": {After: json.RawMessage(`true`)},
+	}, change.Diff, "null fields are left out")
+
+	change, err = NewAuditChange(AuditEntityLink, AuditOperationUpdate, "1", entity{Name: "link", Active: true}, entity{Name: "link", Note: &note})
+	require.NoError(t, err)
+	assert.Equal(t, AuditDiff{
+		"active": {Before: json.RawMessage(`true`), After: json.RawMessage(`false`)},
+		"note":   {After: json.RawMessage(`"first"`)},
+	}, change.Diff, "unchanged fields are left out")
+
+	change, err = NewAuditChange(AuditEntityLink, AuditOperationDelete, "1", entity{Name: "link"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, AuditDiff{
+		"name":   {Before: json.RawMessage(`"link"`)},
+		"active": {Before: json.RawMessage(`false`)},
+	}, change.Diff)
+}
+
+func TestNewAuditChange_Credential(t *testing.T) {
+	claim := &Claim{
+		ID:              uuid.MustParse("8edd8112-c415-11ed-b036-debe37e1cbd6"),
+		SchemaURL:       "https://example.com/kyc.json",
+		SchemaType:      "KYCAgeCredential",
+		OtherIdentifier: "did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi",
+		RevNonce:        1,
+		Data:            pgtype.JSONB{Bytes: []byte(`{"credentialSubject":{"birthday":19960424}}`), Status: pgtype.Present},
+	}
+	before := claim.AuditView()
+	claim.Revoked = true
+	change, err := NewAuditChange(AuditEntityCredential, AuditOperationRevoke, claim.ID.String(), before, claim.AuditView())
+	require.NoError(t, err)
+	assert.Equal(t, AuditDiff{"revoked": {Before: json.RawMessage(`false`), After: json.RawMessage(`true`)}}, change.Diff)
+
+	change, err = NewAuditChange(AuditEntityCredential, AuditOperationCreate, claim.ID.String(), nil, claim.AuditView())
+	require.NoError(t, err)
+	assert.Contains(t, change.Diff, "schema_type")
+	for name, field := range change.Diff {
+		assert.NotContains(t, string(field.After), "19960424", name)
+		assert.NotContains(t, string(field.After), claim.OtherIdentifier, name)
+	}
+}
+
+func TestAuditEntry_Chain(t *testing.T) {
+	const issuer = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+	entries := make([]AuditEntry, 3)
+	var prev *AuditEntry
+	for i := range entries {
+		entries[i] = AuditEntry{
+			IssuerDID: issuer,
+			Actor:     "alice",
+			Entity:    AuditEntityCredential,
+			Operation: AuditOperationCreate,
+			EntityID:  "8edd8112-c415-11ed-b036-debe37e1cbd6",
+			Diff:      AuditDiff{"revoked": {After: json.RawMessage(`false`)}},
+			CreatedAt: time.Now(),
+		}
+		require.NoError(t, entries[i].Chain(prev))
+		assert.Equal(t, int64(i+1), entries[i].Seq)
+		assert.True(t, entries[i].Follows(prev))
+		prev = &entries[i]
+	}
+	assert.Empty(t, entries[0].PrevHash)
+	assert.Equal(t, entries[1].Hash, entries[2].PrevHash)
+
+	t.Run("should detect tampering", func(t *testing.T) {
+		modified := entries[1]
+		modified.Actor = "mallory"
+		assert.False(t, modified.Follows(&entries[0]), "modified entry")
+
+		modified = entries[1]
+		modified.Diff = AuditDiff{"revoked": {After: json.RawMessage(`true`)}}
+		assert.False(t, modified.Follows(&entries[0]), "modified diff")
+
+		assert.False(t, entries[2].Follows(&entries[0]), "removed entry")
+		assert.False(t, entries[1].Follows(nil), "first entry removed")
+
+		other := entries[1]
+		other.IssuerDID = "did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq"
+		assert.False(t, entries[2].Follows(&other), "entry of another issuer")
+	})
+}
@@ -52,6 +52,35 @@ type Claim struct {
 // Credentials is the type of array of credential
 type Credentials []*Claim
 
+// ClaimAuditView is the metadata of a credential kept in the audit log
+type ClaimAuditView struct {
+	ID         uuid.UUID       `json:"id"`
+	SchemaURL  string          `json:"schema_url"`
+	SchemaType string          `json:"schema_type"`
+	Expiration int64           `json:"expiration"`
+	Version    uint32          `json:"version"`
+	RevNonce   RevNonceUint64  `json:"rev_nonce"`
+	Revoked    bool            `json:"revoked"`
+	Status     *IdentityStatus `json:"status"`
+	LinkID     *uuid.UUID      `json:"link_id"`
+}
+
+// AuditView returns the metadata of the credential to audit. The audit log is kept forever and can't be modified,
+// so the holder, the credential subject and the proofs are left out.
+func (c *Claim) AuditView() ClaimAuditView {
+	return ClaimAuditView{
+		ID:         c.ID,
+		SchemaURL:  c.SchemaURL,
+		SchemaType: c.SchemaType,
+		Expiration: c.Expiration,
+		Version:    c.Version,
+		RevNonce:   c.RevNonce,
+		Revoked:    c.Revoked,
+		Status:     c.Status,
+		LinkID:     c.LinkID,
+	}
+}
+
 // FromClaimer TODO add description
 func FromClaimer(claim *core.Claim, schemaURL, schemaType string) (*Claim, error) {
 	otherIdentifier := ""
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CredentialFormat is the format a credential is delivered in
+type CredentialFormat string
+
+const (
+	// CredentialFormatIden3 is the iden3 W3C credential (Data Model 1.1) with BJJ signature and SMT proofs
+	CredentialFormatIden3 CredentialFormat = "iden3"
+	// CredentialFormatJWT is a W3C credential (Data Model 2.0) secured as a JWT signed with ES256K
+	CredentialFormatJWT CredentialFormat = "jwt_vc_json"
+	// CredentialFormatSDJWT is an SD-JWT VC signed with ES256K, with selectively disclosable subject attributes
+	CredentialFormatSDJWT CredentialFormat = "vc+sd-jwt"
+)
+
+// IsValid returns true if the format is supported
+func (f CredentialFormat) IsValid() bool {
+	switch f {
+	case CredentialFormatIden3, CredentialFormatJWT, CredentialFormatSDJWT:
+		return true
+	}
+	return false
+}
+
+// EncodedCredential is a credential encoded in one of the JWT based formats. The encoding is kept so
+// every fetch returns the same signed token.
+type EncodedCredential struct {
+	ID         uuid.UUID // id of the credential in the claims table
+	Identifier string
+	Format     CredentialFormat
+	Credential string
+	CreatedAt  time.Time
+}
@@ -5,6 +5,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
@@ -24,6 +25,7 @@ var ErrInvalidIdentifier = errors.New("invalid identifier")
 // Identity struct
 type Identity struct {
 	Identifier                    string
+	TenantID                      uuid.UUID `json:"tenantID"`
 	State                         IdentityState
 	DisplayName                   *string                       `json:"displayName"`
 	Relay                         string                        `json:"relay"`
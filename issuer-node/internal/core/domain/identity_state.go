@@ -34,6 +34,7 @@ type IdentityState struct {
 	BlockTimestamp     *int           `json:"block_timestamp,omitempty"`
 	BlockNumber        *int           `json:"block_number,omitempty"`
 	TxID               *string        `json:"tx_id,omitempty"`
+	RelayTxID          *string        `json:"relay_tx_id,omitempty"`
 	PreviousState      *string        `json:"previous_state,omitempty"`
 	Status             IdentityStatus `json:"status,omitempty"`
 	ModifiedAt         time.Time      `json:"modified_at,omitempty"`
@@ -78,6 +78,8 @@ type Link struct {
 	IssuedClaims                int // TODO: Give a value when link redemption is implemented
 	RefreshService              *verifiable.RefreshService
 	DisplayMethod               *verifiable.DisplayMethod
+	CredentialFormat            CredentialFormat
+	CredentialStatusType        *verifiable.CredentialStatusType
 	AuthorizationRequestMessage *pgtype.JSONB `json:"authorization_request_message"`
 	DeepLink                    string
 	UniversalLink               string
@@ -110,6 +112,7 @@ func NewLink(
 		IssuedClaims:             0,
 		RefreshService:           refreshService,
 		DisplayMethod:            displayMethod,
+		CredentialFormat:         CredentialFormatIden3,
 	}
 }
 
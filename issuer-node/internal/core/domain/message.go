@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MessageDirection tells if an iden3comm message was sent or received by the issuer
+type MessageDirection string
+
+const (
+	// MessageDirectionOutbound is a message sent by the issuer to a holder
+	MessageDirectionOutbound MessageDirection = "outbound"
+	// MessageDirectionInbound is a message received by the issuer from a holder
+	MessageDirectionInbound MessageDirection = "inbound"
+)
+
+// MessageStatus represents the delivery status of an iden3comm message
+type MessageStatus string
+
+const (
+	// MessageStatusQueued is an outbound message waiting to be delivered
+	MessageStatusQueued MessageStatus = "queued"
+	// MessageStatusSent is an outbound message delivered to the holder service endpoint
+	MessageStatusSent MessageStatus = "sent"
+	// MessageStatusAcknowledged is an outbound message the holder has replied to in the same thread
+	MessageStatusAcknowledged MessageStatus = "acknowledged"
+	// MessageStatusFailed is an outbound message that could not be delivered after all the attempts
+	MessageStatusFailed MessageStatus = "failed"
+	// MessageStatusReceived is an inbound message
+	MessageStatusReceived MessageStatus = "received"
+)
+
+// Message is an iden3comm message exchanged between an issuer and a holder
+type Message struct {
+	ID            uuid.UUID
+	IssuerDID     string
+	UserDID       string
+	Direction     MessageDirection
+	MessageID     string
+	ThreadID      string
+	Type          string
+	Message       json.RawMessage
+	Status        MessageStatus
+	Attempts      int
+	NextAttemptAt *time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	ModifiedAt    time.Time
+}
+
+// NewOutboundMessage creates a new queued message from the issuer to the holder
+func NewOutboundMessage(issuerDID string, userDID string, messageID string, threadID string, msgType string, message json.RawMessage) *Message {
+	now := time.Now()
+	return &Message{
+		ID:            uuid.New(),
+		IssuerDID:     issuerDID,
+		UserDID:       userDID,
+		Direction:     MessageDirectionOutbound,
+		MessageID:     messageID,
+		ThreadID:      threadID,
+		Type:          msgType,
+		Message:       message,
+		Status:        MessageStatusQueued,
+		NextAttemptAt: &now,
+	}
+}
+
+// NewInboundMessage creates a new message received by the issuer from the holder
+func NewInboundMessage(issuerDID string, userDID string, messageID string, threadID string, msgType string, message json.RawMessage) *Message {
+	return &Message{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		UserDID:   userDID,
+		Direction: MessageDirectionInbound,
+		MessageID: messageID,
+		ThreadID:  threadID,
+		Type:      msgType,
+		Message:   message,
+		Status:    MessageStatusReceived,
+	}
+}
+
+// Conversation holds the messages exchanged between an issuer and a holder in the same thread
+type Conversation struct {
+	ThreadID string
+	Messages []Message
+}
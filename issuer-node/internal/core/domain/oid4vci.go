@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OID4VCIOffer is an OpenID4VCI credential offer of a link with the pre-authorized code flow.
+// Only the hashes of the pre-authorized code and the access token are kept.
+type OID4VCIOffer struct {
+	ID                    uuid.UUID
+	IssuerDID             string
+	LinkID                uuid.UUID
+	PreAuthorizedCodeHash string
+	CodeExpiresAt         time.Time
+	AccessTokenHash       *string
+	TokenExpiresAt        *time.Time
+	CNonce                *string
+	HolderDID             *string
+	CredentialID          *uuid.UUID
+	CreatedAt             time.Time
+	ModifiedAt            time.Time
+}
+
+// Redeemed returns true if the pre-authorized code has been exchanged for an access token
+func (o *OID4VCIOffer) Redeemed() bool {
+	return o.AccessTokenHash != nil
+}
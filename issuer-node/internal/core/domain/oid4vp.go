@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vp"
+)
+
+// OID4VPSessionStatus represents the status of an OpenID4VP presentation session
+type OID4VPSessionStatus string
+
+const (
+	// OID4VPSessionStatusPending is a session waiting for the wallet response
+	OID4VPSessionStatusPending OID4VPSessionStatus = "pending"
+	// OID4VPSessionStatusVerified is a session whose presentation has been verified
+	OID4VPSessionStatusVerified OID4VPSessionStatus = "verified"
+	// OID4VPSessionStatusRejected is a session whose presentation could not be verified
+	OID4VPSessionStatusRejected OID4VPSessionStatus = "rejected"
+)
+
+// OID4VPSession is a presentation request of the identity, acting as verifier, and the result of its verification
+type OID4VPSession struct {
+	ID                     uuid.UUID
+	VerifierDID            string
+	Nonce                  string
+	State                  string
+	Format                 CredentialFormat
+	PresentationDefinition oid4vp.PresentationDefinition
+	Status                 OID4VPSessionStatus
+	Holder                 *string
+	Credentials            []OID4VPCredential
+	Error                  *string
+	CreatedAt              time.Time
+	VerifiedAt             *time.Time
+}
+
+// OID4VPCredential is a verified credential of a presentation with the claims the holder disclosed
+type OID4VPCredential struct {
+	InputDescriptorID string
+	ID                string
+	Issuer            string
+	Type              string
+	Format            CredentialFormat
+	Claims            map[string]interface{}
+}
+
+// Pending returns true while the session waits for the wallet response
+func (s *OID4VPSession) Pending() bool {
+	return s.Status == OID4VPSessionStatusPending
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OnchainCredentialStatus represents the status of a credential added to the claims tree of an identity contract
+type OnchainCredentialStatus string
+
+const (
+	// OnchainCredentialStatusPending is a credential whose addClaimHashAndTransit transaction has not been mined yet
+	OnchainCredentialStatusPending OnchainCredentialStatus = "pending"
+	// OnchainCredentialStatusPublished is a credential already in the claims tree of the identity contract
+	OnchainCredentialStatusPublished OnchainCredentialStatus = "published"
+	// OnchainCredentialStatusFailed is a credential whose transaction was reverted
+	OnchainCredentialStatusFailed OnchainCredentialStatus = "failed"
+)
+
+// OnchainIssuer is an identity contract (OnchainIdentity) whose owner is the ethereum key of an issuer identity.
+// The credentials of an onchain issuer are added to the claims tree of the contract instead of being kept by the issuer node.
+type OnchainIssuer struct {
+	ID              uuid.UUID
+	Identifier      string
+	ControllerDID   string
+	ContractAddress string
+	ChainID         int64
+	Blockchain      string
+	Network         string
+	CreatedAt       time.Time
+}
+
+// ResolverPrefix returns the network resolver prefix of the onchain issuer
+func (o *OnchainIssuer) ResolverPrefix() string {
+	return o.Blockchain + ":" + o.Network
+}
+
+// OnchainCredential keeps track of the transaction that adds a credential to the claims tree of an onchain issuer
+type OnchainCredential struct {
+	ID              uuid.UUID
+	OnchainIssuerID uuid.UUID
+	UserDID         string
+	TxID            string
+	Status          OnchainCredentialStatus
+	CreatedAt       time.Time
+	ModifiedAt      time.Time
+}
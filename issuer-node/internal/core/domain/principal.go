@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Role is the role granted to a caller of the API
+type Role string
+
+// Roles. A read-only caller can only read, a revoker can also revoke credentials, an issuer can do anything on its
+// issuers, and an admin can also manage the API keys.
+const (
+	RoleReadOnly Role = "read-only"
+	RoleRevoker  Role = "revoker"
+	RoleIssuer   Role = "issuer"
+	RoleAdmin    Role = "admin"
+)
+
+// Valid tells whether the role is known
+func (r Role) Valid() bool {
+	switch r {
+	case RoleReadOnly, RoleRevoker, RoleIssuer, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+// Permission is the permission required by an operation of the API
+type Permission string
+
+// API permissions
+const (
+	PermissionRead   Permission = "read"
+	PermissionRevoke Permission = "revoke"
+	PermissionWrite  Permission = "write"
+	PermissionAdmin  Permission = "admin"
+)
+
+// Authentication methods of the principals
+const (
+	AuthMethodBasic  = "basic"
+	AuthMethodAPIKey = "api-key"
+	AuthMethodJWT    = "jwt"
+)
+
+// Grant is a role on some issuers of the node. A grant without issuers is valid for all the issuers and for the
+// endpoints of the node, or for all the issuers of the tenant of the principal.
+type Grant struct {
+	Role       Role
+	IssuerDIDs []string
+}
+
+// Allows tells whether the grant gives the permission on the issuer. An empty issuer is an endpoint of the node.
+func (g Grant) Allows(issuerDID string, permission Permission) bool {
+	if len(g.IssuerDIDs) > 0 && (issuerDID == "" || !slices.Contains(g.IssuerDIDs, issuerDID)) {
+		return false
+	}
+	switch g.Role {
+	case RoleAdmin:
+		return true
+	case RoleIssuer:
+		return permission != PermissionAdmin
+	case RoleRevoker:
+		return permission == PermissionRead || permission == PermissionRevoke
+	case RoleReadOnly:
+		return permission == PermissionRead
+	}
+	return false
+}
+
+// Principal is the authenticated caller of the API. Principals with a tenant can only act on the issuers of their
+// tenant, principals without tenant act on the whole node.
+type Principal struct {
+	ID       string // basic auth user, API key id or token subject
+	Name     string
+	Method   string
+	TenantID *uuid.UUID
+	Grants   []Grant
+}
+
+// InTenant tells whether the principal can act on the issuers of the tenant
+func (p *Principal) InTenant(tenantID uuid.UUID) bool {
+	return p.TenantID == nil || *p.TenantID == tenantID
+}
+
+// Allows tells whether any grant of the principal gives the permission on the issuer
+func (p *Principal) Allows(issuerDID string, permission Permission) bool {
+	for _, grant := range p.Grants {
+		if grant.Allows(issuerDID, permission) {
+			return true
+		}
+	}
+	return false
+}
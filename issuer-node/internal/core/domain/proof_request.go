@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProofRequestStatus represents the status of a proof request sent by the issuer to a connection
+type ProofRequestStatus string
+
+const (
+	// ProofRequestStatusPending is a proof request waiting for the user response
+	ProofRequestStatusPending ProofRequestStatus = "pending"
+	// ProofRequestStatusVerified is a proof request whose response has been verified
+	ProofRequestStatusVerified ProofRequestStatus = "verified"
+	// ProofRequestStatusRejected is a proof request rejected by a response that could not be verified. Failed
+	// responses no longer reject the proof request, so anyone knowing its id can't answer it for the user.
+	ProofRequestStatusRejected ProofRequestStatus = "rejected"
+	// ProofRequestStatusFailed is a proof request that could not be queued for delivery to the user
+	ProofRequestStatusFailed ProofRequestStatus = "failed"
+)
+
+// ProofRequest is an iden3comm authorization request with zero knowledge scopes sent by the issuer to an existing connection
+type ProofRequest struct {
+	ID           uuid.UUID
+	ConnectionID uuid.UUID
+	IssuerDID    string
+	UserDID      string
+	ThreadID     string
+	Reason       string
+	Request      json.RawMessage
+	Status       ProofRequestStatus
+	Response     json.RawMessage
+	Error        *string
+	VerifiedAt   *time.Time
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
+}
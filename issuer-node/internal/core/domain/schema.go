@@ -64,4 +64,5 @@ type Schema struct {
 	Words           SchemaWords
 	DisplayMethodID *uuid.UUID
 	CreatedAt       time.Time
+	Snapshots       []SchemaSnapshot // snapshots of the JSON schema and JSON-LD context, when loaded
 }
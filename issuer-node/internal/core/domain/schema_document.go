@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// SchemaDocument is a JSON schema and its JSON-LD context built by the node for a new credential type.
+// URL and ContextURL are where they are published, either by the node itself or pinned in IPFS.
+type SchemaDocument struct {
+	ID            uuid.UUID
+	IssuerDID     w3c.DID
+	Type          string
+	Version       string
+	Title         *string
+	Description   *string
+	JSONSchema    []byte
+	JSONLdContext []byte
+	URL           string
+	ContextURL    string
+	CreatedAt     time.Time
+}
@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// SchemaFamily groups the schemas that are versions of the same credential type
+type SchemaFamily struct {
+	ID        uuid.UUID
+	IssuerDID w3c.DID
+	Name      string
+	Versions  []SchemaFamilyVersion // ordered by position, oldest first
+	CreatedAt time.Time
+}
+
+// SchemaFamilyVersion is a schema in a family. Mapping transforms the credential subjects of the previous version
+// into subjects of this one, and it is empty for the first version.
+type SchemaFamilyVersion struct {
+	SchemaID  uuid.UUID
+	Position  int
+	Mapping   SchemaMapping
+	CreatedAt time.Time
+}
+
+// Version returns the version of the family with the given schema
+func (f *SchemaFamily) Version(schemaID uuid.UUID) (*SchemaFamilyVersion, bool) {
+	for i := range f.Versions {
+		if f.Versions[i].SchemaID == schemaID {
+			return &f.Versions[i], true
+		}
+	}
+	return nil, false
+}
+
+// Mapping returns the rules of every version after from up to to, so subjects of the schema from become subjects of
+// the schema to. from must be an older version than to.
+func (f *SchemaFamily) Mapping(from uuid.UUID, to uuid.UUID) (SchemaMapping, error) {
+	fromVersion, ok := f.Version(from)
+	if !ok {
+		return nil, fmt.Errorf("%w: schema %s is not a version of family %s", ErrInvalidSchemaMapping, from, f.ID)
+	}
+	toVersion, ok := f.Version(to)
+	if !ok {
+		return nil, fmt.Errorf("%w: schema %s is not a version of family %s", ErrInvalidSchemaMapping, to, f.ID)
+	}
+	if fromVersion.Position >= toVersion.Position {
+		return nil, fmt.Errorf("%w: schema %s is not older than schema %s", ErrInvalidSchemaMapping, from, to)
+	}
+	mapping := make(SchemaMapping, 0)
+	for _, version := range f.Versions {
+		if version.Position > fromVersion.Position && version.Position <= toVersion.Position {
+			mapping = append(mapping, version.Mapping...)
+		}
+	}
+	return mapping, nil
+}
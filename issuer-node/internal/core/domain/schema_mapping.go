@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// SchemaMappingOp is the operation of a SchemaMappingRule
+type SchemaMappingOp string
+
+const (
+	// SchemaMappingRename moves the value of Field to To
+	SchemaMappingRename SchemaMappingOp = "rename"
+	// SchemaMappingDefault sets Field to Value when the subject doesn't have it
+	SchemaMappingDefault SchemaMappingOp = "default"
+	// SchemaMappingDrop removes Field
+	SchemaMappingDrop SchemaMappingOp = "drop"
+	// SchemaMappingTransform replaces the value of Field with the result of Transform
+	SchemaMappingTransform SchemaMappingOp = "transform"
+)
+
+// SchemaMappingTransformFunc is the function applied by a transform rule
+type SchemaMappingTransformFunc string
+
+// Transforms supported by the transform rules
+const (
+	SchemaMappingToString  SchemaMappingTransformFunc = "string"
+	SchemaMappingToInteger SchemaMappingTransformFunc = "integer"
+	SchemaMappingToNumber  SchemaMappingTransformFunc = "number"
+	SchemaMappingToBoolean SchemaMappingTransformFunc = "boolean"
+	SchemaMappingLowercase SchemaMappingTransformFunc = "lowercase"
+	SchemaMappingUppercase SchemaMappingTransformFunc = "uppercase"
+	SchemaMappingValues    SchemaMappingTransformFunc = "values" // replaces the value with the one for it in Values
+)
+
+// ErrInvalidSchemaMapping is returned when the rules of a mapping can't be applied
+var ErrInvalidSchemaMapping = errors.New("invalid schema mapping")
+
+// SchemaMappingRule is a change of a credential subject attribute between two versions of a schema
+type SchemaMappingRule struct {
+	Op        SchemaMappingOp            `json:"op"`
+	Field     string                     `json:"field"`
+	To        string                     `json:"to,omitempty"`
+	Value     any                        `json:"value,omitempty"`
+	Transform SchemaMappingTransformFunc `json:"transform,omitempty"`
+	Values    map[string]any             `json:"values,omitempty"`
+}
+
+// SchemaMapping transforms the credential subject of a schema version into one of the next version.
+// The rules are applied in order.
+type SchemaMapping []SchemaMappingRule
+
+// Validate checks every rule has the arguments of its operation. The id of the subject can't be changed.
+func (m SchemaMapping) Validate() error {
+	for i, rule := range m {
+		if rule.Field == "" || rule.Field == "id" {
+			return fmt.Errorf("%w: rule %d: invalid field <%s>", ErrInvalidSchemaMapping, i, rule.Field)
+		}
+		switch rule.Op {
+		case SchemaMappingRename:
+			if rule.To == "" || rule.To == "id" || rule.To == rule.Field {
+				return fmt.Errorf("%w: rule %d: invalid rename target <%s>", ErrInvalidSchemaMapping, i, rule.To)
+			}
+		case SchemaMappingDefault:
+			if rule.Value == nil {
+				return fmt.Errorf("%w: rule %d: default requires a value", ErrInvalidSchemaMapping, i)
+			}
+		case SchemaMappingDrop:
+		case SchemaMappingTransform:
+			switch rule.Transform {
+			case SchemaMappingToString, SchemaMappingToInteger, SchemaMappingToNumber, SchemaMappingToBoolean,
+				SchemaMappingLowercase, SchemaMappingUppercase:
+			case SchemaMappingValues:
+				if len(rule.Values) == 0 {
+					return fmt.Errorf("%w: rule %d: values transform requires values", ErrInvalidSchemaMapping, i)
+				}
+			default:
+				return fmt.Errorf("%w: rule %d: unsupported transform <%s>", ErrInvalidSchemaMapping, i, rule.Transform)
+			}
+		default:
+			return fmt.Errorf("%w: rule %d: unsupported operation <%s>", ErrInvalidSchemaMapping, i, rule.Op)
+		}
+	}
+	return nil
+}
+
+// Apply returns a copy of the subject with the rules applied. It fails when a value can't be transformed.
+func (m SchemaMapping) Apply(subject map[string]any) (map[string]any, error) {
+	mapped := make(map[string]any, len(subject))
+	for k, v := range subject {
+		mapped[k] = v
+	}
+	for _, rule := range m {
+		value, ok := mapped[rule.Field]
+		switch rule.Op {
+		case SchemaMappingRename:
+			if ok {
+				delete(mapped, rule.Field)
+				mapped[rule.To] = value
+			}
+		case SchemaMappingDefault:
+			if !ok || value == nil {
+				mapped[rule.Field] = rule.Value
+			}
+		case SchemaMappingDrop:
+			delete(mapped, rule.Field)
+		case SchemaMappingTransform:
+			if !ok {
+				continue
+			}
+			transformed, err := rule.transform(value)
+			if err != nil {
+				return nil, fmt.Errorf("transforming <%s>: %w", rule.Field, err)
+			}
+			mapped[rule.Field] = transformed
+		}
+	}
+	return mapped, nil
+}
+
+func (rule SchemaMappingRule) transform(value any) (any, error) {
+	text := fmt.Sprint(value)
+	switch rule.Transform {
+	case SchemaMappingToString:
+		return text, nil
+	case SchemaMappingToInteger:
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil || n != math.Trunc(n) {
+			return nil, fmt.Errorf("<%v> is not an integer", value)
+		}
+		return int64(n), nil
+	case SchemaMappingToNumber:
+		n, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("<%v> is not a number", value)
+		}
+		return n, nil
+	case SchemaMappingToBoolean:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return nil, fmt.Errorf("<%v> is not a boolean", value)
+		}
+		return b, nil
+	case SchemaMappingLowercase:
+		return strings.ToLower(text), nil
+	case SchemaMappingUppercase:
+		return strings.ToUpper(text), nil
+	case SchemaMappingValues:
+		mapped, ok := rule.Values[text]
+		if !ok {
+			return nil, fmt.Errorf("no value for <%v>", value)
+		}
+		return mapped, nil
+	}
+	return nil, fmt.Errorf("%w: unsupported transform <%s>", ErrInvalidSchemaMapping, rule.Transform)
+}
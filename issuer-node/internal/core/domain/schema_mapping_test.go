@@ -0,0 +1,82 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemaMapping_Apply(t *testing.T) {
+	mapping := SchemaMapping{
+		{Op: SchemaMappingRename, Field: "company", To: "employer"},
+		{Op: SchemaMappingDefault, Field: "country", Value: "ES"},
+		{Op: SchemaMappingDrop, Field: "legacyCode"},
+		{Op: SchemaMappingTransform, Field: "seniority", Transform: SchemaMappingToInteger},
+		{Op: SchemaMappingTransform, Field: "level", Transform: SchemaMappingValues, Values: map[string]any{"jr": "junior", "sr": "senior"}},
+		{Op: SchemaMappingTransform, Field: "email", Transform: SchemaMappingLowercase},
+	}
+	require.NoError(t, mapping.Validate())
+
+	subject := map[string]any{"id": "did:iden3:subject", "company": "Acme", "legacyCode": 7, "seniority": "3", "level": "sr", "email": "Jane@Acme.COM"}
+	mapped, err := mapping.Apply(subject)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"id": "did:iden3:subject", "employer": "Acme", "country": "ES", "seniority": int64(3), "level": "senior", "email": "jane@acme.com"}, mapped)
+	assert.Equal(t, "Acme", subject["company"], "the subject is not modified")
+
+	t.Run("should keep present values on default", func(t *testing.T) {
+		mapped, err := mapping.Apply(map[string]any{"country": "FR"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]any{"country": "FR"}, mapped)
+	})
+
+	t.Run("should fail when a value can't be transformed", func(t *testing.T) {
+		_, err := mapping.Apply(map[string]any{"seniority": "3.5"})
+		assert.Error(t, err)
+		_, err = mapping.Apply(map[string]any{"level": "principal"})
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject invalid rules", func(t *testing.T) {
+		for _, invalid := range []SchemaMapping{
+			{{Op: SchemaMappingRename, Field: "company"}},
+			{{Op: SchemaMappingRename, Field: "company", To: "id"}},
+			{{Op: SchemaMappingDrop, Field: "id"}},
+			{{Op: SchemaMappingDefault, Field: "country"}},
+			{{Op: SchemaMappingTransform, Field: "level", Transform: "reverse"}},
+			{{Op: SchemaMappingTransform, Field: "level", Transform: SchemaMappingValues}},
+			{{Op: "copy", Field: "level"}},
+		} {
+			assert.ErrorIs(t, invalid.Validate(), ErrInvalidSchemaMapping, invalid)
+		}
+	})
+}
+
+func TestSchemaFamily_Mapping(t *testing.T) {
+	v1, v2, v3 := uuid.New(), uuid.New(), uuid.New()
+	family := SchemaFamily{
+		ID: uuid.New(),
+		Versions: []SchemaFamilyVersion{
+			{SchemaID: v1, Position: 1},
+			{SchemaID: v2, Position: 2, Mapping: SchemaMapping{{Op: SchemaMappingRename, Field: "company", To: "employer"}}},
+			{SchemaID: v3, Position: 3, Mapping: SchemaMapping{{Op: SchemaMappingDrop, Field: "legacyCode"}}},
+		},
+	}
+
+	mapping, err := family.Mapping(v1, v3)
+	require.NoError(t, err)
+	assert.Equal(t, SchemaMapping{
+		{Op: SchemaMappingRename, Field: "company", To: "employer"},
+		{Op: SchemaMappingDrop, Field: "legacyCode"},
+	}, mapping)
+
+	mapping, err = family.Mapping(v2, v3)
+	require.NoError(t, err)
+	assert.Len(t, mapping, 1)
+
+	_, err = family.Mapping(v3, v1)
+	assert.ErrorIs(t, err, ErrInvalidSchemaMapping)
+	_, err = family.Mapping(v1, uuid.New())
+	assert.ErrorIs(t, err, ErrInvalidSchemaMapping)
+}
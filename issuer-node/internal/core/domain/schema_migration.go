@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// SchemaMigrationStatus is the status of a SchemaMigration
+type SchemaMigrationStatus string
+
+const (
+	// SchemaMigrationPending is a migration waiting for the migration job
+	SchemaMigrationPending SchemaMigrationStatus = "pending"
+	// SchemaMigrationRunning is a migration being processed by the migration job
+	SchemaMigrationRunning SchemaMigrationStatus = "running"
+	// SchemaMigrationDone is a processed migration. Some credentials may have failed, see Failures.
+	SchemaMigrationDone SchemaMigrationStatus = "done"
+	// SchemaMigrationFailed is a migration that could not be processed, see Error
+	SchemaMigrationFailed SchemaMigrationStatus = "failed"
+)
+
+// SchemaMigration re-issues the active credentials of a schema under a newer version of its family and revokes
+// the old ones. A dry run only reports the credentials whose subjects fail the validation of the new schema.
+type SchemaMigration struct {
+	ID           uuid.UUID
+	IssuerDID    w3c.DID
+	FamilyID     uuid.UUID
+	FromSchemaID uuid.UUID
+	ToSchemaID   uuid.UUID
+	DryRun       bool
+	Status       SchemaMigrationStatus
+	Total        int // active credentials of the old schema
+	Migrated     int // credentials re-issued, or that would be in a dry run
+	Failures     []SchemaMigrationFailure
+	Error        *string
+	CreatedAt    time.Time
+	ModifiedAt   time.Time
+}
+
+// SchemaMigrationFailure is a credential that can't be migrated
+type SchemaMigrationFailure struct {
+	CredentialID uuid.UUID `json:"credentialId"`
+	Subject      string    `json:"subject"`
+	Error        string    `json:"error"`
+}
+
+// NewSchemaMigration creates a pending migration
+func NewSchemaMigration(issuerDID w3c.DID, familyID uuid.UUID, fromSchemaID uuid.UUID, toSchemaID uuid.UUID, dryRun bool) *SchemaMigration {
+	now := time.Now()
+	return &SchemaMigration{
+		ID:           uuid.New(),
+		IssuerDID:    issuerDID,
+		FamilyID:     familyID,
+		FromSchemaID: fromSchemaID,
+		ToSchemaID:   toSchemaID,
+		DryRun:       dryRun,
+		Status:       SchemaMigrationPending,
+		Failures:     []SchemaMigrationFailure{},
+		CreatedAt:    now,
+		ModifiedAt:   now,
+	}
+}
+
+// Fail records a credential that can't be migrated
+func (m *SchemaMigration) Fail(credentialID uuid.UUID, subject string, err error) {
+	m.Failures = append(m.Failures, SchemaMigrationFailure{CredentialID: credentialID, Subject: subject, Error: err.Error()})
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// SchemaSnapshot is the content of a JSON schema or JSON-LD context as it was fetched when a schema using it was
+// imported. Documents are resolved from their snapshot, so changes of the remote content don't change the
+// credentials issued with the schema. RemoteDigest is the digest of the remote content the last time it was checked.
+type SchemaSnapshot struct {
+	URL          string
+	Digest       string
+	Content      []byte
+	RemoteDigest *string
+	CheckedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// NewSchemaSnapshot returns the snapshot of the content of the document at url
+func NewSchemaSnapshot(url string, content []byte) *SchemaSnapshot {
+	return &SchemaSnapshot{
+		URL:       url,
+		Digest:    SchemaSnapshotDigest(content),
+		Content:   content,
+		CreatedAt: time.Now(),
+	}
+}
+
+// SchemaSnapshotDigest returns the hex encoded sha256 of the content of a document
+func SchemaSnapshotDigest(content []byte) string {
+	digest := sha256.Sum256(content)
+	return hex.EncodeToString(digest[:])
+}
+
+// Valid returns true when the content matches the digest of the snapshot
+func (s *SchemaSnapshot) Valid() bool {
+	return SchemaSnapshotDigest(s.Content) == s.Digest
+}
+
+// Drifted returns true when the remote content has changed since the snapshot was taken
+func (s *SchemaSnapshot) Drifted() bool {
+	return s.RemoteDigest != nil && *s.RemoteDigest != s.Digest
+}
@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusList is a bitstring status list of an issuer. Credentials get the next free index of the current list of
+// their issuer, and the list credential is signed again every time one of them is revoked.
+type StatusList struct {
+	ID         uuid.UUID
+	Identifier string
+	Purpose    string
+	Size       int
+	NextIndex  int
+	Bitstring  []byte
+	Credential string // status list credential as a JWT-VC signed by the issuer
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// Full returns true when every index of the list has been allocated
+func (l *StatusList) Full() bool {
+	return l.NextIndex >= l.Size
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTenantID is the tenant of the identities created before tenants existed and of the ones created by the
+// callers of the node without tenant
+var DefaultTenantID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+// ErrInvalidTenant is returned when a tenant has an invalid configuration
+var ErrInvalidTenant = errors.New("invalid tenant")
+
+// Tenant owns a set of issuer identities and the API keys that act on them. Its quotas limit the identities it can
+// create and the credentials its issuers can issue every month, without limit when they are nil. The issuer name and
+// logo override the ones of the node configuration for its issuers.
+type Tenant struct {
+	ID                     uuid.UUID
+	Name                   string
+	MaxIdentities          *int
+	MaxCredentialsPerMonth *int
+	IssuerName             *string
+	IssuerLogo             *string
+	CreatedAt              time.Time
+}
+
+// NewTenant creates a tenant without quotas nor configuration overrides
+func NewTenant(name string) (*Tenant, error) {
+	tenant := &Tenant{ID: uuid.New(), Name: strings.TrimSpace(name), CreatedAt: time.Now()}
+	if err := tenant.Validate(); err != nil {
+		return nil, err
+	}
+	return tenant, nil
+}
+
+// Validate checks the name and the quotas of the tenant
+func (t *Tenant) Validate() error {
+	if t.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidTenant)
+	}
+	if t.MaxIdentities != nil && *t.MaxIdentities < 0 {
+		return fmt.Errorf("%w: max identities can't be negative", ErrInvalidTenant)
+	}
+	if t.MaxCredentialsPerMonth != nil && *t.MaxCredentialsPerMonth < 0 {
+		return fmt.Errorf("%w: max credentials per month can't be negative", ErrInvalidTenant)
+	}
+	return nil
+}
+
+// IssuerDisplay returns the name and the logo of the issuers of the tenant, the ones of the node unless the tenant
+// overrides them
+func (t *Tenant) IssuerDisplay(name, logo string) (string, string) {
+	if t.IssuerName != nil {
+		name = *t.IssuerName
+	}
+	if t.IssuerLogo != nil {
+		logo = *t.IssuerLogo
+	}
+	return name, logo
+}
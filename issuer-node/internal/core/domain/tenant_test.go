@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+)
+
+func TestNewTenant(t *testing.T) {
+	tenant, err := NewTenant("  acme ")
+	require.NoError(t, err)
+	assert.Equal(t, "acme", tenant.Name)
+	assert.Nil(t, tenant.MaxIdentities)
+
+	_, err = NewTenant(" ")
+	assert.ErrorIs(t, err, ErrInvalidTenant)
+	tenant.MaxCredentialsPerMonth = common.ToPointer(-1)
+	assert.ErrorIs(t, tenant.Validate(), ErrInvalidTenant)
+}
+
+func TestTenant_IssuerDisplay(t *testing.T) {
+	tenant := &Tenant{Name: "acme", IssuerName: common.ToPointer("Acme")}
+	name, logo := tenant.IssuerDisplay("node", "https://node/logo.png")
+	assert.Equal(t, "Acme", name)
+	assert.Equal(t, "https://node/logo.png", logo)
+}
+
+func TestPrincipal_InTenant(t *testing.T) {
+	tenantID := uuid.New()
+	node := &Principal{ID: "admin"}
+	assert.True(t, node.InTenant(tenantID), "principals without tenant act on every tenant")
+	principal := &Principal{ID: "operator", TenantID: &tenantID}
+	assert.True(t, principal.InTenant(tenantID))
+	assert.False(t, principal.InTenant(DefaultTenantID))
+}
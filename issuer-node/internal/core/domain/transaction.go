@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TransactionType represents the kind of on-chain transaction
+type TransactionType string
+
+const (
+	// TransactionTypeStateTransition is a transaction publishing an identity state
+	TransactionTypeStateTransition TransactionType = "state_transition"
+	// TransactionTypePayment is a transaction paying a payment request. It's sent and paid by the holder,
+	// so it's not a cost of the issuer
+	TransactionTypePayment TransactionType = "payment"
+	// TransactionTypeOnchainCredential is a transaction adding a credential to the claims tree of an identity contract
+	TransactionTypeOnchainCredential TransactionType = "onchain_credential"
+)
+
+// TransactionStatus represents the status of an on-chain transaction
+type TransactionStatus string
+
+const (
+	// TransactionStatusPending is a transaction sent but without receipt yet
+	TransactionStatusPending TransactionStatus = "pending"
+	// TransactionStatusSuccess is a mined transaction with a successful receipt
+	TransactionStatusSuccess TransactionStatus = "success"
+	// TransactionStatusFailed is a mined transaction with a failed receipt
+	TransactionStatusFailed TransactionStatus = "failed"
+)
+
+// Transaction holds the history and cost of an on-chain transaction related to an issuer.
+// A stuck transaction re-sent with the same nonce and higher fees is recorded as a new transaction that Replaces it.
+type Transaction struct {
+	ID                uuid.UUID
+	IssuerDID         string
+	Network           string
+	Type              TransactionType
+	Reference         string
+	TxID              string
+	Status            TransactionStatus
+	GasUsed           *big.Int
+	EffectiveGasPrice *big.Int
+	Fee               *big.Int
+	BlockNumber       *int64
+	Replaces          *string
+	CreatedAt         time.Time
+	ModifiedAt        time.Time
+}
+
+// NewTransaction creates a new pending transaction
+func NewTransaction(issuerDID string, network string, txType TransactionType, reference string, txID string) *Transaction {
+	return &Transaction{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		Network:   network,
+		Type:      txType,
+		Reference: reference,
+		TxID:      txID,
+		Status:    TransactionStatusPending,
+	}
+}
+
+// TransactionCostTotal holds the aggregated cost of the transactions of an issuer for a network and transaction type
+type TransactionCostTotal struct {
+	Network      string
+	Type         TransactionType
+	Transactions int
+	Replacements int
+	GasUsed      *big.Int
+	Fee          *big.Int
+}
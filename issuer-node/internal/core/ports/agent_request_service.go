@@ -1,6 +1,7 @@
 package ports
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -39,7 +40,14 @@ func NewAgentRequest(basicMessage *comm.BasicMessage) (*AgentRequest, error) {
 		return nil, fmt.Errorf("'id' field cannot be empty")
 	}
 
+	// the accept profiles are optional, messages whose body is not an object have none
+	var accept struct {
+		Accept []string `json:"accept"`
+	}
+	_ = json.Unmarshal(basicMessage.Body, &accept)
+
 	return &AgentRequest{
+		Accept:    accept.Accept,
 		Body:      basicMessage.Body,
 		UserDID:   fromDID,
 		IssuerDID: toDID,
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/iden3comm/v2"
+)
+
+// AgentHandler handles the messages of a protocol message type received in the agent endpoint.
+// A nil message without error means that the message has been accepted and there is nothing to reply.
+type AgentHandler func(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*iden3comm.BasicMessage, error)
+
+// AgentRouter routes the agent messages to the handler registered for their type
+type AgentRouter interface {
+	Route(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType) (*iden3comm.BasicMessage, error)
+}
+
+// AgentResponsePacker packs the agent responses in the envelope accepted by the request
+type AgentResponsePacker interface {
+	// Pack returns the response in an encrypted envelope, or nil if it has to be replied as plain JSON
+	Pack(ctx context.Context, req *AgentRequest, mediatype iden3comm.MediaType, response *iden3comm.BasicMessage) ([]byte, error)
+}
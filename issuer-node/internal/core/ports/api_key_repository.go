@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// APIKeyRepository is the interface implemented by the repository of the API keys
+type APIKeyRepository interface {
+	Save(ctx context.Context, key *domain.APIKey) error
+	GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error)
+	GetAll(ctx context.Context, tenantID *uuid.UUID) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID, tenantID *uuid.UUID, revokedAt time.Time) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// APIKeyService is the interface implemented by the service that manages the API keys and authenticates them
+type APIKeyService interface {
+	Create(ctx context.Context, name string, role domain.Role, tenantID *uuid.UUID, issuerDIDs []w3c.DID, expiresAt *time.Time) (*domain.APIKey, string, error)
+	GetAll(ctx context.Context, tenantID *uuid.UUID) ([]domain.APIKey, error)
+	Revoke(ctx context.Context, id uuid.UUID, tenantID *uuid.UUID) error
+	Authenticate(ctx context.Context, plain string) (*domain.APIKey, error)
+}
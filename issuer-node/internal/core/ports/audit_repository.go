@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// AuditRepository is the interface implemented by the repository of the audit log
+type AuditRepository interface {
+	// GetLast locks the audit log of the issuer until the end of the transaction of conn and returns its last entry,
+	// or nil when it is empty
+	GetLast(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (*domain.AuditEntry, error)
+	// Save appends the entry to the audit log and moves the head of the log of the issuer to it
+	Save(ctx context.Context, conn db.Querier, entry *domain.AuditEntry) error
+	// GetHead returns the head of the audit log of the issuer, or nil when it is empty
+	GetHead(ctx context.Context, issuerDID w3c.DID) (*domain.AuditHead, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter AuditFilter) ([]domain.AuditEntry, uint, error)
+	// GetChain returns at most limit entries of the issuer after the entry with the sequence number, in order
+	GetChain(ctx context.Context, issuerDID w3c.DID, afterSeq int64, limit int) ([]domain.AuditEntry, error)
+}
@@ -0,0 +1,38 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// AuditFilter is the filter to use when getting the audit log of an issuer
+type AuditFilter struct {
+	Entity     *domain.AuditEntity
+	Operation  *domain.AuditOperation
+	EntityID   *string
+	Actor      *string
+	From       *time.Time
+	To         *time.Time
+	MaxResults uint // Max number of results to return on each call.
+	Page       uint // Page number to return. First is 1.
+}
+
+// AuditChainStatus is the result of the verification of the audit log of an issuer
+type AuditChainStatus struct {
+	Entries  int64
+	BrokenAt *int64 // sequence number of the first entry that doesn't follow the previous one
+}
+
+// AuditService is the service that keeps the audit log of the state-changing operations of the issuers
+type AuditService interface {
+	// Record appends the change to the audit log of the issuer, with the caller of the request in ctx. It is written
+	// in the transaction of conn, so it must be the transaction of the change.
+	Record(ctx context.Context, conn db.Querier, issuerDID w3c.DID, change *domain.AuditChange) error
+	GetAll(ctx context.Context, issuerDID w3c.DID, filter AuditFilter) ([]domain.AuditEntry, uint, error)
+	Verify(ctx context.Context, issuerDID w3c.DID) (*AuditChainStatus, error)
+}
@@ -46,6 +46,8 @@ type CreateClaimRequest struct {
 
 // AgentRequest struct
 type AgentRequest struct {
+	// Accept holds the envelope profiles accepted by the sender for the response, as in the discovery accept feature
+	Accept    []string
 	Body      json.RawMessage
 	ThreadID  string
 	IssuerDID *w3c.DID
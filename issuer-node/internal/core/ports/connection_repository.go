@@ -19,6 +19,6 @@ type ConnectionRepository interface {
 	GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID) (*domain.Connection, error)
 	GetByUserID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, userDID w3c.DID) (*domain.Connection, error)
 	GetAllWithCredentialsByIssuerID(ctx context.Context, conn db.Querier, issuerDID w3c.DID, filter *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
-	GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error)
+	GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID, tenantID *uuid.UUID) (*domain.Connection, error)
 	SaveUserAuthentication(ctx context.Context, conn db.Querier, connID uuid.UUID, sessID uuid.UUID, mTime time.Time) error
 }
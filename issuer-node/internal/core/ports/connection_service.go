@@ -78,5 +78,5 @@ type ConnectionService interface {
 	GetByIDAndIssuerID(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) (*domain.Connection, error)
 	GetByUserID(ctx context.Context, issuerDID w3c.DID, userID w3c.DID) (*domain.Connection, error)
 	GetAllByIssuerID(ctx context.Context, issuerDID w3c.DID, request *NewGetAllConnectionsRequest) ([]domain.Connection, uint, error)
-	GetByUserSessionID(ctx context.Context, sessionID uuid.UUID) (*domain.Connection, error)
+	GetByUserSessionID(ctx context.Context, sessionID uuid.UUID, tenantID *uuid.UUID) (*domain.Connection, error)
 }
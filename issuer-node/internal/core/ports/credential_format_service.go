@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CredentialFormatService is the interface implemented by the credential format service.
+// It encodes the iden3 credentials in the JWT based formats, signed with the ethereum key of the issuer.
+type CredentialFormatService interface {
+	Validate(ctx context.Context, issuerDID w3c.DID, format domain.CredentialFormat) error
+	Encode(ctx context.Context, claim *domain.Claim, format domain.CredentialFormat) (*domain.EncodedCredential, error)
+	DefaultFormat(ctx context.Context, claim *domain.Claim) (domain.CredentialFormat, error)
+}
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+// DeferredStateRepository is the interface implemented by the repository of the state transitions held while the
+// network base fee is above the configured ceiling
+type DeferredStateRepository interface {
+	Hold(ctx context.Context, issuerDID w3c.DID) error
+	Release(ctx context.Context, issuerDID w3c.DID) error
+	GetAll(ctx context.Context) ([]w3c.DID, error)
+}
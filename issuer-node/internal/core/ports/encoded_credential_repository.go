@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// EncodedCredentialRepository is the interface implemented by the encoded credentials repository
+type EncodedCredentialRepository interface {
+	Save(ctx context.Context, credential *domain.EncodedCredential) error
+	Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, format domain.CredentialFormat) (*domain.EncodedCredential, error)
+	GetByCredential(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) ([]domain.EncodedCredential, error)
+}
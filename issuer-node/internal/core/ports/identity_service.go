@@ -27,6 +27,15 @@ type DIDCreationOptions struct {
 	KeyType              kms.KeyType                     `json:"keyType"`
 	AuthCredentialStatus verifiable.CredentialStatusType `json:"authCredentialStatus,omitempty"`
 	DisplayName          *string                         `json:"displayName,omitempty"`
+	TenantID             *uuid.UUID                      `json:"tenantID,omitempty"`
+}
+
+// Tenant returns the tenant of the new identity, the default tenant when it is not set
+func (o *DIDCreationOptions) Tenant() uuid.UUID {
+	if o == nil || o.TenantID == nil {
+		return domain.DefaultTenantID
+	}
+	return *o.TenantID
 }
 
 // CreateAuthenticationQRCodeResponse represents the response of the CreateAuthenticationQRCode method
@@ -41,7 +50,7 @@ type IdentityService interface {
 	GetByDID(ctx context.Context, identifier w3c.DID) (*domain.Identity, error)
 	Create(ctx context.Context, hostURL string, didOptions *DIDCreationOptions) (*domain.Identity, error)
 	SignClaimEntry(ctx context.Context, authClaim *domain.Claim, claimEntry *core.Claim) (*verifiable.BJJSignatureProof2021, error)
-	Get(ctx context.Context) (identities []domain.IdentityDisplayName, err error)
+	Get(ctx context.Context, tenantID *uuid.UUID) (identities []domain.IdentityDisplayName, err error)
 	UpdateState(ctx context.Context, did w3c.DID) (*domain.IdentityState, error)
 	Exists(ctx context.Context, identifier w3c.DID) (bool, error)
 	GetLatestStateByID(ctx context.Context, identifier w3c.DID) (*domain.IdentityState, error)
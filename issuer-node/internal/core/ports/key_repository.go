@@ -14,6 +14,6 @@ import (
 type KeyRepository interface {
 	Save(ctx context.Context, conn db.Querier, key *domain.Key) (uuid.UUID, error)
 	GetByPublicKey(ctx context.Context, issuerDID w3c.DID, publicKey string) (*domain.Key, error)
-	Delete(ctx context.Context, issuerDID w3c.DID, publicKey string) error
+	Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, publicKey string) error
 	GetByName(ctx context.Context, issuerDID w3c.DID, name string) (*domain.Key, error)
 }
@@ -16,6 +16,6 @@ type LinkRepository interface {
 	Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uuid.UUID, error)
 	GetByID(ctx context.Context, issuerID w3c.DID, id uuid.UUID) (*domain.Link, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, status LinkStatus, query *string) ([]*domain.Link, error)
-	Delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) error
+	Delete(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID) error
 	AddAuthorizationRequest(ctx context.Context, linkID uuid.UUID, issuerDID w3c.DID, authorizationRequest *protocol.AuthorizationRequestMessage) error
 }
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// MessageRepository is the interface implemented by the iden3comm messages repository
+type MessageRepository interface {
+	Save(ctx context.Context, message *domain.Message) error
+	UpdateDelivery(ctx context.Context, message *domain.Message) error
+	LeaseDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]domain.Message, error)
+	AcknowledgeThread(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, threadID string) (int64, error)
+	GetByUser(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) ([]domain.Message, error)
+}
@@ -0,0 +1,29 @@
+package ports
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// MessageService is the interface implemented by the iden3comm inbox/outbox service.
+// Outbound messages are stored per holder and delivered with retries to the service endpoints of the holder DID document.
+// Inbound messages are stored and threaded with the outbound ones by their thread id.
+type MessageService interface {
+	Send(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, message json.RawMessage) error
+	DeliverPending(ctx context.Context) (int, error)
+	RecordInbound(ctx context.Context, req *AgentRequest) error
+	RecordReply(ctx context.Context, req *AgentRequest, response *iden3comm.BasicMessage) error
+	GetConversations(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]domain.Conversation, error)
+}
+
+// MessageGateway delivers iden3comm messages to the service endpoints of a holder DID document
+type MessageGateway interface {
+	Deliver(ctx context.Context, msg json.RawMessage, userDIDDocument verifiable.DIDDocument) error
+}
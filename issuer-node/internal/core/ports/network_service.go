@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/polygonid/sh-id-platform/internal/network"
+)
+
+// NetworkService is the interface implemented by the network service
+type NetworkService interface {
+	ReloadSettings(ctx context.Context) (*network.ReloadResult, error)
+}
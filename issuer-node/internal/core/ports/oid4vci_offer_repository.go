@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// OID4VCIOfferRepository is the interface implemented by the OpenID4VCI offers repository
+type OID4VCIOfferRepository interface {
+	Save(ctx context.Context, offer *domain.OID4VCIOffer) error
+	Redeem(ctx context.Context, offer *domain.OID4VCIOffer) error
+	GetByPreAuthorizedCode(ctx context.Context, issuerDID w3c.DID, codeHash string) (*domain.OID4VCIOffer, error)
+	GetByAccessToken(ctx context.Context, issuerDID w3c.DID, tokenHash string) (*domain.OID4VCIOffer, error)
+}
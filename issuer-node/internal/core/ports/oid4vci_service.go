@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vci"
+)
+
+// OID4VCIIssuerURL is the credential issuer identifier of an identity. The OpenID4VCI endpoints are relative to it.
+const OID4VCIIssuerURL = "%s/v2/oid4vci/%s"
+
+// OID4VCIService is the interface implemented by the OpenID4VCI service.
+// Links are offered to OpenID4VCI wallets with the pre-authorized code flow and issued in the JWT based formats.
+type OID4VCIService interface {
+	CreateOffer(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) (*oid4vci.CredentialOffer, error)
+	IssuerMetadata(ctx context.Context, issuerDID w3c.DID) (*oid4vci.IssuerMetadata, error)
+	AuthorizationServerMetadata(ctx context.Context, issuerDID w3c.DID) (*oid4vci.AuthorizationServerMetadata, error)
+	Token(ctx context.Context, issuerDID w3c.DID, grantType string, preAuthorizedCode string) (*oid4vci.TokenResponse, error)
+	Credential(ctx context.Context, issuerDID w3c.DID, accessToken string, req *oid4vci.CredentialRequest) (*oid4vci.CredentialResponse, error)
+}
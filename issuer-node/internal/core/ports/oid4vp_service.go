@@ -0,0 +1,42 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vp"
+)
+
+const (
+	// OID4VPRequestURL is the URL the wallet gets the request object of a session from
+	OID4VPRequestURL = "%s/v2/oid4vp/sessions/%s/request"
+	// OID4VPResponseURL is the URL the wallet posts the vp_token of a session to
+	OID4VPResponseURL = "%s/v2/oid4vp/sessions/%s/response"
+)
+
+// CreateOID4VPSessionRequest is the request of a presentation of a credential of the schema with the given fields
+type CreateOID4VPSessionRequest struct {
+	SchemaID uuid.UUID
+	Format   domain.CredentialFormat
+	Purpose  string
+	Fields   []OID4VPField
+}
+
+// OID4VPField is an attribute of the credential subject requested to the holder, optionally valid against a filter
+type OID4VPField struct {
+	Name     string
+	Filter   oid4vp.Filter
+	Optional bool
+}
+
+// OID4VPService is the interface implemented by the OpenID4VP verifier service.
+// Identities request presentations of credentials to OpenID4VP wallets and verify the responses posted by them.
+type OID4VPService interface {
+	CreateSession(ctx context.Context, verifierDID w3c.DID, req *CreateOID4VPSessionRequest) (*domain.OID4VPSession, string, error)
+	GetSession(ctx context.Context, verifierDID w3c.DID, id uuid.UUID) (*domain.OID4VPSession, error)
+	RequestObject(ctx context.Context, id uuid.UUID) (string, error)
+	Response(ctx context.Context, id uuid.UUID, state string, vpToken string, submission *oid4vp.PresentationSubmission) (*domain.OID4VPSession, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// OnchainIssuerRepository is the interface implemented by the onchain issuers repository
+type OnchainIssuerRepository interface {
+	Save(ctx context.Context, onchainIssuer *domain.OnchainIssuer) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.OnchainIssuer, error)
+	GetByIdentifier(ctx context.Context, identifier w3c.DID) (*domain.OnchainIssuer, error)
+	GetByController(ctx context.Context, controllerDID w3c.DID) ([]domain.OnchainIssuer, error)
+	SaveCredential(ctx context.Context, conn db.Querier, credential *domain.OnchainCredential) error
+	GetCredential(ctx context.Context, id uuid.UUID) (*domain.OnchainCredential, error)
+	GetPendingCredentials(ctx context.Context, limit int) ([]domain.OnchainCredential, error)
+}
@@ -0,0 +1,45 @@
+package ports
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	comm "github.com/iden3/iden3comm/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// CreateOnchainCredentialRequest is the request to issue a credential in the claims tree of an onchain issuer
+type CreateOnchainCredentialRequest struct {
+	ControllerDID         w3c.DID
+	OnchainIssuerDID      w3c.DID
+	Schema                string
+	CredentialSubject     map[string]any
+	Expiration            *time.Time
+	Type                  string
+	Version               uint32
+	SubjectPos            string
+	MerklizedRootPosition string
+}
+
+// OnchainIssuerService is the interface implemented by the onchain issuer service.
+// It issues credentials by adding their core claims to identity contracts owned by the ethereum key of an issuer
+// and offers them to the users once the transaction is mined.
+type OnchainIssuerService interface {
+	Register(ctx context.Context, controllerDID w3c.DID, contract common.Address) (*domain.OnchainIssuer, error)
+	GetByController(ctx context.Context, controllerDID w3c.DID) ([]domain.OnchainIssuer, error)
+	CreateCredential(ctx context.Context, req *CreateOnchainCredentialRequest) (*domain.Claim, *domain.OnchainCredential, error)
+	CheckPending(ctx context.Context) (int, error)
+	Agent(ctx context.Context, req *AgentRequest, mediatype comm.MediaType) (*comm.BasicMessage, error)
+}
+
+// OnchainIdentityGateway sends the transactions to the identity contracts of the onchain issuers
+type OnchainIdentityGateway interface {
+	AddClaimHashAndTransit(ctx context.Context, onchainIssuer *domain.OnchainIssuer, hashIndex, hashValue *big.Int) (string, error)
+	GetTransactionReceipt(ctx context.Context, onchainIssuer *domain.OnchainIssuer, txID string) (*types.Receipt, error)
+	Owner(ctx context.Context, onchainIssuer *domain.OnchainIssuer) (common.Address, error)
+}
@@ -44,6 +44,7 @@ type PaymentService interface {
 	GetPaymentRequests(ctx context.Context, issuerDID *w3c.DID, queryParams *domain.PaymentRequestsQueryParams) ([]domain.PaymentRequest, error)
 	GetPaymentRequest(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) (*domain.PaymentRequest, error)
 	DeletePaymentRequest(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) error
+	Agent(ctx context.Context, req *AgentRequest, mediatype comm.MediaType) (*comm.BasicMessage, error)
 	CreatePaymentRequestForProposalRequest(ctx context.Context, proposalRequest *protocol.CredentialsProposalRequestMessage) (*comm.BasicMessage, error)
 	GetSettings() payments.Config
 	VerifyPayment(ctx context.Context, issuerDID w3c.DID, nonce *big.Int, txHash *string, userDID *w3c.DID) (BlockchainPaymentStatus, error)
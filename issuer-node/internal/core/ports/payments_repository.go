@@ -8,14 +8,15 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
 // PaymentRepository is the interface that defines the available methods for the Payment repository
 type PaymentRepository interface {
-	SavePaymentOption(ctx context.Context, opt *domain.PaymentOption) (uuid.UUID, error)
+	SavePaymentOption(ctx context.Context, conn db.Querier, opt *domain.PaymentOption) (uuid.UUID, error)
 	GetAllPaymentOptions(ctx context.Context, issuerDID w3c.DID) ([]domain.PaymentOption, error)
 	GetPaymentOptionByID(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) (*domain.PaymentOption, error)
-	DeletePaymentOption(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error
+	DeletePaymentOption(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error
 
 	SavePaymentRequest(ctx context.Context, req *domain.PaymentRequest) (uuid.UUID, error)
 	GetPaymentRequestByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.PaymentRequest, error)
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/presentation"
+)
+
+// PresentationService is the interface implemented by the presentation service.
+// It builds selective disclosure presentations of the credentials issued by an identity.
+type PresentationService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID, fields []string) (*presentation.Presentation, error)
+}
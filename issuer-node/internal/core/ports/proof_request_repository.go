@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ProofRequestRepository is the interface implemented by the proof requests repository
+type ProofRequestRepository interface {
+	Save(ctx context.Context, proofRequest *domain.ProofRequest) error
+	Answer(ctx context.Context, proofRequest *domain.ProofRequest) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.ProofRequest, error)
+	GetByConnection(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]domain.ProofRequest, error)
+}
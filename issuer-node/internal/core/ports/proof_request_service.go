@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// ProofRequestCallbackURL is the URL the user calls back with the response to a proof request
+const ProofRequestCallbackURL = "%s/v2/proof-requests/callback?id=%s"
+
+// ProofRequestService is the interface implemented by the proof request service.
+// The issuer sends authorization requests with zero knowledge scopes to existing connections and verifies the responses asynchronously.
+type ProofRequestService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID, reason string, scope []protocol.ZeroKnowledgeProofRequest) (*domain.ProofRequest, error)
+	Callback(ctx context.Context, id uuid.UUID, token string) (*domain.ProofRequest, error)
+	GetByConnection(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]domain.ProofRequest, error)
+}
+
+// AuthorizationResponseVerifier verifies the authorization responses of the users. It is implemented by the go-iden3-auth verifier.
+type AuthorizationResponseVerifier interface {
+	FullVerify(ctx context.Context, token string, request protocol.AuthorizationRequestMessage, opts ...pubsignals.VerifyOpt) (*protocol.AuthorizationResponseMessage, error)
+}
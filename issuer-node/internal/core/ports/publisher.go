@@ -14,3 +14,10 @@ type Publisher interface {
 	RetryPublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error)
 	CheckTransactionStatus(ctx context.Context, identity *domain.Identity)
 }
+
+// PublishingScheduler - Define the interface for publishers that can hold non urgent state transitions
+// while the network fees are too high
+type PublishingScheduler interface {
+	Publisher
+	SchedulePublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error)
+}
@@ -0,0 +1,30 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+)
+
+const (
+	// SchemaDocumentURL is the public URL the JSON schemas built by the node are served at
+	SchemaDocumentURL = "%s/v2/schemas/%s/schema.json"
+	// SchemaDocumentContextURL is the public URL the JSON-LD contexts built by the node are served at
+	SchemaDocumentContextURL = "%s/v2/schemas/%s/context.jsonld"
+)
+
+// SchemaBuilderService is the interface implemented by the schema builder. It builds the JSON schema and JSON-LD
+// context of new credential types and publishes them, so they can be imported as any other schema.
+type SchemaBuilderService interface {
+	Build(ctx context.Context, issuerDID w3c.DID, def jsonschema.Definition) (*domain.SchemaDocument, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.SchemaDocument, error)
+}
+
+// IPFSPinner is the interface implemented by the IPFS nodes documents are pinned to
+type IPFSPinner interface {
+	Pin(ctx context.Context, content []byte) (string, error)
+}
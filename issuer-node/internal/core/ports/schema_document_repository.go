@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// SchemaDocumentRepository is the interface implemented by the repository of the schemas built by the node
+type SchemaDocumentRepository interface {
+	Save(ctx context.Context, doc *domain.SchemaDocument) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.SchemaDocument, error)
+}
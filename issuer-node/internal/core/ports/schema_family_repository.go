@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// SchemaFamilyRepository is the interface implemented by the repository of the schema families
+type SchemaFamilyRepository interface {
+	Save(ctx context.Context, family *domain.SchemaFamily) error
+	AddVersion(ctx context.Context, familyID uuid.UUID, version *domain.SchemaFamilyVersion) error
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaFamily, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.SchemaFamily, error)
+}
+
+// SchemaMigrationRepository is the interface implemented by the repository of the schema migrations
+type SchemaMigrationRepository interface {
+	Save(ctx context.Context, migration *domain.SchemaMigration) error
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaMigration, error)
+	LeasePending(ctx context.Context, limit int) ([]domain.SchemaMigration, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// SchemaFamilyService is the interface implemented by the service that versions schemas and migrates the
+// credentials between the versions
+type SchemaFamilyService interface {
+	Create(ctx context.Context, issuerDID w3c.DID, name string) (*domain.SchemaFamily, error)
+	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaFamily, error)
+	GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.SchemaFamily, error)
+	AddVersion(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, schemaID uuid.UUID, mapping domain.SchemaMapping) (*domain.SchemaFamily, error)
+	CreateMigration(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, fromSchemaID uuid.UUID, toSchemaID uuid.UUID, dryRun bool) (*domain.SchemaMigration, error)
+	GetMigration(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaMigration, error)
+	RunPending(ctx context.Context) (int, error)
+}
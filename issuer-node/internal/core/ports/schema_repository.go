@@ -7,11 +7,12 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
 // SchemaRepository interface that define repo methods for schemas
 type SchemaRepository interface {
-	Save(ctx context.Context, schema *domain.Schema) error
+	Save(ctx context.Context, conn db.Querier, schema *domain.Schema) error
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
 	Update(ctx context.Context, schema *domain.Schema) error
@@ -15,6 +15,7 @@ type SchemaService interface {
 	GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
 	GetAll(ctx context.Context, issuerDID w3c.DID, query *string) ([]domain.Schema, error)
 	Update(ctx context.Context, schema *domain.Schema) error
+	CheckDrift(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error)
 }
 
 // ImportSchemaRequest defines the request for importing a schema
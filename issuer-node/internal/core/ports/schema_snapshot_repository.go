@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// SchemaSnapshotRepository is the interface implemented by the repository of the snapshots of the imported schemas
+type SchemaSnapshotRepository interface {
+	Save(ctx context.Context, snapshot *domain.SchemaSnapshot) error
+	GetByURL(ctx context.Context, url string) (*domain.SchemaSnapshot, error)
+	UpdateRemoteDigest(ctx context.Context, url string, remoteDigest string, checkedAt time.Time) error
+}
@@ -3,11 +3,16 @@ package ports
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 )
 
 // SessionRepository defines the interface for managing sessions
 type SessionRepository interface {
 	Get(ctx context.Context, key string) (protocol.AuthorizationRequestMessage, error)
 	Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error
+	GetOID4VP(ctx context.Context, id uuid.UUID) (*domain.OID4VPSession, error)
+	SetOID4VP(ctx context.Context, session *domain.OID4VPSession) error
 }
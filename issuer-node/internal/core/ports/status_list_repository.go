@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// StatusListRepository is the interface implemented by the status lists repository
+type StatusListRepository interface {
+	Save(ctx context.Context, conn db.Querier, list *domain.StatusList) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.StatusList, error)
+	GetForUpdate(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.StatusList, error)
+	GetCurrent(ctx context.Context, conn db.Querier, issuerDID w3c.DID, purpose string) (*domain.StatusList, error)
+	AllocateIndex(ctx context.Context, conn db.Querier, id uuid.UUID) (int, error)
+}
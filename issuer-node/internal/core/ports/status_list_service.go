@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/statuslist"
+)
+
+// StatusListCredentialURL is the public URL the status list credentials are published at
+const StatusListCredentialURL = "%s/v2/status-lists/%s"
+
+// StatusListService is the interface implemented by the bitstring status list service.
+// It allocates the index of the credentials with a BitstringStatusListEntry status and keeps the status list
+// credentials of the issuers signed with their revocations.
+type StatusListService interface {
+	Validate(ctx context.Context, issuerDID w3c.DID) error
+	Allocate(ctx context.Context, issuerDID w3c.DID) (*statuslist.Entry, error)
+	Revoke(ctx context.Context, conn db.Querier, issuerDID w3c.DID, claims []*domain.Claim) error
+	GetCredential(ctx context.Context, id uuid.UUID) (string, error)
+}
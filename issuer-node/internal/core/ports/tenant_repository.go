@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// TenantRepository is the interface implemented by the repository of the tenants
+type TenantRepository interface {
+	Save(ctx context.Context, tenant *domain.Tenant) error
+	Update(ctx context.Context, tenant *domain.Tenant) error
+	GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error)
+	GetByIssuer(ctx context.Context, issuerDID w3c.DID) (*domain.Tenant, error)
+	GetAll(ctx context.Context) ([]domain.Tenant, error)
+	Lock(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error)
+	CountIdentities(ctx context.Context, conn db.Querier, id uuid.UUID) (int, error)
+	CountCredentials(ctx context.Context, conn db.Querier, id uuid.UUID, since time.Time) (int, error)
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// TenantService is the interface implemented by the service that manages the tenants and enforces their quotas
+type TenantService interface {
+	Create(ctx context.Context, tenant *domain.Tenant) error
+	Update(ctx context.Context, tenant *domain.Tenant) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Tenant, error)
+	GetByIssuer(ctx context.Context, issuerDID w3c.DID) (*domain.Tenant, error)
+	GetAll(ctx context.Context) ([]domain.Tenant, error)
+	CheckIdentityQuota(ctx context.Context, conn db.Querier, id uuid.UUID) error
+	CheckCredentialQuota(ctx context.Context, conn db.Querier, issuerDID w3c.DID) error
+}
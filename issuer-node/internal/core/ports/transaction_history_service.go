@@ -0,0 +1,28 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// TransactionCostFilter is the filter used to aggregate the transaction costs of an issuer
+type TransactionCostFilter struct {
+	From    *time.Time
+	To      *time.Time
+	Network *string
+}
+
+// TransactionHistoryService is the interface implemented by the transaction history service.
+// It keeps track of the on-chain transactions of each issuer and the fees paid for them.
+type TransactionHistoryService interface {
+	RecordSent(ctx context.Context, issuerDID w3c.DID, network string, txType domain.TransactionType, reference string, txID string) error
+	RecordReplacement(ctx context.Context, reference string, oldTxID string, newTxID string) error
+	RecordReceipt(ctx context.Context, issuerDID w3c.DID, network string, txType domain.TransactionType, reference string, receipt *types.Receipt) error
+	GetSentTxIDs(ctx context.Context, txType domain.TransactionType, reference string) ([]string, error)
+	GetCostTotals(ctx context.Context, issuerDID w3c.DID, filter TransactionCostFilter) ([]domain.TransactionCostTotal, error)
+}
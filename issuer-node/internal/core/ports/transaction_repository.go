@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+// TransactionRepository is the interface implemented by the transaction history repository
+type TransactionRepository interface {
+	Save(ctx context.Context, tx *domain.Transaction) error
+	SaveReplacement(ctx context.Context, reference string, oldTxID string, newTxID string) (int64, error)
+	GetByTxID(ctx context.Context, reference string, txID string) (*domain.Transaction, error)
+	GetByReference(ctx context.Context, txType domain.TransactionType, reference string) ([]domain.Transaction, error)
+	GetCostTotals(ctx context.Context, issuerDID w3c.DID, filter TransactionCostFilter) ([]domain.TransactionCostTotal, error)
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/packagemanager"
+)
+
+type agentResponsePacker struct {
+	packageManager *iden3comm.PackageManager
+	kms            kms.KMSType
+}
+
+// NewAgentResponsePacker creates the service that encrypts the credentials sent through the agent endpoint
+func NewAgentResponsePacker(packageManager *iden3comm.PackageManager, kms kms.KMSType) ports.AgentResponsePacker {
+	return &agentResponsePacker{
+		packageManager: packageManager,
+		kms:            kms,
+	}
+}
+
+// Pack encrypts the credential issuance responses to the user key agreement key when the request accepts an
+// encrypted envelope, either in its accept profiles or by coming encrypted.
+// Responses are authcrypted when the user accepts it and the issuer has a X25519 key, anoncrypted otherwise.
+// mediatype is the one of the request envelope. The response is not encrypted, and sent as it is, when the user has no
+// X25519 key agreement key.
+func (p *agentResponsePacker) Pack(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType, response *iden3comm.BasicMessage) ([]byte, error) {
+	if response.Type != protocol.CredentialIssuanceResponseMessageType || req.UserDID == nil || req.IssuerDID == nil {
+		return nil, nil
+	}
+	algorithms := acceptedEncryptionAlgorithms(req, mediatype)
+	if len(algorithms) == 0 {
+		return nil, nil
+	}
+
+	params := packagemanager.JWEPackerParams{RecipientDID: req.UserDID.String()}
+	if slices.Contains(algorithms, packagemanager.AuthcryptAlgorithm) {
+		keyIDs, err := p.kms.KeysByIdentity(ctx, *req.IssuerDID)
+		if err != nil {
+			log.Error(ctx, "getting issuer keys", "err", err, "issuerDID", req.IssuerDID)
+			return nil, err
+		}
+		for i := range keyIDs {
+			if keyIDs[i].Type == kms.KeyTypeX25519 {
+				params.SenderKeyID = &keyIDs[i]
+				break
+			}
+		}
+	}
+	if params.SenderKeyID == nil && !slices.Contains(algorithms, packagemanager.AnoncryptAlgorithm) {
+		log.Warn(ctx, "authcrypt accepted but the issuer has no X25519 key", "issuerDID", req.IssuerDID)
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(response)
+	if err != nil {
+		return nil, err
+	}
+	envelope, err := p.packageManager.Pack(packers.MediaTypeEncryptedMessage, payload, params)
+	if errors.Is(err, packagemanager.ErrNoKeyAgreementKey) {
+		log.Warn(ctx, "user has no X25519 key agreement key, the agent response is not encrypted", "userDID", req.UserDID)
+		return nil, nil
+	}
+	if err != nil {
+		log.Error(ctx, "encrypting agent response", "err", err, "userDID", req.UserDID)
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// acceptedEncryptionAlgorithms returns the JWE algorithms accepted by the request
+func acceptedEncryptionAlgorithms(req *ports.AgentRequest, mediatype iden3comm.MediaType) []string {
+	var algorithms []string
+	for _, profile := range req.Accept {
+		if accepted, ok := packagemanager.ParseEncryptedProfile(profile); ok {
+			algorithms = append(algorithms, accepted...)
+		}
+	}
+	if len(algorithms) == 0 && mediatype == packers.MediaTypeEncryptedMessage {
+		return []string{packagemanager.AnoncryptAlgorithm, packagemanager.AuthcryptAlgorithm}
+	}
+	return algorithms
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ProblemReportCodeUnsupportedMessageType is the problem report code replied to messages without handler
+const ProblemReportCodeUnsupportedMessageType protocol.ProblemErrorCode = "e.p.msg.unsupported-type"
+
+// AgentRouter routes the messages received in the agent endpoint to the handler registered for their type.
+// Messages without handler are replied with a problem report.
+type AgentRouter struct {
+	mediatypeManager *MediaTypeManager
+	handlers         map[iden3comm.ProtocolMessage]ports.AgentHandler
+}
+
+// NewAgentRouter creates a new agent router
+func NewAgentRouter(mediatypeManager *MediaTypeManager) *AgentRouter {
+	return &AgentRouter{
+		mediatypeManager: mediatypeManager,
+		handlers:         make(map[iden3comm.ProtocolMessage]ports.AgentHandler),
+	}
+}
+
+// Register sets the handler of the message type and allows the media types for it in the media type manager.
+// It must be called before the agent endpoint starts serving requests.
+func (r *AgentRouter) Register(messageType iden3comm.ProtocolMessage, mediaTypes []string, handler ports.AgentHandler) {
+	r.handlers[messageType] = handler
+	r.mediatypeManager.Allow(messageType, mediaTypes)
+}
+
+// Route sends the message to the handler registered for its type
+func (r *AgentRouter) Route(ctx context.Context, req *ports.AgentRequest, mediatype iden3comm.MediaType) (*iden3comm.BasicMessage, error) {
+	handler, ok := r.handlers[req.Type]
+	if !ok {
+		log.Warn(ctx, "agent: unsupported message type", "type", req.Type)
+		return NewProblemReport(req, ProblemReportCodeUnsupportedMessageType, fmt.Sprintf("message type '%s' is not supported", req.Type)), nil
+	}
+
+	if !r.mediatypeManager.AllowMediaType(req.Type, mediatype) {
+		err := fmt.Errorf("unsupported media type '%s' for message type '%s'", mediatype, req.Type)
+		log.Error(ctx, "agent: unsupported media type", "err", err)
+		return nil, err
+	}
+	return handler(ctx, req, mediatype)
+}
+
+// NewProblemReport creates a problem report message replying to the request
+func NewProblemReport(req *ports.AgentRequest, code protocol.ProblemErrorCode, comment string) *iden3comm.BasicMessage {
+	var from, to string
+	if req.IssuerDID != nil {
+		from = req.IssuerDID.String()
+	}
+	if req.UserDID != nil {
+		to = req.UserDID.String()
+	}
+	threadID := req.ThreadID
+	if threadID == "" {
+		threadID = req.ID.String()
+	}
+
+	body, _ := json.Marshal(protocol.ProblemReportMessageBody{Code: code, Comment: comment})
+	return &iden3comm.BasicMessage{
+		ID:       uuid.NewString(),
+		Typ:      req.Typ,
+		Type:     protocol.ProblemReportMessageType,
+		ThreadID: threadID,
+		Body:     body,
+		From:     from,
+		To:       to,
+	}
+}
@@ -0,0 +1,71 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+)
+
+func TestAgentRouter_Route(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+
+	mediatypeManager := services.NewMediaTypeManager(map[iden3comm.ProtocolMessage][]string{}, true)
+	router := services.NewAgentRouter(mediatypeManager)
+	handled := 0
+	router.Register(protocol.CredentialFetchRequestMessageType, []string{string(packers.MediaTypeZKPMessage)}, func(context.Context, *ports.AgentRequest, iden3comm.MediaType) (*iden3comm.BasicMessage, error) {
+		handled++
+		return nil, nil
+	})
+
+	newRequest := func(messageType iden3comm.ProtocolMessage) *ports.AgentRequest {
+		return &ports.AgentRequest{
+			ID:        uuid.New(),
+			ThreadID:  "thread-1",
+			Type:      messageType,
+			IssuerDID: issuerDID,
+			UserDID:   userDID,
+		}
+	}
+
+	t.Run("should call the handler registered for the message type", func(t *testing.T) {
+		response, err := router.Route(ctx, newRequest(protocol.CredentialFetchRequestMessageType), packers.MediaTypeZKPMessage)
+		require.NoError(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, 1, handled)
+	})
+
+	t.Run("should refuse the media types not allowed for the message type", func(t *testing.T) {
+		_, err := router.Route(ctx, newRequest(protocol.CredentialFetchRequestMessageType), packers.MediaTypePlainMessage)
+		assert.Error(t, err)
+		assert.Equal(t, 1, handled)
+	})
+
+	t.Run("should reply with a problem report to unsupported message types", func(t *testing.T) {
+		response, err := router.Route(ctx, newRequest(protocol.CredentialOfferMessageType), packers.MediaTypeZKPMessage)
+		require.NoError(t, err)
+		require.NotNil(t, response)
+		assert.Equal(t, protocol.ProblemReportMessageType, response.Type)
+		assert.Equal(t, "thread-1", response.ThreadID)
+		assert.Equal(t, issuerDID.String(), response.From)
+		assert.Equal(t, userDID.String(), response.To)
+
+		var body protocol.ProblemReportMessageBody
+		require.NoError(t, json.Unmarshal(response.Body, &body))
+		assert.Equal(t, services.ProblemReportCodeUnsupportedMessageType, body.Code)
+	})
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrAPIKeyNotFound is returned when the API key does not exist
+	ErrAPIKeyNotFound = errors.New("api key not found")
+	// ErrAPIKeyUnauthorized is returned when an API key is unknown, revoked or expired
+	ErrAPIKeyUnauthorized = errors.New("api key unauthorized")
+)
+
+type apiKey struct {
+	keys    ports.APIKeyRepository
+	tenants ports.TenantService
+}
+
+// NewAPIKey creates the service that manages the API keys of the node
+func NewAPIKey(keys ports.APIKeyRepository, tenants ports.TenantService) ports.APIKeyService {
+	return &apiKey{keys: keys, tenants: tenants}
+}
+
+// Create creates an API key of the tenant, or of the node when tenantID is nil. The issuers of a tenant key must
+// belong to the tenant. The returned plain key is not stored, so it can't be recovered later.
+func (s *apiKey) Create(ctx context.Context, name string, role domain.Role, tenantID *uuid.UUID, issuerDIDs []w3c.DID, expiresAt *time.Time) (*domain.APIKey, string, error) {
+	if tenantID != nil {
+		if _, err := s.tenants.GetByID(ctx, *tenantID); err != nil {
+			return nil, "", err
+		}
+	}
+	issuers := make([]string, len(issuerDIDs))
+	for i := range issuerDIDs {
+		issuers[i] = issuerDIDs[i].String()
+		if tenantID == nil {
+			continue
+		}
+		tenant, err := s.tenants.GetByIssuer(ctx, issuerDIDs[i])
+		if err != nil && !errors.Is(err, ErrTenantNotFound) {
+			return nil, "", err
+		}
+		if tenant == nil || tenant.ID != *tenantID {
+			return nil, "", fmt.Errorf("%w: issuer %s is not an issuer of the tenant", domain.ErrInvalidAPIKey, issuers[i])
+		}
+	}
+	key, plain, err := domain.NewAPIKey(name, role, tenantID, issuers, expiresAt)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.keys.Save(ctx, key); err != nil {
+		log.Error(ctx, "saving api key", "err", err, "name", name)
+		return nil, "", err
+	}
+	return key, plain, nil
+}
+
+// GetAll returns the API keys of the tenant, or all the API keys of the node when tenantID is nil
+func (s *apiKey) GetAll(ctx context.Context, tenantID *uuid.UUID) ([]domain.APIKey, error) {
+	return s.keys.GetAll(ctx, tenantID)
+}
+
+// Revoke revokes the API key of the tenant, or of any tenant when tenantID is nil. It can't be used anymore.
+func (s *apiKey) Revoke(ctx context.Context, id uuid.UUID, tenantID *uuid.UUID) error {
+	err := s.keys.Revoke(ctx, id, tenantID, time.Now())
+	if errors.Is(err, repositories.APIKeyNotFoundErr) {
+		return ErrAPIKeyNotFound
+	}
+	return err
+}
+
+// Authenticate returns the active API key of the plain key
+func (s *apiKey) Authenticate(ctx context.Context, plain string) (*domain.APIKey, error) {
+	prefix, err := domain.ParseAPIKeyPrefix(plain)
+	if err != nil {
+		return nil, ErrAPIKeyUnauthorized
+	}
+	key, err := s.keys.GetByPrefix(ctx, prefix)
+	if err != nil {
+		if errors.Is(err, repositories.APIKeyNotFoundErr) {
+			return nil, ErrAPIKeyUnauthorized
+		}
+		log.Error(ctx, "loading api key", "err", err, "prefix", prefix)
+		return nil, err
+	}
+	if !key.Matches(plain) || !key.Active(time.Now()) {
+		return nil, ErrAPIKeyUnauthorized
+	}
+	return key, nil
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/auth"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// auditVerifyBatch is the number of entries loaded at once to verify the chain of an issuer
+const auditVerifyBatch = 500
+
+type audit struct {
+	entries ports.AuditRepository
+}
+
+// NewAudit creates the service that keeps the audit log of the issuers
+func NewAudit(entries ports.AuditRepository) ports.AuditService {
+	return &audit{entries: entries}
+}
+
+// Record appends the change to the audit log of the issuer in the transaction of conn, and moves the head of the
+// log to it. Entries of the same issuer are serialized until the transaction ends, so the chain can't fork.
+func (a *audit) Record(ctx context.Context, conn db.Querier, issuerDID w3c.DID, change *domain.AuditChange) error {
+	entry := &domain.AuditEntry{
+		IssuerDID: issuerDID.String(),
+		Actor:     domain.AuditActorSystem,
+		Entity:    change.Entity,
+		Operation: change.Operation,
+		EntityID:  change.EntityID,
+		Diff:      change.Diff,
+		CreatedAt: time.Now(),
+	}
+	if principal := auth.PrincipalFromContext(ctx); principal != nil {
+		entry.Actor, entry.ActorMethod = principal.ID, principal.Method
+	}
+	origin := auth.OriginFromContext(ctx)
+	entry.RequestID, entry.SourceIP = origin.RequestID, origin.SourceIP
+
+	// a savepoint inside the transaction of the change, or a transaction of its own when conn is the pool
+	err := conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		last, err := a.entries.GetLast(ctx, tx, issuerDID)
+		if err != nil {
+			return err
+		}
+		if err := entry.Chain(last); err != nil {
+			return err
+		}
+		return a.entries.Save(ctx, tx, entry)
+	})
+	if err != nil {
+		log.Error(ctx, "recording audit entry", "err", err, "entity", change.Entity, "operation", change.Operation, "id", change.EntityID)
+		return err
+	}
+	return nil
+}
+
+// GetAll returns a page of the audit log of the issuer, the most recent entries first
+func (a *audit) GetAll(ctx context.Context, issuerDID w3c.DID, filter ports.AuditFilter) ([]domain.AuditEntry, uint, error) {
+	return a.entries.GetAll(ctx, issuerDID, filter)
+}
+
+// Verify checks the hash chain of the audit log of the issuer, and returns the first entry that was tampered with.
+// The chain must reach the head of the log, otherwise the first missing entry is returned.
+func (a *audit) Verify(ctx context.Context, issuerDID w3c.DID) (*ports.AuditChainStatus, error) {
+	// the head is loaded first, entries appended meanwhile come after it
+	head, err := a.entries.GetHead(ctx, issuerDID)
+	if err != nil {
+		log.Error(ctx, "loading audit log head", "err", err, "did", issuerDID.String())
+		return nil, err
+	}
+
+	status := &ports.AuditChainStatus{}
+	var prev *domain.AuditEntry
+	for {
+		var afterSeq int64
+		if prev != nil {
+			afterSeq = prev.Seq
+		}
+		entries, err := a.entries.GetChain(ctx, issuerDID, afterSeq, auditVerifyBatch)
+		if err != nil {
+			log.Error(ctx, "loading audit log", "err", err, "did", issuerDID.String())
+			return nil, err
+		}
+		for i := range entries {
+			if !entries[i].Follows(prev) || (head != nil && entries[i].Seq == head.Seq && entries[i].Hash != head.Hash) {
+				log.Warn(ctx, "audit log chain is broken", "did", issuerDID.String(), "seq", entries[i].Seq)
+				status.BrokenAt = &entries[i].Seq
+				return status, nil
+			}
+			prev = &entries[i]
+			status.Entries++
+		}
+		if len(entries) < auditVerifyBatch {
+			break
+		}
+	}
+
+	if head != nil && (prev == nil || prev.Seq < head.Seq) {
+		missing := int64(1)
+		if prev != nil {
+			missing = prev.Seq + 1
+		}
+		log.Warn(ctx, "audit log is truncated", "did", issuerDID.String(), "seq", missing, "head", head.Seq)
+		status.BrokenAt = &missing
+	}
+	return status, nil
+}
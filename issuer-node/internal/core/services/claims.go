@@ -37,8 +37,10 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
 	schemaPkg "github.com/polygonid/sh-id-platform/internal/schema"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 	"github.com/polygonid/sh-id-platform/internal/urn"
 	"github.com/polygonid/sh-id-platform/internal/utils"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/statuslist"
 )
 
 var (
@@ -78,10 +80,13 @@ type claim struct {
 	ipfsClient               *shell.Shell
 	revocationStatusResolver *revocationstatus.Resolver
 	mediatypeManager         ports.MediaTypeManager
+	statusLists              ports.StatusListService
+	audit                    ports.AuditService
+	tenants                  ports.TenantService
 }
 
 // NewClaim creates a new claim service
-func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, eventBus bus.EventBus, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, mediatypeManager ports.MediaTypeManager, cfg config.UniversalLinks) ports.ClaimService {
+func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrService ports.QrStoreService, mtService ports.MtService, identityStateRepository ports.IdentityStateRepository, ld loader.DocumentLoader, storage *db.Storage, host string, eventBus bus.EventBus, ipfsGatewayURL string, revocationStatusResolver *revocationstatus.Resolver, mediatypeManager ports.MediaTypeManager, cfg config.UniversalLinks, statusLists ports.StatusListService, audit ports.AuditService, tenants ports.TenantService) ports.ClaimService {
 	s := &claim{
 		host:                     host,
 		icRepo:                   repo,
@@ -95,6 +100,9 @@ func NewClaim(repo ports.ClaimRepository, idenSrv ports.IdentityService, qrServi
 		revocationStatusResolver: revocationStatusResolver,
 		mediatypeManager:         mediatypeManager,
 		cfg:                      cfg,
+		statusLists:              statusLists,
+		audit:                    audit,
+		tenants:                  tenants,
 	}
 	if ipfsGatewayURL != "" {
 		s.ipfsClient = shell.NewShell(ipfsGatewayURL)
@@ -111,12 +119,26 @@ func (c *claim) Save(ctx context.Context, req *ports.CreateClaimRequest) (*domai
 	if err != nil {
 		return nil, err
 	}
-	claim.ID, err = c.icRepo.Save(ctx, c.storage.Pgx, claim)
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := c.tenants.CheckCredentialQuota(ctx, tx, *req.DID); err != nil {
+			return err
+		}
+		claim.ID, err = c.icRepo.Save(ctx, tx, claim)
+		if err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityCredential, domain.AuditOperationCreate, claim.ID.String(), nil, claim.AuditView())
+		if err != nil {
+			return err
+		}
+		return c.audit.Record(ctx, tx, *req.DID, change)
+	})
 	if err != nil {
 		return nil, err
 	}
+	telemetry.CredentialsIssued.Inc()
 	if req.SignatureProof {
-		err = c.eventBus.Publish(event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: []string{claim.ID.String()}, IssuerID: req.DID.String()})
+		err = bus.Publish(ctx, c.eventBus, event.CreateCredentialEvent, &event.CreateCredential{CredentialIDs: []string{claim.ID.String()}, IssuerID: req.DID.String()})
 		if err != nil {
 			log.Error(ctx, "publish CreateCredentialEvent", "err", err.Error(), "credential", claim.ID.String())
 		}
@@ -136,7 +158,6 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 		log.Error(ctx, "create claim request validation", "req", req, "err", err)
 		return nil, err
 	}
-
 	var nonce uint64
 	var err error
 	if req.RevNonce != nil {
@@ -284,7 +305,11 @@ func (c *claim) CreateCredential(ctx context.Context, req *ports.CreateClaimRequ
 }
 
 func (c *claim) Revoke(ctx context.Context, id w3c.DID, nonce uint64, description string) error {
-	return c.revoke(ctx, &id, nonce, description, c.storage.Pgx)
+	if err := c.revoke(ctx, &id, nonce, description, c.storage.Pgx); err != nil {
+		return err
+	}
+	telemetry.CredentialsRevoked.Inc()
+	return nil
 }
 
 func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, issuerID w3c.DID) error {
@@ -293,7 +318,7 @@ func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, i
 		return err
 	}
 
-	return c.storage.Pgx.BeginFunc(ctx,
+	err = c.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
 			for _, credential := range credentials {
 				err := c.revoke(ctx, &issuerID, uint64(credential.RevNonce), "", tx)
@@ -303,6 +328,11 @@ func (c *claim) RevokeAllFromConnection(ctx context.Context, connID uuid.UUID, i
 			}
 			return nil
 		})
+	if err != nil {
+		return err
+	}
+	telemetry.CredentialsRevoked.Add(float64(len(credentials)))
+	return nil
 }
 
 func (c *claim) Delete(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) error {
@@ -331,7 +361,16 @@ func (c *claim) Delete(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) er
 		return ErrAuthCredentialCannotBeRevoked
 	}
 
-	err = c.icRepo.Delete(ctx, c.storage.Pgx, id)
+	err = c.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := c.icRepo.Delete(ctx, tx, id); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityCredential, domain.AuditOperationDelete, id.String(), claim.AuditView(), nil)
+		if err != nil {
+			return err
+		}
+		return c.audit.Record(ctx, tx, *issuerDID, change)
+	})
 	if err != nil {
 		if errors.Is(err, repositories.ErrClaimDoesNotExist) {
 			return ErrCredentialNotFound
@@ -717,12 +756,25 @@ func (c *claim) revoke(ctx context.Context, did *w3c.DID, nonce uint64, descript
 	err = c.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
 			for _, claim := range claims {
+				before := *claim
 				claim.Revoked = true
 				_, err = c.icRepo.Save(ctx, tx, claim)
 				if err != nil {
 					log.Error(ctx, "error saving the claim", "err", err)
 					return fmt.Errorf("error saving the claim: %w", err)
 				}
+				change, err := domain.NewAuditChange(domain.AuditEntityCredential, domain.AuditOperationRevoke, claim.ID.String(), before.AuditView(), claim.AuditView())
+				if err != nil {
+					return err
+				}
+				if err := c.audit.Record(ctx, tx, *did, change); err != nil {
+					return fmt.Errorf("error recording the revocation: %w", err)
+				}
+			}
+
+			if err := c.statusLists.Revoke(ctx, tx, *did, claims); err != nil {
+				log.Error(ctx, "error revoking the claims in the status lists", "err", err)
+				return fmt.Errorf("error revoking the claims in the status lists: %w", err)
 			}
 
 			return c.icRepo.RevokeNonce(ctx, tx, &revocation)
@@ -922,6 +974,13 @@ func (c *claim) guardCreateClaimRequest(req *ports.CreateClaimRequest) error {
 			}
 			return nil
 		},
+		// check the issuer can sign the status list of the credential
+		func() error {
+			if req.CredentialStatusType != statuslist.BitstringStatusListEntry {
+				return nil
+			}
+			return validateStatusListIssuer(req.DID)
+		},
 	}
 	if req.RefreshService != nil {
 		if req.Expiration == nil {
@@ -962,7 +1021,12 @@ func (c *claim) newVerifiableCredential(ctx context.Context, claimReq *ports.Cre
 		log.Error(ctx, "getting latest issuer state", "err", err)
 		return verifiable.W3CCredential{}, err
 	}
-	cs, err := c.revocationStatusResolver.GetCredentialRevocationStatus(ctx, *claimReq.DID, nonce, *latestIssuerState.State, claimReq.CredentialStatusType)
+	var cs interface{}
+	if claimReq.CredentialStatusType == statuslist.BitstringStatusListEntry {
+		cs, err = c.statusLists.Allocate(ctx, *claimReq.DID)
+	} else {
+		cs, err = c.revocationStatusResolver.GetCredentialRevocationStatus(ctx, *claimReq.DID, nonce, *latestIssuerState.State, claimReq.CredentialStatusType)
+	}
 	if err != nil {
 		log.Error(ctx, "getting credential status", "err", err)
 		return verifiable.W3CCredential{}, err
@@ -69,8 +69,8 @@ func (c *connection) GetByIDAndIssuerID(ctx context.Context, id uuid.UUID, issue
 	return conn, nil
 }
 
-func (c *connection) GetByUserSessionID(ctx context.Context, sessionID uuid.UUID) (*domain.Connection, error) {
-	conn, err := c.connRepo.GetByUserSessionID(ctx, c.storage.Pgx, sessionID)
+func (c *connection) GetByUserSessionID(ctx context.Context, sessionID uuid.UUID, tenantID *uuid.UUID) (*domain.Connection, error) {
+	conn, err := c.connRepo.GetByUserSessionID(ctx, c.storage.Pgx, sessionID, tenantID)
 	if err != nil {
 		if errors.Is(err, repositories.ErrConnectionDoesNotExist) {
 			return nil, ErrConnectionDoesNotExist
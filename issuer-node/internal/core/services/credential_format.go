@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/primitive"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	schemaPkg "github.com/polygonid/sh-id-platform/internal/schema"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/jwtvc"
+)
+
+var (
+	// ErrCredentialFormatUnsupported is returned when the credential format is unknown
+	ErrCredentialFormatUnsupported = errors.New("unsupported credential format")
+	// ErrCredentialFormatUnsupportedIssuer is returned when a JWT based format is requested for an identity
+	// whose signing key can't be resolved from its DID
+	ErrCredentialFormatUnsupportedIssuer = errors.New("JWT credential formats are only supported for ethereum based identities")
+	// ErrCredentialFormatKeyNotFound is returned when the ethereum key of the identity is not in the KMS
+	ErrCredentialFormatKeyNotFound = errors.New("ethereum key of the identity not found")
+)
+
+type credentialFormat struct {
+	repository     ports.EncodedCredentialRepository
+	linkRepository ports.LinkRepository
+	kms            kms.KMSType
+}
+
+// NewCredentialFormat creates the service that encodes credentials in the JWT based formats
+func NewCredentialFormat(repository ports.EncodedCredentialRepository, linkRepository ports.LinkRepository, keyStore kms.KMSType) ports.CredentialFormatService {
+	return &credentialFormat{
+		repository:     repository,
+		linkRepository: linkRepository,
+		kms:            keyStore,
+	}
+}
+
+// Validate checks the identity can issue credentials in the given format
+func (c *credentialFormat) Validate(_ context.Context, issuerDID w3c.DID, format domain.CredentialFormat) error {
+	return validateCredentialFormat(&issuerDID, format)
+}
+
+// Encode returns the credential in the given format. The first encoding is stored and returned by the next calls,
+// so holders always get the same signed token.
+func (c *credentialFormat) Encode(ctx context.Context, claim *domain.Claim, format domain.CredentialFormat) (*domain.EncodedCredential, error) {
+	issuerDID, err := w3c.ParseDID(claim.Issuer)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCredentialFormat(issuerDID, format); err != nil {
+		return nil, err
+	}
+	if format == domain.CredentialFormatIden3 {
+		return nil, fmt.Errorf("%w: iden3 credentials are not encoded", ErrCredentialFormatUnsupported)
+	}
+
+	encoded, err := c.repository.Get(ctx, *issuerDID, claim.ID, format)
+	if err == nil {
+		return encoded, nil
+	}
+	if !errors.Is(err, repositories.EncodedCredentialNotFoundErr) {
+		return nil, err
+	}
+
+	vc, err := schemaPkg.FromClaimModelToW3CCredential(*claim)
+	if err != nil {
+		log.Error(ctx, "getting verifiable credential", "err", err, "id", claim.ID)
+		return nil, err
+	}
+	signer, err := identitySigner(ctx, c.kms, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+
+	var token string
+	credential := jwtvc.FromW3CCredential(vc)
+	switch format {
+	case domain.CredentialFormatJWT:
+		token, err = jwtvc.EncodeJWT(ctx, credential, signer)
+	case domain.CredentialFormatSDJWT:
+		token, err = jwtvc.EncodeSDJWT(ctx, credential, signer)
+	}
+	if err != nil {
+		log.Error(ctx, "encoding credential", "err", err, "id", claim.ID, "format", format)
+		return nil, err
+	}
+
+	if err := c.repository.Save(ctx, &domain.EncodedCredential{
+		ID:         claim.ID,
+		Identifier: issuerDID.String(),
+		Format:     format,
+		Credential: token,
+	}); err != nil {
+		return nil, err
+	}
+	return c.repository.Get(ctx, *issuerDID, claim.ID, format)
+}
+
+// DefaultFormat returns the format a credential is delivered in when none is requested: the one it was issued in,
+// that for credentials issued from links is the format of the link.
+func (c *credentialFormat) DefaultFormat(ctx context.Context, claim *domain.Claim) (domain.CredentialFormat, error) {
+	issuerDID, err := w3c.ParseDID(claim.Issuer)
+	if err != nil {
+		return "", err
+	}
+	encodings, err := c.repository.GetByCredential(ctx, *issuerDID, claim.ID)
+	if err != nil {
+		return "", err
+	}
+	if len(encodings) > 0 {
+		return encodings[0].Format, nil
+	}
+	if claim.LinkID != nil {
+		link, err := c.linkRepository.GetByID(ctx, *issuerDID, *claim.LinkID)
+		if err != nil {
+			return "", err
+		}
+		return link.CredentialFormat, nil
+	}
+	return domain.CredentialFormatIden3, nil
+}
+
+// identitySigner returns the signer of the ethereum key the DID of the identity is built from
+func identitySigner(ctx context.Context, keyStore kms.KMSType, issuerDID *w3c.DID) (*primitive.ETHSigner, error) {
+	address, err := jwtvc.IssuerAddress(issuerDID.String())
+	if err != nil {
+		return nil, ErrCredentialFormatUnsupportedIssuer
+	}
+	keyIDs, err := keyStore.KeysByIdentity(ctx, *issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	for _, keyID := range keyIDs {
+		if keyID.Type != kms.KeyTypeEthereum {
+			continue
+		}
+		pubKey, err := ethPubKey(ctx, keyStore, keyID)
+		if err != nil {
+			return nil, err
+		}
+		if crypto.PubkeyToAddress(*pubKey) == address {
+			return primitive.NewETHSigner(keyStore, keyID)
+		}
+	}
+	return nil, ErrCredentialFormatKeyNotFound
+}
+
+func validateCredentialFormat(issuerDID *w3c.DID, format domain.CredentialFormat) error {
+	if !format.IsValid() {
+		return ErrCredentialFormatUnsupported
+	}
+	if format == domain.CredentialFormatIden3 {
+		return nil
+	}
+	isEthIdentity, _, err := common.CheckEthIdentityByDID(issuerDID)
+	if err != nil {
+		return err
+	}
+	if !isEthIdentity {
+		return ErrCredentialFormatUnsupportedIssuer
+	}
+	return nil
+}
@@ -40,6 +40,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/repositories"
 	"github.com/polygonid/sh-id-platform/internal/reversehash"
 	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 	"github.com/polygonid/sh-id-platform/internal/urn"
 	"github.com/polygonid/sh-id-platform/internal/utils"
 	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/circuit/signer"
@@ -96,11 +97,12 @@ type identity struct {
 	networkResolver          network.Resolver
 	rhsFactory               reversehash.Factory
 	keyRepository            ports.KeyRepository
+	tenants                  ports.TenantService
 }
 
 // NewIdentity creates a new identity
 // nolint
-func NewIdentity(kms kms.KMSType, identityRepository ports.IdentityRepository, imtRepository ports.IdentityMerkleTreeRepository, identityStateRepository ports.IdentityStateRepository, mtservice ports.MtService, qrService ports.QrStoreService, claimsRepository ports.ClaimRepository, revocationRepository ports.RevocationRepository, connectionsRepository ports.ConnectionRepository, storage *db.Storage, verifier *auth.Verifier, sessionRepository ports.SessionRepository, eventBus bus.EventBus, networkResolver network.Resolver, rhsFactory reversehash.Factory, revocationStatusResolver *revocationstatus.Resolver, keyRepository ports.KeyRepository) ports.IdentityService {
+func NewIdentity(kms kms.KMSType, identityRepository ports.IdentityRepository, imtRepository ports.IdentityMerkleTreeRepository, identityStateRepository ports.IdentityStateRepository, mtservice ports.MtService, qrService ports.QrStoreService, claimsRepository ports.ClaimRepository, revocationRepository ports.RevocationRepository, connectionsRepository ports.ConnectionRepository, storage *db.Storage, verifier *auth.Verifier, sessionRepository ports.SessionRepository, eventBus bus.EventBus, networkResolver network.Resolver, rhsFactory reversehash.Factory, revocationStatusResolver *revocationstatus.Resolver, keyRepository ports.KeyRepository, tenants ports.TenantService) ports.IdentityService {
 	return &identity{
 		identityRepository:       identityRepository,
 		imtRepository:            imtRepository,
@@ -120,6 +122,7 @@ func NewIdentity(kms kms.KMSType, identityRepository ports.IdentityRepository, i
 		rhsFactory:               rhsFactory,
 		revocationStatusResolver: revocationStatusResolver,
 		keyRepository:            keyRepository,
+		tenants:                  tenants,
 	}
 }
 
@@ -150,6 +153,10 @@ func (i *identity) Create(ctx context.Context, hostURL string, didOptions *ports
 	var err error
 	err = i.storage.Pgx.BeginFunc(ctx,
 		func(tx pgx.Tx) error {
+			if err := i.tenants.CheckIdentityQuota(ctx, tx, didOptions.Tenant()); err != nil {
+				return err
+			}
+
 			var keyType kms.KeyType
 			if didOptions == nil || didOptions.KeyType == "" {
 				keyType = kms.KeyTypeBabyJubJub
@@ -238,9 +245,9 @@ func (i *identity) Exists(ctx context.Context, identifier w3c.DID) (bool, error)
 	return identity != nil, nil
 }
 
-// Get - returns all the identities
-func (i *identity) Get(ctx context.Context) (identities []domain.IdentityDisplayName, err error) {
-	return i.identityRepository.Get(ctx, i.storage.Pgx)
+// Get - returns the identities of the tenant, or all the identities when tenantID is nil
+func (i *identity) Get(ctx context.Context, tenantID *uuid.UUID) (identities []domain.IdentityDisplayName, err error) {
+	return i.identityRepository.Get(ctx, i.storage.Pgx, tenantID)
 }
 
 // GetLatestStateByID get latest identity state by identifier
@@ -394,6 +401,7 @@ func (i *identity) UpdateState(ctx context.Context, did w3c.DID) (*domain.Identi
 	if err != nil {
 		return nil, err
 	}
+	telemetry.StateTransitions.WithLabelValues(string(domain.StatusCreated)).Inc()
 
 	return newState, err
 }
@@ -510,7 +518,7 @@ func (i *identity) AuthenticateWithRequest(ctx context.Context, sessionID *uuid.
 	}
 
 	if connID == conn.ID { // a connection has been created so previously created credentials have to be sent
-		err = i.eventBus.Publish(event.CreateConnectionEvent, &event.CreateConnection{ConnectionID: connID.String(), IssuerID: issuerDID.String()})
+		err = bus.Publish(ctx, i.eventBus, event.CreateConnectionEvent, &event.CreateConnection{ConnectionID: connID.String(), IssuerID: issuerDID.String()})
 		if err != nil {
 			log.Error(ctx, "sending connection notification", "err", err.Error(), "connection", connID)
 		}
@@ -664,6 +672,7 @@ func (i *identity) createEthIdentity(ctx context.Context, tx db.Querier, hostURL
 	}
 
 	identity.DisplayName = didOptions.DisplayName
+	identity.TenantID = didOptions.Tenant()
 
 	if err = i.identityRepository.Save(ctx, tx, identity); err != nil {
 		if errors.Is(err, repositories.ErrDisplayNameDuplicated) {
@@ -772,6 +781,7 @@ func (i *identity) createIdentity(ctx context.Context, tx db.Querier, hostURL st
 		return nil, nil, fmt.Errorf("can't add genesis claims to tree: %w", err)
 	}
 	identity.DisplayName = didOptions.DisplayName
+	identity.TenantID = didOptions.Tenant()
 
 	claimsTree, err := mts.ClaimsTree()
 	if err != nil {
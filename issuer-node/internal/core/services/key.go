@@ -10,10 +10,12 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
@@ -34,14 +36,18 @@ type Key struct {
 	kms           *kms.KMS
 	claimService  ports.ClaimService
 	keyRepository ports.KeyRepository
+	storage       *db.Storage
+	audit         ports.AuditService
 }
 
 // NewKey creates a new Key
-func NewKey(kms *kms.KMS, claimService ports.ClaimService, keyRepository ports.KeyRepository) ports.KeyService {
+func NewKey(kms *kms.KMS, claimService ports.ClaimService, keyRepository ports.KeyRepository, storage *db.Storage, audit ports.AuditService) ports.KeyService {
 	return &Key{
 		kms:           kms,
 		claimService:  claimService,
 		keyRepository: keyRepository,
+		storage:       storage,
+		audit:         audit,
 	}
 }
 
@@ -60,7 +66,7 @@ func (ks *Key) Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, na
 		}
 	}
 
-	if keyType == kms.KeyTypeBabyJubJub {
+	if keyType == kms.KeyTypeBabyJubJub || keyType == kms.KeyTypeX25519 {
 		keyID, err = ks.kms.CreateKey(keyType, did)
 		if err != nil {
 			log.Error(ctx, "failed to create key", "err", err)
@@ -89,13 +95,22 @@ func (ks *Key) Create(ctx context.Context, did *w3c.DID, keyType kms.KeyType, na
 
 	publicKey := hexutil.Encode(publicKeyAsBytes)
 	keyToSave := domain.NewKey(*did, publicKey, name)
-	_, err = ks.keyRepository.Save(ctx, nil, keyToSave)
+	encodedKeyID := b64.StdEncoding.EncodeToString([]byte(keyID.ID))
+	err = ks.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := ks.keyRepository.Save(ctx, tx, keyToSave); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityKey, domain.AuditOperationCreate, encodedKeyID, nil, keyToSave)
+		if err != nil {
+			return err
+		}
+		return ks.audit.Record(ctx, tx, *did, change)
+	})
 	if err != nil {
 		log.Error(ctx, "failed to save key", "err", err)
 		return kms.KeyID{}, err
 	}
 
-	encodedKeyID := b64.StdEncoding.EncodeToString([]byte(keyID.ID))
 	log.Info(ctx, "key created successfully", "keyID", encodedKeyID)
 	keyID.ID = encodedKeyID
 	return keyID, nil
@@ -136,12 +151,23 @@ func (ks *Key) Update(ctx context.Context, did *w3c.DID, keyID string, name stri
 			return err
 		}
 	}
+	var before *domain.Key
 	if keyInfo == nil {
 		keyInfo = domain.NewKey(*did, hexutil.Encode(publicKey), name)
+	} else {
+		before = common.ToPointer(*keyInfo)
 	}
 	keyInfo.Name = name
-	_, err = ks.keyRepository.Save(ctx, nil, keyInfo)
-	return err
+	return ks.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := ks.keyRepository.Save(ctx, tx, keyInfo); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityKey, domain.AuditOperationUpdate, b64.StdEncoding.EncodeToString([]byte(keyID)), before, keyInfo)
+		if err != nil {
+			return err
+		}
+		return ks.audit.Record(ctx, tx, *did, change)
+	})
 }
 
 // Get returns the public key for the given keyID
@@ -187,6 +213,8 @@ func (ks *Key) Get(ctx context.Context, did *w3c.DID, keyID string) (*ports.KMSK
 			log.Error(ctx, "failed to check if key has associated auth credential", "err", err)
 			return nil, err
 		}
+	case kms.KeyTypeX25519:
+		// key agreement keys are not used by the identity auth credentials
 	default:
 		return nil, ErrInvalidKeyType
 	}
@@ -320,11 +348,29 @@ func (ks *Key) Delete(ctx context.Context, did *w3c.DID, keyID string) error {
 			log.Info(ctx, "can not be deleted because it is associated with the identity")
 			return ErrKeyAssociatedWithIdentity
 		}
+	case kms.KeyTypeX25519:
 	default:
 		return ErrInvalidKeyType
 	}
 
-	if err := ks.keyRepository.Delete(ctx, *did, hexutil.Encode(publicKey)); err != nil {
+	keyInfo, err := ks.keyRepository.GetByPublicKey(ctx, *did, hexutil.Encode(publicKey))
+	if err != nil {
+		if !errors.Is(err, repositories.ErrKeyNotFound) {
+			return err
+		}
+		keyInfo = domain.NewKey(*did, hexutil.Encode(publicKey), "")
+	}
+	err = ks.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := ks.keyRepository.Delete(ctx, tx, *did, hexutil.Encode(publicKey)); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityKey, domain.AuditOperationDelete, b64.StdEncoding.EncodeToString([]byte(keyID)), keyInfo, nil)
+		if err != nil {
+			return err
+		}
+		return ks.audit.Record(ctx, tx, *did, change)
+	})
+	if err != nil {
 		log.Error(ctx, "failed to delete key", "err", err)
 		return err
 	}
@@ -349,7 +395,9 @@ func (ks *Key) getPublicKey(ctx context.Context, keyID string) ([]byte, error) {
 // getKeyType returns the key type for the given keyID
 func getKeyType(keyID string) (kms.KeyType, error) {
 	var keyType kms.KeyType
-	if strings.Contains(keyID, "BJJ") {
+	if strings.Contains(keyID, "/"+string(kms.KeyTypeX25519)+":") {
+		keyType = kms.KeyTypeX25519
+	} else if strings.Contains(keyID, "BJJ") {
 		keyType = kms.KeyTypeBabyJubJub
 	} else if strings.Contains(keyID, "ETH") {
 		keyType = kms.KeyTypeEthereum
@@ -28,6 +28,8 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/qrlink"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/statuslist"
 )
 
 var (
@@ -57,10 +59,12 @@ type Link struct {
 	publisher        pubsub.Publisher
 	identityService  ports.IdentityService
 	networkResolver  network.Resolver
+	audit            ports.AuditService
+	tenants          ports.TenantService
 }
 
 // NewLinkService - constructor
-func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrService ports.QrStoreService, claimRepository ports.ClaimRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, identityService ports.IdentityService, networkResolver network.Resolver, cfg config.UniversalLinks) ports.LinkService {
+func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrService ports.QrStoreService, claimRepository ports.ClaimRepository, linkRepository ports.LinkRepository, schemaRepository ports.SchemaRepository, ld loader.DocumentLoader, sessionManager ports.SessionRepository, publisher pubsub.Publisher, identityService ports.IdentityService, networkResolver network.Resolver, cfg config.UniversalLinks, audit ports.AuditService, tenants ports.TenantService) ports.LinkService {
 	return &Link{
 		storage:          storage,
 		claimsService:    claimsService,
@@ -74,6 +78,8 @@ func NewLinkService(storage *db.Storage, claimsService ports.ClaimService, qrSer
 		identityService:  identityService,
 		networkResolver:  networkResolver,
 		cfg:              cfg,
+		audit:            audit,
+		tenants:          tenants,
 	}
 }
 
@@ -90,6 +96,8 @@ func (ls *Link) Save(
 	credentialSubject domain.CredentialSubject,
 	refreshService *verifiable.RefreshService,
 	displayMethod *verifiable.DisplayMethod,
+	credentialFormat domain.CredentialFormat,
+	credentialStatusType verifiable.CredentialStatusType,
 ) (*domain.Link, error) {
 	schemaDB, err := ls.schemaRepository.GetByID(ctx, did, schemaID)
 	if err != nil {
@@ -109,8 +117,32 @@ func (ls *Link) Save(
 		return nil, err
 	}
 
+	if err = validateCredentialFormat(&did, credentialFormat); err != nil {
+		log.Error(ctx, "validating credential format", "err", err, "format", credentialFormat)
+		return nil, err
+	}
+	if credentialStatusType == statuslist.BitstringStatusListEntry {
+		if err = validateStatusListIssuer(&did); err != nil {
+			log.Error(ctx, "validating credential status type", "err", err, "type", credentialStatusType)
+			return nil, err
+		}
+	}
+
 	link := domain.NewLink(did, maxIssuance, validUntil, schemaID, credentialExpiration, credentialSignatureProof, credentialMTPProof, credentialSubject, refreshService, displayMethod)
-	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
+	link.CredentialFormat = credentialFormat
+	if credentialStatusType != "" {
+		link.CredentialStatusType = &credentialStatusType
+	}
+	err = ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := ls.linkRepository.Save(ctx, tx, link); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityLink, domain.AuditOperationCreate, link.ID.String(), nil, link)
+		if err != nil {
+			return err
+		}
+		return ls.audit.Record(ctx, tx, did, change)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -135,9 +167,18 @@ func (ls *Link) Activate(ctx context.Context, issuerID w3c.DID, linkID uuid.UUID
 		return ErrLinkAlreadyInactive
 	}
 
+	before := *link
 	link.Active = active
-	_, err = ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
-	return err
+	return ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := ls.linkRepository.Save(ctx, tx, link); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityLink, domain.AuditOperationUpdate, link.ID.String(), &before, link)
+		if err != nil {
+			return err
+		}
+		return ls.audit.Record(ctx, tx, issuerID, change)
+	})
 }
 
 // GetByID returns a link by id and issuerDID
@@ -203,7 +244,20 @@ func (ls *Link) addLinksToLink(link *domain.Link, serverURL string, issuerDID w3
 
 // Delete - delete a link by id
 func (ls *Link) Delete(ctx context.Context, id uuid.UUID, did w3c.DID) error {
-	return ls.linkRepository.Delete(ctx, id, did)
+	link, err := ls.linkRepository.GetByID(ctx, did, id)
+	if err != nil {
+		return err
+	}
+	return ls.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := ls.linkRepository.Delete(ctx, tx, id, did); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityLink, domain.AuditOperationDelete, id.String(), link, nil)
+		if err != nil {
+			return err
+		}
+		return ls.audit.Record(ctx, tx, did, change)
+	})
 }
 
 // CreateQRCode - generates a qr code for a link
@@ -237,21 +291,42 @@ func (ls *Link) CreateQRCode(ctx context.Context, issuerDID w3c.DID, linkID uuid
 
 // IssueOrFetchClaim - Create a new claim
 func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID, hostURL string) (*protocol.CredentialsOfferMessage, error) {
+	credentialIssued, link, err := ls.IssueOrFetchCredential(ctx, issuerDID, userDID, linkID)
+	if err != nil {
+		return nil, err
+	}
+
+	if link.CredentialSignatureProof {
+		credOffer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, hostURL), credentialIssued)
+		return credOffer, err
+	} else {
+		if credentialIssued.MTPProof.Bytes != nil {
+			credOffer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, hostURL), credentialIssued)
+			return credOffer, err
+		}
+		log.Info(ctx, "credential issued without MTP proof. Publishing state have to be done", "credential", credentialIssued.ID.String())
+		return nil, nil
+	}
+}
+
+// IssueOrFetchCredential - issues the credential of the link for the user, or returns the one already issued.
+// The link must be valid, so its expiration and maximum number of issuances are respected.
+func (ls *Link) IssueOrFetchCredential(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, linkID uuid.UUID) (*domain.Claim, *domain.Link, error) {
 	link, err := ls.linkRepository.GetByID(ctx, issuerDID, linkID)
 	if err != nil {
 		log.Error(ctx, "cannot fetch the link", "err", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	issuedByUser, err := ls.claimRepository.GetClaimsIssuedForUser(ctx, ls.storage.Pgx, issuerDID, userDID, linkID)
 	if err != nil {
 		log.Error(ctx, "cannot fetch the claims issued for the user", "err", err, "issuerDID", issuerDID, "userDID", userDID)
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := ls.Validate(ctx, link); err != nil {
 		log.Error(ctx, "cannot Validate the link", "err", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	var credentialIssuedID uuid.UUID
@@ -260,7 +335,7 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 	schema, err := ls.schemaRepository.GetByID(ctx, issuerDID, link.SchemaID)
 	if err != nil {
 		log.Error(ctx, "cannot fetch the schema", "err", err)
-		return nil, err
+		return nil, nil, err
 	}
 
 	claimRequestProofs := ports.ClaimRequestProofs{
@@ -271,9 +346,12 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 		identity, err := ls.identityService.GetByDID(ctx, issuerDID)
 		if err != nil {
 			log.Error(ctx, "cannot fetch the identity", "err", err)
-			return nil, err
+			return nil, nil, err
 		}
 		credentialStatusType := verifiable.CredentialStatusType(identity.AuthCoreClaimRevocationStatus.Type)
+		if link.CredentialStatusType != nil {
+			credentialStatusType = *link.CredentialStatusType
+		}
 		link.CredentialSubject["id"] = userDID.String()
 		claimReq := ports.NewCreateClaimRequest(&issuerDID,
 			nil,
@@ -294,44 +372,43 @@ func (ls *Link) IssueOrFetchClaim(ctx context.Context, issuerDID w3c.DID, userDI
 		credentialIssued, err = ls.claimsService.CreateCredential(ctx, claimReq)
 		if err != nil {
 			log.Error(ctx, "cannot create the claim", "err", err.Error())
-			return nil, err
+			return nil, nil, err
 		}
 
 		err = ls.storage.Pgx.BeginFunc(ctx,
 			func(tx pgx.Tx) error {
+				if err := ls.tenants.CheckCredentialQuota(ctx, tx, issuerDID); err != nil {
+					return err
+				}
 				link.IssuedClaims += 1
-				_, err := ls.linkRepository.Save(ctx, ls.storage.Pgx, link)
+				_, err := ls.linkRepository.Save(ctx, tx, link)
 				if err != nil {
 					return err
 				}
 
-				credentialIssuedID, err = ls.claimRepository.Save(ctx, ls.storage.Pgx, credentialIssued)
+				credentialIssuedID, err = ls.claimRepository.Save(ctx, tx, credentialIssued)
 				if err != nil {
 					return err
 				}
 
-				return nil
+				credentialIssued.ID = credentialIssuedID
+				change, err := domain.NewAuditChange(domain.AuditEntityCredential, domain.AuditOperationCreate, credentialIssuedID.String(), nil, credentialIssued.AuditView())
+				if err != nil {
+					return err
+				}
+				return ls.audit.Record(ctx, tx, issuerDID, change)
 			})
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+		telemetry.CredentialsIssued.Inc()
 	} else {
 		credentialIssuedID = issuedByUser[0].ID
 		credentialIssued = issuedByUser[0]
 	}
 
 	credentialIssued.ID = credentialIssuedID
-	if link.CredentialSignatureProof {
-		credOffer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, hostURL), credentialIssued)
-		return credOffer, err
-	} else {
-		if credentialIssued.MTPProof.Bytes != nil {
-			credOffer, err := notifications.NewOfferMsg(fmt.Sprintf(ports.AgentUrl, hostURL), credentialIssued)
-			return credOffer, err
-		}
-		log.Info(ctx, "credential issued without MTP proof. Publishing state have to be done", "credential", credentialIssued.ID.String())
-		return nil, nil
-	}
+	return credentialIssued, link, nil
 }
 
 // ProcessCallBack - process the callback.
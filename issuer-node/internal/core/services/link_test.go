@@ -18,6 +18,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/loader"
 	networkPkg "github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
@@ -45,9 +46,9 @@ func Test_link_issueClaim(t *testing.T) {
 
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
-	identityService := NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
+	identityService := NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, NewTenant(repositories.NewTenant(*storage), storage))
 	sessionRepository := repositories.NewSessionCached(cachex)
-	schemaService := NewSchema(schemaRepository, docLoader, displayMethodService)
+	schemaService := NewSchema(schemaRepository, docLoader, displayMethodService, repositories.NewSchemaSnapshot(*storage), loader.MultiProtocolFactory(ipfsGatewayURL), storage, NewAudit(repositories.NewAudit(*storage)))
 
 	mediaTypeManager := NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -57,7 +58,7 @@ func Test_link_issueClaim(t *testing.T) {
 		true,
 	)
 
-	claimsService := NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	claimsService := NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, NewStatusList(repositories.NewStatusList(), storage, keyStore, cfg.ServerUrl), NewAudit(repositories.NewAudit(*storage)), NewTenant(repositories.NewTenant(*storage), storage))
 	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: net, KeyType: BJJ})
 	assert.NoError(t, err)
 
@@ -90,15 +91,15 @@ func Test_link_issueClaim(t *testing.T) {
 
 	linkRepository := repositories.NewLink(*storage)
 	qrService := NewQrStoreService(cachex)
-	linkService := NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, docLoader, sessionRepository, pubsub.NewMock(), identityService, *networkResolver, cfg.UniversalLinks)
+	linkService := NewLinkService(storage, claimsService, qrService, claimsRepo, linkRepository, schemaRepository, docLoader, sessionRepository, pubsub.NewMock(), identityService, *networkResolver, cfg.UniversalLinks, NewAudit(repositories.NewAudit(*storage)), NewTenant(repositories.NewTenant(*storage), storage))
 
 	tomorrow := time.Now().Add(24 * time.Hour)
 	nextWeek := time.Now().Add(7 * 24 * time.Hour)
 
-	link, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, true, false, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, domain.CredentialFormatIden3, "")
 	assert.NoError(t, err)
 
-	link2, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, false, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil)
+	link2, err := linkService.Save(ctx, *did, common.ToPointer(100), &tomorrow, schema.ID, &nextWeek, false, true, domain.CredentialSubject{"birthday": 19791109, "documentType": 12}, nil, nil, domain.CredentialFormatIden3, "")
 	assert.NoError(t, err)
 
 	type expected struct {
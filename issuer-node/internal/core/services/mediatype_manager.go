@@ -37,6 +37,14 @@ func (m *MediaTypeManager) AllowMediaType(protoclMessage iden3comm.ProtocolMessa
 	return false
 }
 
+// Allow adds the media types to the allowed list of the protocol message
+func (m *MediaTypeManager) Allow(protocolMessage iden3comm.ProtocolMessage, mediaTypes []string) {
+	if m.allowList == nil {
+		m.allowList = make(map[iden3comm.ProtocolMessage][]string)
+	}
+	m.allowList[protocolMessage] = append(m.allowList[protocolMessage], mediaTypes...)
+}
+
 // GetSupportedProtocolMessages returns the supported by Agent protocol messages
 func (m *MediaTypeManager) GetSupportedProtocolMessages() []iden3comm.ProtocolMessage {
 	var supportedProtocolMessages []iden3comm.ProtocolMessage
@@ -0,0 +1,243 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+const (
+	messageDeliveryBatch = 100
+	// messageDeliveryLease is the time a worker owns the leased messages before other workers can retry them
+	messageDeliveryLease = 5 * time.Minute
+	// messageMaxRetryBackoff caps the exponential backoff between delivery attempts
+	messageMaxRetryBackoff = 24 * time.Hour
+)
+
+type message struct {
+	repository  ports.MessageRepository
+	connService ports.ConnectionService
+	gateway     ports.MessageGateway
+	cfg         config.Messages
+}
+
+// NewMessage creates the iden3comm inbox/outbox service
+func NewMessage(repository ports.MessageRepository, connService ports.ConnectionService, gateway ports.MessageGateway, cfg config.Messages) ports.MessageService {
+	return &message{
+		repository:  repository,
+		connService: connService,
+		gateway:     gateway,
+		cfg:         cfg,
+	}
+}
+
+// Send stores the message in the outbox of the user and tries to deliver it right away.
+// If the delivery fails, the message stays queued to be retried by DeliverPending.
+func (m *message) Send(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, msg json.RawMessage) error {
+	var basicMessage iden3comm.BasicMessage
+	if err := json.Unmarshal(msg, &basicMessage); err != nil {
+		return fmt.Errorf("parsing outbound message: %w", err)
+	}
+	threadID := basicMessage.ThreadID
+	if threadID == "" {
+		threadID = basicMessage.ID
+	}
+
+	outbound := domain.NewOutboundMessage(issuerDID.String(), userDID.String(), basicMessage.ID, threadID, string(basicMessage.Type), msg)
+	if err := m.repository.Save(ctx, outbound); err != nil {
+		log.Error(ctx, "storing outbound message", "err", err, "issuerDID", issuerDID, "userDID", userDID)
+		return err
+	}
+	m.deliver(ctx, outbound)
+	return nil
+}
+
+// DeliverPending retries the delivery of the queued messages whose next attempt is due.
+// It returns the number of messages delivered.
+func (m *message) DeliverPending(ctx context.Context) (int, error) {
+	now := time.Now()
+	messages, err := m.repository.LeaseDue(ctx, now, now.Add(messageDeliveryLease), messageDeliveryBatch)
+	if err != nil {
+		log.Error(ctx, "getting pending messages", "err", err)
+		return 0, err
+	}
+
+	delivered := 0
+	for i := range messages {
+		m.deliver(ctx, &messages[i])
+		if messages[i].Status == domain.MessageStatusSent {
+			delivered++
+		}
+	}
+	return delivered, nil
+}
+
+// RecordInbound stores a message received by the agent and acknowledges the outbound messages of the same thread
+func (m *message) RecordInbound(ctx context.Context, req *ports.AgentRequest) error {
+	if req.IssuerDID == nil || req.UserDID == nil {
+		return nil
+	}
+	threadID := req.ThreadID
+	if threadID == "" {
+		threadID = req.ID.String()
+	}
+	msg, err := json.Marshal(iden3comm.BasicMessage{
+		ID:       req.ID.String(),
+		Typ:      req.Typ,
+		Type:     req.Type,
+		ThreadID: threadID,
+		Body:     req.Body,
+		From:     req.UserDID.String(),
+		To:       req.IssuerDID.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	inbound := domain.NewInboundMessage(req.IssuerDID.String(), req.UserDID.String(), req.ID.String(), threadID, string(req.Type), msg)
+	if err := m.repository.Save(ctx, inbound); err != nil {
+		log.Error(ctx, "storing inbound message", "err", err, "issuerDID", req.IssuerDID, "userDID", req.UserDID)
+		return err
+	}
+
+	acknowledged, err := m.repository.AcknowledgeThread(ctx, *req.IssuerDID, *req.UserDID, threadID)
+	if err != nil {
+		log.Error(ctx, "acknowledging thread", "err", err, "threadID", threadID)
+		return err
+	}
+	if acknowledged > 0 {
+		log.Info(ctx, "outbound messages acknowledged by the user", "count", acknowledged, "threadID", threadID, "userDID", req.UserDID)
+	}
+	return nil
+}
+
+// RecordReply stores the response returned by the agent to the user, which is delivered in the agent response itself
+func (m *message) RecordReply(ctx context.Context, req *ports.AgentRequest, response *iden3comm.BasicMessage) error {
+	if response == nil || req.IssuerDID == nil || req.UserDID == nil {
+		return nil
+	}
+	msg, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	threadID := response.ThreadID
+	if threadID == "" {
+		threadID = req.ThreadID
+	}
+
+	reply := domain.NewOutboundMessage(req.IssuerDID.String(), req.UserDID.String(), response.ID, threadID, string(response.Type), msg)
+	reply.Status = domain.MessageStatusSent
+	reply.Attempts = 1
+	reply.NextAttemptAt = nil
+	if err := m.repository.Save(ctx, reply); err != nil {
+		log.Error(ctx, "storing agent reply", "err", err, "issuerDID", req.IssuerDID, "userDID", req.UserDID)
+		return err
+	}
+	return nil
+}
+
+// GetConversations returns the messages exchanged with the user of the connection grouped by thread.
+// Conversations and their messages are sorted from the oldest to the newest.
+func (m *message) GetConversations(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]domain.Conversation, error) {
+	conn, err := m.connService.GetByIDAndIssuerID(ctx, connID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	messages, err := m.repository.GetByUser(ctx, issuerDID, conn.UserDID)
+	if err != nil {
+		log.Error(ctx, "getting connection messages", "err", err, "connID", connID)
+		return nil, err
+	}
+
+	conversations := make([]domain.Conversation, 0)
+	threads := make(map[string]int)
+	for _, msg := range messages {
+		i, ok := threads[msg.ThreadID]
+		if !ok {
+			i = len(conversations)
+			threads[msg.ThreadID] = i
+			conversations = append(conversations, domain.Conversation{ThreadID: msg.ThreadID})
+		}
+		conversations[i].Messages = append(conversations[i].Messages, msg)
+	}
+	return conversations, nil
+}
+
+// deliver makes a delivery attempt and stores its result, unless the message was acknowledged meanwhile.
+// Failed attempts are retried with an exponential backoff until the max number of attempts is reached.
+func (m *message) deliver(ctx context.Context, msg *domain.Message) {
+	msg.Attempts++
+	err := m.send(ctx, msg)
+	switch {
+	case err == nil:
+		msg.Status = domain.MessageStatusSent
+		msg.NextAttemptAt = nil
+		msg.LastError = nil
+	case msg.Attempts >= m.cfg.MaxAttempts:
+		log.Error(ctx, "message delivery failed", "err", err, "messageID", msg.MessageID, "userDID", msg.UserDID, "attempts", msg.Attempts)
+		msg.Status = domain.MessageStatusFailed
+		msg.NextAttemptAt = nil
+		msg.LastError = common.ToPointer(err.Error())
+	default:
+		log.Warn(ctx, "message delivery attempt failed", "err", err, "messageID", msg.MessageID, "userDID", msg.UserDID, "attempts", msg.Attempts)
+		nextAttemptAt := time.Now().Add(m.retryBackoff(msg.Attempts))
+		msg.NextAttemptAt = &nextAttemptAt
+		msg.LastError = common.ToPointer(err.Error())
+	}
+
+	if err := m.repository.UpdateDelivery(ctx, msg); err != nil {
+		if errors.Is(err, repositories.MessageNotQueuedErr) {
+			log.Debug(ctx, "message no longer queued", "messageID", msg.MessageID)
+			return
+		}
+		log.Error(ctx, "storing message delivery status", "err", err, "messageID", msg.MessageID)
+	}
+}
+
+// retryBackoff returns the time to wait after the given number of failed attempts, doubling the configured backoff
+// after each attempt up to messageMaxRetryBackoff
+func (m *message) retryBackoff(attempts int) time.Duration {
+	backoff := m.cfg.RetryBackoff
+	for i := 1; i < attempts && backoff < messageMaxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > messageMaxRetryBackoff {
+		return messageMaxRetryBackoff
+	}
+	return backoff
+}
+
+func (m *message) send(ctx context.Context, msg *domain.Message) error {
+	issuerDID, err := w3c.ParseDID(msg.IssuerDID)
+	if err != nil {
+		return err
+	}
+	userDID, err := w3c.ParseDID(msg.UserDID)
+	if err != nil {
+		return err
+	}
+	conn, err := m.connService.GetByUserID(ctx, *issuerDID, *userDID)
+	if err != nil {
+		return err
+	}
+
+	var userDIDDoc verifiable.DIDDocument
+	if err := json.Unmarshal(conn.UserDoc, &userDIDDoc); err != nil {
+		return fmt.Errorf("unmarshal user did document: %w", err)
+	}
+	return m.gateway.Deliver(ctx, msg.Message, userDIDDoc)
+}
@@ -0,0 +1,232 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// messageRepository is an in memory MessageRepository
+type messageRepository struct {
+	messages []*domain.Message
+}
+
+func (r *messageRepository) Save(_ context.Context, message *domain.Message) error {
+	for i := range r.messages {
+		if r.messages[i].ID == message.ID {
+			saved := *message
+			r.messages[i] = &saved
+			return nil
+		}
+	}
+	saved := *message
+	r.messages = append(r.messages, &saved)
+	return nil
+}
+
+func (r *messageRepository) UpdateDelivery(_ context.Context, message *domain.Message) error {
+	for i := range r.messages {
+		if r.messages[i].ID == message.ID {
+			if r.messages[i].Status != domain.MessageStatusQueued {
+				return repositories.MessageNotQueuedErr
+			}
+			saved := *message
+			r.messages[i] = &saved
+			return nil
+		}
+	}
+	return repositories.MessageNotQueuedErr
+}
+
+func (r *messageRepository) LeaseDue(_ context.Context, now time.Time, leaseUntil time.Time, limit int) ([]domain.Message, error) {
+	var due []domain.Message
+	for _, message := range r.messages {
+		if message.Status == domain.MessageStatusQueued && !message.NextAttemptAt.After(now) && len(due) < limit {
+			message.NextAttemptAt = &leaseUntil
+			due = append(due, *message)
+		}
+	}
+	return due, nil
+}
+
+func (r *messageRepository) AcknowledgeThread(_ context.Context, _ w3c.DID, _ w3c.DID, threadID string) (int64, error) {
+	var updated int64
+	for _, message := range r.messages {
+		if message.Direction == domain.MessageDirectionOutbound && message.ThreadID == threadID &&
+			(message.Status == domain.MessageStatusQueued || message.Status == domain.MessageStatusSent) {
+			message.Status = domain.MessageStatusAcknowledged
+			message.NextAttemptAt = nil
+			updated++
+		}
+	}
+	return updated, nil
+}
+
+func (r *messageRepository) GetByUser(_ context.Context, _ w3c.DID, userDID w3c.DID) ([]domain.Message, error) {
+	var messages []domain.Message
+	for _, message := range r.messages {
+		if message.UserDID == userDID.String() {
+			messages = append(messages, *message)
+		}
+	}
+	return messages, nil
+}
+
+type messageConnections struct {
+	ports.ConnectionService
+	conn *domain.Connection
+}
+
+func (c *messageConnections) GetByUserID(_ context.Context, _ w3c.DID, _ w3c.DID) (*domain.Connection, error) {
+	return c.conn, nil
+}
+
+func (c *messageConnections) GetByIDAndIssuerID(_ context.Context, id uuid.UUID, _ w3c.DID) (*domain.Connection, error) {
+	if id != c.conn.ID {
+		return nil, services.ErrConnectionDoesNotExist
+	}
+	return c.conn, nil
+}
+
+// messageGateway fails the first deliveries
+type messageGateway struct {
+	failures  int
+	delivered []json.RawMessage
+	onDeliver func()
+}
+
+func (g *messageGateway) Deliver(_ context.Context, msg json.RawMessage, _ verifiable.DIDDocument) error {
+	if g.onDeliver != nil {
+		g.onDeliver()
+	}
+	if g.failures > 0 {
+		g.failures--
+		return errors.New("service unavailable")
+	}
+	g.delivered = append(g.delivered, msg)
+	return nil
+}
+
+func TestMessage_Delivery(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	conn := &domain.Connection{ID: uuid.New(), IssuerDID: *issuerDID, UserDID: *userDID, UserDoc: json.RawMessage(`{"id":"` + userDID.String() + `"}`)}
+	cfg := config.Messages{MaxAttempts: 3}
+
+	offer := func(id string) json.RawMessage {
+		msg, err := json.Marshal(iden3comm.BasicMessage{ID: id, ThreadID: id, Type: protocol.CredentialOfferMessageType, From: issuerDID.String(), To: userDID.String()})
+		require.NoError(t, err)
+		return msg
+	}
+
+	t.Run("should deliver right away", func(t *testing.T) {
+		repository := &messageRepository{}
+		gateway := &messageGateway{}
+		messageService := services.NewMessage(repository, &messageConnections{conn: conn}, gateway, cfg)
+		require.NoError(t, messageService.Send(ctx, *issuerDID, *userDID, offer("1")))
+		require.Len(t, repository.messages, 1)
+		assert.Equal(t, domain.MessageStatusSent, repository.messages[0].Status)
+		assert.Equal(t, 1, repository.messages[0].Attempts)
+		assert.Len(t, gateway.delivered, 1)
+	})
+
+	t.Run("should retry failed deliveries until the max attempts", func(t *testing.T) {
+		repository := &messageRepository{}
+		gateway := &messageGateway{failures: 5}
+		messageService := services.NewMessage(repository, &messageConnections{conn: conn}, gateway, cfg)
+		require.NoError(t, messageService.Send(ctx, *issuerDID, *userDID, offer("1")))
+		require.Len(t, repository.messages, 1)
+		assert.Equal(t, domain.MessageStatusQueued, repository.messages[0].Status)
+		assert.Equal(t, "service unavailable", *repository.messages[0].LastError)
+
+		for i := 0; i < 2; i++ {
+			// make the retry due
+			repository.messages[0].NextAttemptAt = &time.Time{}
+			delivered, err := messageService.DeliverPending(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 0, delivered)
+		}
+		assert.Equal(t, domain.MessageStatusFailed, repository.messages[0].Status)
+		assert.Equal(t, 3, repository.messages[0].Attempts)
+		assert.Nil(t, repository.messages[0].NextAttemptAt)
+	})
+
+	t.Run("should cap the retry backoff", func(t *testing.T) {
+		repository := &messageRepository{}
+		messageService := services.NewMessage(repository, &messageConnections{conn: conn}, &messageGateway{failures: 1}, config.Messages{MaxAttempts: 100, RetryBackoff: time.Minute})
+		msg := domain.NewOutboundMessage(issuerDID.String(), userDID.String(), "1", "1", string(protocol.CredentialOfferMessageType), offer("1"))
+		msg.Attempts = 70
+		msg.NextAttemptAt = &time.Time{}
+		repository.messages = append(repository.messages, msg)
+
+		_, err := messageService.DeliverPending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, domain.MessageStatusQueued, repository.messages[0].Status)
+		assert.True(t, repository.messages[0].NextAttemptAt.After(time.Now()))
+		assert.False(t, repository.messages[0].NextAttemptAt.After(time.Now().Add(24*time.Hour)))
+	})
+
+	t.Run("should not overwrite a message acknowledged during the delivery", func(t *testing.T) {
+		repository := &messageRepository{}
+		gateway := &messageGateway{}
+		gateway.onDeliver = func() {
+			_, err := repository.AcknowledgeThread(ctx, *issuerDID, *userDID, "1")
+			require.NoError(t, err)
+		}
+		messageService := services.NewMessage(repository, &messageConnections{conn: conn}, gateway, cfg)
+		require.NoError(t, messageService.Send(ctx, *issuerDID, *userDID, offer("1")))
+		require.Len(t, repository.messages, 1)
+		assert.Equal(t, domain.MessageStatusAcknowledged, repository.messages[0].Status)
+	})
+
+	t.Run("should thread inbound messages and acknowledge the offer", func(t *testing.T) {
+		repository := &messageRepository{}
+		messageService := services.NewMessage(repository, &messageConnections{conn: conn}, &messageGateway{}, cfg)
+		require.NoError(t, messageService.Send(ctx, *issuerDID, *userDID, offer("1")))
+		require.NoError(t, messageService.Send(ctx, *issuerDID, *userDID, offer("2")))
+
+		req := &ports.AgentRequest{
+			ID:        uuid.New(),
+			ThreadID:  "1",
+			Type:      protocol.CredentialFetchRequestMessageType,
+			IssuerDID: issuerDID,
+			UserDID:   userDID,
+			Body:      json.RawMessage(`{"id":"credential"}`),
+		}
+		require.NoError(t, messageService.RecordInbound(ctx, req))
+		require.NoError(t, messageService.RecordReply(ctx, req, &iden3comm.BasicMessage{ID: "3", ThreadID: "1", Type: protocol.CredentialIssuanceResponseMessageType}))
+
+		_, err := messageService.GetConversations(ctx, *issuerDID, uuid.New())
+		assert.ErrorIs(t, err, services.ErrConnectionDoesNotExist)
+
+		conversations, err := messageService.GetConversations(ctx, *issuerDID, conn.ID)
+		require.NoError(t, err)
+		require.Len(t, conversations, 2)
+		assert.Equal(t, "1", conversations[0].ThreadID)
+		require.Len(t, conversations[0].Messages, 3)
+		assert.Equal(t, domain.MessageStatusAcknowledged, conversations[0].Messages[0].Status)
+		assert.Equal(t, domain.MessageDirectionInbound, conversations[0].Messages[1].Direction)
+		assert.Equal(t, domain.MessageStatusSent, conversations[0].Messages[2].Status)
+		assert.Equal(t, "2", conversations[1].ThreadID)
+		assert.Equal(t, domain.MessageStatusSent, conversations[1].Messages[0].Status)
+	})
+}
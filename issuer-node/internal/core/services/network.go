@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+)
+
+// NetworkService reloads the network resolver settings without restarting the process
+type NetworkService struct {
+	cfg                *config.Configuration
+	networkResolver    *network.Resolver
+	identityRepository ports.IdentityRepository
+	storage            *db.Storage
+}
+
+// NewNetworkService creates a new network service
+func NewNetworkService(cfg *config.Configuration, networkResolver *network.Resolver, identityRepository ports.IdentityRepository, storage *db.Storage) *NetworkService {
+	return &NetworkService{
+		cfg:                cfg,
+		networkResolver:    networkResolver,
+		identityRepository: identityRepository,
+		storage:            storage,
+	}
+}
+
+// ReloadSettings reads the resolver settings file again and applies the changes to the network resolver.
+// It fails with network.ErrNetworkInUse if a network used by existing identities has been removed from the file.
+func (n *NetworkService) ReloadSettings(ctx context.Context) (*network.ReloadResult, error) {
+	reader, err := network.GetReaderFromConfig(n.cfg, ctx)
+	if err != nil {
+		log.Error(ctx, "cannot read network resolver file", "err", err)
+		return nil, err
+	}
+	return n.networkResolver.Reload(ctx, reader, n)
+}
+
+// IsNetworkInUse returns true if there are identities on the blockchain network
+func (n *NetworkService) IsNetworkInUse(ctx context.Context, blockchain, network string) (bool, error) {
+	return n.identityRepository.ExistsByNetwork(ctx, n.storage.Pgx, blockchain, network)
+}
+
+// ReloadOnSignal reloads the resolver settings every time the process receives a SIGHUP
+func (n *NetworkService) ReloadOnSignal(ctx context.Context) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(hup)
+		for {
+			select {
+			case <-hup:
+				if _, err := n.ReloadSettings(ctx); err != nil {
+					log.Error(ctx, "failed to reload network resolver settings", "err", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/event"
@@ -16,20 +18,22 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/log"
 	notifications2 "github.com/polygonid/sh-id-platform/internal/notifications"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 type notification struct {
-	notificationGateway ports.NotificationGateway
-	connService         ports.ConnectionService
-	credService         ports.ClaimService
+	messageService ports.MessageService
+	connService    ports.ConnectionService
+	credService    ports.ClaimService
 }
 
-// NewNotification returns a Notification Service
-func NewNotification(notificationGateway ports.NotificationGateway, connService ports.ConnectionService, credService ports.ClaimService) ports.NotificationService {
+// NewNotification returns a Notification Service.
+// Notifications are sent through the outbox of the message service, which retries failed deliveries.
+func NewNotification(messageService ports.MessageService, connService ports.ConnectionService, credService ports.ClaimService) ports.NotificationService {
 	return &notification{
-		notificationGateway: notificationGateway,
-		connService:         connService,
-		credService:         credService,
+		messageService: messageService,
+		connService:    connService,
+		credService:    credService,
 	}
 }
 
@@ -87,15 +91,15 @@ func (n *notification) sendRevokeCredentialNotification(ctx context.Context, sta
 			return err
 		}
 
-		credOfferBytes, subjectDIDDoc, err := getRevokedCredentialData(connection, rCred)
+		credOfferBytes, err := notifications2.NewRevokedMsg(rCred)
 		if err != nil {
-			log.Error(ctx, "sendRevokeCredentialNotification: getCredentialOfferData", "err", err.Error(), "issuerID", rCred.Issuer, "credID", rCred.ID)
+			log.Error(ctx, "sendRevokeCredentialNotification: NewRevokedMsg", "err", err.Error(), "issuerID", rCred.Issuer, "credID", rCred.ID)
 			return err
 		}
 
 		// send notification
-		log.Info(ctx, "sendRevokeCredentialNotification: sending notification", "issuerID", rCred.Issuer, "subjectDIDDoc", subjectDIDDoc.ID)
-		err = n.send(ctx, credOfferBytes, subjectDIDDoc)
+		log.Info(ctx, "sendRevokeCredentialNotification: sending notification", "issuerID", rCred.Issuer, "userDID", connection.UserDID.String())
+		err = n.send(ctx, connection, credOfferBytes)
 		if err != nil {
 			log.Error(ctx, "sendRevokeCredentialNotification: send notification", "err", err.Error(), "issuerID", rCred.Issuer, "credID", rCred.ID)
 			return err
@@ -145,15 +149,15 @@ func (n *notification) sendCreateCredentialNotification(ctx context.Context, iss
 		credentials[i] = credential
 	}
 
-	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(connection, credentials...)
+	credOfferBytes, err := getCredentialOfferData(connection, credentials...)
 	if err != nil {
 		log.Error(ctx, "sendCreateCredentialNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerID)
 		return err
 	}
 
 	// send notification
-	log.Info(ctx, "sendCreateCredentialNotification: sending notification", "issuerID", issuerID, "subjectDIDDoc", subjectDIDDoc.ID)
-	err = n.send(ctx, credOfferBytes, subjectDIDDoc)
+	log.Info(ctx, "sendCreateCredentialNotification: sending notification", "issuerID", issuerID, "userDID", connection.UserDID.String())
+	err = n.send(ctx, connection, credOfferBytes)
 	if err != nil {
 		log.Error(ctx, "sendCreateCredentialNotification: send notification", "err", err.Error(), "issuerID", issuerID)
 		return err
@@ -187,77 +191,42 @@ func (n *notification) sendCreateConnectionNotification(ctx context.Context, iss
 		return err
 	}
 
-	credOfferBytes, subjectDIDDoc, err := getCredentialOfferData(conn, credentials...)
+	credOfferBytes, err := getCredentialOfferData(conn, credentials...)
 	if err != nil {
 		log.Error(ctx, "sendCreateConnectionNotification: getCredentialOfferData", "err", err.Error(), "issuerID", issuerID, "connID", connID)
 		return err
 	}
 
-	return n.send(ctx, credOfferBytes, subjectDIDDoc)
+	return n.send(ctx, conn, credOfferBytes)
 }
 
-func (n *notification) send(ctx context.Context, credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument) error {
-	res, err := n.notificationGateway.Notify(ctx, credOfferBytes, subjectDIDDoc)
-	if err != nil {
-		return err
-	}
-
-	for _, nr := range res.Devices {
-		if nr.Status != domain.DeviceNotificationStatusSuccess {
-			log.Error(ctx, "failed to send push notification to certain user device",
-				"device encrypted info", nr.Device.Ciphertext, "reason", nr.Reason)
-			return errors.New("failed to send push notification")
-		}
-	}
-
-	return nil
+func (n *notification) send(ctx context.Context, conn *domain.Connection, msg []byte) error {
+	ctx, span := telemetry.Start(ctx, "notification push", trace.WithAttributes(attribute.String("connection", conn.ID.String())))
+	err := n.messageService.Send(ctx, conn.IssuerDID, conn.UserDID, msg)
+	telemetry.End(span, err)
+	return err
 }
 
-func getCredentialOfferData(conn *domain.Connection, credentials ...*domain.Claim) (credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument, err error) {
+func getCredentialOfferData(conn *domain.Connection, credentials ...*domain.Claim) (credOfferBytes []byte, err error) {
 	var managedDIDDoc verifiable.DIDDocument
 	err = json.Unmarshal(conn.IssuerDoc, &managedDIDDoc)
 	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal managedDIDDoc, err: %v", err.Error())
+		return nil, fmt.Errorf("unmarshal managedDIDDoc, err: %v", err.Error())
 	}
 
 	managedService, err := notifications2.FindServiceByType(managedDIDDoc, verifiable.Iden3CommServiceType)
 	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal managedService, err: %v", err.Error())
+		return nil, fmt.Errorf("unmarshal managedService, err: %v", err.Error())
 	}
 
 	credOffer, err := notifications2.NewOfferMsg(managedService.ServiceEndpoint, credentials...)
 	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("newOfferMsg, err: %v", err.Error())
+		return nil, fmt.Errorf("newOfferMsg, err: %v", err.Error())
 	}
 
 	credOfferBytes, err = json.Marshal(credOffer)
 	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("marshal credOffer, err: %v", err.Error())
-	}
-
-	err = json.Unmarshal(conn.UserDoc, &subjectDIDDoc)
-	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal subjectDIDDoc, err: %v", err.Error())
-	}
-
-	return
-}
-
-func getRevokedCredentialData(conn *domain.Connection, credential *domain.Claim) (credOfferBytes []byte, subjectDIDDoc verifiable.DIDDocument, err error) {
-	var managedDIDDoc verifiable.DIDDocument
-	err = json.Unmarshal(conn.IssuerDoc, &managedDIDDoc)
-	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal managedDIDDoc, err: %v", err.Error())
-	}
-
-	credOfferBytes, err = notifications2.NewRevokedMsg(credential)
-	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("NewRevokedMsg, err: %v", err.Error())
-	}
-
-	err = json.Unmarshal(conn.UserDoc, &subjectDIDDoc)
-	if err != nil {
-		return nil, verifiable.DIDDocument{}, fmt.Errorf("unmarshal subjectDIDDoc, err: %v", err.Error())
+		return nil, fmt.Errorf("marshal credOffer, err: %v", err.Error())
 	}
 
 	return
@@ -47,7 +47,7 @@ func TestNotification_SendNotification(t *testing.T) {
 
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
-	identityService := NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
+	identityService := NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, NewTenant(repositories.NewTenant(*storage), storage))
 
 	mediaTypeManager := NewMediaTypeManager(
 		map[iden3comm.ProtocolMessage][]string{
@@ -57,7 +57,7 @@ func TestNotification_SendNotification(t *testing.T) {
 		true,
 	)
 
-	credentialsService := NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks)
+	credentialsService := NewClaim(claimsRepo, identityService, nil, mtService, identityStateRepo, docLoader, storage, cfg.ServerUrl, pubsub.NewMock(), ipfsGateway, revocationStatusResolver, mediaTypeManager, cfg.UniversalLinks, NewStatusList(repositories.NewStatusList(), storage, keyStore, cfg.ServerUrl), NewAudit(repositories.NewAudit(*storage)), NewTenant(repositories.NewTenant(*storage), storage))
 	connectionsService := NewConnection(connectionsRepository, claimsRepo, storage)
 	iden, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: network, KeyType: BJJ})
 	require.NoError(t, err)
@@ -69,7 +69,8 @@ func TestNotification_SendNotification(t *testing.T) {
 	require.NoError(t, err)
 
 	notificationGateway := gateways.NewPushNotificationClient(http.DefaultHTTPClientWithRetry)
-	notificationService := NewNotification(notificationGateway, connectionsService, credentialsService)
+	messageService := NewMessage(repositories.NewMessage(*storage), connectionsService, gateways.NewMessageClient(http.DefaultHTTPClientWithRetry, notificationGateway, keyStore), cfg.Messages)
+	notificationService := NewNotification(messageService, connectionsService, credentialsService)
 
 	fixture := repositories.NewFixture(storage)
 	credID := fixture.CreateClaim(t, &domain.Claim{
@@ -0,0 +1,386 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/jwtvc"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vci"
+)
+
+// ErrOID4VCIUnsupportedLinkFormat is returned when an iden3 link is offered through OpenID4VCI
+var ErrOID4VCIUnsupportedLinkFormat = errors.New("only links issuing jwt_vc_json or vc+sd-jwt credentials can be offered through OpenID4VCI")
+
+const (
+	oid4vciPreAuthorizedCodeTTL = 10 * time.Minute
+	oid4vciAccessTokenTTL       = 5 * time.Minute
+	oid4vciProofMaxAge          = 5 * time.Minute
+	oid4vciSecretLength         = 32
+)
+
+type openID4VCI struct {
+	repository        ports.OID4VCIOfferRepository
+	linkService       ports.LinkService
+	linkRepository    ports.LinkRepository
+	schemaService     ports.SchemaService
+	identityService   ports.IdentityService
+	credentialFormats ports.CredentialFormatService
+	serverURL         string
+}
+
+// NewOID4VCI creates the service that offers and issues the credentials of the links to OpenID4VCI wallets
+func NewOID4VCI(repository ports.OID4VCIOfferRepository, linkService ports.LinkService, linkRepository ports.LinkRepository, schemaService ports.SchemaService, identityService ports.IdentityService, credentialFormats ports.CredentialFormatService, serverURL string) ports.OID4VCIService {
+	return &openID4VCI{
+		repository:        repository,
+		linkService:       linkService,
+		linkRepository:    linkRepository,
+		schemaService:     schemaService,
+		identityService:   identityService,
+		credentialFormats: credentialFormats,
+		serverURL:         serverURL,
+	}
+}
+
+// CreateOffer creates an offer of the link with a new pre-authorized code. The code is valid for a few minutes,
+// and never after the link expiration.
+func (o *openID4VCI) CreateOffer(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) (*oid4vci.CredentialOffer, error) {
+	link, err := o.getLink(ctx, issuerDID, linkID)
+	if err != nil {
+		return nil, err
+	}
+	if link.CredentialFormat == domain.CredentialFormatIden3 {
+		return nil, ErrOID4VCIUnsupportedLinkFormat
+	}
+	if err := o.linkService.Validate(ctx, link); err != nil {
+		return nil, err
+	}
+
+	code, err := newOID4VCISecret()
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(oid4vciPreAuthorizedCodeTTL)
+	if link.ValidUntil != nil && link.ValidUntil.Before(expiresAt) {
+		expiresAt = *link.ValidUntil
+	}
+	if err := o.repository.Save(ctx, &domain.OID4VCIOffer{
+		ID:                    uuid.New(),
+		IssuerDID:             issuerDID.String(),
+		LinkID:                link.ID,
+		PreAuthorizedCodeHash: hashOID4VCISecret(code),
+		CodeExpiresAt:         expiresAt,
+	}); err != nil {
+		log.Error(ctx, "saving oid4vci offer", "err", err, "link", link.ID)
+		return nil, err
+	}
+
+	return &oid4vci.CredentialOffer{
+		CredentialIssuer:           o.issuerURL(issuerDID),
+		CredentialConfigurationIDs: []string{credentialConfigurationID(link.SchemaID, link.CredentialFormat)},
+		Grants: oid4vci.Grants{
+			PreAuthorizedCode: &oid4vci.PreAuthorizedCodeGrant{PreAuthorizedCode: code},
+		},
+	}, nil
+}
+
+// IssuerMetadata returns the metadata of the identity as credential issuer. Every imported schema can be issued
+// in the JWT based formats, that are only available for ethereum based identities.
+func (o *openID4VCI) IssuerMetadata(ctx context.Context, issuerDID w3c.DID) (*oid4vci.IssuerMetadata, error) {
+	if err := validateCredentialFormat(&issuerDID, domain.CredentialFormatJWT); err != nil {
+		return nil, err
+	}
+	identity, err := o.identityService.GetByDID(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	schemas, err := o.schemaService.GetAll(ctx, issuerDID, nil)
+	if err != nil {
+		log.Error(ctx, "getting schemas", "err", err, "did", issuerDID)
+		return nil, err
+	}
+
+	metadata := &oid4vci.IssuerMetadata{
+		CredentialIssuer:                  o.issuerURL(issuerDID),
+		CredentialEndpoint:                o.issuerURL(issuerDID) + "/credential",
+		CredentialConfigurationsSupported: make(map[string]oid4vci.CredentialConfiguration, 2*len(schemas)),
+	}
+	if identity.DisplayName != nil {
+		metadata.Display = []oid4vci.Display{{Name: *identity.DisplayName}}
+	}
+	for _, schema := range schemas {
+		for _, format := range []domain.CredentialFormat{domain.CredentialFormatJWT, domain.CredentialFormatSDJWT} {
+			metadata.CredentialConfigurationsSupported[credentialConfigurationID(schema.ID, format)] = credentialConfiguration(schema, format)
+		}
+	}
+	return metadata, nil
+}
+
+// AuthorizationServerMetadata returns the OAuth metadata of the identity. The issuer is its own authorization server
+// and only supports the pre-authorized code grant.
+func (o *openID4VCI) AuthorizationServerMetadata(_ context.Context, issuerDID w3c.DID) (*oid4vci.AuthorizationServerMetadata, error) {
+	if err := validateCredentialFormat(&issuerDID, domain.CredentialFormatJWT); err != nil {
+		return nil, err
+	}
+	return &oid4vci.AuthorizationServerMetadata{
+		Issuer:        o.issuerURL(issuerDID),
+		TokenEndpoint: o.issuerURL(issuerDID) + "/token",
+		GrantTypesSupported: []string{
+			oid4vci.GrantTypePreAuthorizedCode,
+		},
+		PreAuthorizedGrantAnonymousAccessSupported: true,
+	}, nil
+}
+
+// Token exchanges a pre-authorized code for an access token and the nonce of the first proof of possession.
+// Codes can only be exchanged once.
+func (o *openID4VCI) Token(ctx context.Context, issuerDID w3c.DID, grantType string, preAuthorizedCode string) (*oid4vci.TokenResponse, error) {
+	if grantType != oid4vci.GrantTypePreAuthorizedCode {
+		return nil, oid4vci.NewError(oid4vci.ErrorUnsupportedGrantType, grantType)
+	}
+	if preAuthorizedCode == "" {
+		return nil, oid4vci.NewError(oid4vci.ErrorInvalidRequest, "pre-authorized_code is required")
+	}
+	offer, err := o.repository.GetByPreAuthorizedCode(ctx, issuerDID, hashOID4VCISecret(preAuthorizedCode))
+	if err != nil {
+		if errors.Is(err, repositories.OID4VCIOfferNotFoundErr) {
+			return nil, oid4vci.NewError(oid4vci.ErrorInvalidGrant, "unknown pre-authorized code")
+		}
+		return nil, err
+	}
+	if offer.Redeemed() {
+		return nil, oid4vci.NewError(oid4vci.ErrorInvalidGrant, "pre-authorized code already used")
+	}
+	if time.Now().After(offer.CodeExpiresAt) {
+		return nil, oid4vci.NewError(oid4vci.ErrorInvalidGrant, "pre-authorized code expired")
+	}
+	link, err := o.getLink(ctx, issuerDID, offer.LinkID)
+	if err != nil {
+		return nil, err
+	}
+	if err := o.linkService.Validate(ctx, link); err != nil {
+		return nil, oid4vci.NewError(oid4vci.ErrorInvalidGrant, err.Error())
+	}
+
+	accessToken, err := newOID4VCISecret()
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := newOID4VCISecret()
+	if err != nil {
+		return nil, err
+	}
+	offer.AccessTokenHash = common.ToPointer(hashOID4VCISecret(accessToken))
+	offer.TokenExpiresAt = common.ToPointer(time.Now().Add(oid4vciAccessTokenTTL))
+	offer.CNonce = &nonce
+	if err := o.repository.Redeem(ctx, offer); err != nil {
+		if errors.Is(err, repositories.OID4VCIOfferAlreadyRedeemedErr) {
+			return nil, oid4vci.NewError(oid4vci.ErrorInvalidGrant, "pre-authorized code already used")
+		}
+		log.Error(ctx, "redeeming oid4vci offer", "err", err, "offer", offer.ID)
+		return nil, err
+	}
+
+	return &oid4vci.TokenResponse{
+		AccessToken:     accessToken,
+		TokenType:       oid4vci.TokenTypeBearer,
+		ExpiresIn:       int64(oid4vciAccessTokenTTL.Seconds()),
+		CNonce:          nonce,
+		CNonceExpiresIn: int64(oid4vciAccessTokenTTL.Seconds()),
+	}, nil
+}
+
+// Credential issues the credential of the offered link to the holder of the key in the proof of possession,
+// or returns the one already issued to it. The credential is bound to the first holder proving possession
+// with the access token.
+func (o *openID4VCI) Credential(ctx context.Context, issuerDID w3c.DID, accessToken string, req *oid4vci.CredentialRequest) (*oid4vci.CredentialResponse, error) {
+	offer, err := o.repository.GetByAccessToken(ctx, issuerDID, hashOID4VCISecret(accessToken))
+	if err != nil {
+		if errors.Is(err, repositories.OID4VCIOfferNotFoundErr) {
+			return nil, oid4vci.NewError(oid4vci.ErrorInvalidToken, "unknown access token")
+		}
+		return nil, err
+	}
+	if offer.TokenExpiresAt == nil || time.Now().After(*offer.TokenExpiresAt) {
+		return nil, oid4vci.NewError(oid4vci.ErrorInvalidToken, "access token expired")
+	}
+	link, err := o.getLink(ctx, issuerDID, offer.LinkID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCredentialRequest(link, req); err != nil {
+		return nil, err
+	}
+
+	holder, err := o.verifyProof(ctx, issuerDID, offer, req.Proof)
+	if err != nil {
+		return nil, err
+	}
+	holderDID, err := w3c.ParseDID(holder)
+	if err != nil {
+		return nil, o.invalidProof(ctx, offer, fmt.Sprintf("invalid holder DID: %v", err))
+	}
+
+	claim, _, err := o.linkService.IssueOrFetchCredential(ctx, issuerDID, *holderDID, link.ID)
+	if err != nil {
+		if errors.Is(err, ErrLinkAlreadyExpired) || errors.Is(err, ErrLinkMaxExceeded) || errors.Is(err, ErrLinkInactive) {
+			return nil, oid4vci.NewError(oid4vci.ErrorInvalidCredentialRequest, err.Error())
+		}
+		log.Error(ctx, "issuing oid4vci credential", "err", err, "link", link.ID)
+		return nil, err
+	}
+	encoded, err := o.credentialFormats.Encode(ctx, claim, link.CredentialFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := newOID4VCISecret()
+	if err != nil {
+		return nil, err
+	}
+	offer.CNonce = &nonce
+	offer.HolderDID = &holder
+	offer.CredentialID = &claim.ID
+	if err := o.repository.Save(ctx, offer); err != nil {
+		return nil, err
+	}
+
+	return &oid4vci.CredentialResponse{
+		Credential:      encoded.Credential,
+		CNonce:          nonce,
+		CNonceExpiresIn: int64(time.Until(*offer.TokenExpiresAt).Seconds()),
+	}, nil
+}
+
+// verifyProof checks the proof of possession is for the current nonce and returns the holder DID
+func (o *openID4VCI) verifyProof(ctx context.Context, issuerDID w3c.DID, offer *domain.OID4VCIOffer, proof *oid4vci.Proof) (string, error) {
+	if proof == nil || proof.ProofType != oid4vci.ProofTypeJWT || proof.JWT == "" {
+		return "", o.invalidProof(ctx, offer, "a jwt proof of possession is required")
+	}
+	if offer.CNonce == nil {
+		return "", o.invalidProof(ctx, offer, "no nonce was issued")
+	}
+	holder, err := jwtvc.VerifyProof(proof.JWT, o.issuerURL(issuerDID), *offer.CNonce, oid4vciProofMaxAge)
+	if err != nil {
+		return "", o.invalidProof(ctx, offer, err.Error())
+	}
+	if offer.HolderDID != nil && *offer.HolderDID != holder {
+		return "", o.invalidProof(ctx, offer, "the credential is bound to another holder")
+	}
+	return holder, nil
+}
+
+// invalidProof renews the nonce of the offer and returns it in the error, so the wallet can retry with a new proof
+func (o *openID4VCI) invalidProof(ctx context.Context, offer *domain.OID4VCIOffer, description string) error {
+	nonce, err := newOID4VCISecret()
+	if err != nil {
+		return err
+	}
+	offer.CNonce = &nonce
+	if err := o.repository.Save(ctx, offer); err != nil {
+		return err
+	}
+	return &oid4vci.Error{
+		Code:            oid4vci.ErrorInvalidProof,
+		Description:     description,
+		CNonce:          nonce,
+		CNonceExpiresIn: int64(time.Until(*offer.TokenExpiresAt).Seconds()),
+	}
+}
+
+func (o *openID4VCI) getLink(ctx context.Context, issuerDID w3c.DID, linkID uuid.UUID) (*domain.Link, error) {
+	link, err := o.linkRepository.GetByID(ctx, issuerDID, linkID)
+	if err != nil {
+		if errors.Is(err, repositories.ErrLinkDoesNotExist) {
+			return nil, ErrLinkNotFound
+		}
+		return nil, err
+	}
+	return link, nil
+}
+
+func (o *openID4VCI) issuerURL(issuerDID w3c.DID) string {
+	return fmt.Sprintf(ports.OID4VCIIssuerURL, o.serverURL, issuerDID.String())
+}
+
+// checkCredentialRequest checks the requested credential is the one offered by the link
+func checkCredentialRequest(link *domain.Link, req *oid4vci.CredentialRequest) error {
+	if req.CredentialConfigurationID != "" {
+		if req.CredentialConfigurationID != credentialConfigurationID(link.SchemaID, link.CredentialFormat) {
+			return oid4vci.NewError(oid4vci.ErrorUnsupportedCredentialType, req.CredentialConfigurationID)
+		}
+		return nil
+	}
+	if req.Format != string(link.CredentialFormat) {
+		return oid4vci.NewError(oid4vci.ErrorUnsupportedCredentialFormat, req.Format)
+	}
+	if link.Schema == nil {
+		return nil
+	}
+	if req.CredentialDefinition != nil && !slices.Contains(req.CredentialDefinition.Type, link.Schema.Type) {
+		return oid4vci.NewError(oid4vci.ErrorUnsupportedCredentialType, "credential_definition doesn't include the offered type")
+	}
+	if req.Vct != "" && req.Vct != link.Schema.Type {
+		return oid4vci.NewError(oid4vci.ErrorUnsupportedCredentialType, req.Vct)
+	}
+	return nil
+}
+
+func credentialConfigurationID(schemaID uuid.UUID, format domain.CredentialFormat) string {
+	return fmt.Sprintf("%s_%s", schemaID, format)
+}
+
+func credentialConfiguration(schema domain.Schema, format domain.CredentialFormat) oid4vci.CredentialConfiguration {
+	configuration := oid4vci.CredentialConfiguration{
+		Format:                               string(format),
+		Scope:                                schema.Type,
+		CryptographicBindingMethodsSupported: []string{"did:iden3", "did:polygonid", "did:jwk"},
+		CredentialSigningAlgValuesSupported:  []string{jwtvc.AlgES256K},
+		ProofTypesSupported: map[string]oid4vci.ProofType{
+			oid4vci.ProofTypeJWT: {ProofSigningAlgValuesSupported: []string{jwtvc.AlgES256K, jwtvc.AlgES256}},
+		},
+	}
+	display := oid4vci.Display{Name: schema.Type}
+	if schema.Title != nil {
+		display.Name = *schema.Title
+	}
+	if schema.Description != nil {
+		display.Description = *schema.Description
+	}
+	configuration.Display = []oid4vci.Display{display}
+
+	switch format {
+	case domain.CredentialFormatSDJWT:
+		configuration.Vct = schema.Type
+	default:
+		configuration.CredentialDefinition = &oid4vci.CredentialDefinition{Type: []string{"VerifiableCredential", schema.Type}}
+	}
+	return configuration
+}
+
+func newOID4VCISecret() (string, error) {
+	b := make([]byte, oid4vciSecretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashOID4VCISecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vci"
+)
+
+func TestOID4VCI_CheckCredentialRequest(t *testing.T) {
+	schemaID := uuid.New()
+	link := &domain.Link{
+		SchemaID:         schemaID,
+		Schema:           &domain.Schema{ID: schemaID, Type: "KYCAgeCredential"},
+		CredentialFormat: domain.CredentialFormatSDJWT,
+	}
+
+	type testConfig struct {
+		name    string
+		req     *oid4vci.CredentialRequest
+		errCode string
+	}
+	for _, tc := range []testConfig{
+		{
+			name: "should accept the offered configuration",
+			req:  &oid4vci.CredentialRequest{CredentialConfigurationID: credentialConfigurationID(schemaID, domain.CredentialFormatSDJWT)},
+		},
+		{
+			name: "should accept the offered format and type",
+			req:  &oid4vci.CredentialRequest{Format: string(domain.CredentialFormatSDJWT), Vct: "KYCAgeCredential"},
+		},
+		{
+			name:    "should reject other configurations",
+			req:     &oid4vci.CredentialRequest{CredentialConfigurationID: credentialConfigurationID(schemaID, domain.CredentialFormatJWT)},
+			errCode: oid4vci.ErrorUnsupportedCredentialType,
+		},
+		{
+			name:    "should reject other formats",
+			req:     &oid4vci.CredentialRequest{Format: string(domain.CredentialFormatJWT)},
+			errCode: oid4vci.ErrorUnsupportedCredentialFormat,
+		},
+		{
+			name:    "should reject other types",
+			req:     &oid4vci.CredentialRequest{Format: string(domain.CredentialFormatSDJWT), Vct: "KYCCountryOfResidenceCredential"},
+			errCode: oid4vci.ErrorUnsupportedCredentialType,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkCredentialRequest(link, tc.req)
+			if tc.errCode == "" {
+				assert.NoError(t, err)
+				return
+			}
+			var oid4vciErr *oid4vci.Error
+			require.ErrorAs(t, err, &oid4vciErr)
+			assert.Equal(t, tc.errCode, oid4vciErr.Code)
+		})
+	}
+}
+
+func TestOID4VCI_CredentialConfiguration(t *testing.T) {
+	schema := domain.Schema{ID: uuid.New(), Type: "KYCAgeCredential", Title: common.ToPointer("KYC Age")}
+
+	jwt := credentialConfiguration(schema, domain.CredentialFormatJWT)
+	assert.Equal(t, "jwt_vc_json", jwt.Format)
+	require.NotNil(t, jwt.CredentialDefinition)
+	assert.Equal(t, []string{"VerifiableCredential", "KYCAgeCredential"}, jwt.CredentialDefinition.Type)
+	assert.Equal(t, "KYC Age", jwt.Display[0].Name)
+
+	sdJWT := credentialConfiguration(schema, domain.CredentialFormatSDJWT)
+	assert.Equal(t, "vc+sd-jwt", sdJWT.Format)
+	assert.Equal(t, "KYCAgeCredential", sdJWT.Vct)
+	assert.Nil(t, sdJWT.CredentialDefinition)
+}
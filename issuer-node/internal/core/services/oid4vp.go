@@ -0,0 +1,457 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/urn"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/jwtvc"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vp"
+)
+
+var (
+	// ErrOID4VPInvalidFields is returned when the requested fields are not attributes of the schema or their filters are malformed
+	ErrOID4VPInvalidFields = errors.New("invalid presentation fields")
+	// ErrOID4VPSessionNotPending is returned when the wallet has already responded to the session
+	ErrOID4VPSessionNotPending = errors.New("the presentation session has already been answered")
+	// ErrOID4VPInvalidState is returned when the response state doesn't match the state of the session
+	ErrOID4VPInvalidState = errors.New("invalid presentation state")
+	// ErrOID4VPVerification is returned when the presentation posted by the wallet can't be verified
+	ErrOID4VPVerification = errors.New("the presentation could not be verified")
+)
+
+const (
+	oid4vpRequestObjectTTL = 10 * time.Minute
+	oid4vpPresentationAge  = 10 * time.Minute
+	oid4vpInputDescriptor  = "credential"
+)
+
+type openID4VP struct {
+	sessions      ports.SessionRepository
+	schemaService ports.SchemaService
+	claimService  ports.ClaimService
+	loader        loader.DocumentLoader
+	kms           kms.KMSType
+	serverURL     string
+}
+
+// NewOID4VP creates the service that requests presentations to OpenID4VP wallets and verifies their responses
+func NewOID4VP(sessions ports.SessionRepository, schemaService ports.SchemaService, claimService ports.ClaimService, ld loader.DocumentLoader, keyStore kms.KMSType, serverURL string) ports.OID4VPService {
+	return &openID4VP{
+		sessions:      sessions,
+		schemaService: schemaService,
+		claimService:  claimService,
+		loader:        ld,
+		kms:           keyStore,
+		serverURL:     serverURL,
+	}
+}
+
+// CreateSession creates a presentation session with a presentation definition of one credential of the schema,
+// constrained to the requested fields. It returns the session and the authorization request URI for the wallet,
+// that references the request object of the session.
+func (o *openID4VP) CreateSession(ctx context.Context, verifierDID w3c.DID, req *ports.CreateOID4VPSessionRequest) (*domain.OID4VPSession, string, error) {
+	if req.Format == "" {
+		req.Format = domain.CredentialFormatJWT
+	}
+	if req.Format != domain.CredentialFormatJWT && req.Format != domain.CredentialFormatSDJWT {
+		return nil, "", ErrCredentialFormatUnsupported
+	}
+	schema, err := o.schemaService.GetByID(ctx, verifierDID, req.SchemaID)
+	if err != nil {
+		return nil, "", err
+	}
+	definition, err := o.presentationDefinition(ctx, schema, req)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nonce, err := newOID4VCISecret()
+	if err != nil {
+		return nil, "", err
+	}
+	state, err := newOID4VCISecret()
+	if err != nil {
+		return nil, "", err
+	}
+	session := &domain.OID4VPSession{
+		ID:                     uuid.New(),
+		VerifierDID:            verifierDID.String(),
+		Nonce:                  nonce,
+		State:                  state,
+		Format:                 req.Format,
+		PresentationDefinition: *definition,
+		Status:                 domain.OID4VPSessionStatusPending,
+		CreatedAt:              time.Now(),
+	}
+	if err := o.sessions.SetOID4VP(ctx, session); err != nil {
+		log.Error(ctx, "saving oid4vp session", "err", err, "did", verifierDID)
+		return nil, "", err
+	}
+	clientID, _ := o.clientID(session)
+	return session, oid4vp.AuthorizationRequestURI(clientID, fmt.Sprintf(ports.OID4VPRequestURL, o.serverURL, session.ID)), nil
+}
+
+// GetSession returns the session of the verifier, with the verification result once the wallet has responded
+func (o *openID4VP) GetSession(ctx context.Context, verifierDID w3c.DID, id uuid.UUID) (*domain.OID4VPSession, error) {
+	session, err := o.sessions.GetOID4VP(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if session.VerifierDID != verifierDID.String() {
+		return nil, repositories.OID4VPSessionNotFoundErr
+	}
+	return session, nil
+}
+
+// RequestObject returns the request object of the session. Ethereum based verifiers sign it with ES256K and are
+// identified by their DID, the request objects of other verifiers are unsecured and identified by the response URI.
+func (o *openID4VP) RequestObject(ctx context.Context, id uuid.UUID) (string, error) {
+	session, err := o.sessions.GetOID4VP(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if !session.Pending() {
+		return "", ErrOID4VPSessionNotPending
+	}
+
+	clientID, clientIDScheme := o.clientID(session)
+	now := time.Now()
+	request := &oid4vp.RequestObject{
+		Aud:                    oid4vp.SelfIssuedAudience,
+		Iat:                    now.Unix(),
+		Exp:                    now.Add(oid4vpRequestObjectTTL).Unix(),
+		ResponseType:           oid4vp.ResponseTypeVPToken,
+		ResponseMode:           oid4vp.ResponseModeDirectPost,
+		ClientID:               clientID,
+		ClientIDScheme:         clientIDScheme,
+		ResponseURI:            o.responseURL(session.ID),
+		Nonce:                  session.Nonce,
+		State:                  session.State,
+		PresentationDefinition: session.PresentationDefinition,
+		ClientMetadata:         &oid4vp.ClientMetadata{VPFormats: vpFormats(session.Format)},
+	}
+	if clientIDScheme == oid4vp.ClientIDSchemeRedirectURI {
+		return request.Unsecured()
+	}
+
+	verifierDID, err := w3c.ParseDID(session.VerifierDID)
+	if err != nil {
+		return "", err
+	}
+	signer, err := identitySigner(ctx, o.kms, verifierDID)
+	if err != nil {
+		log.Error(ctx, "getting the verifier signer", "err", err, "did", session.VerifierDID)
+		return "", err
+	}
+	request.Iss = session.VerifierDID
+	return jwtvc.Sign(ctx, oid4vp.TypRequestObject, jwtvc.KeyID(session.VerifierDID), request, signer)
+}
+
+// Response verifies the vp_token posted by the wallet against the presentation definition of the session.
+// The presentations must be signed by the holder of the credentials for the session nonce, and the credentials
+// must have been issued by identities of this node and not be revoked. The result is stored in the session.
+func (o *openID4VP) Response(ctx context.Context, id uuid.UUID, state string, vpToken string, submission *oid4vp.PresentationSubmission) (*domain.OID4VPSession, error) {
+	session, err := o.sessions.GetOID4VP(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !session.Pending() {
+		return nil, ErrOID4VPSessionNotPending
+	}
+	// A wrong state doesn't reject the session, as anyone can post to the response URI
+	if state != session.State {
+		return nil, ErrOID4VPInvalidState
+	}
+
+	holder, credentials, err := o.verify(ctx, session, vpToken, submission)
+	if err != nil {
+		log.Warn(ctx, "oid4vp presentation verification failed", "err", err, "sessionID", id)
+		session.Status = domain.OID4VPSessionStatusRejected
+		session.Error = common.ToPointer(err.Error())
+		if err := o.sessions.SetOID4VP(ctx, session); err != nil {
+			log.Error(ctx, "saving rejected oid4vp session", "err", err, "sessionID", id)
+			return nil, err
+		}
+		return session, ErrOID4VPVerification
+	}
+
+	session.Status = domain.OID4VPSessionStatusVerified
+	session.Holder = &holder
+	session.Credentials = credentials
+	session.VerifiedAt = common.ToPointer(time.Now())
+	if err := o.sessions.SetOID4VP(ctx, session); err != nil {
+		log.Error(ctx, "saving verified oid4vp session", "err", err, "sessionID", id)
+		return nil, err
+	}
+	return session, nil
+}
+
+// verify returns the holder and the credentials of the presentations of the vp_token, located by the submission
+func (o *openID4VP) verify(ctx context.Context, session *domain.OID4VPSession, vpToken string, submission *oid4vp.PresentationSubmission) (string, []domain.OID4VPCredential, error) {
+	if submission == nil {
+		return "", nil, fmt.Errorf("%w: presentation_submission is required", oid4vp.ErrInvalidSubmission)
+	}
+	if err := submission.Validate(&session.PresentationDefinition); err != nil {
+		return "", nil, err
+	}
+	// The vp_token is a single presentation, or an array of them when the submission locates several
+	var tokens interface{} = vpToken
+	if strings.HasPrefix(strings.TrimSpace(vpToken), "[") {
+		if err := json.Unmarshal([]byte(vpToken), &tokens); err != nil {
+			return "", nil, fmt.Errorf("malformed vp_token: %w", err)
+		}
+	}
+
+	audience, _ := o.clientID(session)
+	var holder string
+	credentials := make([]domain.OID4VPCredential, 0, len(submission.DescriptorMap))
+	for _, descriptor := range submission.DescriptorMap {
+		value, found, err := oid4vp.Resolve(tokens, descriptor.Path)
+		if err != nil {
+			return "", nil, err
+		}
+		token, ok := value.(string)
+		if !found || !ok {
+			return "", nil, fmt.Errorf("no presentation at %s", descriptor.Path)
+		}
+
+		var credential *domain.OID4VPCredential
+		var document interface{}
+		var presentationHolder string
+		switch {
+		case descriptor.Format == oid4vp.FormatJWTVPJSON && session.Format == domain.CredentialFormatJWT:
+			credential, document, presentationHolder, err = verifyJWTVPDescriptor(token, &descriptor, audience, session.Nonce)
+		case descriptor.Format == oid4vp.FormatSDJWTVC && session.Format == domain.CredentialFormatSDJWT:
+			credential, document, presentationHolder, err = verifySDJWTDescriptor(token, audience, session.Nonce)
+		default:
+			err = fmt.Errorf("unexpected format %s", descriptor.Format)
+		}
+		if err != nil {
+			return "", nil, err
+		}
+		if holder != "" && presentationHolder != holder {
+			return "", nil, errors.New("presentations of different holders")
+		}
+		holder = presentationHolder
+
+		inputDescriptor, _ := session.PresentationDefinition.InputDescriptor(descriptor.ID)
+		if err := inputDescriptor.Evaluate(document); err != nil {
+			return "", nil, err
+		}
+		if err := o.checkStatus(ctx, credential); err != nil {
+			return "", nil, err
+		}
+		credential.InputDescriptorID = descriptor.ID
+		credentials = append(credentials, *credential)
+	}
+	return holder, credentials, nil
+}
+
+// checkStatus checks the credential was issued by an identity of this node and is not revoked
+func (o *openID4VP) checkStatus(ctx context.Context, credential *domain.OID4VPCredential) error {
+	issuerDID, err := w3c.ParseDID(credential.Issuer)
+	if err != nil {
+		return err
+	}
+	credentialID, err := urn.UUIDFromURNString(credential.ID)
+	if err != nil {
+		return fmt.Errorf("the status of credential %s can't be checked: %w", credential.ID, err)
+	}
+	claim, err := o.claimService.GetByID(ctx, issuerDID, credentialID)
+	if err != nil {
+		if errors.Is(err, ErrCredentialNotFound) {
+			return fmt.Errorf("credential %s was not issued by this node", credential.ID)
+		}
+		return err
+	}
+	if claim.Revoked {
+		return fmt.Errorf("credential %s is revoked", credential.ID)
+	}
+	return nil
+}
+
+// presentationDefinition returns the definition of a credential of the schema type with the requested fields,
+// that must be attributes of the schema. Fields without filter must have the type of the attribute.
+func (o *openID4VP) presentationDefinition(ctx context.Context, schema *domain.Schema, req *ports.CreateOID4VPSessionRequest) (*oid4vp.PresentationDefinition, error) {
+	remoteSchema, err := jsonschema.Load(ctx, schema.URL, o.loader)
+	if err != nil {
+		log.Error(ctx, "loading schema", "err", err, "url", schema.URL)
+		return nil, err
+	}
+
+	fields := make([]oid4vp.Field, 0, len(req.Fields))
+	for _, f := range req.Fields {
+		attribute, err := remoteSchema.AttributeByID(f.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrOID4VPInvalidFields, err)
+		}
+		filter := f.Filter
+		if filter == nil && attribute.Type != "" {
+			filter = oid4vp.Filter{"type": attribute.Type}
+		}
+		fields = append(fields, oid4vp.Field{
+			Path:     []string{subjectFieldPath(req.Format, attribute.ID)},
+			Filter:   filter,
+			Optional: f.Optional,
+		})
+	}
+	return newPresentationDefinition(schema.Type, req.Format, req.Purpose, fields)
+}
+
+// newPresentationDefinition returns the definition of a credential of the type in the format, with the subject fields
+func newPresentationDefinition(credentialType string, format domain.CredentialFormat, purpose string, fields []oid4vp.Field) (*oid4vp.PresentationDefinition, error) {
+	typeField := oid4vp.Field{Path: []string{"$.vc.type"}, Filter: oid4vp.Filter{"type": "array", "contains": map[string]interface{}{"const": credentialType}}}
+	formats := map[string]oid4vp.Format{oid4vp.FormatJWTVCJSON: {Alg: []string{jwtvc.AlgES256K}}}
+	var limitDisclosure string
+	if format == domain.CredentialFormatSDJWT {
+		typeField = oid4vp.Field{Path: []string{"$.vct"}, Filter: oid4vp.Filter{"type": "string", "const": credentialType}}
+		formats = vpFormats(domain.CredentialFormatSDJWT)
+		limitDisclosure = oid4vp.LimitDisclosureRequired
+	}
+
+	definition := &oid4vp.PresentationDefinition{
+		ID:      uuid.NewString(),
+		Purpose: purpose,
+		InputDescriptors: []oid4vp.InputDescriptor{{
+			ID:      oid4vpInputDescriptor,
+			Name:    credentialType,
+			Purpose: purpose,
+			Format:  formats,
+			Constraints: oid4vp.Constraints{
+				LimitDisclosure: limitDisclosure,
+				Fields:          append([]oid4vp.Field{typeField}, fields...),
+			},
+		}},
+	}
+	if err := definition.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrOID4VPInvalidFields, err)
+	}
+	return definition, nil
+}
+
+// subjectFieldPath returns the path of an attribute of the credential subject. SD-JWT VCs have the attributes
+// at the top level, and JWT-VCs in the credentialSubject of the vc claim.
+func subjectFieldPath(format domain.CredentialFormat, name string) string {
+	if format == domain.CredentialFormatSDJWT {
+		return fmt.Sprintf("$.%s", name)
+	}
+	return fmt.Sprintf("$.vc.credentialSubject.%s", name)
+}
+
+// clientID returns the client id of the verifier and its scheme
+func (o *openID4VP) clientID(session *domain.OID4VPSession) (string, string) {
+	if _, err := jwtvc.IssuerAddress(session.VerifierDID); err == nil {
+		return session.VerifierDID, oid4vp.ClientIDSchemeDID
+	}
+	return o.responseURL(session.ID), oid4vp.ClientIDSchemeRedirectURI
+}
+
+func (o *openID4VP) responseURL(id uuid.UUID) string {
+	return fmt.Sprintf(ports.OID4VPResponseURL, o.serverURL, id)
+}
+
+// verifyJWTVPDescriptor verifies the JWT-VP and returns the credential located by the nested path of the descriptor
+func verifyJWTVPDescriptor(token string, descriptor *oid4vp.Descriptor, audience string, nonce string) (*domain.OID4VPCredential, interface{}, string, error) {
+	if descriptor.PathNested == nil || descriptor.PathNested.Format != oid4vp.FormatJWTVCJSON {
+		return nil, nil, "", fmt.Errorf("a %s path_nested is required", oid4vp.FormatJWTVCJSON)
+	}
+	vp, err := jwtvc.VerifyJWTVP(token, audience, nonce, oid4vpPresentationAge)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	vcs := make([]interface{}, len(vp.Presentation.VerifiableCredential))
+	for i, vc := range vp.Presentation.VerifiableCredential {
+		vcs[i] = vc
+	}
+	value, found, err := oid4vp.Resolve(map[string]interface{}{"vp": map[string]interface{}{"verifiableCredential": vcs}}, descriptor.PathNested.Path)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	vc, ok := value.(string)
+	if !found || !ok {
+		return nil, nil, "", fmt.Errorf("no credential at %s", descriptor.PathNested.Path)
+	}
+	vcCredential := vp.Credentials[slices.Index(vp.Presentation.VerifiableCredential, vc)]
+
+	// Constraints are evaluated on the JWT payload, where the credential is the vc claim
+	encoded, err := json.Marshal(vcCredential)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	var vcDocument map[string]interface{}
+	if err := json.Unmarshal(encoded, &vcDocument); err != nil {
+		return nil, nil, "", err
+	}
+	document := map[string]interface{}{"iss": vcCredential.Issuer, "sub": vcCredential.Subject(), "jti": vcCredential.ID, "vc": vcDocument}
+
+	claims := make(map[string]interface{}, len(vcCredential.CredentialSubject))
+	for name, value := range vcCredential.CredentialSubject {
+		if name != "id" && name != "type" {
+			claims[name] = value
+		}
+	}
+	return &domain.OID4VPCredential{
+		ID:     vcCredential.ID,
+		Issuer: vcCredential.Issuer,
+		Type:   vcCredential.CredentialType(),
+		Format: domain.CredentialFormatJWT,
+		Claims: claims,
+	}, document, vp.Holder, nil
+}
+
+// verifySDJWTDescriptor verifies the SD-JWT presented with key binding and returns the credential with the disclosed claims
+func verifySDJWTDescriptor(token string, audience string, nonce string) (*domain.OID4VPCredential, interface{}, string, error) {
+	sdJWT, err := jwtvc.VerifySDJWTPresentation(token, audience, nonce, oid4vpPresentationAge)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	// Constraints are evaluated on the SD-JWT payload with the disclosed claims in place of their digests
+	document := map[string]interface{}{"iss": sdJWT.Issuer, "sub": sdJWT.Subject, "jti": sdJWT.ID, "vct": sdJWT.Type}
+	claims := make(map[string]interface{}, len(sdJWT.Claims))
+	for name, value := range sdJWT.Claims {
+		document[name] = value
+		if name != "type" {
+			claims[name] = value
+		}
+	}
+	return &domain.OID4VPCredential{
+		ID:     sdJWT.ID,
+		Issuer: sdJWT.Issuer,
+		Type:   sdJWT.Type,
+		Format: domain.CredentialFormatSDJWT,
+		Claims: claims,
+	}, document, sdJWT.Subject, nil
+}
+
+// vpFormats are the formats and algorithms the verifier accepts for the presentations of a credential format
+func vpFormats(format domain.CredentialFormat) map[string]oid4vp.Format {
+	if format == domain.CredentialFormatSDJWT {
+		return map[string]oid4vp.Format{oid4vp.FormatSDJWTVC: {
+			SDJWTAlgValues: []string{jwtvc.AlgES256K},
+			KBJWTAlgValues: []string{jwtvc.AlgES256K, jwtvc.AlgES256},
+		}}
+	}
+	return map[string]oid4vp.Format{
+		oid4vp.FormatJWTVPJSON: {Alg: []string{jwtvc.AlgES256K, jwtvc.AlgES256}},
+		oid4vp.FormatJWTVCJSON: {Alg: []string{jwtvc.AlgES256K}},
+	}
+}
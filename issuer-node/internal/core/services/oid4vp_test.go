@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/jwtvc"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/oid4vp"
+)
+
+// ethKeySigner signs with an in memory ethereum key the same way the KMS does
+type ethKeySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *ethKeySigner) Sign(_ context.Context, digest []byte) ([]byte, error) {
+	return crypto.Sign(digest, s.key)
+}
+
+func newEthIdentity(t *testing.T) (*ethKeySigner, string) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	typ, err := core.BuildDIDType(core.DIDMethodIden3, core.Polygon, core.Amoy)
+	require.NoError(t, err)
+	did, err := core.ParseDIDFromID(core.NewID(typ, core.GenesisFromEthAddress(crypto.PubkeyToAddress(key.PublicKey))))
+	require.NoError(t, err)
+	return &ethKeySigner{key: key}, did.String()
+}
+
+// issuedClaims returns the credentials issued by this node, to check their status
+type issuedClaims struct {
+	ports.ClaimService
+	claims map[uuid.UUID]*domain.Claim
+}
+
+func (c *issuedClaims) GetByID(_ context.Context, _ *w3c.DID, id uuid.UUID) (*domain.Claim, error) {
+	claim, ok := c.claims[id]
+	if !ok {
+		return nil, ErrCredentialNotFound
+	}
+	return claim, nil
+}
+
+func TestOID4VP_Response(t *testing.T) {
+	ctx := context.Background()
+	_, verifier := newEthIdentity(t)
+	issuerSigner, issuer := newEthIdentity(t)
+	holderSigner, holder := newEthIdentity(t)
+	claims := &issuedClaims{claims: map[uuid.UUID]*domain.Claim{}}
+	sessions := repositories.NewSessionCached(cache.NewMemoryCache())
+	service := NewOID4VP(sessions, nil, claims, nil, nil, "https://issuer.example.com").(*openID4VP)
+
+	newCredential := func(t *testing.T, birthday float64) *jwtvc.Credential {
+		t.Helper()
+		id := uuid.New()
+		claims.claims[id] = &domain.Claim{ID: id}
+		return &jwtvc.Credential{
+			Context:           []string{jwtvc.VCDM2ContextURL},
+			ID:                "urn:uuid:" + id.String(),
+			Type:              []string{"VerifiableCredential", "KYCAgeCredential"},
+			Issuer:            issuer,
+			CredentialSubject: map[string]interface{}{"id": holder, "birthday": birthday},
+			CredentialSchema:  &verifiable.CredentialSchema{ID: "https://example.com/kyc.json", Type: "JsonSchema2023"},
+		}
+	}
+	newSession := func(t *testing.T, format domain.CredentialFormat) *domain.OID4VPSession {
+		t.Helper()
+		definition, err := newPresentationDefinition("KYCAgeCredential", format, "", []oid4vp.Field{
+			{Path: []string{subjectFieldPath(format, "birthday")}, Filter: oid4vp.Filter{"type": "integer", "maximum": float64(20000101)}},
+		})
+		require.NoError(t, err)
+		session := &domain.OID4VPSession{
+			ID:                     uuid.New(),
+			VerifierDID:            verifier,
+			Nonce:                  uuid.NewString(),
+			State:                  uuid.NewString(),
+			Format:                 format,
+			PresentationDefinition: *definition,
+			Status:                 domain.OID4VPSessionStatusPending,
+		}
+		require.NoError(t, sessions.SetOID4VP(ctx, session))
+		return session
+	}
+	jwtVP := func(t *testing.T, session *domain.OID4VPSession, credential *jwtvc.Credential) (string, *oid4vp.PresentationSubmission) {
+		t.Helper()
+		vc, err := jwtvc.EncodeJWT(ctx, credential, issuerSigner)
+		require.NoError(t, err)
+		vp, err := jwtvc.EncodeJWTVP(ctx, holder, verifier, session.Nonce, []string{vc}, holderSigner)
+		require.NoError(t, err)
+		return vp, &oid4vp.PresentationSubmission{
+			ID:           uuid.NewString(),
+			DefinitionID: session.PresentationDefinition.ID,
+			DescriptorMap: []oid4vp.Descriptor{{
+				ID: oid4vpInputDescriptor, Format: oid4vp.FormatJWTVPJSON, Path: "$",
+				PathNested: &oid4vp.Descriptor{ID: oid4vpInputDescriptor, Format: oid4vp.FormatJWTVCJSON, Path: "$.vp.verifiableCredential[0]"},
+			}},
+		}
+	}
+
+	t.Run("should verify a JWT-VP and store the result in the session", func(t *testing.T) {
+		session := newSession(t, domain.CredentialFormatJWT)
+		vpToken, submission := jwtVP(t, session, newCredential(t, 19960424))
+
+		verified, err := service.Response(ctx, session.ID, session.State, vpToken, submission)
+		require.NoError(t, err)
+		assert.Equal(t, domain.OID4VPSessionStatusVerified, verified.Status)
+		assert.Equal(t, holder, *verified.Holder)
+		require.Len(t, verified.Credentials, 1)
+		assert.Equal(t, float64(19960424), verified.Credentials[0].Claims["birthday"])
+
+		verifierDID, err := w3c.ParseDID(verifier)
+		require.NoError(t, err)
+		stored, err := service.GetSession(ctx, *verifierDID, session.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.OID4VPSessionStatusVerified, stored.Status)
+
+		_, err = service.Response(ctx, session.ID, session.State, vpToken, submission)
+		assert.ErrorIs(t, err, ErrOID4VPSessionNotPending)
+	})
+
+	t.Run("should verify an SD-JWT with key binding", func(t *testing.T) {
+		session := newSession(t, domain.CredentialFormatSDJWT)
+		sdJWT, err := jwtvc.EncodeSDJWT(ctx, newCredential(t, 19960424), issuerSigner)
+		require.NoError(t, err)
+		vpToken, err := jwtvc.KeyBind(ctx, sdJWT, holder, verifier, session.Nonce, holderSigner)
+		require.NoError(t, err)
+		submission := &oid4vp.PresentationSubmission{
+			ID:            uuid.NewString(),
+			DefinitionID:  session.PresentationDefinition.ID,
+			DescriptorMap: []oid4vp.Descriptor{{ID: oid4vpInputDescriptor, Format: oid4vp.FormatSDJWTVC, Path: "$"}},
+		}
+
+		verified, err := service.Response(ctx, session.ID, session.State, vpToken, submission)
+		require.NoError(t, err)
+		assert.Equal(t, domain.OID4VPSessionStatusVerified, verified.Status)
+		assert.Equal(t, domain.CredentialFormatSDJWT, verified.Credentials[0].Format)
+	})
+
+	t.Run("should reject credentials that don't satisfy the constraints", func(t *testing.T) {
+		session := newSession(t, domain.CredentialFormatJWT)
+		vpToken, submission := jwtVP(t, session, newCredential(t, 20100101))
+
+		rejected, err := service.Response(ctx, session.ID, session.State, vpToken, submission)
+		assert.ErrorIs(t, err, ErrOID4VPVerification)
+		assert.Equal(t, domain.OID4VPSessionStatusRejected, rejected.Status)
+	})
+
+	t.Run("should reject revoked credentials", func(t *testing.T) {
+		session := newSession(t, domain.CredentialFormatJWT)
+		credential := newCredential(t, 19960424)
+		claims.claims[uuid.MustParse(strings.TrimPrefix(credential.ID, "urn:uuid:"))].Revoked = true
+		vpToken, submission := jwtVP(t, session, credential)
+
+		rejected, err := service.Response(ctx, session.ID, session.State, vpToken, submission)
+		assert.ErrorIs(t, err, ErrOID4VPVerification)
+		assert.Contains(t, *rejected.Error, "revoked")
+	})
+
+	t.Run("should not reject the session on a wrong state", func(t *testing.T) {
+		session := newSession(t, domain.CredentialFormatJWT)
+		vpToken, submission := jwtVP(t, session, newCredential(t, 19960424))
+
+		_, err := service.Response(ctx, session.ID, "other", vpToken, submission)
+		assert.ErrorIs(t, err, ErrOID4VPInvalidState)
+		stored, err := sessions.GetOID4VP(ctx, session.ID)
+		require.NoError(t, err)
+		assert.True(t, stored.Pending())
+	})
+}
@@ -0,0 +1,507 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/merklize"
+	"github.com/iden3/go-schema-processor/v2/processor"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/jackc/pgx/v4"
+
+	inCommon "github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/internal/revocationstatus"
+	schemaPkg "github.com/polygonid/sh-id-platform/internal/schema"
+	"github.com/polygonid/sh-id-platform/internal/urn"
+)
+
+var (
+	// ErrOnchainIssuerController is returned when the controller of an onchain issuer is not an ethereum identity
+	ErrOnchainIssuerController = errors.New("onchain issuers must be controlled by an ethereum identity")
+	// ErrOnchainIssuerOwner is returned when the identity contract is not owned by the ethereum key of the controller
+	ErrOnchainIssuerOwner = errors.New("the identity contract must be owned by the ethereum key of the controller")
+)
+
+const onchainCredentialsBatch = 100
+
+// ProblemReportCodeOnchainCredentialNotFound is the problem report code replied to onchain offer requests without
+// any published credential of the user
+const ProblemReportCodeOnchainCredentialNotFound protocol.ProblemErrorCode = "e.p.onchain-credential-not-found"
+
+type onchainIssuer struct {
+	repository       ports.OnchainIssuerRepository
+	claimsRepository ports.ClaimRepository
+	identityService  ports.IdentityService
+	gateway          ports.OnchainIdentityGateway
+	txHistory        ports.TransactionHistoryService
+	messageService   ports.MessageService
+	loader           loader.DocumentLoader
+	storage          *db.Storage
+	audit            ports.AuditService
+	tenants          ports.TenantService
+}
+
+// NewOnchainIssuer creates the service that issues credentials through identity contracts
+func NewOnchainIssuer(repository ports.OnchainIssuerRepository, claimsRepository ports.ClaimRepository, identityService ports.IdentityService, gateway ports.OnchainIdentityGateway, txHistory ports.TransactionHistoryService, messageService ports.MessageService, ld loader.DocumentLoader, storage *db.Storage, audit ports.AuditService, tenants ports.TenantService) ports.OnchainIssuerService {
+	return &onchainIssuer{
+		repository:       repository,
+		claimsRepository: claimsRepository,
+		identityService:  identityService,
+		gateway:          gateway,
+		txHistory:        txHistory,
+		messageService:   messageService,
+		loader:           ld,
+		storage:          storage,
+		audit:            audit,
+		tenants:          tenants,
+	}
+}
+
+// Register adds the identity contract as an onchain issuer of the controller identity.
+// The contract must be owned by the ethereum key of the controller, and its DID is built from the contract address
+// in the same network as the controller.
+func (o *onchainIssuer) Register(ctx context.Context, controllerDID w3c.DID, contract common.Address) (*domain.OnchainIssuer, error) {
+	identity, err := o.identityService.GetByDID(ctx, controllerDID)
+	if err != nil {
+		return nil, err
+	}
+	if identity.KeyType != string(kms.KeyTypeEthereum) {
+		return nil, ErrOnchainIssuerController
+	}
+
+	id, err := core.IDFromDID(controllerDID)
+	if err != nil {
+		return nil, err
+	}
+	blockchain, err := core.BlockchainFromID(id)
+	if err != nil {
+		return nil, err
+	}
+	networkID, err := core.NetworkIDFromID(id)
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := core.ChainIDfromDID(controllerDID)
+	if err != nil {
+		return nil, err
+	}
+	controllerAddress, err := core.EthAddressFromID(id)
+	if err != nil {
+		return nil, ErrOnchainIssuerController
+	}
+
+	var address [20]byte
+	copy(address[:], contract.Bytes())
+	did, err := core.NewDID(id.Type(), core.GenesisFromEthAddress(address))
+	if err != nil {
+		return nil, err
+	}
+
+	oi := &domain.OnchainIssuer{
+		ID:              uuid.New(),
+		Identifier:      did.String(),
+		ControllerDID:   controllerDID.String(),
+		ContractAddress: contract.Hex(),
+		ChainID:         int64(chainID),
+		Blockchain:      string(blockchain),
+		Network:         string(networkID),
+		CreatedAt:       time.Now(),
+	}
+	owner, err := o.gateway.Owner(ctx, oi)
+	if err != nil {
+		log.Error(ctx, "getting owner of the identity contract", "err", err, "contract", contract.Hex())
+		if errors.Is(err, eth.ErrNoIdentityContract) {
+			return nil, ErrOnchainIssuerOwner
+		}
+		return nil, err
+	}
+	if owner != common.Address(controllerAddress) {
+		log.Warn(ctx, "identity contract of another owner", "contract", contract.Hex(), "owner", owner.Hex(), "controller", controllerDID.String())
+		return nil, ErrOnchainIssuerOwner
+	}
+	if err := o.repository.Save(ctx, oi); err != nil {
+		log.Error(ctx, "saving onchain issuer", "err", err, "contract", contract.Hex())
+		return nil, err
+	}
+	return oi, nil
+}
+
+// GetByController returns the onchain issuers of the controller identity
+func (o *onchainIssuer) GetByController(ctx context.Context, controllerDID w3c.DID) ([]domain.OnchainIssuer, error) {
+	return o.repository.GetByController(ctx, controllerDID)
+}
+
+// CreateCredential builds the core claim of the credential and adds it to the claims tree of the identity contract
+// with an addClaimHashAndTransit transaction. The transaction is sent once the credential is stored, so the contract
+// never gets a claim the node doesn't know about. The credential is offered to the user once the transaction is mined,
+// see CheckPending.
+func (o *onchainIssuer) CreateCredential(ctx context.Context, req *ports.CreateOnchainCredentialRequest) (*domain.Claim, *domain.OnchainCredential, error) {
+	oi, err := o.repository.GetByIdentifier(ctx, req.OnchainIssuerDID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if oi.ControllerDID != req.ControllerDID.String() {
+		return nil, nil, repositories.OnchainIssuerNotFoundErr
+	}
+	if _, err := url.ParseRequestURI(req.Schema); err != nil {
+		return nil, nil, ErrMalformedURL
+	}
+	subject, ok := req.CredentialSubject["id"].(string)
+	if !ok {
+		return nil, nil, ErrWrongCredentialSubjectID
+	}
+	userDID, err := w3c.ParseDID(subject)
+	if err != nil {
+		return nil, nil, ErrWrongCredentialSubjectID
+	}
+
+	nonce, err := inCommon.Int64()
+	if err != nil {
+		log.Error(ctx, "create a nonce", "err", err)
+		return nil, nil, err
+	}
+	vcID, err := uuid.NewUUID()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vc, coreClaim, err := o.coreClaim(ctx, oi, req, vcID, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	hashIndex, hashValue, err := coreClaim.HiHv()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	claim, err := domain.FromClaimer(coreClaim, req.Schema, req.Type)
+	if err != nil {
+		log.Error(ctx, "cannot obtain the claim from claimer", "err", err)
+		return nil, nil, err
+	}
+	claim.Identifier = &oi.Identifier
+	claim.Issuer = oi.Identifier
+	claim.ID = vcID
+	if err := claim.Data.Set(vc); err != nil {
+		return nil, nil, err
+	}
+	if err := claim.CredentialStatus.Set(vc.CredentialStatus); err != nil {
+		return nil, nil, err
+	}
+	claim.CreatedAt = *vc.IssuanceDate
+
+	credential := &domain.OnchainCredential{
+		ID:              vcID,
+		OnchainIssuerID: oi.ID,
+		UserDID:         userDID.String(),
+		Status:          domain.OnchainCredentialStatusPending,
+	}
+	err = o.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		// onchain credentials count in the quota of the tenant of the controller
+		if err := o.tenants.CheckCredentialQuota(ctx, tx, req.ControllerDID); err != nil {
+			return err
+		}
+		if _, err := o.claimsRepository.Save(ctx, tx, claim); err != nil {
+			return err
+		}
+		if err := o.repository.SaveCredential(ctx, tx, credential); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityCredential, domain.AuditOperationCreate, claim.ID.String(), nil, claim.AuditView())
+		if err != nil {
+			return err
+		}
+		return o.audit.Record(ctx, tx, req.ControllerDID, change)
+	})
+	if err != nil {
+		log.Error(ctx, "saving onchain credential", "err", err, "onchainIssuer", oi.Identifier)
+		return nil, nil, err
+	}
+
+	credential.TxID, err = o.gateway.AddClaimHashAndTransit(ctx, oi, hashIndex, hashValue)
+	if err != nil {
+		log.Error(ctx, "issuing onchain credential", "err", err, "onchainIssuer", oi.Identifier)
+		credential.Status = domain.OnchainCredentialStatusFailed
+		if err := o.repository.SaveCredential(ctx, o.storage.Pgx, credential); err != nil {
+			log.Error(ctx, "saving failed onchain credential", "err", err, "id", credential.ID)
+		}
+		return nil, nil, err
+	}
+	if err := o.repository.SaveCredential(ctx, o.storage.Pgx, credential); err != nil {
+		log.Error(ctx, "saving onchain credential transaction", "err", err, "id", credential.ID, "tx", credential.TxID)
+		return nil, nil, err
+	}
+
+	if err := o.txHistory.RecordSent(ctx, req.ControllerDID, oi.ResolverPrefix(), domain.TransactionTypeOnchainCredential, vcID.String(), credential.TxID); err != nil {
+		log.Warn(ctx, "recording onchain credential transaction", "err", err, "tx", credential.TxID)
+	}
+	return claim, credential, nil
+}
+
+// CheckPending looks for the receipts of the pending onchain credential transactions.
+// The credentials whose transaction has been mined are offered to the users with a CredentialsOnchainOfferMessage.
+// It returns the number of credentials published.
+func (o *onchainIssuer) CheckPending(ctx context.Context) (int, error) {
+	credentials, err := o.repository.GetPendingCredentials(ctx, onchainCredentialsBatch)
+	if err != nil {
+		log.Error(ctx, "getting pending onchain credentials", "err", err)
+		return 0, err
+	}
+
+	issuers := make(map[uuid.UUID]*domain.OnchainIssuer)
+	published := 0
+	for i := range credentials {
+		credential := &credentials[i]
+		oi, ok := issuers[credential.OnchainIssuerID]
+		if !ok {
+			if oi, err = o.repository.GetByID(ctx, credential.OnchainIssuerID); err != nil {
+				log.Error(ctx, "getting onchain issuer", "err", err, "id", credential.OnchainIssuerID)
+				continue
+			}
+			issuers[credential.OnchainIssuerID] = oi
+		}
+
+		if credential.TxID == "" {
+			log.Warn(ctx, "onchain credential without transaction", "id", credential.ID)
+			continue
+		}
+		receipt, err := o.gateway.GetTransactionReceipt(ctx, oi, credential.TxID)
+		if err != nil {
+			log.Debug(ctx, "onchain credential transaction not mined yet", "err", err, "tx", credential.TxID)
+			continue
+		}
+		if err := o.publish(ctx, oi, credential, receipt); err != nil {
+			log.Error(ctx, "publishing onchain credential", "err", err, "id", credential.ID, "tx", credential.TxID)
+			continue
+		}
+		if credential.Status == domain.OnchainCredentialStatusPublished {
+			published++
+		}
+	}
+	return published, nil
+}
+
+// publish records the receipt of the transaction and offers the credential to the user if it was successful
+func (o *onchainIssuer) publish(ctx context.Context, oi *domain.OnchainIssuer, credential *domain.OnchainCredential, receipt *types.Receipt) error {
+	controllerDID, err := w3c.ParseDID(oi.ControllerDID)
+	if err != nil {
+		return err
+	}
+	if err := o.txHistory.RecordReceipt(ctx, *controllerDID, oi.ResolverPrefix(), domain.TransactionTypeOnchainCredential, credential.ID.String(), receipt); err != nil {
+		log.Warn(ctx, "recording onchain credential receipt", "err", err, "tx", credential.TxID)
+	}
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		log.Warn(ctx, "onchain credential transaction failed", "id", credential.ID, "tx", credential.TxID)
+		credential.Status = domain.OnchainCredentialStatusFailed
+		return o.repository.SaveCredential(ctx, o.storage.Pgx, credential)
+	}
+
+	userDID, err := w3c.ParseDID(credential.UserDID)
+	if err != nil {
+		return err
+	}
+	issuerDID, err := w3c.ParseDID(oi.Identifier)
+	if err != nil {
+		return err
+	}
+	claim, err := o.claimsRepository.GetByIdAndIssuer(ctx, o.storage.Pgx, issuerDID, credential.ID)
+	if err != nil {
+		return err
+	}
+	id := uuid.NewString()
+	offer, err := onchainOfferMessage(oi, []protocol.CredentialOffer{{ID: claim.ID.String(), Description: claim.SchemaType}}, *userDID, id, id)
+	if err != nil {
+		return err
+	}
+	if err := o.messageService.Send(ctx, *controllerDID, *userDID, offer); err != nil {
+		return err
+	}
+
+	credential.Status = domain.OnchainCredentialStatusPublished
+	return o.repository.SaveCredential(ctx, o.storage.Pgx, credential)
+}
+
+// coreClaim builds the verifiable credential and its core claim, with the onchain issuer as the issuer
+func (o *onchainIssuer) coreClaim(ctx context.Context, oi *domain.OnchainIssuer, req *ports.CreateOnchainCredentialRequest, vcID uuid.UUID, nonce uint64) (verifiable.W3CCredential, *core.Claim, error) {
+	schema, err := schemaPkg.LoadSchema(ctx, o.loader, req.Schema)
+	if err != nil {
+		log.Error(ctx, "loading schema", "err", err, "schema", req.Schema)
+		return verifiable.W3CCredential{}, nil, ErrLoadingSchema
+	}
+	if schema.Metadata == nil {
+		return verifiable.W3CCredential{}, nil, ErrProcessSchema
+	}
+	jsonLdContext, ok := schema.Metadata.Uris["jsonLdContext"].(string)
+	if !ok {
+		return verifiable.W3CCredential{}, nil, ErrJSONLdContext
+	}
+	jsonLD, err := jsonschema.Load(ctx, jsonLdContext, o.loader)
+	if err != nil {
+		log.Error(ctx, "loading jsonLdContext", "err", err, "url", jsonLdContext)
+		return verifiable.W3CCredential{}, nil, err
+	}
+	if _, err := merklize.TypeIDFromContext(jsonLD.BytesNoErr(), req.Type); err != nil {
+		log.Error(ctx, "getting credential type", "err", err)
+		return verifiable.W3CCredential{}, nil, err
+	}
+
+	issuerDID, err := w3c.ParseDID(oi.Identifier)
+	if err != nil {
+		return verifiable.W3CCredential{}, nil, err
+	}
+	credentialSubject := req.CredentialSubject
+	credentialSubject["type"] = req.Type
+	issuanceDate := time.Now().UTC()
+	vc := verifiable.W3CCredential{
+		ID:                string(urn.FromUUID(vcID)),
+		Context:           []string{verifiable.JSONLDSchemaW3CCredential2018, verifiable.JSONLDSchemaIden3Credential, jsonLdContext},
+		Type:              []string{verifiable.TypeW3CVerifiableCredential, req.Type},
+		Expiration:        req.Expiration,
+		IssuanceDate:      &issuanceDate,
+		CredentialSubject: credentialSubject,
+		Issuer:            oi.Identifier,
+		CredentialSchema: verifiable.CredentialSchema{
+			ID:   req.Schema,
+			Type: verifiable.JSONSchema2023,
+		},
+		CredentialStatus: revocationstatus.OnchainIssuerCredentialStatus(*issuerDID, nonce, common.HexToAddress(oi.ContractAddress), strconv.FormatInt(oi.ChainID, 10)),
+	}
+
+	opts := &processor.CoreClaimOptions{
+		RevNonce:              nonce,
+		MerklizedRootPosition: inCommon.DefineMerklizedRootPosition(schema.Metadata, req.MerklizedRootPosition),
+		Version:               req.Version,
+		SubjectPosition:       req.SubjectPos,
+		Updatable:             false,
+		MerklizerOpts:         []merklize.MerklizeOption{merklize.WithDocumentLoader(o.loader)},
+	}
+	coreClaim, err := schemaPkg.Process(ctx, o.loader, req.Schema, vc, opts)
+	if err != nil {
+		log.Error(ctx, "credential subject attributes don't match the provided schema", "err", err)
+		switch {
+		case errors.Is(err, schemaPkg.ErrParseClaim):
+			return verifiable.W3CCredential{}, nil, ErrParseClaim
+		case errors.Is(err, schemaPkg.ErrValidateData):
+			return verifiable.W3CCredential{}, nil, ErrInvalidCredentialSubject
+		case errors.Is(err, schemaPkg.ErrLoadSchema):
+			return verifiable.W3CCredential{}, nil, ErrLoadingSchema
+		}
+		return verifiable.W3CCredential{}, nil, err
+	}
+	return vc, coreClaim, nil
+}
+
+// Agent answers the onchain offer messages of the users with the offer of the credentials they list, so a holder
+// that missed the offer sent when the transaction was mined can ask for it again.
+// Only the published credentials of the user are offered, a problem report is replied if there is none.
+func (o *onchainIssuer) Agent(ctx context.Context, req *ports.AgentRequest, _ iden3comm.MediaType) (*iden3comm.BasicMessage, error) {
+	if req.IssuerDID == nil || req.UserDID == nil {
+		return nil, fmt.Errorf("onchain offer requests must have from and to")
+	}
+	var body protocol.CredentialsOnchainOfferMessageBody
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		log.Debug(ctx, "agent: invalid onchain offer request", "err", err)
+		return nil, fmt.Errorf("invalid onchain offer request body: %w", err)
+	}
+	oi, err := o.repository.GetByIdentifier(ctx, *req.IssuerDID)
+	if err != nil {
+		log.Warn(ctx, "agent: onchain offer request to an unknown onchain issuer", "err", err, "did", req.IssuerDID)
+		return NewProblemReport(req, ProblemReportCodeOnchainCredentialNotFound, "unknown onchain issuer"), nil
+	}
+
+	offers := make([]protocol.CredentialOffer, 0, len(body.Credentials))
+	for _, requested := range body.Credentials {
+		id, err := uuid.Parse(requested.ID)
+		if err != nil {
+			continue
+		}
+		credential, err := o.repository.GetCredential(ctx, id)
+		if err != nil {
+			if !errors.Is(err, repositories.OnchainCredentialNotFoundErr) {
+				log.Error(ctx, "agent: getting onchain credential", "err", err, "id", id)
+				return nil, err
+			}
+			continue
+		}
+		if credential.OnchainIssuerID != oi.ID || credential.UserDID != req.UserDID.String() || credential.Status != domain.OnchainCredentialStatusPublished {
+			continue
+		}
+		claim, err := o.claimsRepository.GetByIdAndIssuer(ctx, o.storage.Pgx, req.IssuerDID, id)
+		if err != nil {
+			log.Error(ctx, "agent: getting onchain claim", "err", err, "id", id)
+			return nil, err
+		}
+		offers = append(offers, protocol.CredentialOffer{ID: claim.ID.String(), Description: claim.SchemaType})
+	}
+	if len(offers) == 0 {
+		return NewProblemReport(req, ProblemReportCodeOnchainCredentialNotFound, "no published onchain credential found"), nil
+	}
+
+	threadID := req.ThreadID
+	if threadID == "" {
+		threadID = req.ID.String()
+	}
+	offer, err := onchainOfferMessage(oi, offers, *req.UserDID, uuid.NewString(), threadID)
+	if err != nil {
+		return nil, err
+	}
+	var response iden3comm.BasicMessage
+	if err := json.Unmarshal(offer, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// onchainOfferMessage builds the offer of credentials published in the claims tree of the onchain issuer contract.
+// The transaction data is the getCredential method the holder calls to fetch the credential from the contract.
+func onchainOfferMessage(oi *domain.OnchainIssuer, offers []protocol.CredentialOffer, userDID w3c.DID, id, threadID string) (json.RawMessage, error) {
+	parsed, err := eth.OnchainIdentityABI()
+	if err != nil {
+		return nil, err
+	}
+	method, ok := parsed.Methods[eth.OnchainIdentityGetCredentialMethod]
+	if !ok {
+		return nil, fmt.Errorf("method %s not found in the identity contract abi", eth.OnchainIdentityGetCredentialMethod)
+	}
+
+	return json.Marshal(protocol.CredentialsOnchainOfferMessage{
+		ID:       id,
+		ThreadID: threadID,
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.CredentialOnchainOfferMessageType,
+		Body: protocol.CredentialsOnchainOfferMessageBody{
+			Credentials: offers,
+			TransactionData: protocol.TransactionData{
+				ContractAddress: oi.ContractAddress,
+				MethodID:        common.Bytes2Hex(method.ID),
+				ChainID:         int(oi.ChainID),
+				Network:         oi.Blockchain + "-" + oi.Network,
+			},
+		},
+		From: oi.Identifier,
+		To:   userDID.String(),
+	})
+}
@@ -0,0 +1,240 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// onchainIssuerRepository is an in memory OnchainIssuerRepository
+type onchainIssuerRepository struct {
+	issuers     map[uuid.UUID]domain.OnchainIssuer
+	credentials map[uuid.UUID]domain.OnchainCredential
+}
+
+func (r *onchainIssuerRepository) Save(_ context.Context, onchainIssuer *domain.OnchainIssuer) error {
+	r.issuers[onchainIssuer.ID] = *onchainIssuer
+	return nil
+}
+
+func (r *onchainIssuerRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.OnchainIssuer, error) {
+	onchainIssuer, ok := r.issuers[id]
+	if !ok {
+		return nil, repositories.OnchainIssuerNotFoundErr
+	}
+	return &onchainIssuer, nil
+}
+
+func (r *onchainIssuerRepository) GetByIdentifier(_ context.Context, identifier w3c.DID) (*domain.OnchainIssuer, error) {
+	for _, onchainIssuer := range r.issuers {
+		if onchainIssuer.Identifier == identifier.String() {
+			return &onchainIssuer, nil
+		}
+	}
+	return nil, repositories.OnchainIssuerNotFoundErr
+}
+
+func (r *onchainIssuerRepository) GetByController(_ context.Context, controllerDID w3c.DID) ([]domain.OnchainIssuer, error) {
+	var onchainIssuers []domain.OnchainIssuer
+	for _, onchainIssuer := range r.issuers {
+		if onchainIssuer.ControllerDID == controllerDID.String() {
+			onchainIssuers = append(onchainIssuers, onchainIssuer)
+		}
+	}
+	return onchainIssuers, nil
+}
+
+func (r *onchainIssuerRepository) SaveCredential(_ context.Context, _ db.Querier, credential *domain.OnchainCredential) error {
+	r.credentials[credential.ID] = *credential
+	return nil
+}
+
+func (r *onchainIssuerRepository) GetCredential(_ context.Context, id uuid.UUID) (*domain.OnchainCredential, error) {
+	credential, ok := r.credentials[id]
+	if !ok {
+		return nil, repositories.OnchainCredentialNotFoundErr
+	}
+	return &credential, nil
+}
+
+func (r *onchainIssuerRepository) GetPendingCredentials(_ context.Context, _ int) ([]domain.OnchainCredential, error) {
+	var credentials []domain.OnchainCredential
+	for _, credential := range r.credentials {
+		if credential.Status == domain.OnchainCredentialStatusPending {
+			credentials = append(credentials, credential)
+		}
+	}
+	return credentials, nil
+}
+
+// onchainIdentityGateway returns the owners of the identity contracts
+type onchainIdentityGateway struct {
+	ports.OnchainIdentityGateway
+	owners map[common.Address]common.Address
+}
+
+func (g onchainIdentityGateway) Owner(_ context.Context, onchainIssuer *domain.OnchainIssuer) (common.Address, error) {
+	owner, ok := g.owners[common.HexToAddress(onchainIssuer.ContractAddress)]
+	if !ok {
+		return common.Address{}, eth.ErrNoIdentityContract
+	}
+	return owner, nil
+}
+
+// onchainIssuerIdentities returns the identities with the given key types
+type onchainIssuerIdentities struct {
+	ports.IdentityService
+	keyTypes map[string]kms.KeyType
+}
+
+func (i onchainIssuerIdentities) GetByDID(_ context.Context, identifier w3c.DID) (*domain.Identity, error) {
+	keyType, ok := i.keyTypes[identifier.String()]
+	if !ok {
+		return nil, repositories.ErrIdentityNotFound
+	}
+	return &domain.Identity{Identifier: identifier.String(), KeyType: string(keyType)}, nil
+}
+
+// onchainClaims returns the claims of the onchain issuers
+type onchainClaims struct {
+	ports.ClaimRepository
+	claims map[uuid.UUID]domain.Claim
+}
+
+func (c onchainClaims) GetByIdAndIssuer(_ context.Context, _ db.Querier, identifier *w3c.DID, claimID uuid.UUID) (*domain.Claim, error) {
+	claim, ok := c.claims[claimID]
+	if !ok || claim.Issuer != identifier.String() {
+		return nil, repositories.ErrClaimDoesNotExist
+	}
+	return &claim, nil
+}
+
+func TestOnchainIssuer_Register(t *testing.T) {
+	ctx := context.Background()
+	controller := common.HexToAddress("0xF1d2a5a7A1fA3CbEeF6e1a5A6bD5C8d5b8e4C3a2")
+	didType, err := core.BuildDIDType(core.DIDMethodPolygonID, core.Polygon, core.Amoy)
+	require.NoError(t, err)
+	ethDID, err := core.NewDID(didType, core.GenesisFromEthAddress(controller))
+	require.NoError(t, err)
+	bjjDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	identities := onchainIssuerIdentities{keyTypes: map[string]kms.KeyType{
+		ethDID.String(): kms.KeyTypeEthereum,
+		bjjDID.String(): kms.KeyTypeBabyJubJub,
+	}}
+	repository := &onchainIssuerRepository{issuers: map[uuid.UUID]domain.OnchainIssuer{}, credentials: map[uuid.UUID]domain.OnchainCredential{}}
+	contract := common.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4")
+	otherContract := common.HexToAddress("0x2c1a07Eb5C9Fd2F1E4A5d1C6e5fB8C4b7A3e9D10")
+	noContract := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	gateway := onchainIdentityGateway{owners: map[common.Address]common.Address{
+		contract:      controller,
+		otherContract: common.HexToAddress("0x000000000000000000000000000000000000bEEF"),
+	}}
+	onchainIssuerService := services.NewOnchainIssuer(repository, nil, identities, gateway, nil, nil, nil, nil, nil, nil)
+
+	t.Run("should build the onchain issuer did from the contract address", func(t *testing.T) {
+		onchainIssuer, err := onchainIssuerService.Register(ctx, *ethDID, contract)
+		require.NoError(t, err)
+		assert.Equal(t, contract.Hex(), onchainIssuer.ContractAddress)
+		assert.Equal(t, int64(80002), onchainIssuer.ChainID)
+		assert.Equal(t, "polygon:amoy", onchainIssuer.ResolverPrefix())
+
+		did, err := w3c.ParseDID(onchainIssuer.Identifier)
+		require.NoError(t, err)
+		id, err := core.IDFromDID(*did)
+		require.NoError(t, err)
+		address, err := core.EthAddressFromID(id)
+		require.NoError(t, err)
+		assert.Equal(t, contract.Bytes(), address[:])
+
+		onchainIssuers, err := onchainIssuerService.GetByController(ctx, *ethDID)
+		require.NoError(t, err)
+		assert.Len(t, onchainIssuers, 1)
+	})
+
+	t.Run("should refuse identities without ethereum keys", func(t *testing.T) {
+		_, err := onchainIssuerService.Register(ctx, *bjjDID, contract)
+		assert.ErrorIs(t, err, services.ErrOnchainIssuerController)
+	})
+
+	t.Run("should refuse contracts of other owners", func(t *testing.T) {
+		_, err := onchainIssuerService.Register(ctx, *ethDID, otherContract)
+		assert.ErrorIs(t, err, services.ErrOnchainIssuerOwner)
+		_, err = onchainIssuerService.Register(ctx, *ethDID, noContract)
+		assert.ErrorIs(t, err, services.ErrOnchainIssuerOwner)
+	})
+
+	t.Run("should refuse unknown identities", func(t *testing.T) {
+		unknownDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qV9QXdhXXmN5sKjN1YueMjxgRbnJcEGK2kGpvk3cq")
+		require.NoError(t, err)
+		_, err = onchainIssuerService.Register(ctx, *unknownDID, contract)
+		assert.ErrorIs(t, err, repositories.ErrIdentityNotFound)
+	})
+}
+
+func TestOnchainIssuer_Agent(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	onchainIssuer := domain.OnchainIssuer{ID: uuid.New(), Identifier: issuerDID.String(), ContractAddress: "0x134B1BE34911E39A8397ec6289782989729807a4", ChainID: 80002, Blockchain: "polygon", Network: "amoy"}
+	published, pending := uuid.New(), uuid.New()
+	repository := &onchainIssuerRepository{
+		issuers: map[uuid.UUID]domain.OnchainIssuer{onchainIssuer.ID: onchainIssuer},
+		credentials: map[uuid.UUID]domain.OnchainCredential{
+			published: {ID: published, OnchainIssuerID: onchainIssuer.ID, UserDID: userDID.String(), Status: domain.OnchainCredentialStatusPublished},
+			pending:   {ID: pending, OnchainIssuerID: onchainIssuer.ID, UserDID: userDID.String(), Status: domain.OnchainCredentialStatusPending},
+		},
+	}
+	claims := onchainClaims{claims: map[uuid.UUID]domain.Claim{
+		published: {ID: published, Issuer: issuerDID.String(), SchemaType: "KYCAgeCredential"},
+		pending:   {ID: pending, Issuer: issuerDID.String(), SchemaType: "KYCAgeCredential"},
+	}}
+	onchainIssuerService := services.NewOnchainIssuer(repository, claims, nil, nil, nil, nil, nil, &db.Storage{}, nil, nil)
+
+	request := func(from *w3c.DID, ids ...uuid.UUID) *ports.AgentRequest {
+		body := protocol.CredentialsOnchainOfferMessageBody{}
+		for _, id := range ids {
+			body.Credentials = append(body.Credentials, protocol.CredentialOffer{ID: id.String()})
+		}
+		raw, err := json.Marshal(body)
+		require.NoError(t, err)
+		return &ports.AgentRequest{ID: uuid.New(), ThreadID: "thread", Type: protocol.CredentialOnchainOfferMessageType, IssuerDID: issuerDID, UserDID: from, Body: raw}
+	}
+
+	t.Run("should offer again the published credentials of the user", func(t *testing.T) {
+		response, err := onchainIssuerService.Agent(ctx, request(userDID, published, pending), packers.MediaTypeZKPMessage)
+		require.NoError(t, err)
+		assert.Equal(t, protocol.CredentialOnchainOfferMessageType, response.Type)
+		assert.Equal(t, "thread", response.ThreadID)
+		var body protocol.CredentialsOnchainOfferMessageBody
+		require.NoError(t, json.Unmarshal(response.Body, &body))
+		require.Len(t, body.Credentials, 1)
+		assert.Equal(t, published.String(), body.Credentials[0].ID)
+		assert.Equal(t, onchainIssuer.ContractAddress, body.TransactionData.ContractAddress)
+	})
+
+	t.Run("should not offer the credentials of other users", func(t *testing.T) {
+		response, err := onchainIssuerService.Agent(ctx, request(issuerDID, published), packers.MediaTypeZKPMessage)
+		require.NoError(t, err)
+		assert.Equal(t, protocol.ProblemReportMessageType, response.Type)
+	})
+}
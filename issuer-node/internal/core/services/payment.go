@@ -7,6 +7,7 @@ import (
 	b64 "encoding/base64"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -15,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/google/uuid"
@@ -23,9 +25,12 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	comm "github.com/iden3/iden3comm/v2"
 	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/jackc/pgx/v4"
 
+	inCommon "github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/eth"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -33,18 +38,24 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/payments"
 )
 
+// ErrNoPaymentOptions is returned when the issuer has no payment options to request a payment
+var ErrNoPaymentOptions = errors.New("the issuer has no payment options")
+
 type payment struct {
 	networkResolver                      network.Resolver
 	settings                             payments.Config
 	schemaService                        ports.SchemaService
 	paymentsStore                        ports.PaymentRepository
+	transactionHistory                   ports.TransactionHistoryService
 	kms                                  kms.KMSType
 	iden3PaymentRailsRequestV1Types      apitypes.Types
 	iden3PaymentRailsERC20RequestV1Types apitypes.Types
+	storage                              *db.Storage
+	audit                                ports.AuditService
 }
 
 // NewPaymentService creates a new payment service
-func NewPaymentService(payOptsRepo ports.PaymentRepository, resolver network.Resolver, schemaSrv ports.SchemaService, settings *payments.Config, kms kms.KMSType) (ports.PaymentService, error) {
+func NewPaymentService(payOptsRepo ports.PaymentRepository, resolver network.Resolver, schemaSrv ports.SchemaService, transactionHistory ports.TransactionHistoryService, settings *payments.Config, kms kms.KMSType, storage *db.Storage, audit ports.AuditService) (ports.PaymentService, error) {
 	iden3PaymentRailsRequestV1Types := apitypes.Types{}
 	iden3PaymentRailsERC20RequestV1Types := apitypes.Types{}
 	err := json.Unmarshal([]byte(domain.Iden3PaymentRailsRequestV1SchemaJSON), &iden3PaymentRailsRequestV1Types)
@@ -62,16 +73,30 @@ func NewPaymentService(payOptsRepo ports.PaymentRepository, resolver network.Res
 		settings:                             *settings,
 		schemaService:                        schemaSrv,
 		paymentsStore:                        payOptsRepo,
+		transactionHistory:                   transactionHistory,
 		kms:                                  kms,
 		iden3PaymentRailsRequestV1Types:      iden3PaymentRailsRequestV1Types,
 		iden3PaymentRailsERC20RequestV1Types: iden3PaymentRailsERC20RequestV1Types,
+		storage:                              storage,
+		audit:                                audit,
 	}, nil
 }
 
 // CreatePaymentOption creates a payment option for a specific issuer
 func (p *payment) CreatePaymentOption(ctx context.Context, issuerDID *w3c.DID, name, description string, config *domain.PaymentOptionConfig) (uuid.UUID, error) {
 	paymentOption := domain.NewPaymentOption(*issuerDID, name, description, config)
-	id, err := p.paymentsStore.SavePaymentOption(ctx, paymentOption)
+	var id uuid.UUID
+	err := p.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		var err error
+		if id, err = p.paymentsStore.SavePaymentOption(ctx, tx, paymentOption); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityPaymentOption, domain.AuditOperationCreate, id.String(), nil, paymentOption)
+		if err != nil {
+			return err
+		}
+		return p.audit.Record(ctx, tx, *issuerDID, change)
+	})
 	if err != nil {
 		log.Error(ctx, "failed to save payment option", "err", err, "issuerDID", issuerDID, "name", name, "description", description, "config", config)
 		return uuid.Nil, err
@@ -101,7 +126,21 @@ func (p *payment) GetPaymentOptionByID(ctx context.Context, issuerDID *w3c.DID,
 
 // DeletePaymentOption deletes a payment option
 func (p *payment) DeletePaymentOption(ctx context.Context, issuerDID *w3c.DID, id uuid.UUID) error {
-	err := p.paymentsStore.DeletePaymentOption(ctx, *issuerDID, id)
+	paymentOption, err := p.paymentsStore.GetPaymentOptionByID(ctx, issuerDID, id)
+	if err != nil {
+		log.Error(ctx, "failed to get payment option", "err", err, "issuerDID", issuerDID, "id", id)
+		return err
+	}
+	err = p.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := p.paymentsStore.DeletePaymentOption(ctx, tx, *issuerDID, id); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityPaymentOption, domain.AuditOperationDelete, id.String(), paymentOption, nil)
+		if err != nil {
+			return err
+		}
+		return p.audit.Record(ctx, tx, *issuerDID, change)
+	})
 	if err != nil {
 		log.Error(ctx, "failed to delete payment option", "err", err, "issuerDID", issuerDID, "id", id)
 		return err
@@ -116,6 +155,7 @@ func (p *payment) UpdatePaymentOption(ctx context.Context, issuerDID *w3c.DID, i
 		return err
 	}
 
+	before := *paymentOption
 	if name != nil {
 		paymentOption.Name = *name
 	}
@@ -128,8 +168,16 @@ func (p *payment) UpdatePaymentOption(ctx context.Context, issuerDID *w3c.DID, i
 		paymentOption.Config = *config
 	}
 
-	_, err = p.paymentsStore.SavePaymentOption(ctx, paymentOption)
-	return err
+	return p.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if _, err := p.paymentsStore.SavePaymentOption(ctx, tx, paymentOption); err != nil {
+			return err
+		}
+		change, err := domain.NewAuditChange(domain.AuditEntityPaymentOption, domain.AuditOperationUpdate, id.String(), &before, paymentOption)
+		if err != nil {
+			return err
+		}
+		return p.audit.Record(ctx, tx, *issuerDID, change)
+	})
 }
 
 // CreatePaymentRequest creates a payment request
@@ -231,16 +279,162 @@ func (p *payment) DeletePaymentRequest(ctx context.Context, issuerDID *w3c.DID,
 	return nil
 }
 
-// CreatePaymentRequestForProposalRequest creates a payment request for a proposal request
-func (p *payment) CreatePaymentRequestForProposalRequest(_ context.Context, proposalRequest *protocol.CredentialsProposalRequestMessage) (*comm.BasicMessage, error) {
-	basicMessage := comm.BasicMessage{
+// Agent handles the payment protocol messages received in the agent endpoint:
+// credential proposal requests are replied with a payment request, and payment messages are verified on chain.
+func (p *payment) Agent(ctx context.Context, req *ports.AgentRequest, mediatype comm.MediaType) (*comm.BasicMessage, error) {
+	if req.UserDID == nil {
+		return nil, fmt.Errorf("'from' field cannot be empty")
+	}
+	if req.IssuerDID == nil {
+		return nil, fmt.Errorf("'to' field cannot be empty")
+	}
+
+	switch req.Type {
+	case protocol.CredentialProposalRequestMessageType:
+		body := protocol.CredentialsProposalRequestBody{}
+		if err := json.Unmarshal(req.Body, &body); err != nil {
+			log.Error(ctx, "unmarshalling proposal request body", "err", err)
+			return nil, fmt.Errorf("invalid proposal request body: %w", err)
+		}
+		return p.CreatePaymentRequestForProposalRequest(ctx, &protocol.CredentialsProposalRequestMessage{
+			ID:       req.ID.String(),
+			Typ:      mediatype,
+			Type:     req.Type,
+			ThreadID: req.ThreadID,
+			Body:     body,
+			From:     req.UserDID.String(),
+			To:       req.IssuerDID.String(),
+		})
+	case protocol.CredentialPaymentMessageType:
+		return nil, p.verifyPaymentMessage(ctx, req)
+	default:
+		return nil, errors.New("invalid type")
+	}
+}
+
+// CreatePaymentRequestForProposalRequest creates a payment request for the credentials of a proposal request,
+// with the most recent payment option of the issuer, and returns the payment request message for the user
+func (p *payment) CreatePaymentRequestForProposalRequest(ctx context.Context, proposalRequest *protocol.CredentialsProposalRequestMessage) (*comm.BasicMessage, error) {
+	issuerDID, err := w3c.ParseDID(proposalRequest.To)
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer DID: %w", err)
+	}
+	userDID, err := w3c.ParseDID(proposalRequest.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user DID: %w", err)
+	}
+	if len(proposalRequest.Body.Credentials) == 0 {
+		return nil, errors.New("proposal request without credentials")
+	}
+
+	options, err := p.GetPaymentOptions(ctx, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if len(options) == 0 {
+		return nil, ErrNoPaymentOptions
+	}
+
+	schemas, err := p.schemaService.GetAll(ctx, *issuerDID, nil)
+	if err != nil {
+		log.Error(ctx, "failed to get schemas", "err", err, "issuerDID", issuerDID)
+		return nil, err
+	}
+
+	payments := make([]protocol.PaymentRequestInfo, 0, len(proposalRequest.Body.Credentials))
+	for _, credential := range proposalRequest.Body.Credentials {
+		schema := findSchema(schemas, credential)
+		if schema == nil {
+			return nil, fmt.Errorf("%w: %s", ErrSchemaNotFound, credential.Type)
+		}
+		paymentRequest, err := p.CreatePaymentRequest(ctx, &ports.CreatePaymentRequestReq{
+			IssuerDID: *issuerDID,
+			UserDID:   *userDID,
+			OptionID:  options[0].ID,
+			SchemaID:  schema.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		info := protocol.PaymentRequestInfo{
+			Credentials: paymentRequest.Credentials,
+			Description: paymentRequest.Description,
+		}
+		for _, item := range paymentRequest.Payments {
+			info.Data = append(info.Data, item.Payment)
+		}
+		payments = append(payments, info)
+	}
+
+	resolverPrefix, err := inCommon.ResolverPrefix(issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	rhsSettings, err := p.networkResolver.GetRhsSettings(ctx, resolverPrefix)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(protocol.PaymentRequestMessageBody{
+		Agent:    fmt.Sprintf(ports.AgentUrl, rhsSettings.Iden3CommAgentStatus),
+		Payments: payments,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &comm.BasicMessage{
+		ID:       uuid.NewString(),
+		Typ:      proposalRequest.Typ,
+		Type:     protocol.CredentialPaymentRequestMessageType,
+		ThreadID: proposalRequest.ThreadID,
+		Body:     body,
 		From:     proposalRequest.To,
 		To:       proposalRequest.From,
-		ThreadID: proposalRequest.ThreadID,
-		ID:       proposalRequest.ID,
-		Typ:      proposalRequest.Typ,
+	}, nil
+}
+
+// verifyPaymentMessage verifies on chain every payment of a payment message
+func (p *payment) verifyPaymentMessage(ctx context.Context, req *ports.AgentRequest) error {
+	body := protocol.PaymentMessageBody{}
+	if err := json.Unmarshal(req.Body, &body); err != nil {
+		log.Error(ctx, "unmarshalling payment body", "err", err)
+		return fmt.Errorf("invalid payment body: %w", err)
+	}
+
+	for _, payment := range body.Payments {
+		var nonce, txID string
+		switch data := payment.Data().(type) {
+		case *protocol.Iden3PaymentRailsV1:
+			nonce, txID = data.Nonce, data.PaymentData.TxID
+		case *protocol.Iden3PaymentRailsERC20V1:
+			nonce, txID = data.Nonce, data.PaymentData.TxID
+		default:
+			return fmt.Errorf("unsupported payment type '%s'", payment.Type())
+		}
+
+		bigNonce, ok := new(big.Int).SetString(nonce, 10)
+		if !ok {
+			return fmt.Errorf("invalid payment nonce '%s'", nonce)
+		}
+		status, err := p.VerifyPayment(ctx, *req.IssuerDID, bigNonce, &txID, req.UserDID)
+		if err != nil {
+			return err
+		}
+		if status == ports.BlockchainPaymentStatusFailed || status == ports.BlockchainPaymentStatusCancelled {
+			return fmt.Errorf("payment with nonce %s has not been completed", nonce)
+		}
+		log.Info(ctx, "payment received", "issuerDID", req.IssuerDID, "nonce", nonce, "tx", txID, "status", status)
 	}
-	return &basicMessage, nil
+	return nil
+}
+
+func findSchema(schemas []domain.Schema, credential protocol.CredentialInfo) *domain.Schema {
+	for i := range schemas {
+		if schemas[i].Type == credential.Type && (credential.Context == "" || schemas[i].ContextURL == credential.Context) {
+			return &schemas[i]
+		}
+	}
+	return nil
 }
 
 // GetSettings returns the current payment settings
@@ -289,12 +483,16 @@ func (p *payment) VerifyPayment(ctx context.Context, issuerDID w3c.DID, nonce *b
 		return ports.BlockchainPaymentStatusPending, err
 	}
 
-	status, err := p.verifyPaymentOnBlockchain(ctx, client, instance, signerAddress, nonce, txHash)
+	status, receipt, err := p.verifyPaymentOnBlockchain(ctx, client, instance, signerAddress, nonce, txHash)
 	if err != nil {
 		log.Error(ctx, "failed to verify payment on blockchain", "err", err, "txHash", txHash, "nonce", nonce)
 		return ports.BlockchainPaymentStatusPending, err
 	}
 
+	if receipt != nil {
+		p.recordPaymentReceipt(ctx, issuerDID, core.ChainID(setting.ChainID), paymentReq.ID, receipt)
+	}
+
 	paymentReqStatus := getPaymentRequestStatusFromBlockChainStatus(status)
 	if paymentReqStatus != paymentReq.Status && paymentReq.Status != domain.PaymentRequestStatusSuccess {
 		var paidNonce *big.Int
@@ -334,55 +532,70 @@ func (p *payment) verifyPaymentOnBlockchain(
 	signerAddress common.Address,
 	nonce *big.Int,
 	txID *string,
-) (ports.BlockchainPaymentStatus, error) {
+) (ports.BlockchainPaymentStatus, *types.Receipt, error) {
 	txIdProvided := txID != nil && *txID != ""
 
+	var receipt *types.Receipt
 	if txIdProvided {
-		status, err := handlePaymentTransaction(ctx, client, *txID)
+		var status ports.BlockchainPaymentStatus
+		var err error
+		status, receipt, err = handlePaymentTransaction(ctx, client, *txID)
 		if err != nil || status != ports.BlockchainPaymentStatusSuccess {
-			return status, err
+			return status, receipt, err
 		}
 	}
 
 	isPaid, err := contract.IsPaymentDone(&bind.CallOpts{Context: ctx}, signerAddress, nonce)
 	if err != nil {
-		return ports.BlockchainPaymentStatusPending, nil
+		return ports.BlockchainPaymentStatusPending, receipt, nil
 	}
 
 	if isPaid {
-		return ports.BlockchainPaymentStatusSuccess, nil
+		return ports.BlockchainPaymentStatusSuccess, receipt, nil
 	}
 
-	return ports.BlockchainPaymentStatusFailed, nil
+	return ports.BlockchainPaymentStatusFailed, receipt, nil
 }
 
 func handlePaymentTransaction(
 	ctx context.Context,
 	client *eth.Client,
 	txID string,
-) (ports.BlockchainPaymentStatus, error) {
+) (ports.BlockchainPaymentStatus, *types.Receipt, error) {
 	_, isPending, err := client.GetTransactionByID(ctx, txID)
 	if err != nil {
 		if err.Error() == "not found" {
-			return ports.BlockchainPaymentStatusCancelled, nil
+			return ports.BlockchainPaymentStatusCancelled, nil, nil
 		}
-		return ports.BlockchainPaymentStatusUnknown, err
+		return ports.BlockchainPaymentStatusUnknown, nil, err
 	}
 
 	if isPending {
-		return ports.BlockchainPaymentStatusPending, nil
+		return ports.BlockchainPaymentStatusPending, nil, nil
 	}
 
 	receipt, err := client.GetTransactionReceiptByID(ctx, txID)
 	if err != nil {
-		return ports.BlockchainPaymentStatusUnknown, err
+		return ports.BlockchainPaymentStatusUnknown, nil, err
 	}
 
 	if receipt.Status == 1 {
-		return ports.BlockchainPaymentStatusSuccess, nil
+		return ports.BlockchainPaymentStatusSuccess, receipt, nil
 	}
 
-	return ports.BlockchainPaymentStatusFailed, nil
+	return ports.BlockchainPaymentStatusFailed, receipt, nil
+}
+
+// recordPaymentReceipt stores the gas used and fee of the payment transaction in the transaction history.
+// The fee is paid by the holder, so it's left out of the issuer cost totals. Errors are only logged, they must not change the payment verification result.
+func (p *payment) recordPaymentReceipt(ctx context.Context, issuerDID w3c.DID, chainID core.ChainID, paymentRequestID uuid.UUID, receipt *types.Receipt) {
+	blockchain, networkID, err := core.NetworkByChainID(chainID)
+	if err != nil {
+		log.Error(ctx, "failed to get network from chainID", "err", err, "chainID", chainID)
+		return
+	}
+	network := fmt.Sprintf("%s:%s", blockchain, networkID)
+	_ = p.transactionHistory.RecordReceipt(ctx, issuerDID, network, domain.TransactionTypePayment, paymentRequestID.String(), receipt)
 }
 
 func (p *payment) paymentInfo(ctx context.Context, setting payments.ChainConfig, chainConfig *domain.PaymentOptionConfigItem, nonce *big.Int) (protocol.PaymentRequestInfoDataItem, error) {
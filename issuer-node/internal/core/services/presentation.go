@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/merklize"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/primitive"
+	presentationPkg "github.com/polygonid/sh-id-platform/pkg/credentials/presentation"
+)
+
+// ErrPresentationRevokedCredential is returned when a presentation is requested for a revoked credential
+var ErrPresentationRevokedCredential = errors.New("cannot present a revoked credential")
+
+type presentation struct {
+	claimService    ports.ClaimService
+	identityService ports.IdentityService
+	kms             kms.KMSType
+	loader          loader.DocumentLoader
+}
+
+// NewPresentation creates the service that builds selective disclosure presentations
+func NewPresentation(claimService ports.ClaimService, identityService ports.IdentityService, keyStore kms.KMSType, ld loader.DocumentLoader) ports.PresentationService {
+	return &presentation{
+		claimService:    claimService,
+		identityService: identityService,
+		kms:             keyStore,
+		loader:          ld,
+	}
+}
+
+// Create builds a presentation of the credential that only discloses the given fields.
+// The presentation is signed with the BJJ key of the current auth credential of the issuer.
+func (p *presentation) Create(ctx context.Context, issuerDID w3c.DID, credentialID uuid.UUID, fields []string) (*presentationPkg.Presentation, error) {
+	claim, err := p.claimService.GetByID(ctx, &issuerDID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	if claim.Revoked {
+		return nil, ErrPresentationRevokedCredential
+	}
+
+	credential, err := claim.GetVerifiableCredential()
+	if err != nil {
+		log.Error(ctx, "getting verifiable credential", "err", err, "id", credentialID)
+		return nil, err
+	}
+
+	authClaim, err := p.claimService.GetAuthClaim(ctx, &issuerDID)
+	if err != nil {
+		log.Error(ctx, "getting auth credential", "err", err, "did", issuerDID)
+		return nil, err
+	}
+	keyID, err := p.identityService.GetKeyIDFromAuthClaim(ctx, authClaim)
+	if err != nil {
+		log.Error(ctx, "getting auth credential key", "err", err, "did", issuerDID)
+		return nil, err
+	}
+	signer, err := primitive.NewBJJSigner(p.kms, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return presentationPkg.Build(ctx, &credential, fields, signer, authClaim.CoreClaim.Get(), merklize.WithDocumentLoader(p.loader))
+}
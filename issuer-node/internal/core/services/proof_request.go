@@ -0,0 +1,232 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrProofRequestInvalidScope is returned when the scope of a proof request is empty or malformed
+	ErrProofRequestInvalidScope = errors.New("invalid proof request scope")
+	// ErrProofRequestNotPending is returned when the proof request has already been answered
+	ErrProofRequestNotPending = errors.New("the proof request has already been answered")
+	// ErrProofRequestVerification is returned when the response to the proof request can't be verified
+	ErrProofRequestVerification = errors.New("the proof request response could not be verified")
+)
+
+const proofRequestDefaultReason = "proof request"
+
+type proofRequest struct {
+	repository     ports.ProofRequestRepository
+	connService    ports.ConnectionService
+	messageService ports.MessageService
+	verifier       ports.AuthorizationResponseVerifier
+	serverURL      string
+}
+
+// NewProofRequest creates the service to send proof requests to existing connections
+func NewProofRequest(repository ports.ProofRequestRepository, connService ports.ConnectionService, messageService ports.MessageService, verifier ports.AuthorizationResponseVerifier, serverURL string) ports.ProofRequestService {
+	return &proofRequest{
+		repository:     repository,
+		connService:    connService,
+		messageService: messageService,
+		verifier:       verifier,
+		serverURL:      serverURL,
+	}
+}
+
+// Create builds an authorization request with the given scope for the user of the connection and sends it
+// through the outbox of the message service, which delivers it to the user DID document service endpoint.
+// The proof request is marked as failed if it can't be stored in the outbox.
+func (p *proofRequest) Create(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID, reason string, scope []protocol.ZeroKnowledgeProofRequest) (*domain.ProofRequest, error) {
+	if err := validateProofRequestScope(scope); err != nil {
+		return nil, err
+	}
+	conn, err := p.connService.GetByIDAndIssuerID(ctx, connID, issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	if reason == "" {
+		reason = proofRequestDefaultReason
+	}
+
+	id := uuid.New()
+	msgID := uuid.NewString()
+	authRequest := protocol.AuthorizationRequestMessage{
+		ID:       msgID,
+		ThreadID: msgID,
+		Typ:      packers.MediaTypePlainMessage,
+		Type:     protocol.AuthorizationRequestMessageType,
+		From:     issuerDID.String(),
+		To:       conn.UserDID.String(),
+		Body: protocol.AuthorizationRequestMessageBody{
+			CallbackURL: fmt.Sprintf(ports.ProofRequestCallbackURL, p.serverURL, id),
+			Reason:      reason,
+			Scope:       scope,
+		},
+	}
+	request, err := json.Marshal(authRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	pr := &domain.ProofRequest{
+		ID:           id,
+		ConnectionID: conn.ID,
+		IssuerDID:    issuerDID.String(),
+		UserDID:      conn.UserDID.String(),
+		ThreadID:     authRequest.ThreadID,
+		Reason:       reason,
+		Request:      request,
+		Status:       domain.ProofRequestStatusPending,
+	}
+	if err := p.repository.Save(ctx, pr); err != nil {
+		log.Error(ctx, "saving proof request", "err", err, "connID", connID)
+		return nil, err
+	}
+	if err := p.messageService.Send(ctx, issuerDID, conn.UserDID, request); err != nil {
+		log.Error(ctx, "sending proof request", "err", err, "connID", connID)
+		// the user never receives a request that is not in the outbox, so it can't stay pending
+		pr.Status = domain.ProofRequestStatusFailed
+		pr.Error = common.ToPointer(err.Error())
+		if err := p.repository.Answer(ctx, pr); err != nil {
+			log.Error(ctx, "marking proof request as failed", "err", err, "proofRequestID", pr.ID)
+		}
+		return nil, err
+	}
+	return pr, nil
+}
+
+// Callback verifies the user response to a pending proof request and stores the result.
+// The callback is public, so responses that can't be verified, or come from a DID other than the connection one,
+// only record the error and leave the proof request pending for the user response.
+func (p *proofRequest) Callback(ctx context.Context, id uuid.UUID, token string) (*domain.ProofRequest, error) {
+	pr, err := p.repository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if pr.Status != domain.ProofRequestStatusPending {
+		return nil, ErrProofRequestNotPending
+	}
+
+	var authRequest protocol.AuthorizationRequestMessage
+	if err := json.Unmarshal(pr.Request, &authRequest); err != nil {
+		return nil, err
+	}
+	arm, err := p.verifier.FullVerify(ctx, token, authRequest, pubsignals.WithAcceptedStateTransitionDelay(transitionDelay))
+	if err == nil && arm.From != pr.UserDID {
+		err = fmt.Errorf("response from %s, expected %s", arm.From, pr.UserDID)
+	}
+	if err != nil {
+		log.Warn(ctx, "proof request response verification failed", "err", err, "proofRequestID", id)
+		pr.Error = common.ToPointer(err.Error())
+		if err := p.answer(ctx, pr); err != nil {
+			return nil, err
+		}
+		return pr, ErrProofRequestVerification
+	}
+
+	response, err := json.Marshal(arm.Body.Scope)
+	if err != nil {
+		return nil, err
+	}
+	pr.Status = domain.ProofRequestStatusVerified
+	pr.Response = response
+	pr.VerifiedAt = common.ToPointer(time.Now())
+	pr.Error = nil
+	if err := p.answer(ctx, pr); err != nil {
+		return nil, err
+	}
+	p.recordResponse(ctx, pr, arm)
+	return pr, nil
+}
+
+// answer stores the result of the response while the proof request is still pending
+func (p *proofRequest) answer(ctx context.Context, pr *domain.ProofRequest) error {
+	err := p.repository.Answer(ctx, pr)
+	if errors.Is(err, repositories.ProofRequestNotPendingErr) {
+		return ErrProofRequestNotPending
+	}
+	if err != nil {
+		log.Error(ctx, "saving proof request response", "err", err, "proofRequestID", pr.ID)
+		return err
+	}
+	return nil
+}
+
+// GetByConnection returns the proof requests sent to the connection, newest first
+func (p *proofRequest) GetByConnection(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]domain.ProofRequest, error) {
+	if _, err := p.connService.GetByIDAndIssuerID(ctx, connID, issuerDID); err != nil {
+		return nil, err
+	}
+	return p.repository.GetByConnection(ctx, issuerDID, connID)
+}
+
+// recordResponse adds the response to the conversation with the user, acknowledging the request
+func (p *proofRequest) recordResponse(ctx context.Context, pr *domain.ProofRequest, arm *protocol.AuthorizationResponseMessage) {
+	msgID, err := uuid.Parse(arm.ID)
+	if err != nil {
+		log.Warn(ctx, "proof request response id is not an uuid, not added to the conversation", "proofRequestID", pr.ID)
+		return
+	}
+	issuerDID, err := w3c.ParseDID(pr.IssuerDID)
+	if err != nil {
+		return
+	}
+	userDID, err := w3c.ParseDID(pr.UserDID)
+	if err != nil {
+		return
+	}
+	body, err := json.Marshal(arm.Body)
+	if err != nil {
+		return
+	}
+	threadID := arm.ThreadID
+	if threadID == "" {
+		threadID = pr.ThreadID
+	}
+	_ = p.messageService.RecordInbound(ctx, &ports.AgentRequest{
+		ID:        msgID,
+		ThreadID:  threadID,
+		Typ:       arm.Typ,
+		Type:      arm.Type,
+		Body:      body,
+		IssuerDID: issuerDID,
+		UserDID:   userDID,
+	})
+}
+
+func validateProofRequestScope(scope []protocol.ZeroKnowledgeProofRequest) error {
+	if len(scope) == 0 {
+		return fmt.Errorf("%w: at least one scope is required", ErrProofRequestInvalidScope)
+	}
+	ids := make(map[uint32]bool, len(scope))
+	for _, s := range scope {
+		if s.CircuitID == "" {
+			return fmt.Errorf("%w: scope %d without circuitId", ErrProofRequestInvalidScope, s.ID)
+		}
+		if len(s.Query) == 0 {
+			return fmt.Errorf("%w: scope %d without query", ErrProofRequestInvalidScope, s.ID)
+		}
+		if ids[s.ID] {
+			return fmt.Errorf("%w: duplicated scope id %d", ErrProofRequestInvalidScope, s.ID)
+		}
+		ids[s.ID] = true
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-auth/v2/pubsignals"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/core/services"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// proofRequestRepository is an in memory ProofRequestRepository
+type proofRequestRepository map[uuid.UUID]domain.ProofRequest
+
+func (r proofRequestRepository) Save(_ context.Context, proofRequest *domain.ProofRequest) error {
+	r[proofRequest.ID] = *proofRequest
+	return nil
+}
+
+func (r proofRequestRepository) Answer(_ context.Context, proofRequest *domain.ProofRequest) error {
+	if r[proofRequest.ID].Status != domain.ProofRequestStatusPending {
+		return repositories.ProofRequestNotPendingErr
+	}
+	r[proofRequest.ID] = *proofRequest
+	return nil
+}
+
+func (r proofRequestRepository) GetByID(_ context.Context, id uuid.UUID) (*domain.ProofRequest, error) {
+	proofRequest, ok := r[id]
+	if !ok {
+		return nil, repositories.ProofRequestNotFoundErr
+	}
+	return &proofRequest, nil
+}
+
+func (r proofRequestRepository) GetByConnection(_ context.Context, _ w3c.DID, connID uuid.UUID) ([]domain.ProofRequest, error) {
+	var proofRequests []domain.ProofRequest
+	for _, proofRequest := range r {
+		if proofRequest.ConnectionID == connID {
+			proofRequests = append(proofRequests, proofRequest)
+		}
+	}
+	return proofRequests, nil
+}
+
+// failingMessageService can't store any message in the outbox
+type failingMessageService struct {
+	ports.MessageService
+}
+
+func (failingMessageService) Send(context.Context, w3c.DID, w3c.DID, json.RawMessage) error {
+	return errors.New("outbox unavailable")
+}
+
+// authVerifier accepts the tokens equal to the user DID
+type authVerifier struct{}
+
+func (authVerifier) FullVerify(_ context.Context, token string, request protocol.AuthorizationRequestMessage, _ ...pubsignals.VerifyOpt) (*protocol.AuthorizationResponseMessage, error) {
+	if token == "invalid" {
+		return nil, errors.New("invalid proof")
+	}
+	return &protocol.AuthorizationResponseMessage{
+		ID:       uuid.NewString(),
+		ThreadID: request.ThreadID,
+		Type:     protocol.AuthorizationResponseMessageType,
+		From:     token,
+		To:       request.From,
+		Body: protocol.AuthorizationMessageResponseBody{
+			Scope: []protocol.ZeroKnowledgeProofResponse{{ID: request.Body.Scope[0].ID, CircuitID: request.Body.Scope[0].CircuitID}},
+		},
+	}, nil
+}
+
+func TestProofRequest(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR")
+	require.NoError(t, err)
+	userDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi")
+	require.NoError(t, err)
+	conn := &domain.Connection{ID: uuid.New(), IssuerDID: *issuerDID, UserDID: *userDID, UserDoc: json.RawMessage(`{"id":"` + userDID.String() + `"}`)}
+	connections := &messageConnections{conn: conn}
+	messages := &messageRepository{}
+	gateway := &messageGateway{}
+	messageService := services.NewMessage(messages, connections, gateway, config.Messages{MaxAttempts: 1})
+	repository := proofRequestRepository{}
+	proofRequestService := services.NewProofRequest(repository, connections, messageService, authVerifier{}, "https://issuer.example.com")
+
+	scope := []protocol.ZeroKnowledgeProofRequest{{
+		ID:        1,
+		CircuitID: "credentialAtomicQuerySigV2",
+		Query:     map[string]interface{}{"type": "KYCAgeCredential"},
+	}}
+
+	t.Run("should refuse an empty scope", func(t *testing.T) {
+		_, err := proofRequestService.Create(ctx, *issuerDID, conn.ID, "", nil)
+		assert.ErrorIs(t, err, services.ErrProofRequestInvalidScope)
+	})
+
+	t.Run("should refuse an unknown connection", func(t *testing.T) {
+		_, err := proofRequestService.Create(ctx, *issuerDID, uuid.New(), "", scope)
+		assert.ErrorIs(t, err, services.ErrConnectionDoesNotExist)
+	})
+
+	t.Run("should send the proof request and verify the response", func(t *testing.T) {
+		proofRequest, err := proofRequestService.Create(ctx, *issuerDID, conn.ID, "age check", scope)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProofRequestStatusPending, proofRequest.Status)
+
+		require.Len(t, gateway.delivered, 1)
+		var authRequest protocol.AuthorizationRequestMessage
+		require.NoError(t, json.Unmarshal(gateway.delivered[0], &authRequest))
+		assert.Equal(t, userDID.String(), authRequest.To)
+		assert.Equal(t, "https://issuer.example.com/v2/proof-requests/callback?id="+proofRequest.ID.String(), authRequest.Body.CallbackURL)
+		assert.Equal(t, scope, authRequest.Body.Scope)
+
+		verified, err := proofRequestService.Callback(ctx, proofRequest.ID, userDID.String())
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProofRequestStatusVerified, verified.Status)
+		assert.JSONEq(t, `[{"id":1,"circuitId":"credentialAtomicQuerySigV2","proof":null,"pub_signals":null}]`, string(verified.Response))
+		assert.Equal(t, domain.MessageStatusAcknowledged, messages.messages[0].Status)
+
+		_, err = proofRequestService.Callback(ctx, proofRequest.ID, userDID.String())
+		assert.ErrorIs(t, err, services.ErrProofRequestNotPending)
+	})
+
+	t.Run("should keep the proof request pending when the response can't be verified", func(t *testing.T) {
+		for _, token := range []string{"invalid", "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"} {
+			proofRequest, err := proofRequestService.Create(ctx, *issuerDID, conn.ID, "", scope)
+			require.NoError(t, err)
+			failed, err := proofRequestService.Callback(ctx, proofRequest.ID, token)
+			assert.ErrorIs(t, err, services.ErrProofRequestVerification)
+			assert.Equal(t, domain.ProofRequestStatusPending, failed.Status)
+			assert.NotNil(t, failed.Error)
+
+			verified, err := proofRequestService.Callback(ctx, proofRequest.ID, userDID.String())
+			require.NoError(t, err, "the user can still answer the proof request")
+			assert.Equal(t, domain.ProofRequestStatusVerified, verified.Status)
+			assert.Nil(t, verified.Error)
+		}
+
+		proofRequests, err := proofRequestService.GetByConnection(ctx, *issuerDID, conn.ID)
+		require.NoError(t, err)
+		assert.Len(t, proofRequests, 3)
+	})
+	t.Run("should mark the proof request as failed when it can't be sent", func(t *testing.T) {
+		failing := proofRequestRepository{}
+		service := services.NewProofRequest(failing, connections, failingMessageService{}, authVerifier{}, "https://issuer.example.com")
+		_, err := service.Create(ctx, *issuerDID, conn.ID, "", scope)
+		require.Error(t, err)
+
+		require.Len(t, failing, 1)
+		for _, proofRequest := range failing {
+			assert.Equal(t, domain.ProofRequestStatusFailed, proofRequest.Status)
+			assert.Equal(t, "outbox unavailable", *proofRequest.Error)
+			_, err := service.Callback(ctx, proofRequest.ID, userDID.String())
+			assert.ErrorIs(t, err, services.ErrProofRequestNotPending)
+		}
+	})
+}
@@ -8,9 +8,11 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
 	"github.com/polygonid/sh-id-platform/internal/jsonschema"
 	"github.com/polygonid/sh-id-platform/internal/loader"
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -21,11 +23,16 @@ type schema struct {
 	repo                 ports.SchemaRepository
 	loader               loader.DocumentLoader
 	displayMethodService ports.DisplayMethodService
+	snapshots            ports.SchemaSnapshotRepository
+	remote               loader.Factory
+	storage              *db.Storage
+	audit                ports.AuditService
 }
 
-// NewSchema is the schema service constructor
-func NewSchema(repo ports.SchemaRepository, loader loader.DocumentLoader, displayMethodService ports.DisplayMethodService) *schema {
-	return &schema{repo: repo, loader: loader, displayMethodService: displayMethodService}
+// NewSchema is the schema service constructor. The JSON schema and JSON-LD context of the imported schemas are
+// fetched with the loaders of remote and kept as snapshots.
+func NewSchema(repo ports.SchemaRepository, loader loader.DocumentLoader, displayMethodService ports.DisplayMethodService, snapshots ports.SchemaSnapshotRepository, remote loader.Factory, storage *db.Storage, audit ports.AuditService) *schema {
+	return &schema{repo: repo, loader: loader, displayMethodService: displayMethodService, snapshots: snapshots, remote: remote, storage: storage, audit: audit}
 }
 
 // GetByID returns a domain.Schema by ID
@@ -43,6 +50,74 @@ func (s *schema) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (
 		log.Error(ctx, "fixing schema context", "err", err, "schema", schemaID)
 		return nil, fmt.Errorf("fixing schema context: %w", err)
 	}
+	s.loadSnapshots(ctx, schema)
+	return schema, nil
+}
+
+// loadSnapshots sets the snapshots of the documents of the schema. Schemas imported before snapshots existed get
+// them now, from the current remote content.
+func (s *schema) loadSnapshots(ctx context.Context, schema *domain.Schema) {
+	schema.Snapshots = make([]domain.SchemaSnapshot, 0, 2)
+	for _, url := range []string{schema.URL, schema.ContextURL} {
+		snapshot, err := s.snapshot(ctx, url)
+		if err != nil {
+			log.Warn(ctx, "taking schema snapshot", "err", err, "url", url, "schema", schema.ID)
+			continue
+		}
+		schema.Snapshots = append(schema.Snapshots, *snapshot)
+	}
+}
+
+// snapshot returns the snapshot of the document at url. It is taken when there is none.
+func (s *schema) snapshot(ctx context.Context, url string) (*domain.SchemaSnapshot, error) {
+	snapshot, err := s.snapshots.GetByURL(ctx, url)
+	if err == nil || !errors.Is(err, repositories.SchemaSnapshotNotFoundErr) {
+		return snapshot, err
+	}
+	content, err := s.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.snapshots.Save(ctx, domain.NewSchemaSnapshot(url, content)); err != nil {
+		return nil, err
+	}
+	// another import may have taken it first
+	return s.snapshots.GetByURL(ctx, url)
+}
+
+// fetch returns the current remote content of the document at url
+func (s *schema) fetch(ctx context.Context, url string) ([]byte, error) {
+	remote := s.remote(url)
+	if remote == nil {
+		return nil, fmt.Errorf("unsupported schema url %s", url)
+	}
+	content, _, err := remote.Load(ctx)
+	return content, err
+}
+
+// CheckDrift fetches the remote documents of the schema and records whether they changed since their snapshots
+func (s *schema) CheckDrift(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.Schema, error) {
+	schema, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	checkedAt := time.Now()
+	for i := range schema.Snapshots {
+		snapshot := &schema.Snapshots[i]
+		content, err := s.fetch(ctx, snapshot.URL)
+		if err != nil {
+			log.Error(ctx, "loading remote schema document", "err", err, "url", snapshot.URL)
+			return nil, ErrLoadingSchema
+		}
+		remoteDigest := domain.SchemaSnapshotDigest(content)
+		if err := s.snapshots.UpdateRemoteDigest(ctx, snapshot.URL, remoteDigest, checkedAt); err != nil {
+			return nil, err
+		}
+		snapshot.RemoteDigest, snapshot.CheckedAt = &remoteDigest, &checkedAt
+		if snapshot.Drifted() {
+			log.Warn(ctx, "schema document changed since it was imported", "url", snapshot.URL, "schema", schema.ID)
+		}
+	}
 	return schema, nil
 }
 
@@ -76,11 +151,16 @@ func (s *schema) GetAll(ctx context.Context, issuerDID w3c.DID, query *string) (
 
 // ImportSchema process an schema url and imports into the system
 func (s *schema) ImportSchema(ctx context.Context, did w3c.DID, req *ports.ImportSchemaRequest) (*domain.Schema, error) {
-	remoteSchema, err := jsonschema.Load(ctx, req.URL, s.loader)
+	schemaSnapshot, err := s.snapshot(ctx, req.URL)
 	if err != nil {
 		log.Error(ctx, "loading jsonschema", "err", err, "jsonschema", req.URL)
 		return nil, ErrLoadingSchema
 	}
+	remoteSchema, err := jsonschema.Parse(schemaSnapshot.Content)
+	if err != nil {
+		log.Error(ctx, "parsing jsonschema", "err", err, "jsonschema", req.URL)
+		return nil, ErrProcessSchema
+	}
 	attributeNames, err := remoteSchema.Attributes()
 	if err != nil {
 		log.Error(ctx, "processing jsonschema", "err", err, "jsonschema", req.URL)
@@ -97,6 +177,11 @@ func (s *schema) ImportSchema(ctx context.Context, did w3c.DID, req *ports.Impor
 		log.Error(ctx, "getting jsonld context", "err", err, "jsonschema", req.URL)
 		return nil, ErrProcessSchema
 	}
+	contextSnapshot, err := s.snapshot(ctx, contextUrl)
+	if err != nil {
+		log.Error(ctx, "loading jsonld context", "err", err, "context", contextUrl)
+		return nil, ErrLoadingSchema
+	}
 
 	if req.DisplayMethodID != nil {
 		_, err := s.displayMethodService.GetByID(ctx, did, *req.DisplayMethodID)
@@ -119,9 +204,10 @@ func (s *schema) ImportSchema(ctx context.Context, did w3c.DID, req *ports.Impor
 		Words:           attributeNames.SchemaAttrs(),
 		DisplayMethodID: req.DisplayMethodID,
 		CreatedAt:       time.Now(),
+		Snapshots:       []domain.SchemaSnapshot{*schemaSnapshot, *contextSnapshot},
 	}
 
-	if err := s.repo.Save(ctx, schema); err != nil {
+	if err := s.save(ctx, domain.AuditOperationCreate, nil, schema); err != nil {
 		log.Error(ctx, "saving imported schema", "err", err)
 		return nil, err
 	}
@@ -141,6 +227,36 @@ func (s *schema) Update(ctx context.Context, schema *domain.Schema) error {
 	if err != nil {
 		return err
 	}
+	before := *schemaInDatabase
 	schemaInDatabase.DisplayMethodID = schema.DisplayMethodID
-	return s.repo.Save(ctx, schemaInDatabase)
+	return s.save(ctx, domain.AuditOperationUpdate, &before, schemaInDatabase)
+}
+
+// save stores the schema and records the operation in the audit log of the issuer
+func (s *schema) save(ctx context.Context, operation domain.AuditOperation, before, schema *domain.Schema) error {
+	var auditBefore any
+	if before != nil {
+		auditBefore = schemaAudit(before)
+	}
+	change, err := domain.NewAuditChange(domain.AuditEntitySchema, operation, schema.ID.String(), auditBefore, schemaAudit(schema))
+	if err != nil {
+		return err
+	}
+	return s.storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+		if err := s.repo.Save(ctx, tx, schema); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, schema.IssuerDID, change)
+	})
+}
+
+// schemaAudit returns a copy of the schema without the content of its snapshots, their digests are enough
+func schemaAudit(schema *domain.Schema) *domain.Schema {
+	audited := *schema
+	audited.Snapshots = make([]domain.SchemaSnapshot, len(schema.Snapshots))
+	for i := range schema.Snapshots {
+		audited.Snapshots[i] = schema.Snapshots[i]
+		audited.Snapshots[i].Content = nil
+	}
+	return &audited
 }
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+type schemaBuilder struct {
+	repository ports.SchemaDocumentRepository
+	pinner     ports.IPFSPinner
+	serverURL  string
+}
+
+// NewSchemaBuilder creates the service that builds the schemas of new credential types.
+// The schemas are pinned with pinner when it is not nil, otherwise the node serves them at serverURL.
+func NewSchemaBuilder(repository ports.SchemaDocumentRepository, pinner ports.IPFSPinner, serverURL string) ports.SchemaBuilderService {
+	return &schemaBuilder{
+		repository: repository,
+		pinner:     pinner,
+		serverURL:  serverURL,
+	}
+}
+
+// Build builds and publishes the JSON schema and JSON-LD context of the credential type of the definition.
+// The terms of the context are defined in a vocabulary of its own, urn:uuid:<id>#, so they never collide with
+// the ones of other schemas.
+func (s *schemaBuilder) Build(ctx context.Context, issuerDID w3c.DID, def jsonschema.Definition) (*domain.SchemaDocument, error) {
+	if err := def.Validate(); err != nil {
+		return nil, err
+	}
+	doc := &domain.SchemaDocument{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		Type:      def.Type,
+		Version:   def.Version,
+		CreatedAt: time.Now(),
+	}
+	if def.Title != "" {
+		doc.Title = &def.Title
+	}
+	if def.Description != "" {
+		doc.Description = &def.Description
+	}
+
+	jsonLdContext, err := jsonschema.NewJSONLdContext(def, fmt.Sprintf("urn:uuid:%s#", doc.ID))
+	if err != nil {
+		return nil, err
+	}
+	doc.JSONLdContext = jsonLdContext
+
+	if s.pinner != nil {
+		// IPFS urls are the hash of the content, so the schema can't have its own url in $metadata
+		cid, err := s.pinner.Pin(ctx, jsonLdContext)
+		if err != nil {
+			return nil, fmt.Errorf("pinning json-ld context: %w", err)
+		}
+		doc.ContextURL = "ipfs://" + cid
+		if doc.JSONSchema, err = jsonschema.NewJSONSchema(def, "", doc.ContextURL).Bytes(); err != nil {
+			return nil, err
+		}
+		if cid, err = s.pinner.Pin(ctx, doc.JSONSchema); err != nil {
+			return nil, fmt.Errorf("pinning json schema: %w", err)
+		}
+		doc.URL = "ipfs://" + cid
+	} else {
+		doc.URL = fmt.Sprintf(ports.SchemaDocumentURL, s.serverURL, doc.ID)
+		doc.ContextURL = fmt.Sprintf(ports.SchemaDocumentContextURL, s.serverURL, doc.ID)
+		if doc.JSONSchema, err = jsonschema.NewJSONSchema(def, doc.URL, doc.ContextURL).Bytes(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repository.Save(ctx, doc); err != nil {
+		log.Error(ctx, "saving schema document", "err", err, "type", def.Type)
+		return nil, err
+	}
+	return doc, nil
+}
+
+// GetByID returns the schema document
+func (s *schemaBuilder) GetByID(ctx context.Context, id uuid.UUID) (*domain.SchemaDocument, error) {
+	return s.repository.GetByID(ctx, id)
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// schemaDocuments keeps the schema documents in memory
+type schemaDocuments map[uuid.UUID]*domain.SchemaDocument
+
+func (r schemaDocuments) Save(_ context.Context, doc *domain.SchemaDocument) error {
+	r[doc.ID] = doc
+	return nil
+}
+
+func (r schemaDocuments) GetByID(_ context.Context, id uuid.UUID) (*domain.SchemaDocument, error) {
+	doc, ok := r[id]
+	if !ok {
+		return nil, repositories.SchemaDocumentNotFoundErr
+	}
+	return doc, nil
+}
+
+// ipfsNode keeps the pinned documents by a fake CID, the hash of their content
+type ipfsNode map[string][]byte
+
+func (n ipfsNode) Pin(_ context.Context, content []byte) (string, error) {
+	hash := sha256.Sum256(content)
+	cid := hex.EncodeToString(hash[:])
+	n[cid] = content
+	return cid, nil
+}
+
+func TestSchemaBuilder_Build(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu")
+	require.NoError(t, err)
+	def := jsonschema.Definition{
+		Type:    "HackathonCreator",
+		Version: "1.0.0",
+		Title:   "Hackathon Creator",
+		Attributes: []jsonschema.DefinitionAttribute{
+			{Name: "project", Type: jsonschema.AttributeTypeString, Required: true},
+			{Name: "teamSize", Type: jsonschema.AttributeTypeInteger},
+		},
+	}
+
+	metadataURIs := func(t *testing.T, doc *domain.SchemaDocument) map[string]interface{} {
+		t.Helper()
+		var schema map[string]interface{}
+		require.NoError(t, json.Unmarshal(doc.JSONSchema, &schema))
+		return schema["$metadata"].(map[string]interface{})["uris"].(map[string]interface{})
+	}
+
+	t.Run("should serve the documents from the node", func(t *testing.T) {
+		repository := schemaDocuments{}
+		doc, err := NewSchemaBuilder(repository, nil, "https://issuer.example.com").Build(ctx, *issuerDID, def)
+		require.NoError(t, err)
+		assert.Equal(t, "https://issuer.example.com/v2/schemas/"+doc.ID.String()+"/schema.json", doc.URL)
+		assert.Equal(t, "https://issuer.example.com/v2/schemas/"+doc.ID.String()+"/context.jsonld", doc.ContextURL)
+		assert.Equal(t, map[string]interface{}{"jsonLdContext": doc.ContextURL, "jsonSchema": doc.URL}, metadataURIs(t, doc))
+		assert.Contains(t, string(doc.JSONLdContext), "urn:uuid:"+doc.ID.String()+"#HackathonCreator")
+		assert.Equal(t, "Hackathon Creator", *doc.Title)
+		assert.Nil(t, doc.Description)
+		assert.Equal(t, doc, repository[doc.ID])
+	})
+
+	t.Run("should pin the documents to ipfs", func(t *testing.T) {
+		node := ipfsNode{}
+		doc, err := NewSchemaBuilder(schemaDocuments{}, node, "https://issuer.example.com").Build(ctx, *issuerDID, def)
+		require.NoError(t, err)
+		assert.Len(t, node, 2)
+		assert.Equal(t, doc.JSONSchema, node[doc.URL[len("ipfs://"):]])
+		assert.Equal(t, doc.JSONLdContext, node[doc.ContextURL[len("ipfs://"):]])
+		assert.Equal(t, map[string]interface{}{"jsonLdContext": doc.ContextURL}, metadataURIs(t, doc))
+	})
+
+	t.Run("should reject invalid definitions", func(t *testing.T) {
+		repository := schemaDocuments{}
+		_, err := NewSchemaBuilder(repository, nil, "https://issuer.example.com").Build(ctx, *issuerDID, jsonschema.Definition{Type: "HackathonCreator", Version: "1.0.0"})
+		assert.ErrorIs(t, err, jsonschema.ErrInvalidDefinition)
+		assert.Empty(t, repository)
+	})
+}
@@ -0,0 +1,258 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/jackc/pgtype"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrSchemaFamilyNotFound is returned when the schema family does not exist
+	ErrSchemaFamilyNotFound = errors.New("schema family not found")
+	// ErrSchemaMigrationNotFound is returned when the schema migration does not exist
+	ErrSchemaMigrationNotFound = errors.New("schema migration not found")
+)
+
+// schemaMigrationBatch is the max number of migrations processed on each run of the migration job
+const schemaMigrationBatch = 10
+
+type schemaFamily struct {
+	families     ports.SchemaFamilyRepository
+	migrations   ports.SchemaMigrationRepository
+	schemas      ports.SchemaRepository
+	claimService ports.ClaimService
+	loader       loader.DocumentLoader
+}
+
+// NewSchemaFamily creates the service that versions the imported schemas and migrates the credentials of a
+// version to a newer one
+func NewSchemaFamily(families ports.SchemaFamilyRepository, migrations ports.SchemaMigrationRepository, schemas ports.SchemaRepository, claimService ports.ClaimService, loader loader.DocumentLoader) ports.SchemaFamilyService {
+	return &schemaFamily{
+		families:     families,
+		migrations:   migrations,
+		schemas:      schemas,
+		claimService: claimService,
+		loader:       loader,
+	}
+}
+
+// Create creates a family of the issuer without versions
+func (s *schemaFamily) Create(ctx context.Context, issuerDID w3c.DID, name string) (*domain.SchemaFamily, error) {
+	family := &domain.SchemaFamily{
+		ID:        uuid.New(),
+		IssuerDID: issuerDID,
+		Name:      name,
+		Versions:  []domain.SchemaFamilyVersion{},
+		CreatedAt: time.Now(),
+	}
+	if err := s.families.Save(ctx, family); err != nil {
+		log.Error(ctx, "saving schema family", "err", err, "name", name)
+		return nil, err
+	}
+	return family, nil
+}
+
+// GetByID returns the family with its versions
+func (s *schemaFamily) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaFamily, error) {
+	family, err := s.families.GetByID(ctx, issuerDID, id)
+	if errors.Is(err, repositories.SchemaFamilyNotFoundErr) {
+		return nil, ErrSchemaFamilyNotFound
+	}
+	return family, err
+}
+
+// GetAll returns the families of the issuer
+func (s *schemaFamily) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.SchemaFamily, error) {
+	return s.families.GetAll(ctx, issuerDID)
+}
+
+// AddVersion adds the schema as the newest version of the family. mapping transforms the credential subjects
+// of the current newest version into subjects of the schema.
+func (s *schemaFamily) AddVersion(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, schemaID uuid.UUID, mapping domain.SchemaMapping) (*domain.SchemaFamily, error) {
+	if err := mapping.Validate(); err != nil {
+		return nil, err
+	}
+	family, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.schemas.GetByID(ctx, issuerDID, schemaID); err != nil {
+		if errors.Is(err, repositories.ErrSchemaDoesNotExist) {
+			return nil, ErrSchemaNotFound
+		}
+		return nil, err
+	}
+	if len(family.Versions) == 0 && len(mapping) > 0 {
+		return nil, fmt.Errorf("%w: the first version of a family has no previous version to map", domain.ErrInvalidSchemaMapping)
+	}
+	if mapping == nil {
+		mapping = domain.SchemaMapping{}
+	}
+
+	version := domain.SchemaFamilyVersion{SchemaID: schemaID, Mapping: mapping, CreatedAt: time.Now()}
+	if err := s.families.AddVersion(ctx, family.ID, &version); err != nil {
+		log.Error(ctx, "adding schema family version", "err", err, "family", family.ID, "schema", schemaID)
+		return nil, err
+	}
+	family.Versions = append(family.Versions, version)
+	return family, nil
+}
+
+// CreateMigration schedules the migration of the active credentials of a version of the family to a newer one.
+// The migration job processes it later.
+func (s *schemaFamily) CreateMigration(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, fromSchemaID uuid.UUID, toSchemaID uuid.UUID, dryRun bool) (*domain.SchemaMigration, error) {
+	family, err := s.GetByID(ctx, issuerDID, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := family.Mapping(fromSchemaID, toSchemaID); err != nil {
+		return nil, err
+	}
+	migration := domain.NewSchemaMigration(issuerDID, family.ID, fromSchemaID, toSchemaID, dryRun)
+	if err := s.migrations.Save(ctx, migration); err != nil {
+		log.Error(ctx, "saving schema migration", "err", err, "family", family.ID)
+		return nil, err
+	}
+	return migration, nil
+}
+
+// GetMigration returns the migration with its report
+func (s *schemaFamily) GetMigration(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaMigration, error) {
+	migration, err := s.migrations.GetByID(ctx, issuerDID, id)
+	if errors.Is(err, repositories.SchemaMigrationNotFoundErr) {
+		return nil, ErrSchemaMigrationNotFound
+	}
+	return migration, err
+}
+
+// RunPending processes the pending migrations. It returns the number of migrations processed.
+func (s *schemaFamily) RunPending(ctx context.Context) (int, error) {
+	migrations, err := s.migrations.LeasePending(ctx, schemaMigrationBatch)
+	if err != nil {
+		log.Error(ctx, "getting pending schema migrations", "err", err)
+		return 0, err
+	}
+	for i := range migrations {
+		migration := &migrations[i]
+		if err := s.run(ctx, migration); err != nil {
+			log.Error(ctx, "running schema migration", "err", err, "migration", migration.ID)
+			migration.Status, migration.Error = domain.SchemaMigrationFailed, common.ToPointer(err.Error())
+		} else {
+			migration.Status = domain.SchemaMigrationDone
+		}
+		migration.ModifiedAt = time.Now()
+		if err := s.migrations.Save(ctx, migration); err != nil {
+			log.Error(ctx, "saving schema migration", "err", err, "migration", migration.ID)
+		}
+	}
+	return len(migrations), nil
+}
+
+// run migrates every active credential of the old schema. A credential whose subject can't be mapped or doesn't
+// pass the validation of the new schema is reported as a failure, and it stays active.
+func (s *schemaFamily) run(ctx context.Context, migration *domain.SchemaMigration) error {
+	family, err := s.families.GetByID(ctx, migration.IssuerDID, migration.FamilyID)
+	if err != nil {
+		return err
+	}
+	mapping, err := family.Mapping(migration.FromSchemaID, migration.ToSchemaID)
+	if err != nil {
+		return err
+	}
+	from, err := s.schemas.GetByID(ctx, migration.IssuerDID, migration.FromSchemaID)
+	if err != nil {
+		return err
+	}
+	to, err := s.schemas.GetByID(ctx, migration.IssuerDID, migration.ToSchemaID)
+	if err != nil {
+		return err
+	}
+	fromHash, err := from.Hash.MarshalText()
+	if err != nil {
+		return err
+	}
+
+	claims, _, err := s.claimService.GetAll(ctx, migration.IssuerDID, &ports.ClaimsFilter{SchemaHash: string(fromHash), Revoked: common.ToPointer(false)})
+	if err != nil && !errors.Is(err, ErrCredentialNotFound) {
+		return err
+	}
+
+	now := time.Now()
+	migration.Total, migration.Migrated, migration.Failures = 0, 0, []domain.SchemaMigrationFailure{}
+	for _, claim := range claims {
+		// the hash is the same for the versions with the same type and context
+		if claim.SchemaURL != from.URL || (claim.Expiration > 0 && claim.Expiration < now.Unix()) {
+			continue
+		}
+		migration.Total++
+		if err := s.migrate(ctx, migration, claim, mapping, to); err != nil {
+			log.Warn(ctx, "credential can't be migrated", "err", err, "migration", migration.ID, "credential", claim.ID)
+			migration.Fail(claim.ID, claim.OtherIdentifier, err)
+			continue
+		}
+		migration.Migrated++
+	}
+	return nil
+}
+
+// migrate re-issues the credential with its subject mapped to the schema to and revokes it
+func (s *schemaFamily) migrate(ctx context.Context, migration *domain.SchemaMigration, claim *domain.Claim, mapping domain.SchemaMapping, to *domain.Schema) error {
+	vc, err := claim.GetVerifiableCredential()
+	if err != nil {
+		return fmt.Errorf("reading credential: %w", err)
+	}
+	subject := make(map[string]any, len(vc.CredentialSubject))
+	for k, v := range vc.CredentialSubject {
+		if k != "type" {
+			subject[k] = v
+		}
+	}
+	subject, err = mapping.Apply(subject)
+	if err != nil {
+		return err
+	}
+	// the validation sets the id and type of the subject it is given
+	validated := make(map[string]any, len(subject))
+	for k, v := range subject {
+		validated[k] = v
+	}
+	if err := jsonschema.ValidateCredentialSubject(ctx, s.loader, to.URL, to.Type, validated); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidCredentialSubject, err)
+	}
+	if migration.DryRun {
+		return nil
+	}
+
+	credentialStatusType := verifiable.Iden3commRevocationStatusV1
+	if status, err := claim.GetCredentialStatus(); err == nil && status.Type != "" {
+		credentialStatusType = status.Type
+	}
+	proofs := ports.ClaimRequestProofs{
+		BJJSignatureProof2021:      claim.SignatureProof.Status != pgtype.Null,
+		Iden3SparseMerkleTreeProof: claim.MtProof,
+	}
+	req := ports.NewCreateClaimRequest(&migration.IssuerDID, nil, to.URL, subject, vc.Expiration, to.Type, nil, nil, nil, proofs, nil, false, credentialStatusType, vc.RefreshService, nil, nil)
+	issued, err := s.claimService.Save(ctx, req)
+	if err != nil {
+		return fmt.Errorf("issuing credential: %w", err)
+	}
+	if err := s.claimService.Revoke(ctx, migration.IssuerDID, uint64(claim.RevNonce), fmt.Sprintf("migrated to schema %s", to.ID)); err != nil {
+		log.Error(ctx, "revoking migrated credential", "err", err, "credential", claim.ID, "issued", issued.ID)
+		return fmt.Errorf("revoking credential, re-issued as %s: %w", issued.ID, err)
+	}
+	return nil
+}
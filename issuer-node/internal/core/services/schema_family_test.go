@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/loader"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// schemaFamilies keeps the schema families in memory
+type schemaFamilies struct {
+	families map[uuid.UUID]*domain.SchemaFamily
+}
+
+func (r *schemaFamilies) Save(_ context.Context, family *domain.SchemaFamily) error {
+	saved := *family
+	r.families[family.ID] = &saved
+	return nil
+}
+
+func (r *schemaFamilies) AddVersion(_ context.Context, familyID uuid.UUID, version *domain.SchemaFamilyVersion) error {
+	family := r.families[familyID]
+	version.Position = len(family.Versions) + 1
+	family.Versions = append(family.Versions, *version)
+	return nil
+}
+
+func (r *schemaFamilies) GetByID(_ context.Context, _ w3c.DID, id uuid.UUID) (*domain.SchemaFamily, error) {
+	family, ok := r.families[id]
+	if !ok {
+		return nil, repositories.SchemaFamilyNotFoundErr
+	}
+	found := *family
+	found.Versions = append([]domain.SchemaFamilyVersion{}, family.Versions...)
+	return &found, nil
+}
+
+func (r *schemaFamilies) GetAll(_ context.Context, _ w3c.DID) ([]domain.SchemaFamily, error) {
+	families := make([]domain.SchemaFamily, 0, len(r.families))
+	for _, family := range r.families {
+		families = append(families, *family)
+	}
+	return families, nil
+}
+
+// schemaMigrations keeps the schema migrations in memory
+type schemaMigrations struct {
+	migrations map[uuid.UUID]domain.SchemaMigration
+}
+
+func (r *schemaMigrations) Save(_ context.Context, migration *domain.SchemaMigration) error {
+	r.migrations[migration.ID] = *migration
+	return nil
+}
+
+func (r *schemaMigrations) GetByID(_ context.Context, _ w3c.DID, id uuid.UUID) (*domain.SchemaMigration, error) {
+	migration, ok := r.migrations[id]
+	if !ok {
+		return nil, repositories.SchemaMigrationNotFoundErr
+	}
+	return &migration, nil
+}
+
+func (r *schemaMigrations) LeasePending(_ context.Context, limit int) ([]domain.SchemaMigration, error) {
+	leased := make([]domain.SchemaMigration, 0)
+	for id, migration := range r.migrations {
+		if migration.Status == domain.SchemaMigrationPending && len(leased) < limit {
+			migration.Status = domain.SchemaMigrationRunning
+			r.migrations[id] = migration
+			leased = append(leased, migration)
+		}
+	}
+	return leased, nil
+}
+
+// migratedClaims records the credentials issued and revoked by a migration
+type migratedClaims struct {
+	ports.ClaimService
+	claims  []*domain.Claim
+	issued  []*ports.CreateClaimRequest
+	revoked []uint64
+}
+
+func (c *migratedClaims) GetAll(_ context.Context, _ w3c.DID, filter *ports.ClaimsFilter) ([]*domain.Claim, uint, error) {
+	claims := make([]*domain.Claim, 0)
+	for _, claim := range c.claims {
+		if filter.Revoked == nil || claim.Revoked == *filter.Revoked {
+			claims = append(claims, claim)
+		}
+	}
+	return claims, uint(len(claims)), nil
+}
+
+func (c *migratedClaims) Save(_ context.Context, req *ports.CreateClaimRequest) (*domain.Claim, error) {
+	c.issued = append(c.issued, req)
+	return &domain.Claim{ID: uuid.New()}, nil
+}
+
+func (c *migratedClaims) Revoke(_ context.Context, _ w3c.DID, nonce uint64, _ string) error {
+	c.revoked = append(c.revoked, nonce)
+	return nil
+}
+
+func TestSchemaFamily_Migration(t *testing.T) {
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ")
+	require.NoError(t, err)
+	const subjectDID = "did:iden3:polygon:mumbai:x3HstHLj2rTp6HHXk2WczYP7w3rpCsRbwCMeaQ2H2"
+
+	// the contexts of the dummy credentials used to validate the subjects, so the test runs offline
+	snapshots := repositories.NewSchemaSnapshotInMemory()
+	require.NoError(t, snapshots.Save(ctx, domain.NewSchemaSnapshot(loader.W3CCredential2018ContextURL, []byte(loader.W3CCredential2018ContextDocument))))
+	require.NoError(t, snapshots.Save(ctx, domain.NewSchemaSnapshot("https://schema.iden3.io/core/jsonld/iden3proofs.jsonld", []byte(`{"@context":{}}`))))
+	schemaRepository := repositories.NewSchemaInMemory()
+	importSchema := func(t *testing.T, version string, attrs []jsonschema.DefinitionAttribute) *domain.Schema {
+		t.Helper()
+		def := jsonschema.Definition{Type: "Recruiter", Version: version, Attributes: attrs}
+		schema := &domain.Schema{
+			ID:         uuid.New(),
+			IssuerDID:  *issuerDID,
+			URL:        "https://schemas.example.com/recruiter-" + version + ".json",
+			ContextURL: "https://schemas.example.com/recruiter-" + version + ".jsonld",
+			Type:       def.Type,
+			Version:    version,
+			Hash:       core.NewSchemaHashFromInt(big.NewInt(1)),
+			CreatedAt:  time.Now(),
+		}
+		content, err := jsonschema.NewJSONSchema(def, schema.URL, schema.ContextURL).Bytes()
+		require.NoError(t, err)
+		require.NoError(t, snapshots.Save(ctx, domain.NewSchemaSnapshot(schema.URL, content)))
+		content, err = jsonschema.NewJSONLdContext(def, "urn:uuid:"+schema.ID.String()+"#")
+		require.NoError(t, err)
+		require.NoError(t, snapshots.Save(ctx, domain.NewSchemaSnapshot(schema.ContextURL, content)))
+		require.NoError(t, schemaRepository.Save(ctx, storage.Pgx, schema))
+		return schema
+	}
+	v1 := importSchema(t, "1.0.0", []jsonschema.DefinitionAttribute{
+		{Name: "company", Type: jsonschema.AttributeTypeString},
+		{Name: "seniority", Type: jsonschema.AttributeTypeString},
+	})
+	v2 := importSchema(t, "2.0.0", []jsonschema.DefinitionAttribute{
+		{Name: "employer", Type: jsonschema.AttributeTypeString, Required: true},
+		{Name: "seniority", Type: jsonschema.AttributeTypeInteger},
+	})
+
+	newClaim := func(t *testing.T, schemaURL string, nonce uint64, revoked bool, subject map[string]any) *domain.Claim {
+		t.Helper()
+		subject["id"], subject["type"] = subjectDID, "Recruiter"
+		claim := &domain.Claim{ID: uuid.New(), SchemaURL: schemaURL, OtherIdentifier: subjectDID, RevNonce: domain.RevNonceUint64(nonce), Revoked: revoked, MtProof: true}
+		require.NoError(t, claim.Data.Set(verifiable.W3CCredential{CredentialSubject: subject}))
+		require.NoError(t, claim.CredentialStatus.Set(verifiable.CredentialStatus{Type: verifiable.Iden3commRevocationStatusV1}))
+		return claim
+	}
+	valid := newClaim(t, v1.URL, 1, false, map[string]any{"company": "Acme", "seniority": "3"})
+	claims := &migratedClaims{claims: []*domain.Claim{
+		valid,
+		newClaim(t, v1.URL, 2, false, map[string]any{"company": "Acme", "seniority": "senior"}),
+		newClaim(t, v1.URL, 3, false, map[string]any{"seniority": "2"}),
+		newClaim(t, v1.URL, 4, true, map[string]any{"company": "Acme"}),
+		newClaim(t, "https://schemas.example.com/other.json", 5, false, map[string]any{"company": "Acme"}),
+	}}
+	documentLoader := loader.NewSnapshotDocumentLoader(snapshots, loader.NewDocumentLoader("", false))
+	migrations := &schemaMigrations{migrations: map[uuid.UUID]domain.SchemaMigration{}}
+	s := NewSchemaFamily(&schemaFamilies{families: map[uuid.UUID]*domain.SchemaFamily{}}, migrations, schemaRepository, claims, documentLoader)
+
+	family, err := s.Create(ctx, *issuerDID, "Recruiter")
+	require.NoError(t, err)
+	_, err = s.AddVersion(ctx, *issuerDID, family.ID, v1.ID, domain.SchemaMapping{{Op: domain.SchemaMappingDrop, Field: "company"}})
+	assert.ErrorIs(t, err, domain.ErrInvalidSchemaMapping, "the first version has no previous one")
+	_, err = s.AddVersion(ctx, *issuerDID, family.ID, v1.ID, nil)
+	require.NoError(t, err)
+	family, err = s.AddVersion(ctx, *issuerDID, family.ID, v2.ID, domain.SchemaMapping{
+		{Op: domain.SchemaMappingRename, Field: "company", To: "employer"},
+		{Op: domain.SchemaMappingTransform, Field: "seniority", Transform: domain.SchemaMappingToInteger},
+	})
+	require.NoError(t, err)
+	require.Len(t, family.Versions, 2)
+	_, err = s.AddVersion(ctx, *issuerDID, family.ID, uuid.New(), nil)
+	assert.ErrorIs(t, err, ErrSchemaNotFound)
+
+	_, err = s.CreateMigration(ctx, *issuerDID, family.ID, v2.ID, v1.ID, false)
+	assert.ErrorIs(t, err, domain.ErrInvalidSchemaMapping, "credentials are only migrated to newer versions")
+
+	t.Run("should report the credentials that fail the new schema in a dry run", func(t *testing.T) {
+		migration, err := s.CreateMigration(ctx, *issuerDID, family.ID, v1.ID, v2.ID, true)
+		require.NoError(t, err)
+		processed, err := s.RunPending(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, processed)
+
+		migration, err = s.GetMigration(ctx, *issuerDID, migration.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.SchemaMigrationDone, migration.Status)
+		assert.Equal(t, 3, migration.Total)
+		assert.Equal(t, 1, migration.Migrated)
+		require.Len(t, migration.Failures, 2)
+		for _, failure := range migration.Failures {
+			assert.Equal(t, subjectDID, failure.Subject)
+			assert.NotEqual(t, valid.ID, failure.CredentialID)
+		}
+		assert.Empty(t, claims.issued)
+		assert.Empty(t, claims.revoked)
+	})
+
+	t.Run("should re-issue the valid credentials and revoke the old ones", func(t *testing.T) {
+		migration, err := s.CreateMigration(ctx, *issuerDID, family.ID, v1.ID, v2.ID, false)
+		require.NoError(t, err)
+		_, err = s.RunPending(ctx)
+		require.NoError(t, err)
+
+		migration, err = s.GetMigration(ctx, *issuerDID, migration.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, migration.Migrated)
+		assert.Len(t, migration.Failures, 2)
+		require.Len(t, claims.issued, 1)
+		assert.Equal(t, v2.URL, claims.issued[0].Schema)
+		assert.Equal(t, map[string]any{"id": subjectDID, "employer": "Acme", "seniority": int64(3)}, claims.issued[0].CredentialSubject)
+		assert.True(t, claims.issued[0].MTProof)
+		assert.Equal(t, verifiable.Iden3commRevocationStatusV1, claims.issued[0].CredentialStatusType)
+		assert.Equal(t, []uint64{1}, claims.revoked)
+	})
+
+	_, err = s.GetMigration(ctx, *issuerDID, uuid.New())
+	assert.ErrorIs(t, err, ErrSchemaMigrationNotFound)
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -12,7 +14,10 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/jsonschema"
+	"github.com/polygonid/sh-id-platform/internal/loader"
 	networkPkg "github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/repositories"
@@ -40,7 +45,7 @@ func TestSchema_ImportSchema(t *testing.T) {
 
 	expectHash := utils.CreateSchemaHash([]byte(urlLD + "#" + schemaType))
 
-	s := NewSchema(repo, docLoader, displayMethodService)
+	s := NewSchema(repo, docLoader, displayMethodService, repositories.NewSchemaSnapshot(*storage), loader.MultiProtocolFactory(ipfsGatewayURL), storage, NewAudit(repositories.NewAudit(*storage)))
 	iReq := ports.NewImportSchemaRequest(url, schemaType, common.ToPointer(title), version, common.ToPointer(description), nil)
 	got, err := s.ImportSchema(ctx, *issuerDID, iReq)
 	require.NoError(t, err)
@@ -61,6 +66,81 @@ func TestSchema_ImportSchema(t *testing.T) {
 	assert.Len(t, updatedSchema.Words, 4)
 }
 
+func TestSchema_Snapshots(t *testing.T) {
+	const schemaURL = "https://schemas.example.com/recruiter.json"
+	const contextURL = "https://schemas.example.com/recruiter.jsonld"
+	ctx := context.Background()
+	issuerDID, err := w3c.ParseDID("did:iden3:polygon:mumbai:wyFiV4w71QgWPn6bYLsZoysFay66gKtVa9kfu6yMZ")
+	require.NoError(t, err)
+
+	def := jsonschema.Definition{
+		Type:       "Recruiter",
+		Version:    "1.0.0",
+		Attributes: []jsonschema.DefinitionAttribute{{Name: "company", Type: jsonschema.AttributeTypeString}},
+	}
+	schemaContent, err := jsonschema.NewJSONSchema(def, schemaURL, contextURL).Bytes()
+	require.NoError(t, err)
+	contextContent, err := jsonschema.NewJSONLdContext(def, "urn:uuid:8edd8112-c415-11ed-b036-debe37e1cbd6#")
+	require.NoError(t, err)
+
+	// the remote documents are files, so they can change or disappear
+	dir := t.TempDir()
+	files := map[string]string{schemaURL: filepath.Join(dir, "recruiter.json"), contextURL: filepath.Join(dir, "recruiter.jsonld")}
+	require.NoError(t, os.WriteFile(files[schemaURL], schemaContent, 0o600))
+	require.NoError(t, os.WriteFile(files[contextURL], contextContent, 0o600))
+	remote := func(url string) loader.Loader { return loader.FileFactory(files[url]) }
+
+	snapshots := repositories.NewSchemaSnapshotInMemory()
+	documentLoader := loader.NewSnapshotDocumentLoader(snapshots, loader.NewDocumentLoader("", false))
+	_, err = storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2) ON CONFLICT DO NOTHING", issuerDID.String(), "BJJ")
+	require.NoError(t, err)
+	s := NewSchema(repositories.NewSchemaInMemory(), documentLoader, nil, snapshots, remote, storage, NewAudit(repositories.NewAudit(*storage)))
+
+	imported, err := s.ImportSchema(ctx, *issuerDID, ports.NewImportSchemaRequest(schemaURL, def.Type, nil, def.Version, nil, nil))
+	require.NoError(t, err)
+	require.Len(t, imported.Snapshots, 2)
+	assert.Equal(t, schemaURL, imported.Snapshots[0].URL)
+	assert.Equal(t, domain.SchemaSnapshotDigest(schemaContent), imported.Snapshots[0].Digest)
+	assert.Equal(t, contextURL, imported.Snapshots[1].URL)
+	assert.Equal(t, domain.SchemaSnapshotDigest(contextContent), imported.Snapshots[1].Digest)
+
+	t.Run("should not report drift while the remote documents are the same", func(t *testing.T) {
+		checked, err := s.CheckDrift(ctx, *issuerDID, imported.ID)
+		require.NoError(t, err)
+		for _, snapshot := range checked.Snapshots {
+			assert.False(t, snapshot.Drifted(), snapshot.URL)
+			assert.NotNil(t, snapshot.CheckedAt)
+		}
+	})
+
+	t.Run("should report drift when a remote document changes", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(files[schemaURL], append(schemaContent, ' '), 0o600))
+		checked, err := s.CheckDrift(ctx, *issuerDID, imported.ID)
+		require.NoError(t, err)
+		assert.True(t, checked.Snapshots[0].Drifted())
+		assert.False(t, checked.Snapshots[1].Drifted())
+
+		found, err := s.GetByID(ctx, *issuerDID, imported.ID)
+		require.NoError(t, err)
+		assert.True(t, found.Snapshots[0].Drifted())
+	})
+
+	t.Run("should resolve the documents from the snapshots when the remote ones are gone", func(t *testing.T) {
+		require.NoError(t, os.Remove(files[schemaURL]))
+		require.NoError(t, os.Remove(files[contextURL]))
+		loaded, err := jsonschema.Load(ctx, schemaURL, documentLoader)
+		require.NoError(t, err)
+		jsonLdContext, err := loaded.JSONLdContext()
+		require.NoError(t, err)
+		assert.Equal(t, contextURL, jsonLdContext)
+		_, err = documentLoader.LoadDocument(contextURL)
+		require.NoError(t, err)
+
+		_, err = s.CheckDrift(ctx, *issuerDID, imported.ID)
+		assert.ErrorIs(t, err, ErrLoadingSchema)
+	})
+}
+
 func TestSchema_Update(t *testing.T) {
 	const url = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json"
 	const title = "someTitle"
@@ -88,8 +168,8 @@ func TestSchema_Update(t *testing.T) {
 
 	rhsFactory := reversehash.NewFactory(*networkResolver, reversehash.DefaultRHSTimeOut)
 	revocationStatusResolver := revocationstatus.NewRevocationStatusResolver(*networkResolver)
-	identityService := NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository)
-	schemaService := NewSchema(schemaRepository, docLoader, displayMethodService)
+	identityService := NewIdentity(keyStore, identityRepo, mtRepo, identityStateRepo, mtService, nil, claimsRepo, revocationRepository, connectionsRepository, storage, nil, nil, pubsub.NewMock(), *networkResolver, rhsFactory, revocationStatusResolver, keyRepository, NewTenant(repositories.NewTenant(*storage), storage))
+	schemaService := NewSchema(schemaRepository, docLoader, displayMethodService, repositories.NewSchemaSnapshot(*storage), loader.MultiProtocolFactory(ipfsGatewayURL), storage, NewAudit(repositories.NewAudit(*storage)))
 
 	identity, err := identityService.Create(ctx, "polygon-test", &ports.DIDCreationOptions{Method: method, Blockchain: blockchain, Network: net, KeyType: BJJ})
 	assert.NoError(t, err)
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/jwtvc"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/statuslist"
+)
+
+// ErrStatusListUnsupportedIssuer is returned when a BitstringStatusListEntry status is requested for an identity
+// that can't sign the status list credentials
+var ErrStatusListUnsupportedIssuer = errors.New("BitstringStatusListEntry credential status is only supported for ethereum based identities")
+
+type statusList struct {
+	repository ports.StatusListRepository
+	storage    *db.Storage
+	kms        kms.KMSType
+	serverURL  string
+}
+
+// NewStatusList creates the service that publishes the revocations of the issuers as bitstring status lists
+func NewStatusList(repository ports.StatusListRepository, storage *db.Storage, keyStore kms.KMSType, serverURL string) ports.StatusListService {
+	return &statusList{
+		repository: repository,
+		storage:    storage,
+		kms:        keyStore,
+		serverURL:  serverURL,
+	}
+}
+
+// Validate checks the identity can sign status list credentials
+func (s *statusList) Validate(_ context.Context, issuerDID w3c.DID) error {
+	return validateStatusListIssuer(&issuerDID)
+}
+
+// Allocate returns the status of a new credential of the issuer, with the next free index of its current list.
+// A new list is created when the issuer has none or it is full.
+func (s *statusList) Allocate(ctx context.Context, issuerDID w3c.DID) (*statuslist.Entry, error) {
+	if err := validateStatusListIssuer(&issuerDID); err != nil {
+		return nil, err
+	}
+	list, err := s.repository.GetCurrent(ctx, s.storage.Pgx, issuerDID, statuslist.PurposeRevocation)
+	if err != nil && !errors.Is(err, repositories.StatusListNotFoundErr) {
+		return nil, err
+	}
+	if list == nil || list.Full() {
+		if list, err = s.create(ctx, issuerDID); err != nil {
+			return nil, err
+		}
+	}
+
+	index, err := s.repository.AllocateIndex(ctx, s.storage.Pgx, list.ID)
+	if errors.Is(err, repositories.StatusListFullErr) {
+		// the last indexes were taken since the list was read
+		if list, err = s.create(ctx, issuerDID); err != nil {
+			return nil, err
+		}
+		index, err = s.repository.AllocateIndex(ctx, s.storage.Pgx, list.ID)
+	}
+	if err != nil {
+		log.Error(ctx, "allocating status list index", "err", err, "id", list.ID)
+		return nil, err
+	}
+	return statuslist.NewEntry(s.credentialURL(list.ID), index), nil
+}
+
+// Revoke sets the status of the claims with a BitstringStatusListEntry status and signs their lists again.
+// The lists are locked in the transaction of conn. Claims with other statuses are ignored.
+func (s *statusList) Revoke(ctx context.Context, conn db.Querier, issuerDID w3c.DID, claims []*domain.Claim) error {
+	indexes := make(map[uuid.UUID][]int)
+	for _, claim := range claims {
+		status, err := claim.GetCredentialStatus()
+		if err != nil || status.Type != statuslist.BitstringStatusListEntry {
+			continue
+		}
+		entry, err := statuslist.ParseEntry(json.RawMessage(claim.CredentialStatus.Bytes))
+		if err != nil {
+			return err
+		}
+		listID, err := uuid.Parse(path.Base(entry.StatusListCredential))
+		if err != nil {
+			return fmt.Errorf("%w: unknown list %s", statuslist.ErrInvalidEntry, entry.StatusListCredential)
+		}
+		index, err := entry.Index()
+		if err != nil {
+			return err
+		}
+		indexes[listID] = append(indexes[listID], index)
+	}
+
+	for listID, listIndexes := range indexes {
+		list, err := s.repository.GetForUpdate(ctx, conn, listID)
+		if err != nil {
+			return err
+		}
+		if list.Identifier != issuerDID.String() {
+			return fmt.Errorf("%w: list %s is not of the issuer", statuslist.ErrInvalidEntry, listID)
+		}
+		bitstring := statuslist.Bitstring(list.Bitstring)
+		for _, index := range listIndexes {
+			if err := bitstring.Set(index, true); err != nil {
+				return err
+			}
+		}
+		if err := s.sign(ctx, list); err != nil {
+			return err
+		}
+		if err := s.repository.Save(ctx, conn, list); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetCredential returns the signed status list credential
+func (s *statusList) GetCredential(ctx context.Context, id uuid.UUID) (string, error) {
+	list, err := s.repository.GetByID(ctx, s.storage.Pgx, id)
+	if err != nil {
+		return "", err
+	}
+	return list.Credential, nil
+}
+
+// create stores a new list of the issuer with all the statuses unset and its signed credential
+func (s *statusList) create(ctx context.Context, issuerDID w3c.DID) (*domain.StatusList, error) {
+	list := &domain.StatusList{
+		ID:         uuid.New(),
+		Identifier: issuerDID.String(),
+		Purpose:    statuslist.PurposeRevocation,
+		Size:       statuslist.MinSize,
+		Bitstring:  statuslist.New(statuslist.MinSize),
+	}
+	if err := s.sign(ctx, list); err != nil {
+		return nil, err
+	}
+	if err := s.repository.Save(ctx, s.storage.Pgx, list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// sign sets the credential of the list with its current statuses, signed by the issuer
+func (s *statusList) sign(ctx context.Context, list *domain.StatusList) error {
+	issuerDID, err := w3c.ParseDID(list.Identifier)
+	if err != nil {
+		return err
+	}
+	signer, err := identitySigner(ctx, s.kms, issuerDID)
+	if err != nil {
+		return err
+	}
+	credential, err := statuslist.NewCredential(s.credentialURL(list.ID), list.Identifier, list.Bitstring, time.Now().UTC().Truncate(time.Second))
+	if err != nil {
+		return err
+	}
+	token, err := jwtvc.EncodeJWT(ctx, credential, signer)
+	if err != nil {
+		log.Error(ctx, "signing status list credential", "err", err, "id", list.ID)
+		return err
+	}
+	list.Credential = token
+	return nil
+}
+
+func (s *statusList) credentialURL(id uuid.UUID) string {
+	return fmt.Sprintf(ports.StatusListCredentialURL, s.serverURL, id)
+}
+
+func validateStatusListIssuer(issuerDID *w3c.DID) error {
+	isEthIdentity, _, err := common.CheckEthIdentityByDID(issuerDID)
+	if err != nil {
+		return err
+	}
+	if !isEthIdentity {
+		return ErrStatusListUnsupportedIssuer
+	}
+	return nil
+}
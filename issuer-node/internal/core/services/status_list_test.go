@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/jwtvc"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/statuslist"
+)
+
+// ethKeyStore is a KMS with the ethereum key of a single identity
+type ethKeyStore struct {
+	kms.KMSType
+	signer *ethKeySigner
+}
+
+func (k *ethKeyStore) KeysByIdentity(_ context.Context, _ w3c.DID) ([]kms.KeyID, error) {
+	return []kms.KeyID{{Type: kms.KeyTypeEthereum, ID: "ETH:key"}}, nil
+}
+
+func (k *ethKeyStore) PublicKey(_ kms.KeyID) ([]byte, error) {
+	return crypto.FromECDSAPub(&k.signer.key.PublicKey), nil
+}
+
+func (k *ethKeyStore) Sign(ctx context.Context, _ kms.KeyID, digest []byte) ([]byte, error) {
+	return k.signer.Sign(ctx, digest)
+}
+
+// statusLists keeps the status lists in memory
+type statusLists struct {
+	lists map[uuid.UUID]*domain.StatusList
+}
+
+func (r *statusLists) Save(_ context.Context, _ db.Querier, list *domain.StatusList) error {
+	saved := *list
+	saved.Bitstring = append([]byte{}, list.Bitstring...)
+	if current, ok := r.lists[list.ID]; ok {
+		saved.NextIndex, saved.CreatedAt = current.NextIndex, current.CreatedAt
+	} else {
+		saved.CreatedAt = time.Now()
+	}
+	r.lists[list.ID] = &saved
+	return nil
+}
+
+func (r *statusLists) GetByID(_ context.Context, _ db.Querier, id uuid.UUID) (*domain.StatusList, error) {
+	list, ok := r.lists[id]
+	if !ok {
+		return nil, repositories.StatusListNotFoundErr
+	}
+	found := *list
+	return &found, nil
+}
+
+func (r *statusLists) GetForUpdate(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.StatusList, error) {
+	return r.GetByID(ctx, conn, id)
+}
+
+func (r *statusLists) GetCurrent(_ context.Context, _ db.Querier, issuerDID w3c.DID, purpose string) (*domain.StatusList, error) {
+	var current *domain.StatusList
+	for _, list := range r.lists {
+		if list.Identifier == issuerDID.String() && list.Purpose == purpose && (current == nil || list.CreatedAt.After(current.CreatedAt)) {
+			found := *list
+			current = &found
+		}
+	}
+	if current == nil {
+		return nil, repositories.StatusListNotFoundErr
+	}
+	return current, nil
+}
+
+func (r *statusLists) AllocateIndex(_ context.Context, _ db.Querier, id uuid.UUID) (int, error) {
+	list := r.lists[id]
+	if list.Full() {
+		return 0, repositories.StatusListFullErr
+	}
+	list.NextIndex++
+	return list.NextIndex - 1, nil
+}
+
+func TestStatusList_Revoke(t *testing.T) {
+	ctx := context.Background()
+	signer, issuer := newEthIdentity(t)
+	issuerDID, err := w3c.ParseDID(issuer)
+	require.NoError(t, err)
+	repository := &statusLists{lists: map[uuid.UUID]*domain.StatusList{}}
+	service := NewStatusList(repository, &db.Storage{}, &ethKeyStore{signer: signer}, "https://issuer.example.com")
+
+	statusListCredential := func(t *testing.T, entry *statuslist.Entry) *jwtvc.Credential {
+		t.Helper()
+		token, err := service.GetCredential(ctx, uuid.MustParse(entry.StatusListCredential[len(entry.StatusListCredential)-36:]))
+		require.NoError(t, err)
+		credential, err := jwtvc.VerifyJWT(token)
+		require.NoError(t, err)
+		return credential
+	}
+
+	first, err := service.Allocate(ctx, *issuerDID)
+	require.NoError(t, err)
+	second, err := service.Allocate(ctx, *issuerDID)
+	require.NoError(t, err)
+	assert.Equal(t, "0", first.StatusListIndex)
+	assert.Equal(t, "1", second.StatusListIndex)
+	assert.Equal(t, first.StatusListCredential, second.StatusListCredential)
+	assert.Contains(t, first.StatusListCredential, "https://issuer.example.com/v2/status-lists/")
+
+	t.Run("should publish the list signed by the issuer with every status unset", func(t *testing.T) {
+		credential := statusListCredential(t, first)
+		assert.Equal(t, issuer, credential.Issuer)
+		revoked, err := statuslist.Status(credential, first)
+		require.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("should set the status of the revoked credentials and sign the list again", func(t *testing.T) {
+		revokedClaim := &domain.Claim{ID: uuid.New()}
+		require.NoError(t, revokedClaim.CredentialStatus.Set(second))
+		iden3Claim := &domain.Claim{ID: uuid.New()}
+		require.NoError(t, iden3Claim.CredentialStatus.Set(map[string]interface{}{"id": "https://issuer.example.com/v2/agent", "type": "Iden3commRevocationStatusV1.0"}))
+		require.NoError(t, service.Revoke(ctx, nil, *issuerDID, []*domain.Claim{revokedClaim, iden3Claim}))
+
+		credential := statusListCredential(t, second)
+		revoked, err := statuslist.Status(credential, second)
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		revoked, err = statuslist.Status(credential, first)
+		require.NoError(t, err)
+		assert.False(t, revoked)
+	})
+
+	t.Run("should start a new list when the current one is full", func(t *testing.T) {
+		for _, list := range repository.lists {
+			list.Size = list.NextIndex
+		}
+		entry, err := service.Allocate(ctx, *issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, "0", entry.StatusListIndex)
+		assert.NotEqual(t, first.StatusListCredential, entry.StatusListCredential)
+	})
+
+	t.Run("should not allocate entries for identities that can't sign the list", func(t *testing.T) {
+		bjjDID, err := w3c.ParseDID("did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu")
+		require.NoError(t, err)
+		_, err = service.Allocate(ctx, *bjjDID)
+		assert.ErrorIs(t, err, ErrStatusListUnsupportedIssuer)
+	})
+}
@@ -0,0 +1,151 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+var (
+	// ErrTenantNotFound is returned when the tenant does not exist
+	ErrTenantNotFound = errors.New("tenant not found")
+	// ErrTenantDuplicated is returned when the name of the tenant is already in use
+	ErrTenantDuplicated = errors.New("tenant name already exists")
+	// ErrIdentityQuotaExceeded is returned when the tenant already has the maximum number of identities
+	ErrIdentityQuotaExceeded = errors.New("identity quota of the tenant exceeded")
+	// ErrCredentialQuotaExceeded is returned when the issuers of the tenant already issued the maximum number of
+	// credentials this month
+	ErrCredentialQuotaExceeded = errors.New("monthly credential quota of the tenant exceeded")
+)
+
+type tenant struct {
+	tenants ports.TenantRepository
+	storage *db.Storage
+}
+
+// NewTenant creates the service that manages the tenants of the node
+func NewTenant(tenants ports.TenantRepository, storage *db.Storage) ports.TenantService {
+	return &tenant{tenants: tenants, storage: storage}
+}
+
+// Create stores a new tenant
+func (s *tenant) Create(ctx context.Context, tenant *domain.Tenant) error {
+	if err := tenant.Validate(); err != nil {
+		return err
+	}
+	if err := s.tenants.Save(ctx, tenant); err != nil {
+		if errors.Is(err, repositories.TenantDuplicatedErr) {
+			return ErrTenantDuplicated
+		}
+		log.Error(ctx, "saving tenant", "err", err, "name", tenant.Name)
+		return err
+	}
+	return nil
+}
+
+// Update stores the name, the quotas and the configuration overrides of the tenant. Lowering a quota doesn't remove
+// anything, it only prevents new identities or credentials.
+func (s *tenant) Update(ctx context.Context, tenant *domain.Tenant) error {
+	if err := tenant.Validate(); err != nil {
+		return err
+	}
+	if err := s.tenants.Update(ctx, tenant); err != nil {
+		switch {
+		case errors.Is(err, repositories.TenantNotFoundErr):
+			return ErrTenantNotFound
+		case errors.Is(err, repositories.TenantDuplicatedErr):
+			return ErrTenantDuplicated
+		}
+		log.Error(ctx, "updating tenant", "err", err, "id", tenant.ID)
+		return err
+	}
+	return nil
+}
+
+// GetByID returns the tenant
+func (s *tenant) GetByID(ctx context.Context, id uuid.UUID) (*domain.Tenant, error) {
+	tenant, err := s.tenants.GetByID(ctx, s.storage.Pgx, id)
+	if errors.Is(err, repositories.TenantNotFoundErr) {
+		return nil, ErrTenantNotFound
+	}
+	return tenant, err
+}
+
+// GetByIssuer returns the tenant that owns the issuer
+func (s *tenant) GetByIssuer(ctx context.Context, issuerDID w3c.DID) (*domain.Tenant, error) {
+	tenant, err := s.tenants.GetByIssuer(ctx, issuerDID)
+	if errors.Is(err, repositories.TenantNotFoundErr) {
+		return nil, ErrTenantNotFound
+	}
+	return tenant, err
+}
+
+// GetAll returns the tenants of the node
+func (s *tenant) GetAll(ctx context.Context) ([]domain.Tenant, error) {
+	return s.tenants.GetAll(ctx)
+}
+
+// CheckIdentityQuota returns ErrIdentityQuotaExceeded when the tenant can't have another identity. The tenant is
+// locked until the end of the transaction of conn, where the identity must be created.
+func (s *tenant) CheckIdentityQuota(ctx context.Context, conn db.Querier, id uuid.UUID) error {
+	tenant, err := s.tenants.Lock(ctx, conn, id)
+	if err != nil {
+		if errors.Is(err, repositories.TenantNotFoundErr) {
+			return ErrTenantNotFound
+		}
+		return err
+	}
+	if tenant.MaxIdentities == nil {
+		return nil
+	}
+	count, err := s.tenants.CountIdentities(ctx, conn, id)
+	if err != nil {
+		return err
+	}
+	if count >= *tenant.MaxIdentities {
+		log.Warn(ctx, "identity quota exceeded", "tenant", tenant.ID, "max", *tenant.MaxIdentities)
+		return ErrIdentityQuotaExceeded
+	}
+	return nil
+}
+
+// CheckCredentialQuota returns ErrCredentialQuotaExceeded when the tenant of the issuer already issued its monthly
+// credentials. Months are calendar months in UTC. Issuers that aren't identities of the node have no quota.
+// The tenant is locked until the end of the transaction of conn, where the credential must be created.
+func (s *tenant) CheckCredentialQuota(ctx context.Context, conn db.Querier, issuerDID w3c.DID) error {
+	tenant, err := s.tenants.GetByIssuer(ctx, issuerDID)
+	if err != nil {
+		if errors.Is(err, repositories.TenantNotFoundErr) {
+			return nil
+		}
+		return err
+	}
+	if tenant.MaxCredentialsPerMonth == nil {
+		return nil
+	}
+	if tenant, err = s.tenants.Lock(ctx, conn, tenant.ID); err != nil {
+		return err
+	}
+	if tenant.MaxCredentialsPerMonth == nil {
+		return nil
+	}
+	now := time.Now().UTC()
+	count, err := s.tenants.CountCredentials(ctx, conn, tenant.ID, time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return err
+	}
+	if count >= *tenant.MaxCredentialsPerMonth {
+		log.Warn(ctx, "credential quota exceeded", "tenant", tenant.ID, "did", issuerDID.String(), "max", *tenant.MaxCredentialsPerMonth)
+		return ErrCredentialQuotaExceeded
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/repositories"
+)
+
+// ErrInvalidTransactionDateRange is returned when the from date is after the to date
+var ErrInvalidTransactionDateRange = errors.New("invalid date range: from must be before to")
+
+type transactionHistory struct {
+	repository ports.TransactionRepository
+}
+
+// NewTransactionHistory creates a new transaction history service
+func NewTransactionHistory(repository ports.TransactionRepository) ports.TransactionHistoryService {
+	return &transactionHistory{
+		repository: repository,
+	}
+}
+
+// RecordSent stores a new transaction sent to the network, still without receipt
+func (th *transactionHistory) RecordSent(ctx context.Context, issuerDID w3c.DID, network string, txType domain.TransactionType, reference string, txID string) error {
+	tx := domain.NewTransaction(issuerDID.String(), network, txType, reference, txID)
+	if err := th.repository.Save(ctx, tx); err != nil {
+		log.Error(ctx, "failed to record sent transaction", "err", err, "tx", txID)
+		return err
+	}
+	return nil
+}
+
+// RecordReplacement records newTxID, sent to replace oldTxID in the mempool with the same nonce and higher fees.
+// Both are kept, as any of them can be the one mined.
+func (th *transactionHistory) RecordReplacement(ctx context.Context, reference string, oldTxID string, newTxID string) error {
+	updated, err := th.repository.SaveReplacement(ctx, reference, oldTxID, newTxID)
+	if err != nil {
+		log.Error(ctx, "failed to record transaction replacement", "err", err, "oldTx", oldTxID, "newTx", newTxID)
+		return err
+	}
+	if updated == 0 {
+		log.Warn(ctx, "replaced transaction not found in history", "oldTx", oldTxID, "newTx", newTxID)
+		return repositories.TransactionNotFoundErr
+	}
+	return nil
+}
+
+// RecordReceipt stores the gas used, effective gas price and fee paid from the receipt of a mined transaction.
+// The transaction is created if it was not recorded when sent.
+func (th *transactionHistory) RecordReceipt(ctx context.Context, issuerDID w3c.DID, network string, txType domain.TransactionType, reference string, receipt *types.Receipt) error {
+	tx := domain.NewTransaction(issuerDID.String(), network, txType, reference, receipt.TxHash.Hex())
+	tx.Status = domain.TransactionStatusSuccess
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		tx.Status = domain.TransactionStatusFailed
+	}
+	tx.GasUsed = new(big.Int).SetUint64(receipt.GasUsed)
+	if receipt.EffectiveGasPrice != nil {
+		tx.EffectiveGasPrice = new(big.Int).Set(receipt.EffectiveGasPrice)
+		tx.Fee = new(big.Int).Mul(tx.GasUsed, tx.EffectiveGasPrice)
+	}
+	if receipt.BlockNumber != nil {
+		blockNumber := receipt.BlockNumber.Int64()
+		tx.BlockNumber = &blockNumber
+	}
+
+	if err := th.repository.Save(ctx, tx); err != nil {
+		log.Error(ctx, "failed to record transaction receipt", "err", err, "tx", tx.TxID)
+		return err
+	}
+	return nil
+}
+
+// GetSentTxIDs returns the hashes of all the transactions sent for the reference, the replacements first
+func (th *transactionHistory) GetSentTxIDs(ctx context.Context, txType domain.TransactionType, reference string) ([]string, error) {
+	txs, err := th.repository.GetByReference(ctx, txType, reference)
+	if err != nil {
+		return nil, err
+	}
+	txIDs := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		txIDs = append(txIDs, tx.TxID)
+	}
+	return txIDs, nil
+}
+
+// GetCostTotals returns the transaction costs of the issuer grouped by network and transaction type
+func (th *transactionHistory) GetCostTotals(ctx context.Context, issuerDID w3c.DID, filter ports.TransactionCostFilter) ([]domain.TransactionCostTotal, error) {
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, ErrInvalidTransactionDateRange
+	}
+	return th.repository.GetCostTotals(ctx, issuerDID, filter)
+}
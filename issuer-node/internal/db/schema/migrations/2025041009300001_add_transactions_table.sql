@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE transactions
+(
+    id                   UUID PRIMARY KEY NOT NULL,
+    issuer_did           text             NOT NULL REFERENCES identities (identifier),
+    network              text             NOT NULL, /* resolver prefix, e.g. polygon:amoy */
+    type                 text             NOT NULL, /* state_transition, payment */
+    reference            text             NOT NULL, /* identity state or payment request id */
+    tx_id                text             NOT NULL,
+    status               text             NOT NULL,
+    gas_used             numeric          NULL,
+    effective_gas_price  numeric          NULL,
+    fee                  numeric          NULL, /* wei */
+    block_number         bigint           NULL,
+    replaces             text             NULL, /* tx_id of the stuck transaction re-sent with the same nonce */
+    created_at           timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at          timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+/* the transaction hash is provided by the holders in the payments, it's only unique for a reference */
+CREATE UNIQUE INDEX transactions_reference_tx_id_index ON transactions (reference, tx_id);
+CREATE INDEX transactions_issuer_did_created_at_index ON transactions (issuer_did, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS transactions;
+-- +goose StatementEnd
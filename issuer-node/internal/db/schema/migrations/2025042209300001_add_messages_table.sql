@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE messages
+(
+    id              UUID PRIMARY KEY NOT NULL,
+    issuer_did      text             NOT NULL REFERENCES identities (identifier),
+    user_did        text             NOT NULL,
+    direction       text             NOT NULL, /* inbound, outbound */
+    message_id      text             NOT NULL, /* iden3comm message id */
+    thread_id       text             NOT NULL,
+    type            text             NOT NULL,
+    message         jsonb            NOT NULL,
+    status          text             NOT NULL, /* queued, sent, acknowledged, failed, received */
+    attempts        int              NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz      NULL,
+    last_error      text             NULL,
+    created_at      timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at     timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX messages_issuer_did_user_did_created_at_index ON messages (issuer_did, user_did, created_at);
+CREATE INDEX messages_issuer_did_thread_id_index ON messages (issuer_did, thread_id);
+CREATE INDEX messages_status_next_attempt_at_index ON messages (status, next_attempt_at) WHERE status = 'queued';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS messages;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE proof_requests
+(
+    id            UUID PRIMARY KEY NOT NULL,
+    connection_id UUID             NOT NULL REFERENCES connections (id) ON DELETE CASCADE,
+    issuer_did    text             NOT NULL,
+    user_did      text             NOT NULL,
+    thread_id     text             NOT NULL,
+    reason        text             NOT NULL,
+    request       jsonb            NOT NULL, /* iden3comm authorization request */
+    status        text             NOT NULL, /* pending, verified, rejected, failed */
+    response      jsonb            NULL,     /* verified scope proofs */
+    error         text             NULL,
+    verified_at   timestamptz      NULL,
+    created_at    timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at   timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX proof_requests_connection_id_created_at_index ON proof_requests (connection_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS proof_requests;
+-- +goose StatementEnd
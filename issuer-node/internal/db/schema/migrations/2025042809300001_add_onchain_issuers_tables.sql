@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE onchain_issuers
+(
+    id               UUID PRIMARY KEY NOT NULL,
+    identifier       text             NOT NULL, /* did of the identity contract */
+    controller_did   text             NOT NULL REFERENCES identities (identifier),
+    contract_address text             NOT NULL,
+    chain_id         bigint           NOT NULL,
+    blockchain       text             NOT NULL,
+    network          text             NOT NULL,
+    created_at       timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX onchain_issuers_identifier_index ON onchain_issuers (identifier);
+CREATE INDEX onchain_issuers_controller_did_index ON onchain_issuers (controller_did);
+
+CREATE TABLE onchain_credentials
+(
+    id                UUID PRIMARY KEY NOT NULL, /* id of the credential in the claims table */
+    onchain_issuer_id UUID             NOT NULL REFERENCES onchain_issuers (id) ON DELETE CASCADE,
+    user_did          text             NOT NULL,
+    tx_id             text             NOT NULL,
+    status            text             NOT NULL, /* pending, published, failed */
+    created_at        timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at       timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX onchain_credentials_status_index ON onchain_credentials (status);
+CREATE INDEX onchain_credentials_onchain_issuer_id_created_at_index ON onchain_credentials (onchain_issuer_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS onchain_credentials;
+DROP TABLE IF EXISTS onchain_issuers;
+-- +goose StatementEnd
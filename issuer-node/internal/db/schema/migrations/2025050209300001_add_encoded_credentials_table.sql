@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE encoded_credentials
+(
+    id         UUID        NOT NULL, /* id of the credential in the claims table */
+    identifier text        NOT NULL REFERENCES identities (identifier),
+    format     text        NOT NULL, /* jwt_vc_json, vc+sd-jwt */
+    credential text        NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (id, identifier, format)
+);
+
+ALTER TABLE links ADD COLUMN credential_format text NOT NULL DEFAULT 'iden3';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN IF EXISTS credential_format;
+DROP TABLE IF EXISTS encoded_credentials;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE oid4vci_offers
+(
+    id                       UUID PRIMARY KEY NOT NULL,
+    issuer_did               text             NOT NULL REFERENCES identities (identifier),
+    link_id                  UUID             NOT NULL REFERENCES links (id) ON DELETE CASCADE,
+    pre_authorized_code_hash text             NOT NULL UNIQUE,
+    code_expires_at          timestamptz      NOT NULL,
+    access_token_hash        text             NULL UNIQUE,
+    token_expires_at         timestamptz      NULL,
+    c_nonce                  text             NULL,
+    holder_did               text             NULL, /* subject of the credential, bound with the first proof of possession */
+    credential_id            UUID             NULL,
+    created_at               timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at              timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS oid4vci_offers;
+-- +goose StatementEnd
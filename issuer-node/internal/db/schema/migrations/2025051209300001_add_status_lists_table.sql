@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE status_lists
+(
+    id         UUID PRIMARY KEY NOT NULL,
+    issuer_id  text             NOT NULL REFERENCES identities (identifier),
+    purpose    text             NOT NULL, /* revocation */
+    size       integer          NOT NULL,
+    next_index integer          NOT NULL DEFAULT 0,
+    bitstring  bytea            NOT NULL,
+    credential text             NOT NULL, /* status list credential as a JWT-VC */
+    created_at timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX status_lists_issuer_id_purpose_idx ON status_lists (issuer_id, purpose, created_at);
+
+ALTER TABLE links ADD COLUMN credential_status_type text NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE links DROP COLUMN IF EXISTS credential_status_type;
+DROP TABLE IF EXISTS status_lists;
+-- +goose StatementEnd
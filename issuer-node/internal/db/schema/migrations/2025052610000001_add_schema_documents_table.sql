@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE schema_documents
+(
+    id              UUID PRIMARY KEY NOT NULL,
+    issuer_id       text             NOT NULL REFERENCES identities (identifier),
+    type            text             NOT NULL,
+    version         text             NOT NULL,
+    title           text             NULL,
+    description     text             NULL,
+    json_schema     text             NOT NULL,
+    json_ld_context text             NOT NULL,
+    url             text             NOT NULL,
+    context_url     text             NOT NULL,
+    created_at      timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX schema_documents_issuer_id_idx ON schema_documents (issuer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS schema_documents;
+-- +goose StatementEnd
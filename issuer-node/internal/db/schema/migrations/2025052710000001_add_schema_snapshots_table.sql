@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE schema_snapshots
+(
+    url           text        PRIMARY KEY NOT NULL,
+    digest        text        NOT NULL, /* hex encoded sha256 of content */
+    content       bytea       NOT NULL,
+    remote_digest text        NULL,     /* digest of the remote content the last time it was checked */
+    checked_at    timestamptz NULL,
+    created_at    timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS schema_snapshots;
+-- +goose StatementEnd
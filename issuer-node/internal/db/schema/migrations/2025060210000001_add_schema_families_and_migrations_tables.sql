@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE schema_families
+(
+    id         UUID PRIMARY KEY NOT NULL,
+    issuer_id  text             NOT NULL REFERENCES identities (identifier),
+    name       text             NOT NULL,
+    created_at timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT schema_families_issuer_id_name_key UNIQUE (issuer_id, name)
+);
+
+CREATE TABLE schema_family_versions
+(
+    family_id  UUID        NOT NULL REFERENCES schema_families (id) ON DELETE CASCADE,
+    schema_id  UUID        NOT NULL REFERENCES schemas (id) ON DELETE CASCADE,
+    position   integer     NOT NULL,
+    mapping    jsonb       NOT NULL DEFAULT '[]', /* rules from the subjects of the previous version */
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (family_id, position),
+    CONSTRAINT schema_family_versions_schema_id_key UNIQUE (schema_id)
+);
+
+CREATE TABLE schema_migrations
+(
+    id             UUID PRIMARY KEY NOT NULL,
+    issuer_id      text             NOT NULL REFERENCES identities (identifier),
+    family_id      UUID             NOT NULL REFERENCES schema_families (id) ON DELETE CASCADE,
+    from_schema_id UUID             NOT NULL REFERENCES schemas (id) ON DELETE CASCADE,
+    to_schema_id   UUID             NOT NULL REFERENCES schemas (id) ON DELETE CASCADE,
+    dry_run        boolean          NOT NULL,
+    status         text             NOT NULL,
+    total          integer          NOT NULL DEFAULT 0,
+    migrated       integer          NOT NULL DEFAULT 0,
+    failures       jsonb            NOT NULL DEFAULT '[]',
+    error          text             NULL,
+    created_at     timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at    timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX schema_migrations_status_idx ON schema_migrations (status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS schema_migrations;
+DROP TABLE IF EXISTS schema_family_versions;
+DROP TABLE IF EXISTS schema_families;
+-- +goose StatementEnd
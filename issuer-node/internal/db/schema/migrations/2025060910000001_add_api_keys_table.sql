@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE api_keys
+(
+    id         UUID PRIMARY KEY NOT NULL,
+    name       text             NOT NULL,
+    prefix     text             NOT NULL,
+    hash       text             NOT NULL, /* sha256 of the key, the key itself is never stored */
+    role       text             NOT NULL,
+    issuer_ids text[]           NOT NULL DEFAULT '{}', /* empty for the keys valid for every issuer */
+    expires_at timestamptz      NULL,
+    revoked_at timestamptz      NULL,
+    created_at timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT api_keys_prefix_key UNIQUE (prefix)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_log
+(
+    issuer_id    text        NOT NULL REFERENCES identities (identifier),
+    seq          bigint      NOT NULL, /* position of the entry in the chain of the issuer, from 1 */
+    actor        text        NOT NULL,
+    actor_method text        NOT NULL DEFAULT '',
+    entity       text        NOT NULL,
+    operation    text        NOT NULL,
+    entity_id    text        NOT NULL,
+    diff         json        NOT NULL, /* json and not jsonb, the hash covers the diff as it was written */
+    request_id   text        NOT NULL DEFAULT '',
+    source_ip    text        NOT NULL DEFAULT '',
+    created_at   timestamptz NOT NULL,
+    prev_hash    text        NOT NULL,
+    hash         text        NOT NULL,
+    PRIMARY KEY (issuer_id, seq)
+);
+
+CREATE INDEX audit_log_issuer_id_entity_entity_id_idx ON audit_log (issuer_id, entity, entity_id);
+
+CREATE OR REPLACE FUNCTION audit_log_append_only()
+    RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$
+language plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log FOR EACH ROW EXECUTE PROCEDURE audit_log_append_only();
+CREATE TRIGGER audit_log_append_only_truncate
+    BEFORE TRUNCATE ON audit_log FOR EACH STATEMENT EXECUTE PROCEDURE audit_log_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only;
+-- +goose StatementEnd
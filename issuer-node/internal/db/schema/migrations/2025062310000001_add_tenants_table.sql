@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE tenants
+(
+    id                        UUID PRIMARY KEY NOT NULL,
+    name                      text             NOT NULL,
+    max_identities            integer          NULL, /* null for no limit */
+    max_credentials_per_month integer          NULL, /* null for no limit */
+    issuer_name               text             NULL, /* overrides ISSUER_ISSUER_NAME for the issuers of the tenant */
+    issuer_logo               text             NULL, /* overrides ISSUER_ISSUER_LOGO for the issuers of the tenant */
+    created_at                timestamptz      NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT tenants_name_key UNIQUE (name),
+    CONSTRAINT tenants_max_identities_check CHECK (max_identities >= 0),
+    CONSTRAINT tenants_max_credentials_per_month_check CHECK (max_credentials_per_month >= 0)
+);
+
+/* the default tenant owns the identities created before tenants existed and the ones created without tenant */
+INSERT INTO tenants (id, name) VALUES ('00000000-0000-0000-0000-000000000001', 'default');
+
+ALTER TABLE identities
+    ADD COLUMN tenant_id UUID NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001' REFERENCES tenants (id);
+CREATE INDEX identities_tenant_id_idx ON identities (tenant_id);
+
+/* keys without tenant are keys of the node, valid for every tenant */
+ALTER TABLE api_keys
+    ADD COLUMN tenant_id UUID NULL REFERENCES tenants (id);
+CREATE INDEX api_keys_tenant_id_idx ON api_keys (tenant_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE api_keys DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE identities DROP COLUMN IF EXISTS tenant_id;
+DROP TABLE IF EXISTS tenants;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_heads
+(
+    issuer_id   text        NOT NULL PRIMARY KEY REFERENCES identities (identifier),
+    seq         bigint      NOT NULL, /* sequence number of the last entry of the audit log of the issuer */
+    hash        text        NOT NULL, /* hash of the last entry, so removing entries at the end of the log is detected */
+    modified_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO audit_heads (issuer_id, seq, hash)
+SELECT DISTINCT ON (issuer_id) issuer_id, seq, hash
+FROM audit_log
+ORDER BY issuer_id, seq DESC;
+
+CREATE OR REPLACE FUNCTION audit_heads_forward_only()
+    RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        IF NEW.seq > OLD.seq THEN
+            RETURN NEW;
+        END IF;
+    END IF;
+    RAISE EXCEPTION 'audit_heads can only move forward';
+END;
+$$
+language plpgsql;
+
+CREATE TRIGGER audit_heads_forward_only
+    BEFORE UPDATE OR DELETE ON audit_heads FOR EACH ROW EXECUTE PROCEDURE audit_heads_forward_only();
+CREATE TRIGGER audit_heads_forward_only_truncate
+    BEFORE TRUNCATE ON audit_heads FOR EACH STATEMENT EXECUTE PROCEDURE audit_heads_forward_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_heads;
+DROP FUNCTION IF EXISTS audit_heads_forward_only;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE deferred_state_transitions
+(
+    issuer_id  text        NOT NULL PRIMARY KEY REFERENCES identities (identifier), /* identity whose state transition is held until the network base fee goes down */
+    created_at timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS deferred_state_transitions;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE identity_states
+    ADD COLUMN relay_tx_id text NULL; /* id given by the relayer to the state transition, its tx_id may change when re-priced */
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE identity_states
+    DROP COLUMN relay_tx_id;
+-- +goose StatementEnd
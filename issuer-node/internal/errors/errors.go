@@ -1,6 +1,12 @@
 package errors
 
-import "net/http"
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
 
 // AuthError is a special error type used to signal an authorization error
 type AuthError struct {
@@ -12,6 +18,29 @@ func (a AuthError) Error() string {
 	return a.Err.Error()
 }
 
+// ForbiddenError is a special error type used to signal that the caller is authenticated but not allowed to
+// perform the operation
+type ForbiddenError struct {
+	Err error
+}
+
+// Error satisfies error interface for ForbiddenError
+func (f ForbiddenError) Error() string {
+	return f.Err.Error()
+}
+
+// TooManyRequestsError is a special error type used to signal that the caller exceeded its rate limit and can retry
+// after RetryAfter
+type TooManyRequestsError struct {
+	Err        error
+	RetryAfter time.Duration
+}
+
+// Error satisfies error interface for TooManyRequestsError
+func (t TooManyRequestsError) Error() string {
+	return t.Err.Error()
+}
+
 // RequestErrorHandlerFunc is a Request Error Handler that can be injected in oapi-codegen to handler errors in requests
 func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 	http.Error(w, err.Error(), http.StatusBadRequest)
@@ -21,11 +50,20 @@ func RequestErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error)
 // We use it to create custom responses to some errors that may occur, like an authentication error.
 func ResponseErrorHandlerFunc(w http.ResponseWriter, _ *http.Request, err error) {
 	w.Header().Add("Content-Type", "application/json")
-	switch err.(type) {
+	switch e := err.(type) {
+	case TooManyRequestsError:
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Max(1, math.Ceil(e.RetryAfter.Seconds())))))
+		w.WriteHeader(http.StatusTooManyRequests)
+		_ = json.NewEncoder(w).Encode(struct {
+			Message string `json:"message"`
+		}{Message: e.Error()})
 	case AuthError:
 		w.WriteHeader(http.StatusUnauthorized)
 		w.Header().Add("WWW-Authenticate", `Basic realm="restricted", charset="UTF-8"`)
 		_, _ = w.Write([]byte("\"Unauthorized\""))
+	case ForbiddenError:
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("\"Forbidden\""))
 	default:
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = w.Write([]byte(err.Error()))
@@ -18,6 +18,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 	"github.com/iden3/contracts-abi/state/go/abi"
 
 	"github.com/polygonid/sh-id-platform/internal/kms"
@@ -35,6 +36,8 @@ const (
 	gasPriceIncrement               = 10
 	transactionUnderpricedIncrement = 30
 	feeIncrement                    = 1.25
+	defaultFeeBumpPercent           = 20
+	minFeeBumpPercent               = 10 // minimum price bump accepted by nodes to replace a pending transaction
 )
 
 var (
@@ -46,6 +49,10 @@ var (
 	ErrReceiptNotReceived = errors.New("receipt not available")
 	// ErrTransactionNotFound transaction doesn't exist on blockchain
 	ErrTransactionNotFound = errors.New("transaction not found")
+	// ErrTransactionNotPending when trying to replace a transaction that is already mined
+	ErrTransactionNotPending = errors.New("transaction is not pending")
+	// ErrFeeCapReached when the fees of a replacement transaction would be above the configured max fee per gas
+	ErrFeeCapReached = errors.New("max fee per gas reached")
 	// CompressedPublicKeyLength is the length of a compressed public key
 	CompressedPublicKeyLength = 33
 	// AwsKmsPublicKeyLength is the length of a public key from AWS KMS
@@ -54,9 +61,11 @@ var (
 
 // Client is an ethereum client to call Smart Contract methods.
 type Client struct {
-	client *ethclient.Client
-	Config *ClientConfig
-	kms    *kms.KMS
+	client    *ethclient.Client
+	Config    *ClientConfig
+	kms       *kms.KMS
+	nonces    *NonceTracker
+	endpoints *EndpointPool
 }
 
 // ClientConfig eth client config
@@ -71,6 +80,10 @@ type ClientConfig struct {
 	RPCResponseTimeout     time.Duration `json:"rpc_response_time_out"`
 	WaitReceiptCycleTime   time.Duration `json:"wait_receipt*eth.Client_cycle_time_out"`
 	WaitBlockCycleTime     time.Duration `json:"wait_block_cycle_time_out"`
+	MaxBaseFee             *big.Int      `json:"max_base_fee"`
+	MaxFeePerGas           *big.Int      `json:"max_fee_per_gas"`
+	StuckTxTimeout         time.Duration `json:"stuck_tx_timeout"`
+	FeeBumpPercent         int           `json:"fee_bump_percent"`
 }
 
 // NewClient creates a Client instance.
@@ -79,9 +92,17 @@ func NewClient(client *ethclient.Client, c *ClientConfig, kms *kms.KMS) *Client
 		client: client,
 		Config: c,
 		kms:    kms,
+		nonces: NewNonceTracker(),
 	}
 }
 
+// NewFailoverClient creates a Client instance that sends its requests to the healthiest endpoint of the pool
+func NewFailoverClient(endpoints *EndpointPool, c *ClientConfig, kms *kms.KMS) *Client {
+	client := NewClient(endpoints.Client(), c, kms)
+	client.endpoints = endpoints
+	return client
+}
+
 // GetEthereumClient returns the underlying ethereum client
 func (c *Client) GetEthereumClient() *ethclient.Client {
 	return c.client
@@ -104,8 +125,22 @@ func (c *Client) BalanceAt(ctx context.Context, addr common.Address) (*big.Int,
 	return c.client.BalanceAt(_ctx, addr, nil)
 }
 
-// GetLatestStateByID TBD
+// GetLatestStateByID returns the latest state of the identity from the state contract.
+// If the client has a RPC endpoint pool with a state quorum, the state is read from several endpoints and
+// returned only when quorum of them agree on it.
 func (c *Client) GetLatestStateByID(ctx context.Context, addr common.Address, id *big.Int) (abi.IStateStateInfo, error) {
+	if c.endpoints != nil && c.endpoints.cfg.StateQuorum > 1 {
+		return quorumCall(ctx, c.endpoints, c.endpoints.cfg.StateQuorum,
+			func(info abi.IStateStateInfo) string { return fmt.Sprintf("%+v", info) },
+			func(ctx context.Context, client *ethclient.Client) (abi.IStateStateInfo, error) {
+				stateContact, err := abi.NewState(addr, client)
+				if err != nil {
+					return abi.IStateStateInfo{}, err
+				}
+				return stateContact.GetStateInfoById(&bind.CallOpts{Context: ctx}, id)
+			})
+	}
+
 	var (
 		latestState abi.IStateStateInfo
 		err         error
@@ -331,32 +366,49 @@ func (c *Client) CreateTxOpts(ctx context.Context, kmsKey kms.KeyID) (*bind.Tran
 
 	sigFn := c.signerFnFactory(ctx, kmsKey)
 
+	nonce, err := c.nonces.Acquire(ctx, addr, c.pendingNonceAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce: %w", err)
+	}
+
 	opts := &bind.TransactOpts{
 		From:   addr,
 		Signer: sigFn,
+		Nonce:  new(big.Int).SetUint64(nonce),
 	}
 
 	if !c.Config.GasLess { // Some Ethereum nodes don't support eth_maxPriorityFeePerGas so we set GasLess = true
 		tip, err := c.suggestGasTipCap(ctx)
 		if err != nil {
+			c.nonces.Release(addr, nonce)
 			return nil, err
 		}
 		opts.GasPrice = nil
 		gasLimit := uint64(0)
 		opts.GasLimit = gasLimit
 		opts.GasTipCap = tip
+		if isPositive(c.Config.MaxFeePerGas) {
+			feeCap, err := c.cappedFeeCap(ctx, tip)
+			if err != nil {
+				c.nonces.Release(addr, nonce)
+				return nil, err
+			}
+			opts.GasFeeCap = feeCap
+		}
 		return opts, nil
 	}
 
 	gasPrice, err := c.getGasPrice(ctx)
 	if err != nil {
 		log.Error(ctx, "failed to get gas price", "err", err)
+		c.nonces.Release(addr, nonce)
 		return nil, err
 	}
 
 	opts = &bind.TransactOpts{
 		From:     addr,
 		Signer:   sigFn,
+		Nonce:    new(big.Int).SetUint64(nonce),
 		GasPrice: gasPrice,
 		GasLimit: uint64(c.Config.DefaultGasLimit),
 		Context:  ctx,
@@ -514,6 +566,32 @@ func (c *Client) getGasPrice(ctx context.Context) (*big.Int, error) {
 	return gasPrice, err
 }
 
+// Address returns the address of the ethereum key
+func (c *Client) Address(k kms.KeyID) (common.Address, error) {
+	return c.getAddress(k)
+}
+
+// SignTypedData signs the EIP-712 hash of the typed data with the ethereum key.
+// The recovery id of the signature is 27 or 28, as expected by the contracts verifying it with ecrecover.
+func (c *Client) SignTypedData(ctx context.Context, k kms.KeyID, typedData apitypes.TypedData) ([]byte, error) {
+	if c.kms == nil {
+		return nil, errors.Join(errors.New("the signer is read-only"))
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := c.kms.Sign(ctx, k, hash)
+	if err != nil {
+		return nil, err
+	}
+	const recoveryIDOffset = 64
+	if len(signature) > recoveryIDOffset && signature[recoveryIDOffset] <= 1 {
+		signature[recoveryIDOffset] += 27
+	}
+	return signature, nil
+}
+
 // getAddress - get address by keyID
 func (c *Client) getAddress(k kms.KeyID) (common.Address, error) {
 	if c.kms == nil {
@@ -0,0 +1,354 @@
+package eth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	defaultHealthCheckPeriod = 15 * time.Second
+	defaultMaxBlockLag       = 5
+	defaultMaxErrorRate      = 0.5
+	// ewmaWeight is the weight of the last sample in the latency and error rate moving averages
+	ewmaWeight = 0.2
+)
+
+var (
+	// ErrNoEndpoints when a network has no RPC URL
+	ErrNoEndpoints = errors.New("no RPC endpoints configured")
+	// ErrEndpointUnhealthy is returned by the health check of an endpoint that should not be used
+	ErrEndpointUnhealthy = errors.New("RPC endpoint unhealthy")
+	// ErrQuorumNotReached when not enough RPC endpoints return the same result
+	ErrQuorumNotReached = errors.New("RPC endpoints quorum not reached")
+)
+
+// FailoverConfig holds the health check settings of the RPC endpoints of a network
+type FailoverConfig struct {
+	// HealthCheckPeriod is the time between two probes of the endpoints head block. Default 15s
+	HealthCheckPeriod time.Duration `yaml:"healthCheckPeriod"`
+	// MaxBlockLag is the number of blocks an endpoint can be behind the highest head before it is unhealthy. Default 5
+	MaxBlockLag uint64 `yaml:"maxBlockLag"`
+	// MaxErrorRate, between 0 and 1, is the error rate above which an endpoint is unhealthy. Default 0.5
+	MaxErrorRate float64 `yaml:"maxErrorRate"`
+	// StateQuorum is the number of endpoints that must agree on the identity states read with GetLatestStateByID.
+	// Zero or one reads the state from a single endpoint.
+	StateQuorum int `yaml:"stateQuorum"`
+}
+
+// endpoint is a RPC endpoint of a network with its health metrics
+type endpoint struct {
+	name   string
+	url    *url.URL
+	client *ethclient.Client
+
+	mu        sync.RWMutex
+	latency   time.Duration
+	errorRate float64
+	head      uint64
+	probeErr  error
+	lag       uint64
+}
+
+// EndpointPool holds the RPC endpoints of a network. Requests are sent to the healthiest endpoint and fail over
+// to the next ones on transport errors.
+type EndpointPool struct {
+	cfg       FailoverConfig
+	endpoints []*endpoint
+	client    *ethclient.Client
+
+	mu   sync.Mutex
+	stop context.CancelFunc
+}
+
+// NewEndpointPool dials every RPC URL of a network. With more than one URL, all of them must be http or https.
+func NewEndpointPool(ctx context.Context, urls []string, cfg FailoverConfig) (*EndpointPool, error) {
+	if len(urls) == 0 {
+		return nil, ErrNoEndpoints
+	}
+	if cfg.HealthCheckPeriod <= 0 {
+		cfg.HealthCheckPeriod = defaultHealthCheckPeriod
+	}
+	if cfg.MaxBlockLag == 0 {
+		cfg.MaxBlockLag = defaultMaxBlockLag
+	}
+	if cfg.MaxErrorRate <= 0 {
+		cfg.MaxErrorRate = defaultMaxErrorRate
+	}
+
+	pool := &EndpointPool{cfg: cfg}
+	names := make(map[string]int)
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RPC URL: %w", err)
+		}
+		if len(urls) > 1 && u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("RPC failover only supports http and https URLs, got %s", u.Scheme)
+		}
+		client, err := ethclient.DialContext(ctx, rawURL)
+		if err != nil {
+			return nil, err
+		}
+		// the URL may hold an API key, so endpoints are named after their host
+		names[u.Host]++
+		name := u.Host
+		if names[u.Host] > 1 {
+			name = fmt.Sprintf("%s#%d", u.Host, names[u.Host])
+		}
+		pool.endpoints = append(pool.endpoints, &endpoint{name: name, url: u, client: client})
+	}
+
+	if len(pool.endpoints) == 1 {
+		pool.client = pool.endpoints[0].client
+		return pool, nil
+	}
+
+	rpcClient, err := rpc.DialOptions(ctx, urls[0], rpc.WithHTTPClient(&http.Client{
+		Transport: &failoverTransport{pool: pool, base: http.DefaultTransport},
+	}))
+	if err != nil {
+		return nil, err
+	}
+	pool.client = ethclient.NewClient(rpcClient)
+	return pool, nil
+}
+
+// Client returns an ethereum client that sends every request to the healthiest endpoint
+func (p *EndpointPool) Client() *ethclient.Client {
+	return p.client
+}
+
+// Run probes the head block of every endpoint every HealthCheckPeriod until ctx is done or Stop is called.
+// Calling Run again stops the previous probes.
+func (p *EndpointPool) Run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	p.mu.Lock()
+	if p.stop != nil {
+		p.stop()
+	}
+	p.stop = cancel
+	p.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(p.cfg.HealthCheckPeriod)
+		defer ticker.Stop()
+		p.Probe(ctx)
+		for {
+			select {
+			case <-ticker.C:
+				p.Probe(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the health probes started by Run
+func (p *EndpointPool) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stop != nil {
+		p.stop()
+		p.stop = nil
+	}
+}
+
+// Probe requests the head block of every endpoint and updates their health metrics
+func (p *EndpointPool) Probe(ctx context.Context) {
+	var wg sync.WaitGroup
+	heads := make([]uint64, len(p.endpoints))
+	for i, e := range p.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			_ctx, cancel := context.WithTimeout(ctx, p.cfg.HealthCheckPeriod)
+			defer cancel()
+			start := time.Now()
+			head, err := e.client.BlockNumber(_ctx)
+			e.record(time.Since(start), err)
+			e.mu.Lock()
+			e.probeErr = err
+			if err == nil {
+				e.head = head
+			}
+			heads[i] = e.head
+			e.mu.Unlock()
+		}(i, e)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for _, head := range heads {
+		if head > highest {
+			highest = head
+		}
+	}
+	for i, e := range p.endpoints {
+		e.mu.Lock()
+		e.lag = highest - heads[i]
+		e.mu.Unlock()
+		if err := p.check(e); err != nil {
+			log.Warn(ctx, "RPC endpoint unhealthy", "endpoint", e.name, "err", err)
+		}
+	}
+}
+
+// Monitors returns a health check per endpoint, that fails while the endpoint is unhealthy
+func (p *EndpointPool) Monitors() map[string]func(ctx context.Context) error {
+	monitors := make(map[string]func(ctx context.Context) error, len(p.endpoints))
+	for _, e := range p.endpoints {
+		e := e
+		monitors[e.name] = func(context.Context) error { return p.check(e) }
+	}
+	return monitors
+}
+
+// ordered returns the healthy endpoints sorted by score, followed by the unhealthy ones as a last resort
+func (p *EndpointPool) ordered() []*endpoint {
+	type scored struct {
+		endpoint *endpoint
+		healthy  bool
+		score    float64
+	}
+	candidates := make([]scored, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		e.mu.RLock()
+		candidates = append(candidates, scored{
+			endpoint: e,
+			healthy:  p.checkLocked(e) == nil,
+			score:    float64(e.latency) * (1 + e.errorRate),
+		})
+		e.mu.RUnlock()
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].healthy != candidates[j].healthy {
+			return candidates[i].healthy
+		}
+		return candidates[i].healthy && candidates[i].score < candidates[j].score
+	})
+	endpoints := make([]*endpoint, 0, len(candidates))
+	for _, c := range candidates {
+		endpoints = append(endpoints, c.endpoint)
+	}
+	return endpoints
+}
+
+func (p *EndpointPool) check(e *endpoint) error {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return p.checkLocked(e)
+}
+
+func (p *EndpointPool) checkLocked(e *endpoint) error {
+	switch {
+	case e.probeErr != nil:
+		return fmt.Errorf("%w: %s", ErrEndpointUnhealthy, e.probeErr)
+	case e.lag > p.cfg.MaxBlockLag:
+		return fmt.Errorf("%w: %d blocks behind", ErrEndpointUnhealthy, e.lag)
+	case e.errorRate > p.cfg.MaxErrorRate:
+		return fmt.Errorf("%w: error rate %.2f", ErrEndpointUnhealthy, e.errorRate)
+	}
+	return nil
+}
+
+// record updates the latency and error rate moving averages of the endpoint
+func (e *endpoint) record(latency time.Duration, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	sample := 0.0
+	if err != nil {
+		sample = 1
+	} else if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration((1-ewmaWeight)*float64(e.latency) + ewmaWeight*float64(latency))
+	}
+	e.errorRate = (1-ewmaWeight)*e.errorRate + ewmaWeight*sample
+}
+
+// failoverTransport sends the JSON-RPC requests to the endpoints of the pool in order of health, moving to the next
+// one on transport errors, rate limiting and server errors. JSON-RPC errors are returned as they are.
+type failoverTransport struct {
+	pool *EndpointPool
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var lastErr error
+	for _, e := range t.pool.ordered() {
+		endpointReq := req.Clone(req.Context())
+		endpointReq.URL = e.url
+		endpointReq.Host = e.url.Host
+		endpointReq.Body = io.NopCloser(bytes.NewReader(body))
+		endpointReq.ContentLength = int64(len(body))
+
+		start := time.Now()
+		resp, err := t.base.RoundTrip(endpointReq)
+		if err == nil && resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode < http.StatusInternalServerError {
+			e.record(time.Since(start), nil)
+			return resp, nil
+		}
+		if err == nil {
+			_ = resp.Body.Close()
+			err = fmt.Errorf("status %s", resp.Status)
+		}
+		e.record(time.Since(start), err)
+		lastErr = fmt.Errorf("%s: %w", e.name, err)
+		if req.Context().Err() != nil {
+			break
+		}
+		log.Warn(req.Context(), "RPC request failed, trying next endpoint", "endpoint", e.name, "err", err)
+	}
+	return nil, lastErr
+}
+
+// quorumCall runs fn against every endpoint, healthiest first, until quorum of them return the same result key.
+func quorumCall[T any](ctx context.Context, p *EndpointPool, quorum int, key func(T) string, fn func(context.Context, *ethclient.Client) (T, error)) (T, error) {
+	var (
+		zero   T
+		errs   []string
+		counts = make(map[string]int)
+	)
+	for _, e := range p.ordered() {
+		res, err := fn(ctx, e.client)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", e.name, err))
+			continue
+		}
+		k := key(res)
+		counts[k]++
+		if counts[k] >= quorum {
+			return res, nil
+		}
+	}
+	if len(errs) > 0 {
+		return zero, fmt.Errorf("%w: %s", ErrQuorumNotReached, strings.Join(errs, "; "))
+	}
+	return zero, ErrQuorumNotReached
+}
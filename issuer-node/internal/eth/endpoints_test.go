@@ -0,0 +1,152 @@
+package eth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRPC is a RPC endpoint that only answers eth_blockNumber
+type fakeRPC struct {
+	head     atomic.Uint64
+	down     atomic.Bool
+	requests atomic.Int64
+}
+
+func newFakeRPC(t *testing.T, head uint64) (*fakeRPC, string) {
+	t.Helper()
+	f := &fakeRPC{}
+	f.head.Store(head)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.requests.Add(1)
+		if f.down.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var req struct {
+			ID     json.RawMessage `json:"id"`
+			Method string          `json:"method"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"0x%x"}`, req.ID, f.head.Load())
+	}))
+	t.Cleanup(server.Close)
+	return f, server.URL
+}
+
+func TestEndpointPool_Failover(t *testing.T) {
+	ctx := context.Background()
+	primary, primaryURL := newFakeRPC(t, 100)
+	fallback, fallbackURL := newFakeRPC(t, 100)
+
+	pool, err := NewEndpointPool(ctx, []string{primaryURL, fallbackURL}, FailoverConfig{MaxBlockLag: 2})
+	require.NoError(t, err)
+
+	t.Run("should use the first endpoint while it is healthy", func(t *testing.T) {
+		head, err := pool.Client().BlockNumber(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(100), head)
+		assert.Equal(t, int64(1), primary.requests.Load())
+		assert.Equal(t, int64(0), fallback.requests.Load())
+	})
+
+	t.Run("should fail over to the next endpoint on server errors", func(t *testing.T) {
+		primary.down.Store(true)
+		fallback.head.Store(101)
+		head, err := pool.Client().BlockNumber(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(101), head)
+	})
+
+	t.Run("should report the endpoints that fail or lag behind as unhealthy", func(t *testing.T) {
+		pool.Probe(ctx)
+		monitors := pool.Monitors()
+		require.Len(t, monitors, 2)
+		unhealthy := 0
+		for _, monitor := range monitors {
+			if err := monitor(ctx); err != nil {
+				assert.ErrorIs(t, err, ErrEndpointUnhealthy)
+				unhealthy++
+			}
+		}
+		assert.Equal(t, 1, unhealthy)
+
+		primary.down.Store(false)
+		pool.Probe(ctx)
+		for name, monitor := range pool.Monitors() {
+			assert.NoError(t, monitor(ctx), "%s should be healthy one block behind", name)
+		}
+	})
+
+	t.Run("should prefer the healthy endpoints", func(t *testing.T) {
+		primary.head.Store(90)
+		pool.Probe(ctx)
+		fallbackRequests := fallback.requests.Load()
+		_, err := pool.Client().BlockNumber(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, fallbackRequests+1, fallback.requests.Load())
+	})
+
+	t.Run("should return an error when every endpoint fails", func(t *testing.T) {
+		primary.down.Store(true)
+		fallback.down.Store(true)
+		_, err := pool.Client().BlockNumber(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestQuorumCall(t *testing.T) {
+	ctx := context.Background()
+	_, url1 := newFakeRPC(t, 100)
+	_, url2 := newFakeRPC(t, 100)
+	lagging, url3 := newFakeRPC(t, 90)
+
+	pool, err := NewEndpointPool(ctx, []string{url3, url1, url2}, FailoverConfig{})
+	require.NoError(t, err)
+	blockNumber := func(ctx context.Context, client *ethclient.Client) (uint64, error) {
+		return client.BlockNumber(ctx)
+	}
+	key := func(head uint64) string { return fmt.Sprint(head) }
+
+	head, err := quorumCall(ctx, pool, 2, key, blockNumber)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), head)
+
+	_, err = quorumCall(ctx, pool, 3, key, blockNumber)
+	assert.ErrorIs(t, err, ErrQuorumNotReached)
+
+	lagging.down.Store(true)
+	_, err = quorumCall(ctx, pool, 3, key, blockNumber)
+	assert.True(t, errors.Is(err, ErrQuorumNotReached))
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestEndpointPool_Stop(t *testing.T) {
+	ctx := context.Background()
+	rpc, url := newFakeRPC(t, 100)
+	pool, err := NewEndpointPool(ctx, []string{url}, FailoverConfig{HealthCheckPeriod: 10 * time.Millisecond})
+	require.NoError(t, err)
+
+	pool.Run(ctx)
+	require.Eventually(t, func() bool { return rpc.requests.Load() >= 2 }, time.Second, 5*time.Millisecond)
+
+	pool.Stop()
+	time.Sleep(20 * time.Millisecond)
+	requests := rpc.requests.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, requests, rpc.requests.Load(), "no probe after Stop")
+}
@@ -0,0 +1,218 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	// baseFeeMultiplier is applied to the base fee to compute the fee cap, so the transaction
+	// stays valid for a few blocks with increasing base fee. Same value used by bind.TransactOpts.
+	baseFeeMultiplier = 2
+	percentBase       = 100
+)
+
+// ReleaseNonce gives back the nonce reserved in opts when the transaction could not be sent
+func (c *Client) ReleaseNonce(opts *bind.TransactOpts) {
+	if opts == nil || opts.Nonce == nil {
+		return
+	}
+	c.nonces.Release(opts.From, opts.Nonce.Uint64())
+}
+
+// ReleaseNonceAfterError gives back the nonce reserved in opts when sending the transaction failed with err.
+// If the node refused the nonce, the tracked one is out of sync and is reset, so the next transaction uses the node
+// pending nonce.
+func (c *Client) ReleaseNonceAfterError(opts *bind.TransactOpts, err error) {
+	if opts == nil || opts.Nonce == nil {
+		return
+	}
+	if IsNonceError(err) {
+		ctx := opts.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		log.Warn(ctx, "transaction nonce refused by the node, resetting the tracked nonce", "err", err, "account", opts.From, "nonce", opts.Nonce)
+		c.nonces.Reset(opts.From)
+		return
+	}
+	c.nonces.Release(opts.From, opts.Nonce.Uint64())
+}
+
+// GetStuckTxTimeout returns the time a transaction can stay pending before its fees are bumped.
+// Zero means that stuck transactions are never replaced.
+func (c *Client) GetStuckTxTimeout() time.Duration {
+	return c.Config.StuckTxTimeout
+}
+
+// BaseFee returns the base fee of the latest block
+func (c *Client) BaseFee(ctx context.Context) (*big.Int, error) {
+	header, err := c.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if header.BaseFee == nil {
+		return big.NewInt(0), nil
+	}
+	return header.BaseFee, nil
+}
+
+// IsBaseFeeAboveCeiling returns true if the network base fee is above the configured MaxBaseFee.
+// It always returns false when no ceiling is configured.
+func (c *Client) IsBaseFeeAboveCeiling(ctx context.Context) (bool, *big.Int, error) {
+	if !isPositive(c.Config.MaxBaseFee) {
+		return false, nil, nil
+	}
+	baseFee, err := c.BaseFee(ctx)
+	if err != nil {
+		return false, nil, err
+	}
+	return baseFee.Cmp(c.Config.MaxBaseFee) == Gt, baseFee, nil
+}
+
+// BumpTransaction re-sends a pending transaction with the same nonce and higher fees, so it
+// replaces the original one in the mempool. The new transaction is signed with kmsKey, that must be
+// the key used to sign the original transaction.
+func (c *Client) BumpTransaction(ctx context.Context, kmsKey kms.KeyID, txID string) (*types.Transaction, error) {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	tx, isPending, err := c.client.TransactionByHash(_ctx, common.HexToHash(txID))
+	if err != nil {
+		return nil, err
+	}
+	if !isPending {
+		return nil, ErrTransactionNotPending
+	}
+
+	addr, err := c.getAddress(kmsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cid, err := c.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chainID: %v", err)
+	}
+
+	var replacement *types.Transaction
+	if tx.Type() == types.LegacyTxType {
+		gasPrice, err := c.getGasPrice(ctx)
+		if err != nil {
+			return nil, err
+		}
+		gasPrice = maxBigInt(gasPrice, c.bump(tx.GasPrice()))
+		replacement = types.NewTx(&types.LegacyTx{
+			Nonce:    tx.Nonce(),
+			GasPrice: gasPrice,
+			Gas:      tx.Gas(),
+			To:       tx.To(),
+			Value:    tx.Value(),
+			Data:     tx.Data(),
+		})
+	} else {
+		tip, err := c.suggestGasTipCap(ctx)
+		if err != nil {
+			return nil, err
+		}
+		baseFee, err := c.BaseFee(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tip = maxBigInt(tip, c.bump(tx.GasTipCap()))
+		feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(baseFeeMultiplier)), tip)
+		feeCap = maxBigInt(feeCap, c.bump(tx.GasFeeCap()))
+		if isPositive(c.Config.MaxFeePerGas) && feeCap.Cmp(c.Config.MaxFeePerGas) == Gt {
+			feeCap = new(big.Int).Set(c.Config.MaxFeePerGas)
+			if feeCap.Cmp(minBump(tx.GasFeeCap())) == Lt || feeCap.Cmp(tip) == Lt {
+				return nil, ErrFeeCapReached
+			}
+		}
+		replacement = types.NewTx(&types.DynamicFeeTx{
+			ChainID:    cid,
+			Nonce:      tx.Nonce(),
+			GasTipCap:  tip,
+			GasFeeCap:  feeCap,
+			Gas:        tx.Gas(),
+			To:         tx.To(),
+			Value:      tx.Value(),
+			Data:       tx.Data(),
+			AccessList: tx.AccessList(),
+		})
+	}
+
+	signed, err := c.signerFnFactory(ctx, kmsKey)(addr, replacement)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.SendRawTx(ctx, signed); err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "pending transaction replaced", "oldTx", txID, "newTx", signed.Hash().Hex(), "nonce", signed.Nonce(),
+		"gasTipCap", signed.GasTipCap(), "gasFeeCap", signed.GasFeeCap())
+	return signed, nil
+}
+
+// cappedFeeCap returns the fee cap for a new transaction with the given tip, limited by MaxFeePerGas
+func (c *Client) cappedFeeCap(ctx context.Context, tip *big.Int) (*big.Int, error) {
+	baseFee, err := c.BaseFee(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feeCap := new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(baseFeeMultiplier)), tip)
+	if feeCap.Cmp(c.Config.MaxFeePerGas) == Gt {
+		log.Debug(ctx, "fee cap limited by configuration", "feeCap", feeCap, "maxFeePerGas", c.Config.MaxFeePerGas)
+		feeCap.Set(c.Config.MaxFeePerGas)
+	}
+	if feeCap.Cmp(tip) == Lt {
+		return nil, ErrFeeCapReached
+	}
+	return feeCap, nil
+}
+
+func (c *Client) pendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	_ctx, cancel := context.WithTimeout(ctx, c.Config.RPCResponseTimeout)
+	defer cancel()
+	return c.client.PendingNonceAt(_ctx, account)
+}
+
+// bump increases value by the configured fee bump percent
+func (c *Client) bump(value *big.Int) *big.Int {
+	percent := c.Config.FeeBumpPercent
+	if percent == 0 {
+		percent = defaultFeeBumpPercent
+	}
+	if percent < minFeeBumpPercent {
+		percent = minFeeBumpPercent
+	}
+	return increasePercent(value, percent)
+}
+
+func minBump(value *big.Int) *big.Int {
+	return increasePercent(value, minFeeBumpPercent)
+}
+
+func increasePercent(value *big.Int, percent int) *big.Int {
+	inc := new(big.Int).Mul(value, big.NewInt(int64(percent)))
+	inc.Div(inc, big.NewInt(percentBase))
+	return inc.Add(inc, value)
+}
+
+func maxBigInt(a, b *big.Int) *big.Int {
+	if a.Cmp(b) == Lt {
+		return b
+	}
+	return a
+}
+
+func isPositive(value *big.Int) bool {
+	return value != nil && value.Sign() > 0
+}
@@ -0,0 +1,197 @@
+package eth
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+const publishingKeyPath = "pbkey"
+
+func TestClient_BumpTransaction(t *testing.T) {
+	ctx := context.Background()
+	backend, client, from := newSimulatedClient(t, &ClientConfig{FeeBumpPercent: 20})
+	keyID := kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath}
+
+	tx := sendTransfer(t, ctx, client, keyID)
+
+	t.Run("should replace a pending transaction with the same nonce and higher fees", func(t *testing.T) {
+		replacement, err := client.BumpTransaction(ctx, keyID, tx.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, tx.Nonce(), replacement.Nonce())
+		assert.True(t, replacement.GasTipCap().Cmp(increasePercent(tx.GasTipCap(), 20)) >= 0)
+		assert.True(t, replacement.GasFeeCap().Cmp(increasePercent(tx.GasFeeCap(), 20)) >= 0)
+
+		backend.Commit()
+
+		receipt, err := client.GetTransactionReceiptByID(ctx, replacement.Hash().Hex())
+		require.NoError(t, err)
+		assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+		_, err = client.GetTransactionReceiptByID(ctx, tx.Hash().Hex())
+		assert.Error(t, err)
+
+		nonce, err := client.GetEthereumClient().NonceAt(ctx, from, nil)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), nonce)
+	})
+
+	t.Run("should not replace a mined transaction", func(t *testing.T) {
+		mined := sendTransfer(t, ctx, client, keyID)
+		backend.Commit()
+		_, err := client.BumpTransaction(ctx, keyID, mined.Hash().Hex())
+		assert.ErrorIs(t, err, ErrTransactionNotPending)
+	})
+}
+
+func TestClient_BumpTransactionFeeCap(t *testing.T) {
+	ctx := context.Background()
+	_, client, _ := newSimulatedClient(t, &ClientConfig{})
+	keyID := kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath}
+
+	tx := sendTransfer(t, ctx, client, keyID)
+	client.Config.MaxFeePerGas = new(big.Int).Set(tx.GasFeeCap())
+
+	_, err := client.BumpTransaction(ctx, keyID, tx.Hash().Hex())
+	assert.ErrorIs(t, err, ErrFeeCapReached)
+}
+
+func TestClient_IsBaseFeeAboveCeiling(t *testing.T) {
+	ctx := context.Background()
+	_, client, _ := newSimulatedClient(t, &ClientConfig{})
+
+	above, _, err := client.IsBaseFeeAboveCeiling(ctx)
+	require.NoError(t, err)
+	assert.False(t, above, "no ceiling configured")
+
+	client.Config.MaxBaseFee = big.NewInt(1)
+	above, baseFee, err := client.IsBaseFeeAboveCeiling(ctx)
+	require.NoError(t, err)
+	assert.True(t, above)
+	assert.True(t, baseFee.Cmp(big.NewInt(1)) > 0)
+
+	client.Config.MaxBaseFee = new(big.Int).Mul(baseFee, big.NewInt(10))
+	above, _, err = client.IsBaseFeeAboveCeiling(ctx)
+	require.NoError(t, err)
+	assert.False(t, above)
+}
+
+func TestClient_CreateTxOptsNonces(t *testing.T) {
+	ctx := context.Background()
+	backend, client, _ := newSimulatedClient(t, &ClientConfig{})
+	keyID := kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath}
+
+	first, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	second, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), first.Nonce.Uint64())
+	assert.Equal(t, uint64(1), second.Nonce.Uint64(), "parallel transactions must not share the nonce")
+
+	client.ReleaseNonce(second)
+	third, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), third.Nonce.Uint64())
+
+	client.nonces.Reset(first.From)
+	backend.Commit()
+	fourth, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), fourth.Nonce.Uint64(), "nothing was sent, the pending nonce is used after reset")
+
+	t.Run("should reset the tracked nonce when the node refuses it", func(t *testing.T) {
+		fifth, err := client.CreateTxOpts(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(1), fifth.Nonce.Uint64())
+
+		client.ReleaseNonceAfterError(fourth, errors.New("nonce too low: address 0x01, tx: 0 state: 3"))
+		sixth, err := client.CreateTxOpts(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(0), sixth.Nonce.Uint64(), "the pending nonce of the node is used after a nonce error")
+	})
+
+	t.Run("should only give back the nonce on other errors", func(t *testing.T) {
+		seventh, err := client.CreateTxOpts(ctx, keyID)
+		require.NoError(t, err)
+		client.ReleaseNonceAfterError(seventh, errors.New("execution reverted"))
+		eighth, err := client.CreateTxOpts(ctx, keyID)
+		require.NoError(t, err)
+		assert.Equal(t, seventh.Nonce.Uint64(), eighth.Nonce.Uint64())
+	})
+}
+
+func newSimulatedClient(t *testing.T, cfg *ClientConfig) (*simulated.Backend, *Client, common.Address) {
+	t.Helper()
+	return newSimulatedClientWithAlloc(t, cfg, types.GenesisAlloc{})
+}
+
+// newSimulatedClientWithAlloc starts a simulated chain with the given accounts and a funded publishing key
+func newSimulatedClientWithAlloc(t *testing.T, cfg *ClientConfig, alloc types.GenesisAlloc) (*simulated.Backend, *Client, common.Address) {
+	t.Helper()
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(privKey.PublicKey)
+
+	ipcPath := filepath.Join(t.TempDir(), "sim.ipc")
+	alloc[from] = types.Account{Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))}
+	backend := simulated.NewBackend(alloc, func(nodeConf *node.Config, _ *ethconfig.Config) {
+		nodeConf.IPCPath = ipcPath
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+
+	ethClient, err := ethclient.Dial(ipcPath)
+	require.NoError(t, err)
+	t.Cleanup(ethClient.Close)
+
+	keysFile := filepath.Join(t.TempDir(), "kms_localstorage_keys.json")
+	content := fmt.Sprintf(`[{"key_type":"ethereum","key_path":%q,"private_key":%q}]`, publishingKeyPath, hex.EncodeToString(crypto.FromECDSA(privKey)))
+	require.NoError(t, os.WriteFile(keysFile, []byte(content), 0o600))
+	keyStore := kms.NewKMS()
+	require.NoError(t, keyStore.RegisterKeyProvider(kms.KeyTypeEthereum, kms.NewLocalEthKeyProvider(kms.KeyTypeEthereum, kms.NewFileStorageManager(keysFile))))
+
+	cfg.DefaultGasLimit = 21000
+	cfg.RPCResponseTimeout = 5 * time.Second
+	return backend, NewClient(ethClient, cfg, keyStore), from
+}
+
+func sendTransfer(t *testing.T, ctx context.Context, client *Client, keyID kms.KeyID) *types.Transaction {
+	t.Helper()
+	opts, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	baseFee, err := client.BaseFee(ctx)
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	tx, err := opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     opts.Nonce.Uint64(),
+		GasTipCap: opts.GasTipCap,
+		GasFeeCap: new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), opts.GasTipCap),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	}))
+	require.NoError(t, err)
+	require.NoError(t, client.SendRawTx(ctx, tx))
+	return tx
+}
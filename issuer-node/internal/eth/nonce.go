@@ -0,0 +1,86 @@
+package eth
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PendingNonceFn returns the pending nonce of an account as seen by the node
+type PendingNonceFn func(ctx context.Context, account common.Address) (uint64, error)
+
+// NonceTracker keeps track of the nonces handed out per sending account, so that parallel issuers
+// publishing with the same key don't build transactions with the same nonce before the previous
+// one reaches the node mempool.
+type NonceTracker struct {
+	mu   sync.Mutex
+	next map[common.Address]uint64
+}
+
+// NewNonceTracker creates a new NonceTracker
+func NewNonceTracker() *NonceTracker {
+	return &NonceTracker{
+		next: make(map[common.Address]uint64),
+	}
+}
+
+// Acquire returns the nonce to be used in the next transaction sent by account.
+// It takes the highest value between the pending nonce reported by the node and the locally tracked one.
+func (n *NonceTracker) Acquire(ctx context.Context, account common.Address, pendingNonce PendingNonceFn) (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	nonce, err := pendingNonce(ctx, account)
+	if err != nil {
+		return 0, err
+	}
+
+	if local, ok := n.next[account]; ok && local > nonce {
+		nonce = local
+	}
+	n.next[account] = nonce + 1
+	return nonce, nil
+}
+
+// Release gives back a nonce that was acquired but not used because the transaction was never sent.
+// It only has effect if nonce is the last one handed out for the account.
+func (n *NonceTracker) Release(account common.Address, nonce uint64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if local, ok := n.next[account]; ok && local == nonce+1 {
+		n.next[account] = nonce
+	}
+}
+
+// Reset forgets the locally tracked nonce for account, so the next Acquire uses the node pending nonce.
+func (n *NonceTracker) Reset(account common.Address) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	delete(n.next, account)
+}
+
+// nonceErrors are the errors returned by the nodes when the nonce of a transaction is already used or ahead of the
+// account nonce, meaning the tracked nonce is out of sync with the node
+var nonceErrors = []string{
+	"nonce too low",
+	"nonce too high",
+	"replacement transaction underpriced",
+	"already known",
+}
+
+// IsNonceError returns true if the transaction was refused by the node because of its nonce
+func IsNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, nonceErr := range nonceErrors {
+		if strings.Contains(msg, nonceErr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+const (
+	// OnchainIdentityAddClaimMethod is the identity contract method that adds a claim to the claims tree
+	// and transits the identity state in the same transaction.
+	OnchainIdentityAddClaimMethod = "addClaimHashAndTransit"
+	// OnchainIdentityGetCredentialMethod is the identity contract method the holders call to fetch their credentials
+	OnchainIdentityGetCredentialMethod = "getCredential"
+	// OnchainIdentityOwnerMethod is the identity contract method that returns the owner of the contract
+	OnchainIdentityOwnerMethod = "owner"
+)
+
+// onchainIdentityABI is the subset of the OnchainIdentity (IdentityBase) contract ABI used by the issuer.
+// The outputs of getCredential are not decoded by the issuer, only its method id is offered to the holders.
+const onchainIdentityABI = `[{"inputs":[{"internalType":"uint256","name":"hashIndex","type":"uint256"},{"internalType":"uint256","name":"hashValue","type":"uint256"}],"name":"addClaimHashAndTransit","outputs":[],"stateMutability":"nonpayable","type":"function"},{"inputs":[{"internalType":"uint256","name":"_userId","type":"uint256"},{"internalType":"uint256","name":"_credentialId","type":"uint256"}],"name":"getCredential","outputs":[],"stateMutability":"view","type":"function"},{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"}]`
+
+// ErrNoIdentityContract is returned when there is no contract at the address of an identity contract
+var ErrNoIdentityContract = errors.New("no identity contract at the address")
+
+// OnchainIdentityABI returns the parsed ABI of the identity contract methods used by the issuer
+func OnchainIdentityABI() (abi.ABI, error) {
+	return abi.JSON(strings.NewReader(onchainIdentityABI))
+}
+
+// AddClaimHashAndTransit sends a transaction that adds the claim with the given index and value hashes
+// to the claims tree of the identity contract. The transaction is signed with the kmsKey, that must be the
+// owner of the contract.
+func (c *Client) AddClaimHashAndTransit(ctx context.Context, kmsKey kms.KeyID, contract common.Address, hashIndex, hashValue *big.Int) (*types.Transaction, error) {
+	parsed, err := OnchainIdentityABI()
+	if err != nil {
+		return nil, err
+	}
+
+	opts, err := c.CreateTxOpts(ctx, kmsKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tx opts: %w", err)
+	}
+	if opts.Context == nil {
+		opts.Context = ctx
+	}
+
+	binding := bind.NewBoundContract(contract, parsed, c.client, c.client, c.client)
+	tx, err := binding.Transact(opts, OnchainIdentityAddClaimMethod, hashIndex, hashValue)
+	if err != nil {
+		c.ReleaseNonceAfterError(opts, err)
+		return nil, err
+	}
+	return tx, nil
+}
+
+// OnchainIdentityOwner returns the owner of the identity contract, the only account allowed to add claims to it
+func (c *Client) OnchainIdentityOwner(ctx context.Context, contract common.Address) (common.Address, error) {
+	parsed, err := OnchainIdentityABI()
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	var out []interface{}
+	binding := bind.NewBoundContract(contract, parsed, c.client, c.client, c.client)
+	if err := binding.Call(&bind.CallOpts{Context: ctx}, &out, OnchainIdentityOwnerMethod); err != nil {
+		if errors.Is(err, bind.ErrNoCode) {
+			return common.Address{}, ErrNoIdentityContract
+		}
+		return common.Address{}, err
+	}
+	owner, ok := out[0].(common.Address)
+	if !ok {
+		return common.Address{}, fmt.Errorf("unexpected owner of the identity contract %v", out[0])
+	}
+	return owner, nil
+}
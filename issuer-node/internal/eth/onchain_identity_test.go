@@ -0,0 +1,113 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// identityContractCode is the creation code of an identity contract with the access control of the OnchainIdentity
+// (IdentityBase) one: the deployer is the owner, returned by owner(), and addClaimHashAndTransit reverts unless it
+// is sent by the owner. The claims tree is simplified to a mapping of the hashIndex to the hashValue,
+// SSTORE(keccak256(hashIndex), hashValue), so the test can read the added claim from the contract storage.
+var identityContractCode = common.FromHex("0x336000556048601060003960486000f360003560e01c80638da5cb5b14601d5763961fc41614602a57600080fd5b5060005460005260206000f35b6000543314603757600080fd5b60243560043560005260206000205500")
+
+// deployIdentityContract deploys the identity contract owned by the kms key and returns its address
+func deployIdentityContract(t *testing.T, ctx context.Context, backend *simulated.Backend, client *Client, keyID kms.KeyID) common.Address {
+	t.Helper()
+	parsed, err := OnchainIdentityABI()
+	require.NoError(t, err)
+	opts, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	opts.Context = ctx
+	contract, _, _, err := bind.DeployContract(opts, parsed, identityContractCode, client.GetEthereumClient())
+	require.NoError(t, err)
+	backend.Commit()
+	return contract
+}
+
+func TestClient_AddClaimHashAndTransit(t *testing.T) {
+	ctx := context.Background()
+	backend, client, from := newSimulatedClientWithAlloc(t, &ClientConfig{}, types.GenesisAlloc{})
+	keyID := kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath}
+	contract := deployIdentityContract(t, ctx, backend, client, keyID)
+	hashIndex, hashValue := big.NewInt(1234), big.NewInt(5678)
+
+	tx, err := client.AddClaimHashAndTransit(ctx, keyID, contract, hashIndex, hashValue)
+	require.NoError(t, err)
+	backend.Commit()
+
+	receipt, err := client.GetTransactionReceiptByID(ctx, tx.Hash().Hex())
+	require.NoError(t, err)
+	assert.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	t.Run("should call the contract method signed by the issuer key", func(t *testing.T) {
+		signer := types.LatestSignerForChainID(tx.ChainId())
+		sender, err := types.Sender(signer, tx)
+		require.NoError(t, err)
+		assert.Equal(t, from, sender)
+		assert.Equal(t, contract, *tx.To())
+
+		identityABI := parsed(t)
+		method, err := identityABI.MethodById(tx.Data()[:4])
+		require.NoError(t, err)
+		assert.Equal(t, OnchainIdentityAddClaimMethod, method.Name)
+		args, err := method.Inputs.Unpack(tx.Data()[4:])
+		require.NoError(t, err)
+		assert.Equal(t, []interface{}{hashIndex, hashValue}, args)
+	})
+
+	t.Run("should add the claim to the contract", func(t *testing.T) {
+		slot := crypto.Keccak256Hash(common.BigToHash(hashIndex).Bytes())
+		stored, err := client.GetEthereumClient().StorageAt(ctx, contract, slot, nil)
+		require.NoError(t, err)
+		assert.Equal(t, common.BigToHash(hashValue).Bytes(), stored)
+	})
+
+	t.Run("should only let the owner add claims", func(t *testing.T) {
+		data, err := parsed(t).Pack(OnchainIdentityAddClaimMethod, hashIndex, hashValue)
+		require.NoError(t, err)
+		_, err = client.GetEthereumClient().CallContract(ctx, ethereum.CallMsg{From: common.HexToAddress("0x000000000000000000000000000000000000dEaD"), To: &contract, Data: data}, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestClient_OnchainIdentityOwner(t *testing.T) {
+	ctx := context.Background()
+	backend, client, from := newSimulatedClientWithAlloc(t, &ClientConfig{}, types.GenesisAlloc{})
+	contract := deployIdentityContract(t, ctx, backend, client, kms.KeyID{Type: kms.KeyTypeEthereum, ID: publishingKeyPath})
+
+	owner, err := client.OnchainIdentityOwner(ctx, contract)
+	require.NoError(t, err)
+	assert.Equal(t, from, owner)
+
+	t.Run("should fail when the address is not an identity contract", func(t *testing.T) {
+		_, err := client.OnchainIdentityOwner(ctx, common.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4"))
+		assert.Error(t, err)
+	})
+}
+
+func TestOnchainIdentityABI(t *testing.T) {
+	method, ok := parsed(t).Methods[OnchainIdentityGetCredentialMethod]
+	require.True(t, ok)
+	assert.Equal(t, "37c1d9ff", common.Bytes2Hex(method.ID))
+}
+
+func parsed(t *testing.T) abi.ABI {
+	t.Helper()
+	parsed, err := OnchainIdentityABI()
+	require.NoError(t, err)
+	return parsed
+}
@@ -0,0 +1,97 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/pkg/errors"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/http"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/notifications"
+	"github.com/polygonid/sh-id-platform/internal/packagemanager"
+)
+
+// MessageClient delivers iden3comm messages to the push or DIDComm messaging services of the holder DID document
+type MessageClient struct {
+	conn                *http.Client
+	notificationGateway ports.NotificationGateway
+	packer              *packagemanager.JWEPacker
+	kms                 kms.KMSType
+}
+
+// NewMessageClient creates a message delivery client. The messages posted to DIDComm messaging services are
+// encrypted with the X25519 keys of keyStore.
+func NewMessageClient(conn *http.Client, notificationGateway ports.NotificationGateway, keyStore kms.KMSType) ports.MessageGateway {
+	return &MessageClient{
+		conn:                conn,
+		notificationGateway: notificationGateway,
+		packer:              packagemanager.NewJWEPacker(keyStore, nil),
+		kms:                 keyStore,
+	}
+}
+
+// Deliver sends the message through the push service of the DID document, if any.
+// Otherwise, the message is encrypted to the key agreement key of the DID document and posted to its DIDComm
+// messaging service endpoint. Messages are never posted in plain text.
+func (c *MessageClient) Deliver(ctx context.Context, msg json.RawMessage, userDIDDocument verifiable.DIDDocument) error {
+	if _, err := notifications.FindNotificationService(userDIDDocument); err == nil {
+		res, err := c.notificationGateway.Notify(ctx, msg, userDIDDocument)
+		if err != nil {
+			return err
+		}
+		for _, nr := range res.Devices {
+			if nr.Status != domain.DeviceNotificationStatusSuccess {
+				return fmt.Errorf("push notification %s by device: %s", nr.Status, nr.Reason)
+			}
+		}
+		return nil
+	}
+
+	endpoint, err := notifications.FindDIDCommServiceEndpoint(userDIDDocument)
+	if err != nil {
+		if errors.Is(err, notifications.ErrNoDIDCommService) {
+			return errors.New("no push or DIDComm messaging service in did document")
+		}
+		return err
+	}
+	envelope, err := c.encrypt(ctx, msg, userDIDDocument)
+	if err != nil {
+		return err
+	}
+	if _, err := c.conn.Post(ctx, endpoint, envelope); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// encrypt packs the message in a JWE envelope, authcrypted when the issuer has a X25519 key, anoncrypted otherwise
+func (c *MessageClient) encrypt(ctx context.Context, msg json.RawMessage, userDIDDocument verifiable.DIDDocument) ([]byte, error) {
+	var basicMessage iden3comm.BasicMessage
+	if err := json.Unmarshal(msg, &basicMessage); err != nil {
+		return nil, fmt.Errorf("parsing message: %w", err)
+	}
+	issuerDID, err := w3c.ParseDID(basicMessage.From)
+	if err != nil {
+		return nil, fmt.Errorf("parsing message sender: %w", err)
+	}
+
+	params := packagemanager.JWEPackerParams{RecipientDID: userDIDDocument.ID, RecipientDIDDocument: &userDIDDocument}
+	keyIDs, err := c.kms.KeysByIdentity(ctx, *issuerDID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range keyIDs {
+		if keyIDs[i].Type == kms.KeyTypeX25519 {
+			params.SenderKeyID = &keyIDs[i]
+			break
+		}
+	}
+	return c.packer.Pack(msg, params)
+}
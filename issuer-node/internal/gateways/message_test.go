@@ -0,0 +1,137 @@
+package gateways
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	httpPkg "github.com/polygonid/sh-id-platform/internal/http"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/packagemanager"
+)
+
+const (
+	messageIssuerDID = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+	messageUserDID   = "did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+)
+
+// messageKeys is an in memory key store with X25519 keys
+type messageKeys struct {
+	kms.KMSType
+	keys map[kms.KeyID]*ecdh.PrivateKey
+}
+
+func (k *messageKeys) newKey(t *testing.T, did string) kms.KeyID {
+	t.Helper()
+	privateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyID := kms.KeyID{Type: kms.KeyTypeX25519, ID: did + "/X25519:" + hex.EncodeToString(privateKey.PublicKey().Bytes())}
+	k.keys[keyID] = privateKey
+	return keyID
+}
+
+func (k *messageKeys) KeysByIdentity(_ context.Context, identity w3c.DID) ([]kms.KeyID, error) {
+	var keyIDs []kms.KeyID
+	for keyID := range k.keys {
+		if strings.HasPrefix(keyID.ID, identity.String()+"/") {
+			keyIDs = append(keyIDs, keyID)
+		}
+	}
+	return keyIDs, nil
+}
+
+func (k *messageKeys) KeyAgreement(_ context.Context, keyID kms.KeyID, peerPublicKey []byte) ([]byte, error) {
+	privateKey, ok := k.keys[keyID]
+	if !ok {
+		return nil, kms.ErrKeyNotFound
+	}
+	peerKey, err := ecdh.X25519().NewPublicKey(peerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.ECDH(peerKey)
+}
+
+// keyAgreementDocument returns a DID document with the X25519 key and a DIDComm messaging service, if any
+func keyAgreementDocument(t *testing.T, did string, keyID kms.KeyID, publicKey *ecdh.PublicKey, endpoint string) *verifiable.DIDDocument {
+	t.Helper()
+	kid, err := packagemanager.KeyAgreementKID(keyID)
+	require.NoError(t, err)
+	doc := &verifiable.DIDDocument{
+		ID: did,
+		KeyAgreement: []interface{}{map[string]interface{}{
+			"id":           kid,
+			"type":         "JsonWebKey2020",
+			"controller":   did,
+			"publicKeyJwk": map[string]interface{}{"kty": "OKP", "crv": "X25519", "x": base64.RawURLEncoding.EncodeToString(publicKey.Bytes())},
+		}},
+	}
+	if endpoint != "" {
+		doc.Service = []interface{}{map[string]interface{}{"id": did + "#didcomm", "type": "DIDCommMessaging", "serviceEndpoint": endpoint}}
+	}
+	return doc
+}
+
+func TestMessageClient_Deliver(t *testing.T) {
+	ctx := context.Background()
+	var posted []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		posted, err = io.ReadAll(r.Body)
+		require.NoError(t, err)
+	}))
+	defer server.Close()
+
+	keys := &messageKeys{keys: map[kms.KeyID]*ecdh.PrivateKey{}}
+	userKey := keys.newKey(t, messageUserDID)
+	userDoc := keyAgreementDocument(t, messageUserDID, userKey, keys.keys[userKey].PublicKey(), server.URL)
+	msg, err := json.Marshal(iden3comm.BasicMessage{ID: "1", ThreadID: "1", Type: protocol.CredentialOfferMessageType, From: messageIssuerDID, To: messageUserDID})
+	require.NoError(t, err)
+	client := NewMessageClient(httpPkg.NewClient(*server.Client()), nil, keys)
+
+	t.Run("should anoncrypt the message to the user key agreement key", func(t *testing.T) {
+		require.NoError(t, client.Deliver(ctx, msg, *userDoc))
+		assert.NotContains(t, string(posted), messageIssuerDID)
+
+		unpacked, err := packagemanager.NewJWEPacker(keys, nil).Unpack(posted)
+		require.NoError(t, err)
+		assert.Equal(t, "1", unpacked.ID)
+		assert.Equal(t, protocol.CredentialOfferMessageType, unpacked.Type)
+	})
+
+	t.Run("should authcrypt the message when the issuer has a X25519 key", func(t *testing.T) {
+		issuerKey := keys.newKey(t, messageIssuerDID)
+		defer delete(keys.keys, issuerKey)
+		issuerDoc := keyAgreementDocument(t, messageIssuerDID, issuerKey, keys.keys[issuerKey].PublicKey(), "")
+		require.NoError(t, client.Deliver(ctx, msg, *userDoc))
+
+		resolver := func(did string) (*verifiable.DIDDocument, error) { return issuerDoc, nil }
+		unpacked, err := packagemanager.NewJWEPacker(keys, resolver).Unpack(posted)
+		require.NoError(t, err)
+		assert.Equal(t, messageIssuerDID, unpacked.From)
+	})
+
+	t.Run("should not post the message in plain text", func(t *testing.T) {
+		posted = nil
+		doc := *userDoc
+		doc.KeyAgreement = nil
+		err := client.Deliver(ctx, msg, doc)
+		assert.ErrorIs(t, err, packagemanager.ErrNoKeyAgreementKey)
+		assert.Nil(t, posted)
+	})
+}
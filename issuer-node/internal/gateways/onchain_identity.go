@@ -0,0 +1,89 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+)
+
+// ErrOnchainIssuerKeyNotFound is returned when the controller identity of an onchain issuer has no ethereum key
+var ErrOnchainIssuerKeyNotFound = errors.New("ethereum key of the onchain issuer controller not found")
+
+// OnchainIdentityGateway sends transactions to the identity contracts of the onchain issuers,
+// signed with the ethereum key of the controller identity, that must own the contract.
+type OnchainIdentityGateway struct {
+	networkResolver network.Resolver
+	kms             kms.KMSType
+}
+
+// NewOnchainIdentityGateway creates a new onchain identity gateway
+func NewOnchainIdentityGateway(resolver network.Resolver, keyStore kms.KMSType) ports.OnchainIdentityGateway {
+	return &OnchainIdentityGateway{
+		networkResolver: resolver,
+		kms:             keyStore,
+	}
+}
+
+// AddClaimHashAndTransit adds the claim hashes to the claims tree of the onchain issuer contract and returns the transaction id
+func (g *OnchainIdentityGateway) AddClaimHashAndTransit(ctx context.Context, onchainIssuer *domain.OnchainIssuer, hashIndex, hashValue *big.Int) (string, error) {
+	keyID, err := g.controllerKeyID(ctx, onchainIssuer)
+	if err != nil {
+		return "", err
+	}
+	client, err := g.networkResolver.GetEthClient(onchainIssuer.ResolverPrefix())
+	if err != nil {
+		log.Error(ctx, "failed to get client", "err", err, "onchainIssuer", onchainIssuer.Identifier)
+		return "", err
+	}
+	tx, err := client.AddClaimHashAndTransit(ctx, keyID, common.HexToAddress(onchainIssuer.ContractAddress), hashIndex, hashValue)
+	if err != nil {
+		log.Error(ctx, "failed to add claim to the identity contract", "err", err, "onchainIssuer", onchainIssuer.Identifier)
+		return "", err
+	}
+	return tx.Hash().Hex(), nil
+}
+
+// GetTransactionReceipt returns the receipt of a transaction sent to the onchain issuer contract
+func (g *OnchainIdentityGateway) GetTransactionReceipt(ctx context.Context, onchainIssuer *domain.OnchainIssuer, txID string) (*types.Receipt, error) {
+	client, err := g.networkResolver.GetEthClient(onchainIssuer.ResolverPrefix())
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTransactionReceiptByID(ctx, txID)
+}
+
+// Owner returns the owner of the onchain issuer contract
+func (g *OnchainIdentityGateway) Owner(ctx context.Context, onchainIssuer *domain.OnchainIssuer) (common.Address, error) {
+	client, err := g.networkResolver.GetEthClient(onchainIssuer.ResolverPrefix())
+	if err != nil {
+		return common.Address{}, err
+	}
+	return client.OnchainIdentityOwner(ctx, common.HexToAddress(onchainIssuer.ContractAddress))
+}
+
+func (g *OnchainIdentityGateway) controllerKeyID(ctx context.Context, onchainIssuer *domain.OnchainIssuer) (kms.KeyID, error) {
+	controllerDID, err := w3c.ParseDID(onchainIssuer.ControllerDID)
+	if err != nil {
+		return kms.KeyID{}, err
+	}
+	keyIDs, err := g.kms.KeysByIdentity(ctx, *controllerDID)
+	if err != nil {
+		return kms.KeyID{}, err
+	}
+	for _, keyID := range keyIDs {
+		if keyID.Type == kms.KeyTypeEthereum {
+			return keyID, nil
+		}
+	}
+	return kms.KeyID{}, ErrOnchainIssuerKeyNotFound
+}
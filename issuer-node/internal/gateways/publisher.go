@@ -16,6 +16,8 @@ import (
 	"github.com/iden3/go-merkletree-sql/v2"
 	rstypes "github.com/iden3/go-rapidsnark/types"
 	"github.com/jackc/pgx/v4"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/common"
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
@@ -27,6 +29,7 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/network"
 	"github.com/polygonid/sh-id-platform/internal/pubsub"
 	"github.com/polygonid/sh-id-platform/internal/syncttlmap"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 type jobIDType string
@@ -48,7 +51,14 @@ const (
 
 // PublisherGateway - Define the interface for publishers.
 type PublisherGateway interface {
-	PublishState(ctx context.Context, identifier *w3c.DID, latestState *merkletree.Hash, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*string, error)
+	PublishState(ctx context.Context, identifier *w3c.DID, latestState *merkletree.Hash, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*StateTransitionTx, error)
+}
+
+// StateTransitionTx is the transaction sent to publish a state. RelayTxID is the ID given by the relayer to the
+// relayed transactions, whose hash changes when the relayer re-prices them.
+type StateTransitionTx struct {
+	TxID      string
+	RelayTxID *string
 }
 
 type publisher struct {
@@ -58,6 +68,7 @@ type publisher struct {
 	mtService             ports.MtService
 	kms                   kms.KMSType
 	transactionService    ports.TransactionService
+	transactionHistory    ports.TransactionHistoryService
 	networkResolver       *network.Resolver
 	zkService             ports.ZKGenerator
 	publisherGateway      PublisherGateway
@@ -66,7 +77,7 @@ type publisher struct {
 }
 
 // NewPublisher - Constructor
-func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimService, mtService ports.MtService, kms kms.KMSType, transactionService ports.TransactionService, zkService ports.ZKGenerator, publisherGateway PublisherGateway, networkResolver *network.Resolver, notificationPublisher pubsub.Publisher) *publisher {
+func NewPublisher(storage *db.Storage, identityService ports.IdentityService, claimService ports.ClaimService, mtService ports.MtService, kms kms.KMSType, transactionService ports.TransactionService, transactionHistory ports.TransactionHistoryService, zkService ports.ZKGenerator, publisherGateway PublisherGateway, networkResolver *network.Resolver, notificationPublisher pubsub.Publisher) *publisher {
 	pendingTransactions := syncttlmap.New(ttl)
 	pendingTransactions.CleaningBackground(transactionCleanup)
 
@@ -77,6 +88,7 @@ func NewPublisher(storage *db.Storage, identityService ports.IdentityService, cl
 		mtService:             mtService,
 		kms:                   kms,
 		transactionService:    transactionService,
+		transactionHistory:    transactionHistory,
 		zkService:             zkService,
 		publisherGateway:      publisherGateway,
 		networkResolver:       networkResolver,
@@ -146,6 +158,7 @@ func (p *publisher) publishState(ctx context.Context, identifier *w3c.DID) (*dom
 			log.Error(ctx, "Error saving the state as failed:", "err", err, "did", identifier.String())
 			return nil, errUpdating
 		}
+		telemetry.StateTransitions.WithLabelValues(string(domain.StatusFailed)).Inc()
 		return nil, err
 	}
 
@@ -186,7 +199,18 @@ func (p *publisher) retryPublishFailedState(ctx context.Context, identifier *w3c
 }
 
 // PublishProof publishes new proof using the latest state
-func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newState domain.IdentityState) (*string, error) {
+func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newState domain.IdentityState) (txID *string, err error) {
+	ctx, span := telemetry.Start(ctx, "publish state", trace.WithAttributes(attribute.String("did", identifier.String())))
+	start := time.Now()
+	defer func() {
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		telemetry.PublishingDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+		telemetry.End(span, err)
+	}()
+
 	did, err := w3c.ParseDID(newState.Identifier)
 	if err != nil {
 		return nil, err
@@ -294,10 +318,11 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 
 	// 7. Publish state and receive txID
 
-	txID, err := p.publisherGateway.PublishState(ctx, did, latestStateHash, newStateHash, isLatestStateGenesis, zkProofData, identity)
+	sent, err := p.publisherGateway.PublishState(ctx, did, latestStateHash, newStateHash, isLatestStateGenesis, zkProofData, identity)
 	if err != nil {
 		return nil, err
 	}
+	txID = &sent.TxID
 
 	log.Info(ctx, "Success!", "TxID", txID)
 
@@ -305,11 +330,21 @@ func (p *publisher) publishProof(ctx context.Context, identifier *w3c.DID, newSt
 
 	newState.Status = domain.StatusTransacted
 	newState.TxID = txID
+	newState.RelayTxID = sent.RelayTxID
 
 	err = p.identityService.UpdateIdentityState(ctx, &newState)
 	if err != nil {
 		return nil, err
 	}
+	telemetry.StateTransitions.WithLabelValues(string(domain.StatusTransacted)).Inc()
+
+	// a transaction missing in the history is recorded later with its receipt, so errors are only logged
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+	} else {
+		_ = p.transactionHistory.RecordSent(ctx, *did, resolverPrefix, domain.TransactionTypeStateTransition, *newState.State, *txID)
+	}
 
 	// add go routine that will listen for transaction status update
 
@@ -433,6 +468,8 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 		return err
 	}
 
+	p.recordReceipt(ctx, identity, state, receipt)
+
 	blockNumber := int(receipt.BlockNumber.Int64())
 	state.BlockNumber = &blockNumber
 
@@ -442,6 +479,9 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 	if receipt.Status == types.ReceiptStatusSuccessful {
 		state.Status = domain.StatusConfirmed
 		err = p.claimService.UpdateClaimsMTPAndState(ctx, state)
+		if err == nil {
+			telemetry.StateTransitions.WithLabelValues(string(domain.StatusConfirmed)).Inc()
+		}
 		did, err := w3c.ParseDID(state.Identifier)
 		if err != nil {
 			log.Error(ctx, "error getting did from state: ", "err", err, "state", state.StateID)
@@ -470,6 +510,9 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 	} else {
 		state.Status = domain.StatusFailed
 		err = p.identityService.UpdateIdentityState(ctx, state)
+		if err == nil {
+			telemetry.StateTransitions.WithLabelValues(string(domain.StatusFailed)).Inc()
+		}
 	}
 
 	if err != nil {
@@ -480,6 +523,23 @@ func (p *publisher) updateIdentityStateTxStatus(ctx context.Context, identity *d
 	return nil
 }
 
+// recordReceipt stores the gas used and fee paid by the state transition transaction.
+// Failing to record it must not prevent the state from being updated.
+func (p *publisher) recordReceipt(ctx context.Context, identity *domain.Identity, state *domain.IdentityState, receipt *types.Receipt) {
+	did, err := w3c.ParseDID(state.Identifier)
+	if err != nil {
+		log.Error(ctx, "error getting did from state: ", "err", err, "state", state.StateID)
+		return
+	}
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+		return
+	}
+	telemetry.PublishingGas.WithLabelValues(resolverPrefix).Observe(float64(receipt.GasUsed))
+	_ = p.transactionHistory.RecordReceipt(ctx, *did, resolverPrefix, domain.TransactionTypeStateTransition, *state.State, receipt)
+}
+
 // groupByUserId - groups claims by user id
 func groupByUserId(claims []*domain.Claim) map[string][]string {
 	grouped := make(map[string][]string)
@@ -560,11 +620,14 @@ func (p *publisher) checkStatus(ctx context.Context, identity *domain.Identity,
 	}
 
 	// GetEthClient receipt and check status
-	receipt, err := p.transactionService.GetTransactionReceiptByID(ctx, identity, *state.TxID)
+	receipt, err := p.getStateTransitionReceipt(ctx, identity, state)
 	if err != nil {
 		log.Error(ctx, "error during receipt receiving:", "err", err, "state-id", *state.TxID)
 		return fmt.Errorf("error during receipt receiving::%s: %w", *state.TxID, err)
 	}
+	// the state keeps the hash of the transaction mined, that may be the original one or any of its replacements
+	txID := receipt.TxHash.Hex()
+	state.TxID = &txID
 
 	resolverPrefix, err := identity.GetResolverPrefix()
 	if err != nil {
@@ -599,3 +662,63 @@ func (p *publisher) checkStatus(ctx context.Context, identity *domain.Identity,
 	log.Info(ctx, "transaction status updated", "tx", *state.TxID)
 	return nil
 }
+
+// getStateTransitionReceipt returns the receipt of the state transition transaction. A stuck transaction may have been
+// replaced with the same nonce, so the receipts of all the transactions sent for the state are checked,
+// as any of them can be the one mined.
+func (p *publisher) getStateTransitionReceipt(ctx context.Context, identity *domain.Identity, state *domain.IdentityState) (*types.Receipt, error) {
+	if state.RelayTxID != nil {
+		p.resolveRelayedTxID(ctx, identity, state)
+	}
+
+	receipt, err := p.transactionService.GetTransactionReceiptByID(ctx, identity, *state.TxID)
+	if err == nil {
+		return receipt, nil
+	}
+
+	txIDs, hErr := p.transactionHistory.GetSentTxIDs(ctx, domain.TransactionTypeStateTransition, *state.State)
+	if hErr != nil {
+		log.Error(ctx, "failed to get the transactions sent for the state", "err", hErr, "state", *state.State)
+		return nil, err
+	}
+	for _, txID := range txIDs {
+		if txID == *state.TxID {
+			continue
+		}
+		if receipt, rErr := p.transactionService.GetTransactionReceiptByID(ctx, identity, txID); rErr == nil {
+			log.Info(ctx, "state transition mined with another transaction for the same nonce", "tx", txID, "lastSent", *state.TxID)
+			return receipt, nil
+		}
+	}
+	return nil, err
+}
+
+// resolveRelayedTxID points the state to the current hash of its relayed transaction, that changes when the relayer
+// re-prices it. The previous hash is kept in the history as a replaced transaction.
+func (p *publisher) resolveRelayedTxID(ctx context.Context, identity *domain.Identity, state *domain.IdentityState) {
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+		return
+	}
+	relayerClient, ok := p.networkResolver.GetRelayer(resolverPrefix)
+	if !ok {
+		log.Warn(ctx, "relayed state transition on a network without relayer", "relayerTxID", *state.RelayTxID, "network", resolverPrefix)
+		return
+	}
+	tx, err := relayerClient.Client.Get(ctx, *state.RelayTxID)
+	if err != nil {
+		log.Warn(ctx, "failed to get relayed transaction", "err", err, "relayerTxID", *state.RelayTxID)
+		return
+	}
+	if tx.Hash == "" || tx.Hash == *state.TxID {
+		return
+	}
+
+	log.Info(ctx, "relayed state transition re-priced", "relayerTxID", tx.ID, "tx", tx.Hash, "replaced", *state.TxID)
+	if err := p.transactionHistory.RecordReplacement(ctx, *state.State, *state.TxID, tx.Hash); err != nil {
+		log.Error(ctx, "failed to record re-priced transaction", "err", err, "tx", tx.Hash, "replaced", *state.TxID)
+	}
+	txID := tx.Hash
+	state.TxID = &txID
+}
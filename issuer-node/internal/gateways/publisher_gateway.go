@@ -54,7 +54,7 @@ func newStateService(resolver network.Resolver, to time.Duration, kServ *kms.KMS
 }
 
 // PublishState creates or updates state in the blockchain
-func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*string, error) {
+func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*StateTransitionTx, error) {
 	pb.rw.Lock()
 	defer pb.rw.Unlock()
 
@@ -71,19 +71,11 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 
 	switch identity.KeyType {
 	case string(kms.KeyTypeEthereum):
-		keyIDs, err := pb.kms.KeysByIdentity(ctx, *identifier)
+		sigKeyID, err := pb.signingKeyID(ctx, identity)
 		if err != nil {
 			return nil, err
 		}
 
-		var sigKeyID kms.KeyID
-		for _, v := range keyIDs {
-			if v.Type == kms.KeyTypeEthereum {
-				sigKeyID = v
-				break
-			}
-		}
-
 		ctxWT, cancel := context.WithTimeout(ctx, pb.ethRPCResponseTimeout)
 		defer cancel()
 
@@ -103,17 +95,20 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 		resolverPrefix, err := identity.GetResolverPrefix()
 		if err != nil {
 			log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+			client.ReleaseNonce(opts)
 			return nil, err
 		}
 
 		contractBinding, err := getContractBinding(client, resolverPrefix, pb.networkResolver)
 		if err != nil {
 			log.Error(ctx, "failed to get contract binding", "err", err)
+			client.ReleaseNonce(opts)
 			return nil, err
 		}
 
 		tx, err = contractBinding.TransitStateGeneric(opts, id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, big.NewInt(1), []byte{})
 		if err != nil {
+			client.ReleaseNonceAfterError(opts, err)
 			return nil, err
 		}
 
@@ -136,31 +131,85 @@ func (pb *PublisherEthGateway) PublishState(ctx context.Context, identifier *w3c
 
 		a, b, c, err := pb.adaptProofToAbi(proof)
 		if err != nil {
+			client.ReleaseNonce(opts)
 			return nil, err
 		}
 
 		resolverPrefix, err := identity.GetResolverPrefix()
 		if err != nil {
 			log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+			client.ReleaseNonce(opts)
 			return nil, err
 		}
 
 		contractBinding, err := getContractBinding(client, resolverPrefix, pb.networkResolver)
 		if err != nil {
 			log.Error(ctx, "failed to get contract binding", "err", err)
+			client.ReleaseNonce(opts)
 			return nil, err
 		}
 		tx, err = contractBinding.TransitState(opts, id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, a, b, c)
 		if err != nil {
+			client.ReleaseNonceAfterError(opts, err)
 			return nil, err
 		}
 	default:
 		return nil, errors.New("unsupported key type for publishing")
 	}
 
-	txID := tx.Hash().Hex()
+	return &StateTransitionTx{TxID: tx.Hash().Hex()}, nil
+}
+
+// ReplaceTransaction re-sends the pending state transition transaction txID with the same nonce and a higher tip.
+// It returns the ID of the replacement transaction.
+func (pb *PublisherEthGateway) ReplaceTransaction(ctx context.Context, identity *domain.Identity, txID string) (*string, error) {
+	pb.rw.Lock()
+	defer pb.rw.Unlock()
+
+	sigKeyID, err := pb.signingKeyID(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := getEthClient(ctx, identity, pb.networkResolver)
+	if err != nil {
+		log.Error(ctx, "failed to get client", "err", err)
+		return nil, err
+	}
+
+	tx, err := client.BumpTransaction(ctx, sigKeyID, txID)
+	if err != nil {
+		return nil, err
+	}
+
+	newTxID := tx.Hash().Hex()
+	return &newTxID, nil
+}
 
-	return &txID, nil
+// signingKeyID returns the key used to sign state transitions of the identity.
+// Ethereum controlled identities sign with their own key, the rest with the publishing key.
+func (pb *PublisherEthGateway) signingKeyID(ctx context.Context, identity *domain.Identity) (kms.KeyID, error) {
+	switch identity.KeyType {
+	case string(kms.KeyTypeEthereum):
+		did, err := w3c.ParseDID(identity.Identifier)
+		if err != nil {
+			return kms.KeyID{}, err
+		}
+		keyIDs, err := pb.kms.KeysByIdentity(ctx, *did)
+		if err != nil {
+			return kms.KeyID{}, err
+		}
+		for _, v := range keyIDs {
+			if v.Type == kms.KeyTypeEthereum {
+				return v, nil
+			}
+		}
+		return kms.KeyID{}, nil
+	case string(kms.KeyTypeBabyJubJub):
+		return pb.publishingKeyID, nil
+	default:
+		return kms.KeyID{}, errors.New("unsupported key type for publishing")
+	}
 }
 
 func (pb *PublisherEthGateway) adaptProofToAbi(proof *rstypes.ProofData) (proofA [2]*big.Int, proofB [2][2]*big.Int, proofC [2]*big.Int, err error) {
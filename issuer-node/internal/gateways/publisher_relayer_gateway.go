@@ -0,0 +1,210 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/iden3/contracts-abi/state/go/abi"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	rstypes "github.com/iden3/go-rapidsnark/types"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/relayer"
+)
+
+// ErrRelayerUnsupportedIdentity is returned when an ethereum identity publishes its state on a network whose relayer
+// has no meta-transaction forwarder
+var ErrRelayerUnsupportedIdentity = errors.New("ethereum identities can't publish their state through a relayer without forwarder")
+
+// forwarderGasOverhead is the gas used by the forwarder to verify the meta-transaction before calling the state contract
+const forwarderGasOverhead = 100000
+
+// PublisherRelayerGateway publishes states through the transaction relayer configured for the network of the identity,
+// so the issuer node does not need native tokens on that network. The networks without relayer are published with
+// the PublisherEthGateway.
+// The transitState call of BJJ identities is authorized by the zk proof, so it's relayed as is. The transitStateGeneric
+// call of ethereum identities is authorized by the sender, that must be the address of the identity: it's signed by
+// the identity as an ERC-2771 meta-transaction and relayed to the forwarder configured for the relayer, that must be
+// trusted by the state contract. ErrRelayerUnsupportedIdentity is returned if the relayer has no forwarder.
+type PublisherRelayerGateway struct {
+	ethGateway      *PublisherEthGateway
+	networkResolver network.Resolver
+	stateABI        *ethabi.ABI
+}
+
+// NewPublisherRelayerGateway creates new instance of the relayer publishing service
+func NewPublisherRelayerGateway(resolver network.Resolver, ethGateway *PublisherEthGateway) (*PublisherRelayerGateway, error) {
+	stateABI, err := abi.StateMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &PublisherRelayerGateway{
+		ethGateway:      ethGateway,
+		networkResolver: resolver,
+		stateABI:        stateABI,
+	}, nil
+}
+
+// PublishState creates or updates state in the blockchain
+func (pr *PublisherRelayerGateway) PublishState(ctx context.Context, identifier *w3c.DID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, proof *rstypes.ProofData, identity *domain.Identity) (*StateTransitionTx, error) {
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+		return nil, err
+	}
+
+	relayerClient, ok := pr.networkResolver.GetRelayer(resolverPrefix)
+	if !ok {
+		return pr.ethGateway.PublishState(ctx, identifier, latestState, newState, isOldStateGenesis, proof, identity)
+	}
+
+	if common.CompareMerkleTreeHash(newState, latestState) {
+		return nil, errors.New("state hasn't been changed")
+	}
+
+	id, err := core.IDFromDID(*identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity.KeyType == string(kms.KeyTypeEthereum) {
+		if relayerClient.Config.Forwarder == nil {
+			log.Error(ctx, "state of an ethereum identity on a network with relayer without forwarder", "did", identifier.String(), "network", resolverPrefix)
+			return nil, ErrRelayerUnsupportedIdentity
+		}
+		return pr.forwardStateTransition(ctx, relayerClient, resolverPrefix, id, latestState, newState, isOldStateGenesis, identity)
+	}
+
+	a, b, c, err := pr.ethGateway.adaptProofToAbi(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := pr.stateABI.Pack("transitState", id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, a, b, c)
+	if err != nil {
+		log.Error(ctx, "failed to pack transitState call", "err", err)
+		return nil, err
+	}
+
+	contractAddress, err := pr.networkResolver.GetContractAddress(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "failed to get contract address", "err", err)
+		return nil, err
+	}
+
+	// the publisher waits for the receipt of the transaction, as it does for the ones sent by the node.
+	// The relayer ID is stored with the state to follow the transaction if the relayer re-prices it
+	tx, err := relayer.Relay(ctx, relayerClient.Client, relayerClient.Config, relayer.TxRequest{
+		To:   contractAddress.Hex(),
+		Data: hexutil.Encode(data),
+	})
+	if err != nil {
+		log.Error(ctx, "failed to relay state transition", "err", err, "network", resolverPrefix)
+		return nil, err
+	}
+
+	log.Info(ctx, "state transition relayed", "relayerTxID", tx.ID, "tx", tx.Hash, "status", tx.Status)
+	return &StateTransitionTx{TxID: tx.Hash, RelayTxID: &tx.ID}, nil
+}
+
+// forwardStateTransition relays the transitStateGeneric call of an ethereum identity as a meta-transaction signed by
+// its key and executed by the forwarder of the relayer
+func (pr *PublisherRelayerGateway) forwardStateTransition(ctx context.Context, relayerClient *network.RelayerClientConfig, resolverPrefix string, id core.ID, latestState, newState *merkletree.Hash, isOldStateGenesis bool, identity *domain.Identity) (*StateTransitionTx, error) {
+	sigKeyID, err := pr.ethGateway.signingKeyID(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	client, err := pr.networkResolver.GetEthClient(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "failed to get client", "err", err)
+		return nil, err
+	}
+	contractAddress, err := pr.networkResolver.GetContractAddress(resolverPrefix)
+	if err != nil {
+		log.Error(ctx, "failed to get contract address", "err", err)
+		return nil, err
+	}
+
+	data, err := pr.stateABI.Pack("transitStateGeneric", id.BigInt(), latestState.BigInt(), newState.BigInt(), isOldStateGenesis, big.NewInt(1), []byte{})
+	if err != nil {
+		log.Error(ctx, "failed to pack transitStateGeneric call", "err", err)
+		return nil, err
+	}
+
+	from, err := client.Address(sigKeyID)
+	if err != nil {
+		return nil, err
+	}
+	forwarderCfg := *relayerClient.Config.Forwarder
+	forwarder := ethCommon.HexToAddress(forwarderCfg.Address)
+	nonce, err := relayer.ForwarderNonce(ctx, client.GetEthereumClient(), forwarder, from)
+	if err != nil {
+		log.Error(ctx, "failed to get forwarder nonce", "err", err, "forwarder", forwarder.Hex())
+		return nil, err
+	}
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := relayerClient.Config.GasLimit
+	if gas == 0 {
+		gas = uint64(client.Config.DefaultGasLimit)
+	}
+	req := relayer.ForwardRequest{
+		From:  from,
+		To:    *contractAddress,
+		Value: big.NewInt(0),
+		Gas:   new(big.Int).SetUint64(gas),
+		Nonce: nonce,
+		Data:  data,
+	}
+	signature, err := client.SignTypedData(ctx, sigKeyID, req.TypedData(forwarderCfg, chainID))
+	if err != nil {
+		log.Error(ctx, "failed to sign forward request", "err", err)
+		return nil, err
+	}
+	execute, err := relayer.PackExecute(req, signature)
+	if err != nil {
+		log.Error(ctx, "failed to pack forwarder execute call", "err", err)
+		return nil, err
+	}
+
+	tx, err := relayer.Relay(ctx, relayerClient.Client, relayerClient.Config, relayer.TxRequest{
+		To:       forwarder.Hex(),
+		Data:     hexutil.Encode(execute),
+		GasLimit: gas + forwarderGasOverhead,
+	})
+	if err != nil {
+		log.Error(ctx, "failed to relay state transition", "err", err, "network", resolverPrefix)
+		return nil, err
+	}
+
+	log.Info(ctx, "state transition forwarded", "relayerTxID", tx.ID, "tx", tx.Hash, "status", tx.Status, "from", from.Hex())
+	return &StateTransitionTx{TxID: tx.Hash, RelayTxID: &tx.ID}, nil
+}
+
+// ReplaceTransaction re-sends the pending state transition transaction txID with a higher tip.
+// Relayed transactions are re-priced by the relayer, so ErrTransactionReplacementNotSupported is returned for them.
+func (pr *PublisherRelayerGateway) ReplaceTransaction(ctx context.Context, identity *domain.Identity, txID string) (*string, error) {
+	resolverPrefix, err := identity.GetResolverPrefix()
+	if err != nil {
+		log.Error(ctx, "failed to get networkResolver prefix", "err", err)
+		return nil, err
+	}
+
+	if _, ok := pr.networkResolver.GetRelayer(resolverPrefix); ok {
+		return nil, ErrTransactionReplacementNotSupported
+	}
+	return pr.ethGateway.ReplaceTransaction(ctx, identity, txID)
+}
@@ -0,0 +1,162 @@
+package gateways
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/iden3/go-merkletree-sql/v2"
+	rstypes "github.com/iden3/go-rapidsnark/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/network"
+	"github.com/polygonid/sh-id-platform/internal/relayer"
+)
+
+const relayerTestDID = "did:polygonid:polygon:amoy:2qSuD8ZDpsAG3s8WJjwzqhMsqGLz8RUG1BHVUe3Gwu"
+
+// fakeRelayer is a defender style relayer that sends every transaction on the first status request
+type fakeRelayer struct {
+	mu       sync.Mutex
+	requests []relayer.TxRequest
+	status   relayer.Status
+}
+
+func (f *fakeRelayer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /txs", func(w http.ResponseWriter, r *http.Request) {
+		var req relayer.TxRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		f.requests = append(f.requests, req)
+		id := fmt.Sprintf("tx-%d", len(f.requests))
+		f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(relayer.Tx{ID: id, Status: relayer.StatusPending})
+	})
+	mux.HandleFunc("GET /txs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		status := f.status
+		f.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(relayer.Tx{ID: r.PathValue("id"), Hash: "0xrelayed", Status: status})
+	})
+	return mux
+}
+
+func TestPublisherRelayerGateway_PublishState(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeRelayer{status: relayer.StatusSubmitted}
+	server := httptest.NewServer(fake.handler())
+	defer server.Close()
+
+	gateway := newTestRelayerGateway(t, server.URL)
+	did, err := w3c.ParseDID(relayerTestDID)
+	require.NoError(t, err)
+	identity := &domain.Identity{Identifier: relayerTestDID, KeyType: "BJJ"}
+	latestState, err := merkletree.NewHashFromBigInt(big.NewInt(1))
+	require.NoError(t, err)
+	newState, err := merkletree.NewHashFromBigInt(big.NewInt(2))
+	require.NoError(t, err)
+
+	t.Run("should relay the state transition of a BJJ identity", func(t *testing.T) {
+		sent, err := gateway.PublishState(ctx, did, latestState, newState, true, testProof(), identity)
+		require.NoError(t, err)
+		assert.Equal(t, "0xrelayed", sent.TxID)
+		require.NotNil(t, sent.RelayTxID, "the relayer id is kept to follow the re-priced transactions")
+		assert.Equal(t, "tx-1", *sent.RelayTxID)
+
+		require.Len(t, fake.requests, 1)
+		assert.Equal(t, "0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124", fake.requests[0].To)
+		assert.Equal(t, "fast", fake.requests[0].Speed)
+
+		data, err := hexutil.Decode(fake.requests[0].Data)
+		require.NoError(t, err)
+		method, err := gateway.stateABI.MethodById(data[:4])
+		require.NoError(t, err)
+		assert.Equal(t, "transitState", method.Name)
+		args, err := method.Inputs.Unpack(data[4:])
+		require.NoError(t, err)
+		id, err := core.IDFromDID(*did)
+		require.NoError(t, err)
+		assert.Equal(t, id.BigInt(), args[0])
+		assert.Equal(t, latestState.BigInt(), args[1])
+		assert.Equal(t, newState.BigInt(), args[2])
+		assert.Equal(t, true, args[3])
+	})
+
+	t.Run("should not relay the state transition of an ethereum identity", func(t *testing.T) {
+		ethIdentity := &domain.Identity{Identifier: relayerTestDID, KeyType: string(kms.KeyTypeEthereum)}
+		_, err := gateway.PublishState(ctx, did, latestState, newState, true, nil, ethIdentity)
+		assert.ErrorIs(t, err, ErrRelayerUnsupportedIdentity)
+		assert.Len(t, fake.requests, 1)
+	})
+
+	t.Run("should fail if the state has not changed", func(t *testing.T) {
+		_, err := gateway.PublishState(ctx, did, latestState, latestState, false, testProof(), identity)
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the relayed transaction fails", func(t *testing.T) {
+		fake.mu.Lock()
+		fake.status = relayer.StatusFailed
+		fake.mu.Unlock()
+		_, err := gateway.PublishState(ctx, did, latestState, newState, true, testProof(), identity)
+		assert.ErrorIs(t, err, relayer.ErrRelayedTxFailed)
+	})
+
+	t.Run("should not replace relayed transactions", func(t *testing.T) {
+		_, err := gateway.ReplaceTransaction(ctx, identity, "0xrelayed")
+		assert.ErrorIs(t, err, ErrTransactionReplacementNotSupported)
+	})
+}
+
+func newTestRelayerGateway(t *testing.T, relayerURL string) *PublisherRelayerGateway {
+	t.Helper()
+	yamlData := fmt.Sprintf(`polygon:
+  amoy:
+    contractAddress: 0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124
+    networkURL: https://polygon-amoy.g.alchemy.com/v2/123
+    defaultGasLimit: 600000
+    rhsSettings:
+      mode: None
+    relayer:
+      type: defender
+      url: %s
+      speed: fast
+      pollInterval: 5ms
+      timeout: 5s
+`, relayerURL)
+	resolver, err := network.NewResolver(context.Background(), config.Configuration{ServerUrl: "https://issuer-node.privado.id"}, nil, common.NewMyYAMLReader([]byte(yamlData)))
+	require.NoError(t, err)
+
+	ethGateway, err := newStateService(*resolver, time.Second, nil, kms.KeyID{Type: kms.KeyTypeEthereum, ID: "pbkey"})
+	require.NoError(t, err)
+	gateway, err := NewPublisherRelayerGateway(*resolver, ethGateway)
+	require.NoError(t, err)
+	return gateway
+}
+
+func testProof() *rstypes.ProofData {
+	return &rstypes.ProofData{
+		A:        []string{"1", "2", "1"},
+		B:        [][]string{{"3", "4"}, {"5", "6"}, {"1", "0"}},
+		C:        []string{"7", "8", "1"},
+		Protocol: "groth16",
+	}
+}
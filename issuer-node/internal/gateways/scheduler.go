@@ -0,0 +1,210 @@
+package gateways
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/network"
+)
+
+// ErrStateTransitionDeferred is returned when a non urgent state transition is held because the network base fee
+// is above the configured ceiling. It will be published by the scheduler once the base fee goes down.
+var ErrStateTransitionDeferred = errors.New("state transition deferred until the network base fee goes down")
+
+// ErrTransactionReplacementNotSupported is returned by replacers when the transaction fees are managed by a third party,
+// like a transaction relayer.
+var ErrTransactionReplacementNotSupported = errors.New("transaction replacement not supported")
+
+// TransactionReplacer - Define the interface for gateways able to replace a pending transaction.
+type TransactionReplacer interface {
+	ReplaceTransaction(ctx context.Context, identity *domain.Identity, txID string) (*string, error)
+}
+
+// PublishingScheduler decorates a publisher with gas aware scheduling:
+// non urgent state transitions are held while the network base fee is above the configured ceiling and published
+// in batch when it goes down, and transactions pending for longer than the configured threshold are re-sent with
+// the same nonce and a higher tip.
+// The held state transitions are stored, so they are published after a restart.
+type PublishingScheduler struct {
+	ports.Publisher
+	identityService    ports.IdentityService
+	transactionHistory ports.TransactionHistoryService
+	replacer           TransactionReplacer
+	networkResolver    *network.Resolver
+	held               ports.DeferredStateRepository
+}
+
+// NewPublishingScheduler - Constructor
+func NewPublishingScheduler(publisher ports.Publisher, identityService ports.IdentityService, transactionHistory ports.TransactionHistoryService, replacer TransactionReplacer, networkResolver *network.Resolver, held ports.DeferredStateRepository) *PublishingScheduler {
+	return &PublishingScheduler{
+		Publisher:          publisher,
+		identityService:    identityService,
+		transactionHistory: transactionHistory,
+		replacer:           replacer,
+		networkResolver:    networkResolver,
+		held:               held,
+	}
+}
+
+// SchedulePublishState publishes the identity state right away if the network base fee is below the ceiling.
+// Otherwise, the identity is held until the next Flush that finds a lower base fee and ErrStateTransitionDeferred is returned.
+func (s *PublishingScheduler) SchedulePublishState(ctx context.Context, identifier *w3c.DID) (*domain.PublishedState, error) {
+	client, err := s.ethClient(identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	above, baseFee, err := client.IsBaseFeeAboveCeiling(ctx)
+	if err != nil {
+		log.Error(ctx, "failed to get base fee", "err", err, "did", identifier.String())
+		return nil, err
+	}
+	if !above {
+		return s.PublishState(ctx, identifier)
+	}
+
+	log.Info(ctx, "base fee above ceiling, holding state transition", "did", identifier.String(), "baseFee", baseFee)
+	if err := s.held.Hold(ctx, *identifier); err != nil {
+		log.Error(ctx, "failed to hold state transition", "err", err, "did", identifier.String())
+		return nil, err
+	}
+	return nil, ErrStateTransitionDeferred
+}
+
+// Flush publishes, network by network, the held state transitions if the base fee is below the ceiling.
+func (s *PublishingScheduler) Flush(ctx context.Context) {
+	held, err := s.held.GetAll(ctx)
+	if err != nil {
+		log.Error(ctx, "failed to get held state transitions", "err", err)
+		return
+	}
+	byNetwork := make(map[string][]*w3c.DID)
+	for i := range held {
+		did := &held[i]
+		resolverPrefix, err := common.ResolverPrefix(did)
+		if err != nil {
+			log.Error(ctx, "error getting resolver prefix", "err", err, "did", did.String())
+			continue
+		}
+		byNetwork[resolverPrefix] = append(byNetwork[resolverPrefix], did)
+	}
+
+	for resolverPrefix, dids := range byNetwork {
+		client, err := s.networkResolver.GetEthClient(resolverPrefix)
+		if err != nil {
+			log.Error(ctx, "failed to get client", "err", err, "network", resolverPrefix)
+			continue
+		}
+		above, baseFee, err := client.IsBaseFeeAboveCeiling(ctx)
+		if err != nil {
+			log.Error(ctx, "failed to get base fee", "err", err, "network", resolverPrefix)
+			continue
+		}
+		if above {
+			log.Debug(ctx, "base fee still above ceiling", "network", resolverPrefix, "baseFee", baseFee, "held", len(dids))
+			continue
+		}
+
+		log.Info(ctx, "publishing held state transitions", "network", resolverPrefix, "count", len(dids))
+		for _, did := range dids {
+			// the state transition stays held until it's published, failed attempts are retried in the next flush
+			_, err := s.PublishState(ctx, did)
+			if errors.Is(err, ErrStateIsBeingProcessed) {
+				continue
+			}
+			if err != nil && !errors.Is(err, ErrNoStatesToProcess) {
+				log.Error(ctx, "error publishing held state transition", "err", err, "did", did.String())
+				continue
+			}
+			if err := s.held.Release(ctx, *did); err != nil {
+				log.Error(ctx, "failed to release held state transition", "err", err, "did", did.String())
+			}
+		}
+	}
+}
+
+// ReplaceStuckTransactions re-sends with a higher tip the state transition transactions that have been pending
+// for longer than the stuck transaction timeout configured for their network.
+func (s *PublishingScheduler) ReplaceStuckTransactions(ctx context.Context) {
+	states, err := s.identityService.GetTransactedStates(ctx)
+	if err != nil {
+		log.Error(ctx, "error getting transacted states", "err", err)
+		return
+	}
+
+	for i := range states {
+		state := states[i]
+		if state.TxID == nil {
+			continue
+		}
+		did, err := w3c.ParseDID(state.Identifier)
+		if err != nil {
+			log.Error(ctx, "error getting did from state", "err", err, "state", state.StateID)
+			continue
+		}
+		client, err := s.ethClient(did)
+		if err != nil {
+			continue
+		}
+		timeout := client.GetStuckTxTimeout()
+		if timeout == 0 || time.Since(state.ModifiedAt) < timeout {
+			continue
+		}
+
+		identity, err := s.identityService.GetByDID(ctx, *did)
+		if err != nil {
+			log.Error(ctx, "error getting identity", "err", err, "did", did.String())
+			continue
+		}
+
+		newTxID, err := s.replacer.ReplaceTransaction(ctx, identity, *state.TxID)
+		if err != nil {
+			if !errors.Is(err, eth.ErrTransactionNotPending) && !errors.Is(err, ErrTransactionReplacementNotSupported) {
+				log.Error(ctx, "error replacing stuck transaction", "err", err, "tx", *state.TxID)
+			}
+			continue
+		}
+
+		// the original transaction is kept in the history, its receipt is checked too as it can still be mined.
+		// The state points to the last one sent, the one to bump if it gets stuck again
+		if err := s.transactionHistory.RecordReplacement(ctx, *state.State, *state.TxID, *newTxID); err != nil {
+			log.Error(ctx, "failed to record replacement transaction", "err", err, "tx", *newTxID, "replaced", *state.TxID)
+		}
+		state.TxID = newTxID
+		if err := s.identityService.UpdateIdentityState(ctx, &state); err != nil {
+			log.Error(ctx, "error updating state with replacement transaction", "err", err, "tx", *newTxID)
+		}
+	}
+}
+
+// Run flushes held state transitions every period until the context is done
+func (s *PublishingScheduler) Run(ctx context.Context, period time.Duration) {
+	go func() {
+		ticker := time.NewTicker(period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.Flush(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (s *PublishingScheduler) ethClient(did *w3c.DID) (*eth.Client, error) {
+	resolverPrefix, err := common.ResolverPrefix(did)
+	if err != nil {
+		return nil, err
+	}
+	return s.networkResolver.GetEthClient(resolverPrefix)
+}
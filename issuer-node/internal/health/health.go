@@ -14,6 +14,7 @@ const (
 type Status struct {
 	sync.RWMutex
 	monitors     Monitors
+	providers    []MonitorsProvider
 	lastStatuses map[string]bool
 }
 
@@ -23,6 +24,9 @@ type Pinger func(ctx context.Context) error
 // Monitors represents a map of Pingers identified by it's human name
 type Monitors map[string]Pinger
 
+// MonitorsProvider returns monitors that can change while the service is running. It is called on every check.
+type MonitorsProvider func() Monitors
+
 // New returns a Health instance
 func New(m Monitors) *Status {
 	return &Status{
@@ -31,6 +35,13 @@ func New(m Monitors) *Status {
 	}
 }
 
+// AddProvider adds monitors that are read again on every check
+func (s *Status) AddProvider(p MonitorsProvider) {
+	s.Lock()
+	defer s.Unlock()
+	s.providers = append(s.providers, p)
+}
+
 // Run starts a monitor that will check each service every t duration.
 func (s *Status) Run(ctx context.Context, t time.Duration) {
 	go func() {
@@ -58,7 +69,14 @@ func (s *Status) Status() map[string]bool {
 func (s *Status) checkStatus(ctx context.Context) {
 	s.Lock()
 	defer s.Unlock()
+	statuses := make(map[string]bool, len(s.monitors))
 	for service, ping := range s.monitors {
-		s.lastStatuses[service] = ping(ctx) == nil
+		statuses[service] = ping(ctx) == nil
+	}
+	for _, provider := range s.providers {
+		for service, ping := range provider() {
+			statuses[service] = ping(ctx) == nil
+		}
 	}
+	s.lastStatuses = statuses
 }
@@ -0,0 +1,36 @@
+// Package ipfs pins documents to an IPFS node through its HTTP API
+package ipfs
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	shell "github.com/ipfs/go-ipfs-api"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const pinTimeout = 30 * time.Second
+
+type pinner struct {
+	shell *shell.Shell
+}
+
+// NewPinner returns a pinner of the IPFS node with the HTTP API at apiURL
+func NewPinner(apiURL string) ports.IPFSPinner {
+	sh := shell.NewShell(apiURL)
+	sh.SetTimeout(pinTimeout)
+	return &pinner{shell: sh}
+}
+
+// Pin adds and pins the content and returns its CID
+func (p *pinner) Pin(ctx context.Context, content []byte) (string, error) {
+	cid, err := p.shell.Add(bytes.NewReader(content), shell.CidVersion(1), shell.Pin(true))
+	if err != nil {
+		log.Error(ctx, "pinning document to ipfs", "err", err)
+		return "", err
+	}
+	return cid, nil
+}
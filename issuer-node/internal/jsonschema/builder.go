@@ -0,0 +1,260 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+)
+
+// AttributeType is the type of the value of an attribute of a Definition
+type AttributeType string
+
+// Attribute types supported by the builder
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeInteger AttributeType = "integer"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeBoolean AttributeType = "boolean"
+	AttributeTypeDate    AttributeType = "date"
+)
+
+const dateLayout = "2006-01-02"
+
+// ErrInvalidDefinition is returned when a schema can't be built from a Definition
+var ErrInvalidDefinition = errors.New("invalid schema definition")
+
+var (
+	typeNameRegex      = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	attributeNameRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// Definition describes a new credential type. Its JSON schema and JSON-LD context are built from it.
+type Definition struct {
+	Type        string
+	Version     string
+	Title       string
+	Description string
+	Attributes  []DefinitionAttribute
+}
+
+// DefinitionAttribute is an attribute of the credential subject of a Definition.
+// Enum, when not empty, is the list of the allowed values of the attribute.
+type DefinitionAttribute struct {
+	Name        string
+	Type        AttributeType
+	Title       string
+	Description string
+	Enum        []any
+	Required    bool
+}
+
+// Validate checks the credential type and its attributes can be used in a JSON schema and a JSON-LD context
+func (d *Definition) Validate() error {
+	if !typeNameRegex.MatchString(d.Type) {
+		return fmt.Errorf("%w: type <%s> must start with a letter and contain only letters, digits and underscores", ErrInvalidDefinition, d.Type)
+	}
+	if d.Version == "" {
+		return fmt.Errorf("%w: version is required", ErrInvalidDefinition)
+	}
+	if len(d.Attributes) == 0 {
+		return fmt.Errorf("%w: at least one attribute is required", ErrInvalidDefinition)
+	}
+	names := make(map[string]bool, len(d.Attributes))
+	for _, attr := range d.Attributes {
+		if !attributeNameRegex.MatchString(attr.Name) || attr.Name == "id" || attr.Name == "type" {
+			return fmt.Errorf("%w: invalid attribute name <%s>", ErrInvalidDefinition, attr.Name)
+		}
+		if names[attr.Name] {
+			return fmt.Errorf("%w: duplicated attribute <%s>", ErrInvalidDefinition, attr.Name)
+		}
+		names[attr.Name] = true
+		if _, ok := xsdTypes[attr.Type]; !ok {
+			return fmt.Errorf("%w: attribute <%s> has unsupported type <%s>", ErrInvalidDefinition, attr.Name, attr.Type)
+		}
+		if len(attr.Enum) > 0 && attr.Type == AttributeTypeBoolean {
+			return fmt.Errorf("%w: enum is not supported for boolean attribute <%s>", ErrInvalidDefinition, attr.Name)
+		}
+		for _, value := range attr.Enum {
+			if !isValueOf(attr.Type, value) {
+				return fmt.Errorf("%w: enum value <%v> of attribute <%s> is not of type %s", ErrInvalidDefinition, value, attr.Name, attr.Type)
+			}
+		}
+	}
+	return nil
+}
+
+var xsdTypes = map[AttributeType]string{
+	AttributeTypeString:  "xsd:string",
+	AttributeTypeInteger: "xsd:integer",
+	AttributeTypeNumber:  "xsd:double",
+	AttributeTypeBoolean: "xsd:boolean",
+	AttributeTypeDate:    "xsd:dateTime",
+}
+
+// NewJSONSchema builds the JSON schema of the credential type of the definition, published at url with its
+// JSON-LD context at contextURL. url may be empty when it is not known in advance, like the IPFS url of the schema itself.
+func NewJSONSchema(def Definition, url string, contextURL string) *JSONSchema {
+	uris := map[string]any{"jsonLdContext": contextURL}
+	if url != "" {
+		uris["jsonSchema"] = url
+	}
+
+	subjectProperties := map[string]any{
+		"id": map[string]any{"title": "Credential Subject ID", "type": "string", "format": "uri"},
+	}
+	required := make([]string, 0, len(def.Attributes))
+	for _, attr := range def.Attributes {
+		subjectProperties[attr.Name] = attributeProperty(attr)
+		if attr.Required {
+			required = append(required, attr.Name)
+		}
+	}
+
+	content := map[string]any{
+		"$schema": "http://json-schema.org/draft-07/schema#",
+		"$metadata": map[string]any{
+			"type":    def.Type,
+			"version": def.Version,
+			"uris":    uris,
+		},
+		"type":     "object",
+		"required": []string{"@context", "id", "type", "issuanceDate", "credentialSubject", "credentialSchema", "credentialStatus", "issuer"},
+		"properties": map[string]any{
+			"@context": map[string]any{"type": []string{"string", "array", "object"}},
+			"id":       map[string]any{"type": "string"},
+			"type":     map[string]any{"type": []string{"string", "array"}, "items": map[string]any{"type": "string"}},
+			"issuer": map[string]any{
+				"type":       []string{"string", "object"},
+				"format":     "uri",
+				"required":   []string{"id"},
+				"properties": map[string]any{"id": map[string]any{"type": "string", "format": "uri"}},
+			},
+			"issuanceDate":   map[string]any{"type": "string", "format": "date-time"},
+			"expirationDate": map[string]any{"type": "string", "format": "date-time"},
+			"credentialSchema": map[string]any{
+				"type":     "object",
+				"required": []string{"id", "type"},
+				"properties": map[string]any{
+					"id":   map[string]any{"type": "string", "format": "uri"},
+					"type": map[string]any{"type": "string"},
+				},
+			},
+			"subjectPosition":          map[string]any{"type": "string", "enum": []string{"none", "index", "value"}},
+			"merklizationRootPosition": map[string]any{"type": "string", "enum": []string{"none", "index", "value"}},
+			"revNonce":                 map[string]any{"type": "integer"},
+			"version":                  map[string]any{"type": "integer"},
+			"updatable":                map[string]any{"type": "boolean"},
+			"credentialSubject": map[string]any{
+				"type":       "object",
+				"required":   required,
+				"properties": subjectProperties,
+			},
+		},
+	}
+	if def.Title != "" {
+		content["title"] = def.Title
+	}
+	if def.Description != "" {
+		content["description"] = def.Description
+	}
+
+	// round trip so the content is the same as the one of a loaded schema
+	schema := &JSONSchema{content: make(map[string]any)}
+	raw, _ := json.Marshal(content)
+	_ = json.Unmarshal(raw, &schema.content)
+	return schema
+}
+
+// NewJSONLdContext builds the JSON-LD context of the credential type of the definition. The terms of the
+// attributes are defined in vocab, an IRI ending with # or /, that is also the base of the type IRI.
+func NewJSONLdContext(def Definition, vocab string) ([]byte, error) {
+	terms := map[string]any{
+		"@version":   1.1,
+		"@protected": true,
+		"id":         "@id",
+		"type":       "@type",
+		"vocab":      vocab,
+		"xsd":        "http://www.w3.org/2001/XMLSchema#",
+	}
+	for _, attr := range def.Attributes {
+		terms[attr.Name] = map[string]any{
+			"@id":   "vocab:" + attr.Name,
+			"@type": xsdTypes[attr.Type],
+		}
+	}
+	return json.Marshal(map[string]any{
+		"@context": []any{
+			map[string]any{
+				"@version":   1.1,
+				"@protected": true,
+				"id":         "@id",
+				"type":       "@type",
+				def.Type: map[string]any{
+					"@id":      vocab + def.Type,
+					"@context": terms,
+				},
+			},
+		},
+	})
+}
+
+func attributeProperty(attr DefinitionAttribute) map[string]any {
+	property := make(map[string]any)
+	switch attr.Type {
+	case AttributeTypeDate:
+		property["type"] = "string"
+		property["format"] = "date"
+	default:
+		property["type"] = string(attr.Type)
+	}
+	if attr.Title != "" {
+		property["title"] = attr.Title
+	}
+	if attr.Description != "" {
+		property["description"] = attr.Description
+	}
+	if len(attr.Enum) > 0 {
+		property["enum"] = attr.Enum
+	}
+	return property
+}
+
+func isValueOf(attrType AttributeType, value any) bool {
+	switch attrType {
+	case AttributeTypeString:
+		_, ok := value.(string)
+		return ok
+	case AttributeTypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return false
+		}
+		_, err := time.Parse(dateLayout, s)
+		return err == nil
+	case AttributeTypeInteger:
+		n, ok := number(value)
+		return ok && n == math.Trunc(n)
+	case AttributeTypeNumber:
+		_, ok := number(value)
+		return ok
+	}
+	return false
+}
+
+func number(value any) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,136 @@
+package jsonschema
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	jsonSuite "github.com/iden3/go-schema-processor/v2/json"
+	"github.com/iden3/go-schema-processor/v2/merklize"
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLoader serves the JSON-LD contexts built in the tests
+type memoryLoader map[string][]byte
+
+func (l memoryLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	raw, ok := l[u]
+	if !ok {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, u)
+	}
+	doc, err := ld.DocumentFromReader(strings.NewReader(string(raw)))
+	if err != nil {
+		return nil, err
+	}
+	return &ld.RemoteDocument{DocumentURL: u, Document: doc}, nil
+}
+
+func TestBuilder(t *testing.T) {
+	const (
+		url        = "https://issuer.example.com/v2/schemas/7e5ae6a2-63d1-4f1b-9d0e-5c1c62e4c31b/schema.json"
+		contextURL = "https://issuer.example.com/v2/schemas/7e5ae6a2-63d1-4f1b-9d0e-5c1c62e4c31b/context.jsonld"
+		vocab      = "urn:uuid:7e5ae6a2-63d1-4f1b-9d0e-5c1c62e4c31b#"
+	)
+	def := Definition{
+		Type:        "HackathonCreator",
+		Version:     "1.0",
+		Title:       "Hackathon Creator",
+		Description: "Creators of a hackathon project",
+		Attributes: []DefinitionAttribute{
+			{Name: "project", Type: AttributeTypeString, Title: "Project", Required: true},
+			{Name: "role", Type: AttributeTypeString, Description: "Role in the team", Enum: []any{"developer", "designer"}},
+			{Name: "teamSize", Type: AttributeTypeInteger, Enum: []any{float64(1), float64(2), float64(3)}},
+			{Name: "score", Type: AttributeTypeNumber},
+			{Name: "winner", Type: AttributeTypeBoolean},
+			{Name: "submittedAt", Type: AttributeTypeDate, Required: true},
+		},
+	}
+	require.NoError(t, def.Validate())
+
+	schema := NewJSONSchema(def, url, contextURL)
+	jsonLdContext, err := schema.JSONLdContext()
+	require.NoError(t, err)
+	assert.Equal(t, contextURL, jsonLdContext)
+	attrs, err := schema.Attributes()
+	require.NoError(t, err)
+	assert.Len(t, attrs, len(def.Attributes)+1)
+	role, err := schema.AttributeByID("role")
+	require.NoError(t, err)
+	assert.Equal(t, "string", role.Type)
+
+	t.Run("should validate credential subjects against the schema", func(t *testing.T) {
+		for _, tc := range []struct {
+			subject map[string]any
+			valid   bool
+		}{
+			{subject: map[string]any{"project": "p", "role": "developer", "teamSize": 2, "score": 9.5, "winner": true, "submittedAt": "2024-05-01"}, valid: true},
+			{subject: map[string]any{"project": "p", "submittedAt": "2024-05-01"}, valid: true},
+			{subject: map[string]any{"project": "p"}, valid: false},
+			{subject: map[string]any{"project": "p", "submittedAt": "2024-05-01", "role": "manager"}, valid: false},
+			{subject: map[string]any{"project": "p", "submittedAt": "2024-05-01", "teamSize": 4}, valid: false},
+			{subject: map[string]any{"project": "p", "submittedAt": "2024-05-01", "winner": "yes"}, valid: false},
+			{subject: map[string]any{"project": "p", "submittedAt": "May 1st"}, valid: false},
+		} {
+			vc, err := createDummyVC(tc.subject, def.Type, contextURL)
+			require.NoError(t, err)
+			err = validateDummyVCAgainstSchema(vc, schema)
+			assert.Equal(t, tc.valid, err == nil, tc.subject)
+		}
+	})
+
+	t.Run("should build a context with the types of the attributes", func(t *testing.T) {
+		jsonLdDoc, err := NewJSONLdContext(def, vocab)
+		require.NoError(t, err)
+		doc := map[string]any{
+			"@context":    []any{contextURL},
+			"type":        def.Type,
+			"project":     "p",
+			"teamSize":    2,
+			"score":       9.5,
+			"winner":      true,
+			"submittedAt": "2024-05-01",
+		}
+		raw, err := json.Marshal(doc)
+		require.NoError(t, err)
+		mz, err := merklize.MerklizeJSONLD(context.Background(), strings.NewReader(string(raw)),
+			merklize.WithDocumentLoader(memoryLoader{contextURL: jsonLdDoc}))
+		require.NoError(t, err)
+		for attr, xsdType := range map[string]string{
+			"project":     "http://www.w3.org/2001/XMLSchema#string",
+			"teamSize":    "http://www.w3.org/2001/XMLSchema#integer",
+			"score":       "http://www.w3.org/2001/XMLSchema#double",
+			"winner":      "http://www.w3.org/2001/XMLSchema#boolean",
+			"submittedAt": "http://www.w3.org/2001/XMLSchema#dateTime",
+		} {
+			path, err := mz.ResolveDocPath(attr)
+			require.NoError(t, err)
+			assert.Equal(t, []any{vocab + attr}, path.Parts())
+			jsonLdType, err := mz.JSONLDType(path)
+			require.NoError(t, err)
+			assert.Equal(t, xsdType, jsonLdType, attr)
+		}
+	})
+
+	t.Run("should reject invalid definitions", func(t *testing.T) {
+		for _, invalid := range []Definition{
+			{Type: "Hackathon Creator", Version: "1.0", Attributes: def.Attributes},
+			{Type: def.Type, Attributes: def.Attributes},
+			{Type: def.Type, Version: "1.0"},
+			{Type: def.Type, Version: "1.0", Attributes: []DefinitionAttribute{{Name: "id", Type: AttributeTypeString}}},
+			{Type: def.Type, Version: "1.0", Attributes: []DefinitionAttribute{{Name: "a", Type: AttributeTypeString}, {Name: "a", Type: AttributeTypeInteger}}},
+			{Type: def.Type, Version: "1.0", Attributes: []DefinitionAttribute{{Name: "a", Type: "object"}}},
+			{Type: def.Type, Version: "1.0", Attributes: []DefinitionAttribute{{Name: "a", Type: AttributeTypeInteger, Enum: []any{1.5}}}},
+			{Type: def.Type, Version: "1.0", Attributes: []DefinitionAttribute{{Name: "a", Type: AttributeTypeDate, Enum: []any{"tomorrow"}}}},
+			{Type: def.Type, Version: "1.0", Attributes: []DefinitionAttribute{{Name: "a", Type: AttributeTypeBoolean, Enum: []any{true}}}},
+		} {
+			assert.ErrorIs(t, invalid.Validate(), ErrInvalidDefinition, invalid)
+		}
+	})
+
+	raw, err := schema.Bytes()
+	require.NoError(t, err)
+	assert.Error(t, jsonSuite.Validator{}.ValidateData([]byte(`{}`), raw))
+}
@@ -17,8 +17,9 @@ import (
 	"github.com/polygonid/sh-id-platform/internal/loader"
 )
 
+// the subject and the issuer of the dummy credentials must be different nodes, or they can't be merklized
 const (
-	fakeUserDID   = "did:polygonid:polygon:amoy:2qY78akW9i87q2hKuPpjP3ews85TnvZPrcJwHBra1a"
+	fakeUserDID   = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
 	fakeIssuerDID = "did:polygonid:polygon:amoy:2qY78akW9i87q2hKuPpjP3ews85TnvZPrcJwHBra1a"
 )
 
@@ -66,7 +67,11 @@ func Load(ctx context.Context, jsonSchemaURL string, loader loader.DocumentLoade
 	if err != nil {
 		return nil, err
 	}
+	return Parse(raw)
+}
 
+// Parse returns the schema of the raw json content
+func Parse(raw []byte) (*JSONSchema, error) {
 	schema := &JSONSchema{content: make(map[string]any)}
 	if err := json.Unmarshal(raw, &schema.content); err != nil {
 		return nil, err
@@ -16,6 +16,7 @@ const (
 	partsNumber3 = 3
 	babyjubjub   = "babyjubjub"
 	ethereum     = "ethereum"
+	x25519       = "x25519"
 )
 
 type localStorageProviderFileContent struct {
@@ -6,13 +6,18 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/vault/api"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 // StorageManager - interface for managing local storage
@@ -35,6 +40,7 @@ type KMSType interface {
 	LinkToIdentity(ctx context.Context, keyID KeyID, identity w3c.DID) (KeyID, error)
 	Delete(ctx context.Context, keyID KeyID) error
 	Exists(ctx context.Context, keyID KeyID) (bool, error)
+	KeyAgreement(ctx context.Context, keyID KeyID, peerPublicKey []byte) ([]byte, error)
 }
 
 // ConfigProvider is a key provider configuration
@@ -55,12 +61,17 @@ const (
 	BJJAWSSecretManagerStorage ConfigProvider = "aws-sm"
 	// ETHAWSSecretManagerStorage - AWS Secret Manager storage for Ethereum keys
 	ETHAWSSecretManagerStorage ConfigProvider = "aws-sm"
+	// X25519LocalStorageKeyProvider is a key provider for X25519 keys in local storage
+	X25519LocalStorageKeyProvider ConfigProvider = "localstorage"
+	// X25519AWSSecretManagerStorage - AWS Secret Manager storage for X25519 keys
+	X25519AWSSecretManagerStorage ConfigProvider = "aws-sm"
 )
 
 // Config is a configuration for KMS
 type Config struct {
 	BJJKeyProvider           ConfigProvider
 	ETHKeyProvider           ConfigProvider
+	X25519KeyProvider        ConfigProvider
 	AWSAccessKey             string
 	AWSSecretKey             string
 	AWSRegion                string
@@ -92,6 +103,12 @@ type KeyProvider interface {
 	Exists(ctx context.Context, keyID KeyID) (bool, error)
 }
 
+// KeyAgreementProvider is implemented by the key providers whose keys are used to derive shared secrets
+type KeyAgreementProvider interface {
+	// KeyAgreement returns the shared secret between the key and the peer public key
+	KeyAgreement(ctx context.Context, keyID KeyID, peerPublicKey []byte) ([]byte, error)
+}
+
 // KMS stores keys and secrets
 type KMS struct {
 	registry map[KeyType]KeyProvider
@@ -104,6 +121,7 @@ type KeyType string
 const (
 	KeyTypeBabyJubJub KeyType = "BJJ"
 	KeyTypeEthereum   KeyType = "ETH"
+	KeyTypeX25519     KeyType = "X25519"
 )
 
 // ErrUnknownKeyType returns when we do not support this type of keys
@@ -122,6 +140,12 @@ var ErrPermissionDenied = stderr.New("permission denied")
 // ErrKeyNotFound raises when key is not found
 var ErrKeyNotFound = stderr.New("key not found")
 
+// ErrKeyAgreementOnly raises when a key agreement key is used to sign
+var ErrKeyAgreementOnly = stderr.New("key can only be used for key agreement")
+
+// ErrKeyAgreementNotSupported raises when the key type can't be used for key agreement
+var ErrKeyAgreementNotSupported = stderr.New("key agreement not supported by key type")
+
 // KeyID is a key unique identifier
 type KeyID struct {
 	Type KeyType
@@ -173,7 +197,29 @@ func (k *KMS) Sign(ctx context.Context, keyID KeyID, data []byte) ([]byte, error
 		return nil, errors.WithStack(ErrUnknownKeyType)
 	}
 
-	return kp.Sign(ctx, keyID, data)
+	provider := strings.TrimPrefix(fmt.Sprintf("%T", kp), "*kms.")
+	ctx, span := telemetry.Start(ctx, "kms sign", trace.WithAttributes(
+		attribute.String("kms.provider", provider),
+		attribute.String("kms.key_type", string(keyID.Type)),
+	))
+	start := time.Now()
+	signature, err := kp.Sign(ctx, keyID, data)
+	telemetry.KMSSignDuration.WithLabelValues(provider, string(keyID.Type)).Observe(time.Since(start).Seconds())
+	telemetry.End(span, err)
+	return signature, err
+}
+
+// KeyAgreement derives the shared secret between the private key and the peer public key
+func (k *KMS) KeyAgreement(ctx context.Context, keyID KeyID, peerPublicKey []byte) ([]byte, error) {
+	kp, ok := k.registry[keyID.Type]
+	if !ok {
+		return nil, errors.WithStack(ErrUnknownKeyType)
+	}
+	kap, ok := kp.(KeyAgreementProvider)
+	if !ok {
+		return nil, errors.WithStack(ErrKeyAgreementNotSupported)
+	}
+	return kap.KeyAgreement(ctx, keyID, peerPublicKey)
 }
 
 // KeysByIdentity lists keys by identity
@@ -390,6 +436,11 @@ func OpenWithConfig(ctx context.Context, config Config) (*KMS, error) {
 		log.Info(ctx, "Ethereum key provider created", "provider:", ETHAwsKmsKeyProvider)
 	}
 
+	x25519KeyProvider, err := newX25519KeyProvider(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
 	keyStore := NewKMS()
 	err = keyStore.RegisterKeyProvider(KeyTypeBabyJubJub, bjjKeyProvider)
 	if err != nil {
@@ -400,9 +451,45 @@ func OpenWithConfig(ctx context.Context, config Config) (*KMS, error) {
 	if err != nil {
 		return nil, fmt.Errorf("cannot register BabyJubJub key provider: %+v", err)
 	}
+	if x25519KeyProvider != nil {
+		err = keyStore.RegisterKeyProvider(KeyTypeX25519, x25519KeyProvider)
+		if err != nil {
+			return nil, fmt.Errorf("cannot register X25519 key provider: %+v", err)
+		}
+	}
 	return keyStore, nil
 }
 
+// newX25519KeyProvider creates the X25519 key provider. It returns nil if no provider is configured.
+// Vault can't hold X25519 keys, so they are stored in the local storage or in AWS Secret Manager.
+func newX25519KeyProvider(ctx context.Context, config Config) (KeyProvider, error) {
+	switch config.X25519KeyProvider {
+	case "":
+		return nil, nil
+	case X25519LocalStorageKeyProvider:
+		filePath, err := createFileIfNotExists(ctx, config.LocalStoragePath, LocalStorageFileName)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create file: %v", err)
+		}
+		log.Info(ctx, "X25519 key provider created", "provider:", X25519LocalStorageKeyProvider)
+		return NewLocalX25519KeyProvider(KeyTypeX25519, NewFileStorageManager(filePath)), nil
+	case X25519AWSSecretManagerStorage:
+		provider, err := NewAwsSecretStorageProvider(ctx, AwsSecretStorageProviderConfig{
+			AccessKey: config.AWSAccessKey,
+			SecretKey: config.AWSSecretKey,
+			Region:    config.AWSRegion,
+			URL:       config.AWSURL,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot create X25519 aws key provider: %+v", err)
+		}
+		log.Info(ctx, "X25519 key provider created", "provider:", X25519AWSSecretManagerStorage)
+		return NewLocalX25519KeyProvider(KeyTypeX25519, provider), nil
+	default:
+		return nil, fmt.Errorf("X25519 key provider %s is not supported", config.X25519KeyProvider)
+	}
+}
+
 func createFileIfNotExists(ctx context.Context, folderPath, fileName string) (string, error) {
 	if err := os.MkdirAll(folderPath, os.ModePerm); err != nil {
 		return "", fmt.Errorf("error creating folder: %v", err)
@@ -0,0 +1,132 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"regexp"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+type localX25519KeyProvider struct {
+	keyType          KeyType
+	reIdenKeyPathHex *regexp.Regexp // RE of key path bound to identity
+	storageManager   StorageManager
+}
+
+// NewLocalX25519KeyProvider - creates new key provider for X25519 key agreement keys stored in local storage.
+// X25519 keys are always bound to an identity.
+func NewLocalX25519KeyProvider(keyType KeyType, storageManager StorageManager) KeyProvider {
+	keyTypeRE := regexp.QuoteMeta(string(keyType))
+	reIdenKeyPathHex := regexp.MustCompile("^(?i).*/" + keyTypeRE + ":([a-f0-9]{64})$")
+	return &localX25519KeyProvider{
+		keyType:          keyType,
+		storageManager:   storageManager,
+		reIdenKeyPathHex: reIdenKeyPathHex,
+	}
+}
+
+func (ls *localX25519KeyProvider) New(identity *w3c.DID) (KeyID, error) {
+	if identity == nil {
+		return KeyID{}, errors.New("X25519 keys must be bound to an identity")
+	}
+
+	privateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return KeyID{}, err
+	}
+	keyID := KeyID{
+		Type: ls.keyType,
+		ID:   keyPathForLocalProvider(identity, ls.keyType, hex.EncodeToString(privateKey.PublicKey().Bytes())),
+	}
+	keyMaterial := map[string]string{
+		jsonKeyType: string(ls.keyType),
+		jsonKeyData: hex.EncodeToString(privateKey.Bytes()),
+	}
+	if err := ls.storageManager.SaveKeyMaterial(context.Background(), keyMaterial, keyID.ID); err != nil {
+		return KeyID{}, err
+	}
+	return keyID, nil
+}
+
+func (ls *localX25519KeyProvider) PublicKey(keyID KeyID) ([]byte, error) {
+	if keyID.Type != ls.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	ss := ls.reIdenKeyPathHex.FindStringSubmatch(keyID.ID)
+	if len(ss) != partsNumber {
+		return nil, errors.New("unable to get public key from key ID")
+	}
+	return hex.DecodeString(ss[1])
+}
+
+func (ls *localX25519KeyProvider) Sign(_ context.Context, _ KeyID, _ []byte) ([]byte, error) {
+	return nil, ErrKeyAgreementOnly
+}
+
+// KeyAgreement returns the X25519 shared secret between the key and the peer public key
+func (ls *localX25519KeyProvider) KeyAgreement(ctx context.Context, keyID KeyID, peerPublicKey []byte) ([]byte, error) {
+	privateKey, err := ls.privateKey(ctx, keyID)
+	if err != nil {
+		return nil, err
+	}
+	peerKey, err := ecdh.X25519().NewPublicKey(peerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.ECDH(peerKey)
+}
+
+// ListByIdentity lists keys by identity
+func (ls *localX25519KeyProvider) ListByIdentity(ctx context.Context, identity w3c.DID) ([]KeyID, error) {
+	return ls.storageManager.searchByIdentity(ctx, identity, ls.keyType)
+}
+
+func (ls *localX25519KeyProvider) LinkToIdentity(_ context.Context, keyID KeyID, _ w3c.DID) (KeyID, error) {
+	return keyID, errors.New("X25519 keys are bound to an identity on creation")
+}
+
+func (ls *localX25519KeyProvider) Delete(ctx context.Context, keyID KeyID) error {
+	return ls.storageManager.deleteKeyMaterial(ctx, keyID)
+}
+
+func (ls *localX25519KeyProvider) Exists(ctx context.Context, keyID KeyID) (bool, error) {
+	_, err := ls.storageManager.getKeyMaterial(ctx, keyID)
+	if err != nil {
+		if errors.Is(err, ErrKeyNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (ls *localX25519KeyProvider) privateKey(ctx context.Context, keyID KeyID) (*ecdh.PrivateKey, error) {
+	if keyID.Type != ls.keyType {
+		return nil, ErrIncorrectKeyType
+	}
+
+	if !ls.reIdenKeyPathHex.MatchString(keyID.ID) {
+		log.Error(ctx, "incorrect key ID", "keyID", keyID)
+		return nil, errors.New("incorrect key ID")
+	}
+
+	privateKey, err := ls.storageManager.searchPrivateKey(ctx, keyID)
+	if err != nil {
+		log.Error(ctx, "cannot get private key", "err", err, "keyID", keyID)
+		return nil, err
+	}
+
+	val, err := hex.DecodeString(privateKey)
+	if err != nil {
+		log.Error(ctx, "cannot decode private key", "err", err, "keyID", keyID)
+		return nil, err
+	}
+	return ecdh.X25519().NewPrivateKey(val)
+}
@@ -0,0 +1,62 @@
+package kms
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_LocalX25519Provider(t *testing.T) {
+	ctx := context.Background()
+	tmpFile, err := createTestFile(t)
+	require.NoError(t, err)
+	//nolint:errcheck
+	defer os.Remove(tmpFile.Name())
+	keyStore := NewKMS()
+	require.NoError(t, keyStore.RegisterKeyProvider(KeyTypeX25519, NewLocalX25519KeyProvider(KeyTypeX25519, NewFileStorageManager(tmpFile.Name()))))
+	did := randomDID(t)
+
+	t.Run("should not create keys without identity", func(t *testing.T) {
+		_, err := keyStore.CreateKey(KeyTypeX25519, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("should derive the same shared secret on both sides", func(t *testing.T) {
+		keyID, err := keyStore.CreateKey(KeyTypeX25519, &did)
+		require.NoError(t, err)
+		keyIDParts := strings.Split(keyID.ID, "/")
+		require.Len(t, keyIDParts, 2)
+		assert.Equal(t, did.String(), keyIDParts[0])
+		assert.True(t, strings.HasPrefix(keyIDParts[1], string(KeyTypeX25519)+":"))
+
+		publicKey, err := keyStore.PublicKey(keyID)
+		require.NoError(t, err)
+		issuerKey, err := ecdh.X25519().NewPublicKey(publicKey)
+		require.NoError(t, err)
+
+		peer, err := ecdh.X25519().GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		secret, err := keyStore.KeyAgreement(ctx, keyID, peer.PublicKey().Bytes())
+		require.NoError(t, err)
+		expected, err := peer.ECDH(issuerKey)
+		require.NoError(t, err)
+		assert.Equal(t, expected, secret)
+
+		keys, err := keyStore.KeysByIdentity(ctx, did)
+		require.NoError(t, err)
+		assert.Equal(t, []KeyID{keyID}, keys)
+	})
+
+	t.Run("should not sign with key agreement keys", func(t *testing.T) {
+		keyID, err := keyStore.CreateKey(KeyTypeX25519, &did)
+		require.NoError(t, err)
+		_, err = keyStore.Sign(ctx, keyID, []byte("data"))
+		assert.ErrorIs(t, err, ErrKeyAgreementOnly)
+	})
+}
@@ -23,26 +23,30 @@ func getKeyID(identity *w3c.DID, keyType KeyType, keyID string) string {
 }
 
 // convertToKeyType - converts string to KeyType
-// converts from babyjubjub to BJJ and from ethereum to ETH
+// converts from babyjubjub to BJJ, from ethereum to ETH and from x25519 to X25519
 func convertToKeyType(keyType string) KeyType {
 	switch keyType {
 	case babyjubjub:
 		return KeyTypeBabyJubJub
 	case ethereum:
 		return KeyTypeEthereum
+	case x25519:
+		return KeyTypeX25519
 	default:
 		return ""
 	}
 }
 
 // convertFromKeyType - converts KeyType to string
-// converts from BJJ to babyjubjub and from ETH to ethereum
+// converts from BJJ to babyjubjub, from ETH to ethereum and from X25519 to x25519
 func convertFromKeyType(keyType KeyType) string {
 	switch keyType {
 	case KeyTypeBabyJubJub:
 		return babyjubjub
 	case KeyTypeEthereum:
 		return ethereum
+	case KeyTypeX25519:
+		return x25519
 	default:
 		return ""
 	}
@@ -3,6 +3,10 @@ package loader
 import (
 	"github.com/iden3/go-schema-processor/processor"
 	"github.com/piprate/json-gold/ld"
+	"golang.org/x/time/rate"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/config"
 )
 
 // DocumentLoader is an alias for json-gold DocumentLoader
@@ -20,3 +24,18 @@ type Factory func(url string) Loader
 func NewDocumentLoader(ipfsGateway string, withCache bool) ld.DocumentLoader {
 	return NewW3CDocumentLoader(nil, ipfsGateway, withCache)
 }
+
+// NewDocumentLoaderFromConfig returns the document loader of the node. When the schema cache is enabled, the
+// documents are kept in c, that is shared by all the processes of the node.
+func NewDocumentLoaderFromConfig(cfg config.Configuration, c cache.Cache) ld.DocumentLoader {
+	documentLoader := NewDocumentLoader(cfg.IPFS.GatewayURL, false)
+	if !cfg.SchemaCache || c == nil {
+		return documentLoader
+	}
+	return NewSharedCacheDocumentLoader(c, documentLoader, SharedCacheConfig{
+		TTL:         cfg.SchemaLoader.CacheTTL,
+		NotFoundTTL: cfg.SchemaLoader.CacheNotFoundTTL,
+		HostRate:    rate.Limit(cfg.SchemaLoader.HostRate),
+		HostBurst:   cfg.SchemaLoader.HostBurst,
+	})
+}
@@ -0,0 +1,274 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+	"golang.org/x/time/rate"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	// documentRetention is how long a document stays in the cache after it goes stale, so it can be revalidated
+	// instead of downloaded again
+	documentRetention            = 7 * 24 * time.Hour
+	defaultNotFoundCacheDuration = 5 * time.Minute
+	documentFetchTimeout         = 30 * time.Second
+	documentMaxSize              = 10 << 20
+
+	acceptHeader = "application/ld+json, application/json;q=0.9, application/javascript;q=0.5, text/javascript;q=0.5, text/plain;q=0.2, */*;q=0.1"
+)
+
+// Results of a lookup in the shared cache of documents
+const (
+	cacheResultHit         = "hit"         // fresh document found in the cache
+	cacheResultNotFound    = "not_found"   // the document is known to be missing
+	cacheResultMiss        = "miss"        // the document is downloaded
+	cacheResultRevalidated = "revalidated" // the stale document is still valid for its origin
+	cacheResultStale       = "stale"       // the origin failed and the stale document is returned
+)
+
+var documentCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "issuer",
+	Subsystem: "jsonld_cache",
+	Name:      "requests_total",
+	Help:      "JSON-LD documents requested to the shared cache by result",
+}, []string{"result"})
+
+// SharedCacheConfig configures the document loader backed by a shared cache
+type SharedCacheConfig struct {
+	TTL         time.Duration // time a document is used before it is revalidated with its origin
+	NotFoundTTL time.Duration // time a document missing in its origin is reported as missing without fetching it
+	HostRate    rate.Limit    // max number of fetches per second to the same host
+	HostBurst   int
+}
+
+// cachedDocument is the entry of a document in the shared cache. Documents without http origin are immutable and
+// never expire.
+type cachedDocument struct {
+	Document     []byte
+	ContextURL   string
+	ETag         string
+	LastModified string
+	NotFound     bool
+	ExpiresAt    time.Time
+}
+
+func (d *cachedDocument) fresh(now time.Time) bool {
+	return d.ExpiresAt.IsZero() || now.Before(d.ExpiresAt)
+}
+
+type sharedCacheDocumentLoader struct {
+	cache    cache.Cache
+	next     ld.DocumentLoader
+	cfg      SharedCacheConfig
+	client   *http.Client
+	fetches  singleflight.Group
+	mutex    sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewSharedCacheDocumentLoader returns a document loader that keeps the documents in a cache shared by all the
+// processes of the node. http documents are revalidated with their ETag or Last-Modified headers once they are
+// older than the TTL, missing documents are remembered for a while, concurrent loads of the same document are
+// made once, and the fetches to each host are rate limited. Other documents are loaded with next and cached forever.
+func NewSharedCacheDocumentLoader(c cache.Cache, next ld.DocumentLoader, cfg SharedCacheConfig) ld.DocumentLoader {
+	if cfg.TTL <= 0 {
+		cfg.TTL = defaultSchemaCacheDuration
+	}
+	if cfg.NotFoundTTL <= 0 {
+		cfg.NotFoundTTL = defaultNotFoundCacheDuration
+	}
+	if cfg.HostRate <= 0 {
+		cfg.HostRate = rate.Inf
+	}
+	return &sharedCacheDocumentLoader{
+		cache:    c,
+		next:     next,
+		cfg:      cfg,
+		client:   &http.Client{Timeout: documentFetchTimeout},
+		limiters: make(map[string]*rate.Limiter),
+	}
+}
+
+// LoadDocument loads a document from the cache or its origin
+func (l *sharedCacheDocumentLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	if u == W3CCredential2018ContextURL {
+		return remoteDocument(u, &cachedDocument{Document: []byte(W3CCredential2018ContextDocument), ContextURL: u})
+	}
+
+	ctx := context.Background()
+	var entry cachedDocument
+	if l.cache.Get(ctx, l.key(u), &entry) && entry.fresh(time.Now()) {
+		if entry.NotFound {
+			documentCacheRequests.WithLabelValues(cacheResultNotFound).Inc()
+			return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("document not found: %s", u))
+		}
+		documentCacheRequests.WithLabelValues(cacheResultHit).Inc()
+		return remoteDocument(u, &entry)
+	}
+
+	var stale *cachedDocument
+	if len(entry.Document) > 0 {
+		stale = &entry
+	}
+	res, err, _ := l.fetches.Do(u, func() (any, error) {
+		return l.load(ctx, u, stale)
+	})
+	if err != nil {
+		return nil, err
+	}
+	loaded := res.(*cachedDocument)
+	if loaded.NotFound {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("document not found: %s", u))
+	}
+	return remoteDocument(u, loaded)
+}
+
+// load loads the document from its origin and caches it
+func (l *sharedCacheDocumentLoader) load(ctx context.Context, u string, stale *cachedDocument) (*cachedDocument, error) {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("error parsing URL: %s", u))
+	}
+
+	var entry *cachedDocument
+	ttl := cache.ForEver
+	if parsed.Scheme == "http" || parsed.Scheme == "https" {
+		var result string
+		entry, result, err = l.fetch(ctx, parsed, stale)
+		if err != nil {
+			if stale == nil {
+				return nil, err
+			}
+			log.Warn(ctx, "loading document, using stale copy", "err", err, "url", u)
+			documentCacheRequests.WithLabelValues(cacheResultStale).Inc()
+			return stale, nil
+		}
+		documentCacheRequests.WithLabelValues(result).Inc()
+		ttl = documentRetention
+		if entry.NotFound {
+			ttl = l.cfg.NotFoundTTL
+		}
+	} else {
+		doc, err := l.next.LoadDocument(u)
+		if err != nil {
+			return nil, err
+		}
+		documentCacheRequests.WithLabelValues(cacheResultMiss).Inc()
+		content, err := json.Marshal(doc.Document)
+		if err != nil {
+			return nil, ld.NewJsonLdError(ld.LoadingDocumentFailed, err)
+		}
+		entry = &cachedDocument{Document: content, ContextURL: doc.ContextURL}
+	}
+
+	if err := l.cache.Set(ctx, l.key(u), *entry, ttl); err != nil {
+		log.Warn(ctx, "caching document. Bypassing cache", "err", err, "url", u)
+	}
+	return entry, nil
+}
+
+// fetch downloads the document, or revalidates the stale copy when there is one
+func (l *sharedCacheDocumentLoader) fetch(ctx context.Context, u *url.URL, stale *cachedDocument) (*cachedDocument, string, error) {
+	if err := l.limiter(u.Host).Wait(ctx); err != nil {
+		return nil, "", ld.NewJsonLdError(ld.LoadingDocumentFailed, err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", ld.NewJsonLdError(ld.LoadingDocumentFailed, err)
+	}
+	req.Header.Set("Accept", acceptHeader)
+	if stale != nil {
+		if stale.ETag != "" {
+			req.Header.Set("If-None-Match", stale.ETag)
+		}
+		if stale.LastModified != "" {
+			req.Header.Set("If-Modified-Since", stale.LastModified)
+		}
+	}
+
+	res, err := l.client.Do(req)
+	if err != nil {
+		return nil, "", ld.NewJsonLdError(ld.LoadingDocumentFailed, err)
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	expiresAt := time.Now().Add(l.cfg.TTL)
+	switch {
+	case res.StatusCode == http.StatusNotModified && stale != nil:
+		revalidated := *stale
+		revalidated.ExpiresAt = expiresAt
+		return &revalidated, cacheResultRevalidated, nil
+	case res.StatusCode == http.StatusNotFound || res.StatusCode == http.StatusGone:
+		return &cachedDocument{NotFound: true, ExpiresAt: time.Now().Add(l.cfg.NotFoundTTL)}, cacheResultMiss, nil
+	case res.StatusCode != http.StatusOK:
+		return nil, "", ld.NewJsonLdError(ld.LoadingDocumentFailed, fmt.Sprintf("bad response status code: %d", res.StatusCode))
+	}
+
+	content, err := io.ReadAll(io.LimitReader(res.Body, documentMaxSize))
+	if err != nil {
+		return nil, "", ld.NewJsonLdError(ld.LoadingDocumentFailed, err)
+	}
+	if _, err := ld.DocumentFromReader(bytes.NewReader(content)); err != nil {
+		return nil, "", err
+	}
+	entry := &cachedDocument{
+		Document:     content,
+		ContextURL:   contextLink(res.Header.Get("Link")),
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+		ExpiresAt:    expiresAt,
+	}
+	return entry, cacheResultMiss, nil
+}
+
+// limiter returns the rate limiter of the host
+func (l *sharedCacheDocumentLoader) limiter(host string) *rate.Limiter {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	limiter, ok := l.limiters[host]
+	if !ok {
+		limiter = rate.NewLimiter(l.cfg.HostRate, l.cfg.HostBurst)
+		l.limiters[host] = limiter
+	}
+	return limiter
+}
+
+func (l *sharedCacheDocumentLoader) key(u string) string {
+	return fmt.Sprintf("jsonld-%s", u)
+}
+
+// contextLink returns the context linked by the Link header of a json document, if any
+func contextLink(header string) string {
+	if header == "" {
+		return ""
+	}
+	links := ld.ParseLinkHeader(header)["http://www.w3.org/ns/json-ld#context"]
+	if len(links) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(links[0]["target"])
+}
+
+func remoteDocument(u string, entry *cachedDocument) (*ld.RemoteDocument, error) {
+	doc, err := ld.DocumentFromReader(bytes.NewReader(entry.Document))
+	if err != nil {
+		return nil, err
+	}
+	return &ld.RemoteDocument{DocumentURL: u, Document: doc, ContextURL: entry.ContextURL}, nil
+}
@@ -0,0 +1,117 @@
+package loader
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+)
+
+type ipfsSpy struct {
+	called int
+}
+
+func (s *ipfsSpy) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	s.called++
+	return &ld.RemoteDocument{DocumentURL: u, Document: map[string]any{"@context": map[string]any{}}}, nil
+}
+
+func TestSharedCacheDocumentLoader_LoadDocument(t *testing.T) {
+	var fetches, revalidations atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		switch r.URL.Path {
+		case "/slow.jsonld":
+			<-release
+		case "/missing.jsonld":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			revalidations.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"@context":{"name":"https://schema.org/name"}}`))
+	}))
+	defer server.Close()
+
+	c := cache.NewMemoryCache()
+	documentLoader := NewSharedCacheDocumentLoader(c, &ipfsSpy{}, SharedCacheConfig{TTL: time.Hour, NotFoundTTL: time.Hour})
+
+	t.Run("should fetch the document once", func(t *testing.T) {
+		fetches.Store(0)
+		for i := 0; i < 5; i++ {
+			doc, err := documentLoader.LoadDocument(server.URL + "/context.jsonld")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"@context": map[string]any{"name": "https://schema.org/name"}}, doc.Document)
+		}
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+
+	t.Run("should share the documents with other loaders of the same cache", func(t *testing.T) {
+		fetches.Store(0)
+		other := NewSharedCacheDocumentLoader(c, &ipfsSpy{}, SharedCacheConfig{TTL: time.Hour})
+		_, err := other.LoadDocument(server.URL + "/context.jsonld")
+		require.NoError(t, err)
+		assert.Equal(t, int32(0), fetches.Load())
+	})
+
+	t.Run("should revalidate stale documents", func(t *testing.T) {
+		fetches.Store(0)
+		stale := NewSharedCacheDocumentLoader(cache.NewMemoryCache(), &ipfsSpy{}, SharedCacheConfig{TTL: time.Nanosecond})
+		for i := 0; i < 3; i++ {
+			doc, err := stale.LoadDocument(server.URL + "/context.jsonld")
+			require.NoError(t, err)
+			assert.NotNil(t, doc.Document)
+		}
+		assert.Equal(t, int32(3), fetches.Load())
+		assert.Equal(t, int32(2), revalidations.Load())
+	})
+
+	t.Run("should remember missing documents", func(t *testing.T) {
+		fetches.Store(0)
+		for i := 0; i < 3; i++ {
+			_, err := documentLoader.LoadDocument(server.URL + "/missing.jsonld")
+			assert.Error(t, err)
+		}
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+
+	t.Run("should fetch once the documents loaded concurrently", func(t *testing.T) {
+		fetches.Store(0)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := documentLoader.LoadDocument(server.URL + "/slow.jsonld")
+				assert.NoError(t, err)
+			}()
+		}
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+
+	t.Run("should cache the ipfs documents forever", func(t *testing.T) {
+		spy := &ipfsSpy{}
+		ipfsLoader := NewSharedCacheDocumentLoader(cache.NewMemoryCache(), spy, SharedCacheConfig{TTL: time.Nanosecond})
+		for i := 0; i < 3; i++ {
+			_, err := ipfsLoader.LoadDocument("ipfs://QmYsxBXoqbCPw5t8RVpyjE1ZiYBRtT9kR1WgVHwGyNNRnv")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, spy.called)
+	})
+}
@@ -0,0 +1,50 @@
+package loader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/piprate/json-gold/ld"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrInvalidSnapshot is returned when the content of a snapshot doesn't match its digest
+var ErrInvalidSnapshot = errors.New("schema snapshot content doesn't match its digest")
+
+// Snapshots is the store of the snapshots of the documents of the imported schemas
+type Snapshots interface {
+	GetByURL(ctx context.Context, url string) (*domain.SchemaSnapshot, error)
+}
+
+type snapshotDocumentLoader struct {
+	snapshots Snapshots
+	next      ld.DocumentLoader
+}
+
+// NewSnapshotDocumentLoader returns a document loader that resolves the documents from their snapshots
+// and only loads them with next when there is none
+func NewSnapshotDocumentLoader(snapshots Snapshots, next ld.DocumentLoader) ld.DocumentLoader {
+	return &snapshotDocumentLoader{snapshots: snapshots, next: next}
+}
+
+// LoadDocument returns the snapshot of the document at u, or the document loaded by next if there is no snapshot
+func (l *snapshotDocumentLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	ctx := context.Background()
+	snapshot, err := l.snapshots.GetByURL(ctx, u)
+	if err != nil {
+		log.Debug(ctx, "no schema snapshot, loading document", "url", u, "err", err)
+		return l.next.LoadDocument(u)
+	}
+	if !snapshot.Valid() {
+		log.Error(ctx, "invalid schema snapshot", "url", u, "digest", snapshot.Digest)
+		return nil, ErrInvalidSnapshot
+	}
+	doc, err := ld.DocumentFromReader(bytes.NewReader(snapshot.Content))
+	if err != nil {
+		return nil, err
+	}
+	return &ld.RemoteDocument{DocumentURL: u, Document: doc}, nil
+}
@@ -0,0 +1,53 @@
+package loader
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+type snapshotsMap map[string]*domain.SchemaSnapshot
+
+func (s snapshotsMap) GetByURL(_ context.Context, url string) (*domain.SchemaSnapshot, error) {
+	snapshot, ok := s[url]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return snapshot, nil
+}
+
+type spyDocumentLoader struct {
+	called int
+}
+
+func (s *spyDocumentLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	s.called++
+	return &ld.RemoteDocument{DocumentURL: u, Document: map[string]interface{}{"remote": true}}, nil
+}
+
+func TestSnapshotDocumentLoader_LoadDocument(t *testing.T) {
+	const url = "https://schemas.example.com/schema.json"
+	spy := &spyDocumentLoader{}
+	snapshots := snapshotsMap{url: domain.NewSchemaSnapshot(url, []byte(`{"remote":false}`))}
+	documentLoader := NewSnapshotDocumentLoader(snapshots, spy)
+
+	doc, err := documentLoader.LoadDocument(url)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"remote": false}, doc.Document)
+	assert.Equal(t, 0, spy.called)
+
+	doc, err = documentLoader.LoadDocument("https://schemas.example.com/other.json")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"remote": true}, doc.Document)
+	assert.Equal(t, 1, spy.called)
+
+	snapshots[url].Content = []byte(`{"remote":"tampered"}`)
+	_, err = documentLoader.LoadDocument(url)
+	assert.ErrorIs(t, err, ErrInvalidSnapshot)
+}
@@ -20,18 +20,21 @@ func requestLogger(ctx context.Context) func(next http.Handler) http.Handler {
 		fn := func(w http.ResponseWriter, r *http.Request) {
 			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
 			t1 := time.Now()
+			attrs := &requestAttrs{}
+			r = r.WithContext(context.WithValue(r.Context(), requestAttrsKey{}, attrs))
 			//nolint:contextcheck
 			defer func() {
 				ua := r.Header.Get("User-Agent")
-				Info(ctx,
-					"http req",
+				args := []any{
 					"req-id", middleware.GetReqID(r.Context()),
 					"method", r.Method,
 					"uri", r.RequestURI,
 					"status", ww.Status(),
 					"bytes", ww.BytesWritten(),
 					"ua", ua,
-					"d", time.Since(t1))
+					"d", time.Since(t1),
+				}
+				Info(ctx, "http req", append(args, attrs.get()...)...)
 			}()
 			next.ServeHTTP(ww, r)
 		}
@@ -0,0 +1,62 @@
+package log
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+)
+
+type (
+	contextAttrsKey struct{}
+	requestAttrsKey struct{}
+)
+
+// requestAttrs are the attributes added to the log of an http request while it is served
+type requestAttrs struct {
+	mutex sync.Mutex
+	args  []any
+}
+
+// WithAttrs returns a copy of ctx whose log records include the attributes in args
+func WithAttrs(ctx context.Context, args ...any) context.Context {
+	attrs, _ := ctx.Value(contextAttrsKey{}).([]any)
+	return context.WithValue(ctx, contextAttrsKey{}, append(append([]any{}, attrs...), args...))
+}
+
+// AddRequestAttrs adds the attributes in args to the log of the http request served with ctx, if any.
+// See ChiMiddleware.
+func AddRequestAttrs(ctx context.Context, args ...any) {
+	attrs, ok := ctx.Value(requestAttrsKey{}).(*requestAttrs)
+	if !ok {
+		return
+	}
+	attrs.mutex.Lock()
+	defer attrs.mutex.Unlock()
+	attrs.args = append(attrs.args, args...)
+}
+
+func (a *requestAttrs) get() []any {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return append([]any{}, a.args...)
+}
+
+// contextHandler adds the attributes of the context to the log records
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if attrs, ok := ctx.Value(contextAttrsKey{}).([]any); ok {
+		r.Add(attrs...)
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
@@ -33,7 +33,7 @@ func Config(level, format int, w io.Writer) {
 	if format == OutputJSON {
 		handler = slog.NewJSONHandler(w, &opts)
 	}
-	slog.SetDefault(slog.New(handler))
+	slog.SetDefault(slog.New(contextHandler{handler}))
 }
 
 // With changes the default logger to include the extra attributes
@@ -0,0 +1,108 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrNetworkInUse is returned when a reload removes a network used by existing identities
+var ErrNetworkInUse = errors.New("network is used by existing identities")
+
+// UsageChecker tells whether a network is used by existing identities
+type UsageChecker interface {
+	IsNetworkInUse(ctx context.Context, blockchain, network string) (bool, error)
+}
+
+// ReloadResult holds the networks, as blockchain:network, changed by a reload
+type ReloadResult struct {
+	Added   []string
+	Updated []string
+	Removed []string
+}
+
+// reloader builds the resolver states. Reloads are serialized.
+// ctx is the context the resolver was created with, it bounds the health probes of the RPC endpoints.
+type reloader struct {
+	mutex sync.Mutex
+	ctx   context.Context
+	cfg   config.Configuration
+	kms   *kms.KMS
+}
+
+// Reload reads the resolver settings from the reader and replaces the current ones.
+// Only the added networks and the ones whose settings changed are connected again. The new settings are applied
+// all at once: if any network fails, the current settings are kept.
+// The reload is refused with ErrNetworkInUse if it removes a network used by existing identities.
+// The state resolvers and contracts given to the auth verifier and the package manager at startup are not reloaded.
+func (r *Resolver) Reload(ctx context.Context, reader io.Reader, checker UsageChecker) (*ReloadResult, error) {
+	rs, err := parseResolversSettings(ctx, reader)
+	if err != nil {
+		return nil, errors.New("failed to parse resolver settings")
+	}
+
+	r.reloader.mutex.Lock()
+	defer r.reloader.mutex.Unlock()
+
+	current := r.state.Load()
+	result := diffSettings(current.settings, rs)
+	for _, removed := range result.Removed {
+		blockchain, networkID, _ := strings.Cut(removed, ":")
+		inUse, err := checker.IsNetworkInUse(ctx, blockchain, networkID)
+		if err != nil {
+			log.Error(ctx, "cannot check if the network is in use", "err", err, "network", removed)
+			return nil, err
+		}
+		if inUse {
+			log.Warn(ctx, "resolver settings reload refused", "network", removed)
+			return nil, fmt.Errorf("%w: %s", ErrNetworkInUse, removed)
+		}
+	}
+
+	rState, err := r.reloader.buildState(ctx, rs, current)
+	if err != nil {
+		log.Error(ctx, "cannot reload resolver settings", "err", err)
+		return nil, err
+	}
+	r.state.Store(rState)
+	current.stopEndpoints(rState)
+
+	log.Info(ctx, "resolver settings reloaded", "added", result.Added, "updated", result.Updated, "removed", result.Removed)
+	return result, nil
+}
+
+// diffSettings returns the networks added, updated and removed from the current settings
+func diffSettings(current, next ResolverSettings) *ReloadResult {
+	result := &ReloadResult{Added: []string{}, Updated: []string{}, Removed: []string{}}
+	for chainName, chainSettings := range next {
+		for networkName, networkSettings := range chainSettings {
+			currentSettings, ok := current[chainName][networkName]
+			switch {
+			case !ok:
+				result.Added = append(result.Added, getResolverPrefixKey(chainName, networkName))
+			case !reflect.DeepEqual(currentSettings, networkSettings):
+				result.Updated = append(result.Updated, getResolverPrefixKey(chainName, networkName))
+			}
+		}
+	}
+	for chainName, chainSettings := range current {
+		for networkName := range chainSettings {
+			if _, ok := next[chainName][networkName]; !ok {
+				result.Removed = append(result.Removed, getResolverPrefixKey(chainName, networkName))
+			}
+		}
+	}
+	sort.Strings(result.Added)
+	sort.Strings(result.Updated)
+	sort.Strings(result.Removed)
+	return result
+}
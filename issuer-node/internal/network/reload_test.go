@@ -0,0 +1,95 @@
+package network
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/config"
+)
+
+const amoySettings = `polygon:
+  amoy:
+    contractAddress: 0x1a4cC30f2aA0377b0c3bc9848766D90cb4404124
+    networkURL: https://polygon-amoy.g.alchemy.com/v2/123
+    defaultGasLimit: 600000
+    rhsSettings:
+      mode: None
+`
+
+const mainSettings = `  main:
+    contractAddress: 0x624ce98D2d27b20b8f8d521723Df8fC4db71D79D
+    networkURL: https://polygon-mainnet.g.alchemy.com/v2/123
+    defaultGasLimit: 600000
+    rhsSettings:
+      mode: None
+`
+
+type usageChecker map[string]bool
+
+func (u usageChecker) IsNetworkInUse(_ context.Context, blockchain, network string) (bool, error) {
+	return u[blockchain+":"+network], nil
+}
+
+func TestResolver_Reload(t *testing.T) {
+	ctx := context.Background()
+	resolver, err := NewResolver(ctx, config.Configuration{ServerUrl: "https://issuer-node.privado.id"}, nil, bytes.NewBufferString(amoySettings))
+	require.NoError(t, err)
+	copied := *resolver
+	amoyClient, err := resolver.GetEthClient("polygon:amoy")
+	require.NoError(t, err)
+
+	t.Run("should add a network and keep the clients of the unchanged ones", func(t *testing.T) {
+		result, err := resolver.Reload(ctx, bytes.NewBufferString(amoySettings+mainSettings), usageChecker{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"polygon:main"}, result.Added)
+		assert.Empty(t, result.Updated)
+		assert.Empty(t, result.Removed)
+
+		_, err = copied.GetEthClient("polygon:main")
+		assert.NoError(t, err, "copies of the resolver must see the reloaded settings")
+		client, err := copied.GetEthClient("polygon:amoy")
+		require.NoError(t, err)
+		assert.Same(t, amoyClient, client)
+	})
+
+	t.Run("should replace the clients of the updated networks", func(t *testing.T) {
+		updated := bytes.Replace([]byte(amoySettings), []byte("v2/123"), []byte("v2/456"), 1)
+		result, err := resolver.Reload(ctx, bytes.NewBuffer(append(updated, mainSettings...)), usageChecker{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"polygon:amoy"}, result.Updated)
+		assert.Empty(t, result.Added)
+		assert.Empty(t, result.Removed)
+
+		client, err := resolver.GetEthClient("polygon:amoy")
+		require.NoError(t, err)
+		assert.NotSame(t, amoyClient, client)
+	})
+
+	t.Run("should refuse to remove a network in use", func(t *testing.T) {
+		_, err := resolver.Reload(ctx, bytes.NewBufferString(amoySettings), usageChecker{"polygon:main": true})
+		assert.ErrorIs(t, err, ErrNetworkInUse)
+		_, err = resolver.GetEthClient("polygon:main")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should keep the current settings if the new ones are invalid", func(t *testing.T) {
+		invalid := bytes.Replace([]byte(amoySettings), []byte("mode: None"), []byte("mode: OffChain"), 1)
+		_, err := resolver.Reload(ctx, bytes.NewBuffer(invalid), usageChecker{})
+		assert.Error(t, err)
+		_, err = resolver.GetEthClient("polygon:main")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should remove a network not in use", func(t *testing.T) {
+		result, err := resolver.Reload(ctx, bytes.NewBufferString(amoySettings), usageChecker{"polygon:amoy": true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"polygon:main"}, result.Removed)
+		_, err = resolver.GetEthClient("polygon:main")
+		assert.Error(t, err)
+		assert.Len(t, resolver.GetSupportedNetworks(), 1)
+	})
+}
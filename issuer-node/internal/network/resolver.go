@@ -6,12 +6,13 @@ import (
 	"fmt"
 	"io"
 	"math/big"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/iden3/contracts-abi/state/go/abi"
 	"github.com/iden3/go-iden3-auth/v2/pubsignals"
 	"github.com/iden3/go-iden3-auth/v2/state"
@@ -21,8 +22,11 @@ import (
 
 	"github.com/polygonid/sh-id-platform/internal/config"
 	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/health"
 	"github.com/polygonid/sh-id-platform/internal/kms"
 	"github.com/polygonid/sh-id-platform/internal/log"
+	"github.com/polygonid/sh-id-platform/internal/relayer"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/statuslist"
 )
 
 const (
@@ -47,14 +51,32 @@ type ResolverClientConfig struct {
 	contractAddress string
 }
 
+// RelayerClientConfig holds the relayer client of a network and its settings
+type RelayerClientConfig struct {
+	Client relayer.Relayer
+	Config relayer.Config
+}
+
 // Resolver holds the resolver
+// The resolver state is shared by all the copies of a Resolver, so the settings reloaded with Reload are seen by
+// every service that received the resolver at startup.
 type Resolver struct {
+	state    *atomic.Pointer[resolverState]
+	reloader *reloader
+}
+
+// resolverState holds the clients and settings of all the supported networks. It is never modified once built,
+// a reload builds a new state and swaps it.
+type resolverState struct {
+	settings                 ResolverSettings
 	ethereumClientsByChainID map[core.ChainID]ResolverClientConfig
 	ethereumClients          map[resolverPrefix]ResolverClientConfig
+	relayers                 map[resolverPrefix]RelayerClientConfig
 	rhsSettings              map[resolverPrefix]RhsSettings
 	supportedContracts       map[string]*abi.State
 	stateResolvers           map[string]pubsignals.StateResolver
 	supportedNetworks        []SupportedNetworks
+	endpoints                map[resolverPrefix]*eth.EndpointPool
 }
 
 // SupportedNetworks holds the chain and networks supoprted
@@ -74,8 +96,11 @@ type RhsSettings struct {
 	SingleIssuer         bool
 }
 
-// ResolverSettings holds the resolver settings
-type ResolverSettings map[string]map[string]struct {
+// ResolverSettings holds the resolver settings by blockchain and network
+type ResolverSettings map[string]map[string]NetworkSettings
+
+// NetworkSettings holds the settings of a network
+type NetworkSettings struct {
 	ContractAddress        string        `yaml:"contractAddress"`
 	NetworkURL             string        `yaml:"networkURL"`
 	DefaultGasLimit        int           `yaml:"defaultGasLimit"`
@@ -88,11 +113,21 @@ type ResolverSettings map[string]map[string]struct {
 	WaitReceiptCycleTime   time.Duration `yaml:"waitReceiptCycleTime"`
 	WaitBlockCycleTime     time.Duration `yaml:"waitBlockCycleTime"`
 	GasLess                bool          `yaml:"gasLess"`
+	MaxBaseFee             *big.Int      `yaml:"maxBaseFee"`
+	MaxFeePerGas           *big.Int      `yaml:"maxFeePerGas"`
+	StuckTxTimeout         time.Duration `yaml:"stuckTxTimeout"`
+	FeeBumpPercent         int           `yaml:"feeBumpPercent"`
 	TransferAmountWei      *big.Int      `yaml:"transferAmountWei"`
 	RhsSettings            RhsSettings   `yaml:"rhsSettings"`
 	NetworkFlag            byte          `yaml:"networkFlag"`
 	ChainID                string        `yaml:"chainID"`
 	Method                 string        `yaml:"method"`
+
+	// Relayer, when set, makes the issuer node publish the states of the network through a transaction relayer
+	Relayer *relayer.Config `yaml:"relayer"`
+	// NetworkURLs are fallback RPC URLs. Requests are sent to the healthiest of NetworkURL and NetworkURLs
+	NetworkURLs []string           `yaml:"networkURLs"`
+	RPCFailover eth.FailoverConfig `yaml:"rpcFailover"`
 }
 
 // NewResolver returns a new Network Resolver
@@ -103,15 +138,36 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 		return nil, errors.New("failed to parse resolver settings")
 	}
 
-	ethereumClients := make(map[resolverPrefix]ResolverClientConfig)
-	ethereumClientsByChainID := make(map[core.ChainID]ResolverClientConfig)
-	rhsSettings := make(map[resolverPrefix]RhsSettings)
-	supportedContracts := make(map[string]*abi.State)
-	stateResolvers := make(map[string]pubsignals.StateResolver)
-
 	log.Info(ctx, "the issuer node will use the resolver settings file for configuring multi chain feature")
+	reloader := &reloader{ctx: ctx, cfg: cfg, kms: kms}
+	rState, err := reloader.buildState(ctx, rs, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resolver := &Resolver{
+		state:    &atomic.Pointer[resolverState]{},
+		reloader: reloader,
+	}
+	resolver.state.Store(rState)
+	return resolver, nil
+}
+
+// buildState creates the clients of every network in the settings. The clients of the networks whose settings
+// have not changed since the previous state are reused.
+func (rl *reloader) buildState(ctx context.Context, rs ResolverSettings, previous *resolverState) (*resolverState, error) {
+	rState := &resolverState{
+		settings:                 rs,
+		ethereumClients:          make(map[resolverPrefix]ResolverClientConfig),
+		ethereumClientsByChainID: make(map[core.ChainID]ResolverClientConfig),
+		relayers:                 make(map[resolverPrefix]RelayerClientConfig),
+		rhsSettings:              make(map[resolverPrefix]RhsSettings),
+		supportedContracts:       make(map[string]*abi.State),
+		stateResolvers:           make(map[string]pubsignals.StateResolver),
+		endpoints:                make(map[resolverPrefix]*eth.EndpointPool),
+	}
+
 	var printer strings.Builder
-	var supportedNetworks []SupportedNetworks
 	for chainName, chainSettings := range rs {
 		printer.WriteString(fmt.Sprintf("chainName: %s", chainName))
 		var supportedNetwork SupportedNetworks
@@ -119,89 +175,143 @@ func NewResolver(ctx context.Context, cfg config.Configuration, kms *kms.KMS, re
 		for networkName, networkSettings := range chainSettings {
 			printer.WriteString(fmt.Sprintf(", networkName: %s", networkName))
 			supportedNetwork.Networks = append(supportedNetwork.Networks, networkName)
-			if networkSettings.NetworkFlag != 0 {
-				if err := registerCustomDIDMethod(ctx, chainName, networkName, networkSettings.ChainID, networkSettings.Method, networkSettings.NetworkFlag); err != nil {
-					return nil, fmt.Errorf("failed to register custom DID method: %w", err)
-				}
+			if previous != nil && previous.hasSameSettings(chainName, networkName, networkSettings) {
+				rState.copyNetwork(previous, chainName, networkName)
+				continue
 			}
-			resolverPrefixKey := getResolverPrefixKey(chainName, networkName)
-			ethClient, err := ethclient.Dial(networkSettings.NetworkURL)
-			if err != nil {
-				log.Error(ctx, "cannot connect to ethereum network", "err", err, "networkURL", networkSettings.NetworkURL)
+			if err := rl.addNetwork(ctx, rState, chainName, networkName, networkSettings); err != nil {
+				rState.stopEndpoints(previous)
 				return nil, err
 			}
+		}
+		rState.supportedNetworks = append(rState.supportedNetworks, supportedNetwork)
+	}
 
-			client := eth.NewClient(ethClient, &eth.ClientConfig{
-				DefaultGasLimit:        networkSettings.DefaultGasLimit,
-				ConfirmationTimeout:    networkSettings.ConfirmationTimeout,
-				ConfirmationBlockCount: networkSettings.ConfirmationBlockCount,
-				ReceiptTimeout:         networkSettings.ReceiptTimeout,
-				GasLess:                networkSettings.GasLess,
-				MinGasPrice:            big.NewInt(int64(networkSettings.MinGasPrice)),
-				MaxGasPrice:            big.NewInt(int64(networkSettings.MaxGasPrice)),
-				RPCResponseTimeout:     networkSettings.RPCResponseTimeout,
-				WaitReceiptCycleTime:   networkSettings.WaitReceiptCycleTime,
-				WaitBlockCycleTime:     networkSettings.WaitBlockCycleTime,
-			}, kms)
-
-			resolverClientConfig := &ResolverClientConfig{
-				client:          client,
-				contractAddress: networkSettings.ContractAddress,
-			}
+	log.Info(ctx, "resolver settings", "settings:", printer.String())
+	return rState, nil
+}
 
-			ethereumClients[resolverPrefix(resolverPrefixKey)] = *resolverClientConfig
-			chainID, err := core.GetChainID(core.Blockchain(chainName), core.NetworkID(networkName))
-			if err != nil {
-				log.Error(ctx, "cannot get chain ID from blockchain and network", "err", err, "blockchain", chainName, "networl", networkName)
-				return nil, err
-			}
-			ethereumClientsByChainID[chainID] = *resolverClientConfig
-			settings := networkSettings.RhsSettings
-			settings.Iden3CommAgentStatus = strings.TrimSuffix(cfg.ServerUrl, "/")
-
-			if settings.Mode == OffChain || settings.Mode == All {
-				if settings.RhsUrl == nil {
-					return nil, fmt.Errorf("rhs url not found for %s", resolverPrefixKey)
-				}
-			}
+// addNetwork connects to the network and adds its clients and settings to the state
+func (rl *reloader) addNetwork(ctx context.Context, rState *resolverState, chainName, networkName string, networkSettings NetworkSettings) error {
+	if networkSettings.NetworkFlag != 0 {
+		if err := registerCustomDIDMethod(ctx, chainName, networkName, networkSettings.ChainID, networkSettings.Method, networkSettings.NetworkFlag); err != nil {
+			return fmt.Errorf("failed to register custom DID method: %w", err)
+		}
+	}
+	resolverPrefixKey := getResolverPrefixKey(chainName, networkName)
+	endpoints, err := eth.NewEndpointPool(ctx, append([]string{networkSettings.NetworkURL}, networkSettings.NetworkURLs...), networkSettings.RPCFailover)
+	if err != nil {
+		log.Error(ctx, "cannot connect to ethereum network", "err", err, "network", resolverPrefixKey)
+		return err
+	}
+	ethClient := endpoints.Client()
+
+	client := eth.NewFailoverClient(endpoints, &eth.ClientConfig{
+		DefaultGasLimit:        networkSettings.DefaultGasLimit,
+		ConfirmationTimeout:    networkSettings.ConfirmationTimeout,
+		ConfirmationBlockCount: networkSettings.ConfirmationBlockCount,
+		ReceiptTimeout:         networkSettings.ReceiptTimeout,
+		GasLess:                networkSettings.GasLess,
+		MinGasPrice:            big.NewInt(int64(networkSettings.MinGasPrice)),
+		MaxGasPrice:            big.NewInt(int64(networkSettings.MaxGasPrice)),
+		RPCResponseTimeout:     networkSettings.RPCResponseTimeout,
+		WaitReceiptCycleTime:   networkSettings.WaitReceiptCycleTime,
+		WaitBlockCycleTime:     networkSettings.WaitBlockCycleTime,
+		MaxBaseFee:             networkSettings.MaxBaseFee,
+		MaxFeePerGas:           networkSettings.MaxFeePerGas,
+		StuckTxTimeout:         networkSettings.StuckTxTimeout,
+		FeeBumpPercent:         networkSettings.FeeBumpPercent,
+	}, rl.kms)
+
+	resolverClientConfig := &ResolverClientConfig{
+		client:          client,
+		contractAddress: networkSettings.ContractAddress,
+	}
 
-			if settings.Mode == OnChain || settings.Mode == All {
-				if settings.ContractAddress == nil {
-					return nil, fmt.Errorf("contract address not found for %s", resolverPrefixKey)
-				}
-			}
+	rState.ethereumClients[resolverPrefix(resolverPrefixKey)] = *resolverClientConfig
+	chainID, err := core.GetChainID(core.Blockchain(chainName), core.NetworkID(networkName))
+	if err != nil {
+		log.Error(ctx, "cannot get chain ID from blockchain and network", "err", err, "blockchain", chainName, "networl", networkName)
+		return err
+	}
+	rState.ethereumClientsByChainID[chainID] = *resolverClientConfig
 
-			rhsSettings[resolverPrefix(resolverPrefixKey)] = settings
-			stateContract, err := abi.NewState(common.HexToAddress(networkSettings.ContractAddress), ethClient)
-			if err != nil {
-				return nil, fmt.Errorf("error failed create state contract client: %s", err.Error())
-			}
-			supportedContracts[resolverPrefixKey] = stateContract
+	if networkSettings.Relayer != nil {
+		relayerClient, err := relayer.New(*networkSettings.Relayer)
+		if err != nil {
+			log.Error(ctx, "cannot create relayer client", "err", err, "network", resolverPrefixKey)
+			return err
+		}
+		rState.relayers[resolverPrefix(resolverPrefixKey)] = RelayerClientConfig{Client: relayerClient, Config: *networkSettings.Relayer}
+	}
 
-			stateResolvers[resolverPrefixKey] = state.ETHResolver{
-				RPCUrl:          networkSettings.NetworkURL,
-				ContractAddress: common.HexToAddress(networkSettings.ContractAddress),
-			}
+	settings := networkSettings.RhsSettings
+	settings.Iden3CommAgentStatus = strings.TrimSuffix(rl.cfg.ServerUrl, "/")
+
+	if settings.Mode == OffChain || settings.Mode == All {
+		if settings.RhsUrl == nil {
+			return fmt.Errorf("rhs url not found for %s", resolverPrefixKey)
+		}
+	}
+
+	if settings.Mode == OnChain || settings.Mode == All {
+		if settings.ContractAddress == nil {
+			return fmt.Errorf("contract address not found for %s", resolverPrefixKey)
 		}
-		supportedNetworks = append(supportedNetworks, supportedNetwork)
+	}
 
+	rState.rhsSettings[resolverPrefix(resolverPrefixKey)] = settings
+	stateContract, err := abi.NewState(common.HexToAddress(networkSettings.ContractAddress), ethClient)
+	if err != nil {
+		return fmt.Errorf("error failed create state contract client: %s", err.Error())
 	}
+	rState.supportedContracts[resolverPrefixKey] = stateContract
 
-	log.Info(ctx, "resolver settings", "settings:", printer.String())
+	rState.stateResolvers[resolverPrefixKey] = state.ETHResolver{
+		RPCUrl:          networkSettings.NetworkURL,
+		ContractAddress: common.HexToAddress(networkSettings.ContractAddress),
+	}
+
+	endpoints.Run(rl.ctx)
+	rState.endpoints[resolverPrefix(resolverPrefixKey)] = endpoints
+	return nil
+}
 
-	return &Resolver{
-		ethereumClients:          ethereumClients,
-		ethereumClientsByChainID: ethereumClientsByChainID,
-		rhsSettings:              rhsSettings,
-		supportedContracts:       supportedContracts,
-		stateResolvers:           stateResolvers,
-		supportedNetworks:        supportedNetworks,
-	}, nil
+// stopEndpoints stops the health probes of the endpoints of the state that are not in the other state
+func (s *resolverState) stopEndpoints(other *resolverState) {
+	for prefix, endpoints := range s.endpoints {
+		if other == nil || other.endpoints[prefix] != endpoints {
+			endpoints.Stop()
+		}
+	}
+}
+
+// hasSameSettings returns true if the network is in the state with the same settings
+func (s *resolverState) hasSameSettings(chainName, networkName string, networkSettings NetworkSettings) bool {
+	current, ok := s.settings[chainName][networkName]
+	return ok && reflect.DeepEqual(current, networkSettings)
+}
+
+// copyNetwork copies the clients and settings of a network from another state
+func (s *resolverState) copyNetwork(from *resolverState, chainName, networkName string) {
+	key := getResolverPrefixKey(chainName, networkName)
+	prefix := resolverPrefix(key)
+	s.ethereumClients[prefix] = from.ethereumClients[prefix]
+	if chainID, err := core.GetChainID(core.Blockchain(chainName), core.NetworkID(networkName)); err == nil {
+		s.ethereumClientsByChainID[chainID] = from.ethereumClientsByChainID[chainID]
+	}
+	if relayerClient, ok := from.relayers[prefix]; ok {
+		s.relayers[prefix] = relayerClient
+	}
+	s.rhsSettings[prefix] = from.rhsSettings[prefix]
+	s.supportedContracts[key] = from.supportedContracts[key]
+	s.stateResolvers[key] = from.stateResolvers[key]
+	s.endpoints[prefix] = from.endpoints[prefix]
 }
 
 // GetEthClient returns the eth client
 func (r *Resolver) GetEthClient(resolverPrefixKey string) (*eth.Client, error) {
-	resolverClientConfig, ok := r.ethereumClients[resolverPrefix(resolverPrefixKey)]
+	resolverClientConfig, ok := r.state.Load().ethereumClients[resolverPrefix(resolverPrefixKey)]
 	if !ok {
 		return nil, fmt.Errorf("ethClient not found for %s", resolverPrefixKey)
 	}
@@ -210,16 +320,36 @@ func (r *Resolver) GetEthClient(resolverPrefixKey string) (*eth.Client, error) {
 
 // GetEthClientByChainID returns the eth client by chain id.
 func (r *Resolver) GetEthClientByChainID(chainID core.ChainID) (*eth.Client, error) {
-	resolverClientConfig, ok := r.ethereumClientsByChainID[chainID]
+	resolverClientConfig, ok := r.state.Load().ethereumClientsByChainID[chainID]
 	if !ok {
 		return nil, fmt.Errorf("ethClient not found for chainID: %d", chainID)
 	}
 	return resolverClientConfig.client, nil
 }
 
+// GetRelayer returns the relayer client configured for the network, if any
+func (r *Resolver) GetRelayer(resolverPrefixKey string) (*RelayerClientConfig, bool) {
+	relayerClientConfig, ok := r.state.Load().relayers[resolverPrefix(resolverPrefixKey)]
+	if !ok {
+		return nil, false
+	}
+	return &relayerClientConfig, true
+}
+
+// HealthMonitors returns a health monitor per RPC endpoint of every network, named rpc:blockchain:network:host
+func (r *Resolver) HealthMonitors() health.Monitors {
+	monitors := make(health.Monitors)
+	for prefix, endpoints := range r.state.Load().endpoints {
+		for name, monitor := range endpoints.Monitors() {
+			monitors[fmt.Sprintf("rpc:%s:%s", prefix, name)] = monitor
+		}
+	}
+	return monitors
+}
+
 // GetContractAddress returns the contract address
 func (r *Resolver) GetContractAddress(resolverPrefixKey string) (*common.Address, error) {
-	resolverClientConfig, ok := r.ethereumClients[resolverPrefix(resolverPrefixKey)]
+	resolverClientConfig, ok := r.state.Load().ethereumClients[resolverPrefix(resolverPrefixKey)]
 	if !ok {
 		return nil, fmt.Errorf("contract address not found for %s", resolverPrefixKey)
 	}
@@ -230,12 +360,12 @@ func (r *Resolver) GetContractAddress(resolverPrefixKey string) (*common.Address
 
 // GetStateResolvers returns the state resolvers
 func (r *Resolver) GetStateResolvers() StateResolvers {
-	return r.stateResolvers
+	return r.state.Load().stateResolvers
 }
 
 // GetRhsSettings returns the rhs settings
 func (r *Resolver) GetRhsSettings(ctx context.Context, resolverPrefixKey string) (*RhsSettings, error) {
-	rhsSettings, ok := r.rhsSettings[resolverPrefix(resolverPrefixKey)]
+	rhsSettings, ok := r.state.Load().rhsSettings[resolverPrefix(resolverPrefixKey)]
 	if !ok {
 		log.Error(ctx, "rhsSettings not found", "resolverPrefixKey", resolverPrefixKey)
 		return nil, fmt.Errorf("rhsSettings not found for %s", resolverPrefixKey)
@@ -255,7 +385,7 @@ func (r *Resolver) GetRhsSettingsForBlockchainAndNetwork(ctx context.Context, bl
 
 // GetConfirmationBlockCount returns the confirmation block count
 func (r *Resolver) GetConfirmationBlockCount(resolverPrefixKey string) (int64, error) {
-	resolverClientConfig, ok := r.ethereumClients[resolverPrefix(resolverPrefixKey)]
+	resolverClientConfig, ok := r.state.Load().ethereumClients[resolverPrefix(resolverPrefixKey)]
 	if !ok {
 		return 0, fmt.Errorf("contract address not found for %s", resolverPrefixKey)
 	}
@@ -265,7 +395,7 @@ func (r *Resolver) GetConfirmationBlockCount(resolverPrefixKey string) (int64, e
 
 // GetConfirmationTimeout returns the confirmation timeout
 func (r *Resolver) GetConfirmationTimeout(resolverPrefixKey string) (time.Duration, error) {
-	resolverClientConfig, ok := r.ethereumClients[resolverPrefix(resolverPrefixKey)]
+	resolverClientConfig, ok := r.state.Load().ethereumClients[resolverPrefix(resolverPrefixKey)]
 	if !ok {
 		return 0, fmt.Errorf("contract address not found for %s", resolverPrefixKey)
 	}
@@ -275,12 +405,12 @@ func (r *Resolver) GetConfirmationTimeout(resolverPrefixKey string) (time.Durati
 
 // GetSupportedContracts returns the supported contracts
 func (r *Resolver) GetSupportedContracts() map[string]*abi.State {
-	return r.supportedContracts
+	return r.state.Load().supportedContracts
 }
 
 // GetSupportedNetworks returns the supported networks
 func (r *Resolver) GetSupportedNetworks() []SupportedNetworks {
-	return r.supportedNetworks
+	return r.state.Load().supportedNetworks
 }
 
 // IsCredentialStatusTypeSupported returns true if the credential status type is supported
@@ -297,10 +427,12 @@ func (r *Resolver) IsCredentialStatusTypeSupported(rhsMode string, credentialSta
 	return true
 }
 
-// SupportedCredentialStatusTypes returns a list of supported credential status types for a specific rhs mode
+// SupportedCredentialStatusTypes returns a list of supported credential status types for a specific rhs mode.
+// Bitstring status lists are published by the issuer node itself, so they are supported in every mode.
 func (r *Resolver) SupportedCredentialStatusTypes(rhsMode string) []verifiable.CredentialStatusType {
 	accepted := []verifiable.CredentialStatusType{
 		verifiable.Iden3commRevocationStatusV1,
+		statuslist.BitstringStatusListEntry,
 	}
 	if rhsMode == All || rhsMode == OffChain {
 		accepted = append(accepted, verifiable.Iden3ReverseSparseMerkleTreeProof)
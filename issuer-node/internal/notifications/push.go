@@ -12,8 +12,13 @@ var (
 	ErrNoService = errors.New("no service in did document")
 	// ErrNoPushService push service doesn't exist on did document
 	ErrNoPushService = errors.New("no push service in did document")
+	// ErrNoDIDCommService DIDComm messaging service doesn't exist on did document
+	ErrNoDIDCommService = errors.New("no DIDComm messaging service in did document")
 )
 
+// DIDCommMessagingServiceType is the service type for delivering DIDComm messages to identity
+const DIDCommMessagingServiceType = "DIDCommMessaging"
+
 // FindNotificationService returns the verifiable push service of a given didDoc
 func FindNotificationService(didDoc verifiable.DIDDocument) (verifiable.PushService, error) {
 	var service verifiable.PushService
@@ -51,3 +56,35 @@ func FindServiceByType(didDoc verifiable.DIDDocument, serviceType string) (verif
 	}
 	return verifiable.Service{}, ErrNoService
 }
+
+// FindDIDCommServiceEndpoint returns the endpoint of the DIDComm messaging service of a given didDoc.
+// The service endpoint can be either an URI or a DIDComm v2 endpoint object.
+func FindDIDCommServiceEndpoint(didDoc verifiable.DIDDocument) (string, error) {
+	for _, s := range didDoc.Service {
+		serviceBytes, err := json.Marshal(s)
+		if err != nil {
+			return "", err
+		}
+		var service struct {
+			Type            string          `json:"type"`
+			ServiceEndpoint json.RawMessage `json:"serviceEndpoint"`
+		}
+		if err := json.Unmarshal(serviceBytes, &service); err != nil {
+			return "", err
+		}
+		if service.Type != DIDCommMessagingServiceType {
+			continue
+		}
+		var uri string
+		if err := json.Unmarshal(service.ServiceEndpoint, &uri); err == nil && uri != "" {
+			return uri, nil
+		}
+		var endpoint struct {
+			URI string `json:"uri"`
+		}
+		if err := json.Unmarshal(service.ServiceEndpoint, &endpoint); err == nil && endpoint.URI != "" {
+			return endpoint.URI, nil
+		}
+	}
+	return "", ErrNoDIDCommService
+}
@@ -0,0 +1,512 @@
+package packagemanager
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	josecipher "github.com/go-jose/go-jose/v4/cipher"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/mr-tron/base58"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+const (
+	// AnoncryptAlgorithm is the JWE key management algorithm of anonymous encryption
+	AnoncryptAlgorithm = "ECDH-ES+A256KW"
+	// AuthcryptAlgorithm is the JWE key management algorithm of authenticated encryption
+	AuthcryptAlgorithm = "ECDH-1PU+A256KW"
+
+	contentEncryption = "A256CBC-HS512"
+	cekSize           = 64
+	kekSize           = 32
+	ivSize            = 16
+	tagSize           = 32
+
+	verificationMethodX25519KeyAgreementKey2019 = "X25519KeyAgreementKey2019"
+	verificationMethodX25519KeyAgreementKey2020 = "X25519KeyAgreementKey2020"
+	verificationMethodMultikey                  = "Multikey"
+	verificationMethodJSONWebKey2020            = "JsonWebKey2020"
+)
+
+// x25519Multicodec is the multicodec prefix of X25519 public keys in publicKeyMultibase
+var x25519Multicodec = []byte{0xec, 0x01}
+
+var (
+	// ErrNoKeyAgreementKey is returned when a DID document has no X25519 key agreement key
+	ErrNoKeyAgreementKey = errors.New("no X25519 key agreement key found in the DID document")
+	// ErrInvalidJWE is returned when an envelope is not a JWE the packer can decrypt
+	ErrInvalidJWE = errors.New("invalid JWE")
+)
+
+// KeyAgreementStore derives shared secrets with the X25519 keys of the identities
+type KeyAgreementStore interface {
+	KeyAgreement(ctx context.Context, keyID kms.KeyID, peerPublicKey []byte) ([]byte, error)
+}
+
+// JWEPacker packs iden3comm messages in compact JWE envelopes encrypted to X25519 key agreement keys.
+// Messages are anoncrypted (ECDH-ES+A256KW) or, when the sender key is known, authcrypted (ECDH-1PU+A256KW).
+// The content is encrypted with A256CBC-HS512.
+// The payload is a plain message or an envelope of another media type, like a ZKP token, unpacked with payloads.
+type JWEPacker struct {
+	keys        KeyAgreementStore
+	didResolver packers.DIDResolverHandlerFunc
+	payloads    *iden3comm.PackageManager
+}
+
+// JWEPackerParams holds the keys a message is packed with
+type JWEPackerParams struct {
+	iden3comm.PackerParams
+	// RecipientDID is the DID the message is encrypted to. Its first X25519 key agreement key is used.
+	RecipientDID string
+	// RecipientDIDDocument is the DID document of the recipient, when it is already known. It isn't resolved then.
+	RecipientDIDDocument *verifiable.DIDDocument
+	// SenderKeyID is the X25519 key of the sender. Messages are authcrypted when it is set, anoncrypted otherwise.
+	SenderKeyID *kms.KeyID
+}
+
+type jweHeader struct {
+	Typ  iden3comm.MediaType `json:"typ"`
+	Alg  string              `json:"alg"`
+	Enc  string              `json:"enc"`
+	KID  string              `json:"kid"`
+	SKID string              `json:"skid,omitempty"`
+	APU  string              `json:"apu,omitempty"`
+	APV  string              `json:"apv,omitempty"`
+	EPK  okpJWK              `json:"epk"`
+}
+
+type okpJWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+}
+
+// NewJWEPacker creates a JWE packer. keys holds the X25519 keys of the issuers and didResolver resolves the
+// key agreement keys of the other parties.
+func NewJWEPacker(keys KeyAgreementStore, didResolver packers.DIDResolverHandlerFunc) *JWEPacker {
+	return &JWEPacker{keys: keys, didResolver: didResolver}
+}
+
+// KeyAgreementKID returns the JWE key id of a X25519 KMS key, the DID URL did#X25519:<public key hex>
+func KeyAgreementKID(keyID kms.KeyID) (string, error) {
+	did, fragment, ok := strings.Cut(keyID.ID, "/")
+	if keyID.Type != kms.KeyTypeX25519 || !ok {
+		return "", fmt.Errorf("%w: %s is not a X25519 key bound to an identity", kms.ErrIncorrectKeyType, keyID.ID)
+	}
+	return did + "#" + fragment, nil
+}
+
+// keyIDFromKID is the inverse of KeyAgreementKID
+func keyIDFromKID(kid string) (kms.KeyID, error) {
+	did, fragment, ok := strings.Cut(kid, "#")
+	if !ok || !strings.HasPrefix(fragment, string(kms.KeyTypeX25519)+":") {
+		return kms.KeyID{}, fmt.Errorf("%w: unknown recipient key %s", ErrInvalidJWE, kid)
+	}
+	return kms.KeyID{Type: kms.KeyTypeX25519, ID: did + "/" + fragment}, nil
+}
+
+// Pack returns the payload encrypted to the recipient key agreement key
+func (p *JWEPacker) Pack(payload []byte, params iden3comm.PackerParams) ([]byte, error) {
+	ctx := context.Background()
+	packerParams, ok := params.(JWEPackerParams)
+	if !ok {
+		return nil, errors.New("can't cast params to JWE packer params")
+	}
+
+	var recipientKID string
+	var recipientKey *ecdh.PublicKey
+	var err error
+	if packerParams.RecipientDIDDocument != nil {
+		recipientKID, recipientKey, err = keyAgreementKey(packerParams.RecipientDIDDocument, "")
+	} else {
+		recipientKID, recipientKey, err = p.resolveKeyAgreementKey(packerParams.RecipientDID, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+	ephemeralKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	apv := sha256.Sum256([]byte(recipientKID))
+	header := jweHeader{
+		Typ: packers.MediaTypeEncryptedMessage,
+		Alg: AnoncryptAlgorithm,
+		Enc: contentEncryption,
+		KID: recipientKID,
+		APV: base64.RawURLEncoding.EncodeToString(apv[:]),
+		EPK: okpJWK{Kty: "OKP", Crv: "X25519", X: base64.RawURLEncoding.EncodeToString(ephemeralKey.PublicKey().Bytes())},
+	}
+	if packerParams.SenderKeyID != nil {
+		header.Alg = AuthcryptAlgorithm
+		header.SKID, err = KeyAgreementKID(*packerParams.SenderKeyID)
+		if err != nil {
+			return nil, err
+		}
+		header.APU = base64.RawURLEncoding.EncodeToString([]byte(header.SKID))
+	}
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return nil, err
+	}
+	protected := base64.RawURLEncoding.EncodeToString(headerBytes)
+
+	cek := make([]byte, cekSize)
+	iv := make([]byte, ivSize)
+	if _, err := rand.Read(cek); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+	aead, err := josecipher.NewCBCHMAC(cek, aes.NewCipher)
+	if err != nil {
+		return nil, err
+	}
+	sealed := aead.Seal(nil, iv, payload, []byte(protected))
+	ciphertext, tag := sealed[:len(sealed)-tagSize], sealed[len(sealed)-tagSize:]
+
+	z, err := ephemeralKey.ECDH(recipientKey)
+	if err != nil {
+		return nil, err
+	}
+	var ccTag []byte
+	if packerParams.SenderKeyID != nil {
+		zs, err := p.keys.KeyAgreement(ctx, *packerParams.SenderKeyID, recipientKey.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		z = append(z, zs...)
+		ccTag = tag
+	}
+	kek, err := deriveKEK(z, header, ccTag)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := josecipher.KeyWrap(block, cek)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.Join([]string{
+		protected,
+		base64.RawURLEncoding.EncodeToString(encryptedKey),
+		base64.RawURLEncoding.EncodeToString(iv),
+		base64.RawURLEncoding.EncodeToString(ciphertext),
+		base64.RawURLEncoding.EncodeToString(tag),
+	}, ".")), nil
+}
+
+// Unpack decrypts the envelope with the X25519 key of the issuer it is encrypted to.
+// Authcrypted messages must come from the DID of the sender key.
+func (p *JWEPacker) Unpack(envelope []byte) (*iden3comm.BasicMessage, error) {
+	ctx := context.Background()
+	parts := strings.Split(strings.TrimSpace(string(envelope)), ".")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("%w: compact serialization expected", ErrInvalidJWE)
+	}
+	decoded := make([][]byte, len(parts))
+	for i, part := range parts {
+		var err error
+		decoded[i], err = base64.RawURLEncoding.DecodeString(part)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidJWE, err)
+		}
+	}
+	encryptedKey, iv, ciphertext, tag := decoded[1], decoded[2], decoded[3], decoded[4]
+
+	var header jweHeader
+	if err := json.Unmarshal(decoded[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidJWE, err)
+	}
+	if header.Enc != contentEncryption || header.EPK.Kty != "OKP" || header.EPK.Crv != "X25519" {
+		return nil, fmt.Errorf("%w: unsupported encryption %s with %s key", ErrInvalidJWE, header.Enc, header.EPK.Crv)
+	}
+	keyID, err := keyIDFromKID(header.KID)
+	if err != nil {
+		return nil, err
+	}
+	epk, err := base64.RawURLEncoding.DecodeString(header.EPK.X)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidJWE, err)
+	}
+	z, err := p.keys.KeyAgreement(ctx, keyID, epk)
+	if err != nil {
+		return nil, err
+	}
+
+	var ccTag []byte
+	var senderDID string
+	switch header.Alg {
+	case AnoncryptAlgorithm:
+	case AuthcryptAlgorithm:
+		senderDID, _, _ = strings.Cut(header.SKID, "#")
+		_, senderKey, err := p.resolveKeyAgreementKey(senderDID, header.SKID)
+		if err != nil {
+			return nil, err
+		}
+		zs, err := p.keys.KeyAgreement(ctx, keyID, senderKey.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		z = append(z, zs...)
+		ccTag = tag
+	default:
+		return nil, fmt.Errorf("%w: unsupported algorithm %s", ErrInvalidJWE, header.Alg)
+	}
+
+	kek, err := deriveKEK(z, header, ccTag)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+	cek, err := josecipher.KeyUnwrap(block, encryptedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidJWE, err)
+	}
+	aead, err := josecipher.NewCBCHMAC(cek, aes.NewCipher)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := aead.Open(nil, iv, append(ciphertext, tag...), []byte(parts[0]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidJWE, err)
+	}
+
+	msg, err := p.unpackPayload(payload)
+	if err != nil {
+		return nil, err
+	}
+	if header.Alg == AuthcryptAlgorithm && msg.From != senderDID {
+		return nil, fmt.Errorf("%w: sender key %s does not belong to %s", ErrInvalidJWE, header.SKID, msg.From)
+	}
+	return msg, nil
+}
+
+// unpackPayload unpacks the decrypted payload. The Typ of the message is set to the media type of the payload,
+// so the message is routed by the envelope it was sent in and not by the typ it claims.
+func (p *JWEPacker) unpackPayload(payload []byte) (*iden3comm.BasicMessage, error) {
+	if strings.HasPrefix(strings.TrimSpace(string(payload)), "{") {
+		var msg iden3comm.BasicMessage
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			return nil, err
+		}
+		msg.Typ = packers.MediaTypePlainMessage
+		return &msg, nil
+	}
+	if p.payloads == nil {
+		return nil, fmt.Errorf("%w: nested envelopes are not supported", ErrInvalidJWE)
+	}
+	mediaType, err := p.payloads.GetMediaType(payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidJWE, err)
+	}
+	if mediaType == packers.MediaTypeEncryptedMessage || mediaType == packers.MediaTypePlainMessage {
+		return nil, fmt.Errorf("%w: unsupported nested envelope %s", ErrInvalidJWE, mediaType)
+	}
+	msg, err := p.payloads.UnpackWithType(mediaType, payload)
+	if err != nil {
+		return nil, err
+	}
+	msg.Typ = mediaType
+	return msg, nil
+}
+
+// PayloadMediaType returns the media type a message is routed by: the one of the payload for encrypted envelopes,
+// the one of the envelope otherwise
+func PayloadMediaType(msg *iden3comm.BasicMessage, mediaType iden3comm.MediaType) iden3comm.MediaType {
+	if mediaType == packers.MediaTypeEncryptedMessage {
+		return msg.Typ
+	}
+	return mediaType
+}
+
+// MediaType for iden3comm
+func (p *JWEPacker) MediaType() iden3comm.MediaType {
+	return packers.MediaTypeEncryptedMessage
+}
+
+// GetSupportedProfiles gets packer envelope (supported profiles) with options
+func (p *JWEPacker) GetSupportedProfiles() []string {
+	return []string{
+		fmt.Sprintf("%s;env=%s;alg=%s,%s", protocol.Iden3CommVersion1, p.MediaType(), AnoncryptAlgorithm, AuthcryptAlgorithm),
+	}
+}
+
+// IsProfileSupported checks if profile is supported by packer
+func (p *JWEPacker) IsProfileSupported(profile string) bool {
+	_, ok := ParseEncryptedProfile(profile)
+	return ok
+}
+
+// ParseEncryptedProfile returns the algorithms of an encrypted envelope accept profile, both if none is given.
+// ok is false if the profile is not an encrypted envelope one.
+func ParseEncryptedProfile(profile string) (algorithms []string, ok bool) {
+	params := strings.Split(profile, ";")
+	if len(params) < 2 || strings.TrimSpace(params[0]) != string(protocol.Iden3CommVersion1) {
+		return nil, false
+	}
+	if strings.TrimSpace(params[1]) != "env="+string(packers.MediaTypeEncryptedMessage) {
+		return nil, false
+	}
+	for _, param := range params[2:] {
+		name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+		if name != "alg" {
+			return nil, false
+		}
+		for _, alg := range strings.Split(value, ",") {
+			alg = strings.TrimSpace(alg)
+			if alg == AnoncryptAlgorithm || alg == AuthcryptAlgorithm {
+				algorithms = append(algorithms, alg)
+			}
+		}
+		return algorithms, len(algorithms) > 0
+	}
+	return []string{AnoncryptAlgorithm, AuthcryptAlgorithm}, true
+}
+
+// resolveKeyAgreementKey returns the id and the public key of the X25519 key agreement key kid of the DID,
+// or of the first one when kid is empty
+func (p *JWEPacker) resolveKeyAgreementKey(did string, kid string) (string, *ecdh.PublicKey, error) {
+	if p.didResolver == nil {
+		return "", nil, errors.New("no DID resolver to find the key agreement keys")
+	}
+	doc, err := p.didResolver(did)
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot resolve %s: %w", did, err)
+	}
+	return keyAgreementKey(doc, kid)
+}
+
+// keyAgreementKey returns the id and the public key of the X25519 key agreement key kid of the DID document,
+// or of the first one when kid is empty
+func keyAgreementKey(doc *verifiable.DIDDocument, kid string) (string, *ecdh.PublicKey, error) {
+	for _, entry := range doc.KeyAgreement {
+		vm, err := keyAgreementMethod(doc, entry)
+		if err != nil || (kid != "" && vm.ID != kid) {
+			continue
+		}
+		publicKey, err := x25519PublicKey(vm)
+		if err != nil {
+			continue
+		}
+		return vm.ID, publicKey, nil
+	}
+	return "", nil, fmt.Errorf("%w: %s", ErrNoKeyAgreementKey, doc.ID)
+}
+
+// keyAgreementMethod returns the verification method of a keyAgreement entry, a reference or an embedded method
+func keyAgreementMethod(doc *verifiable.DIDDocument, entry interface{}) (*verifiable.CommonVerificationMethod, error) {
+	if ref, ok := entry.(string); ok {
+		if strings.HasPrefix(ref, "#") {
+			ref = doc.ID + ref
+		}
+		for i := range doc.VerificationMethod {
+			id := doc.VerificationMethod[i].ID
+			if strings.HasPrefix(id, "#") {
+				id = doc.ID + id
+			}
+			if id == ref {
+				vm := doc.VerificationMethod[i]
+				vm.ID = id
+				return &vm, nil
+			}
+		}
+		return nil, fmt.Errorf("verification method %s not found", ref)
+	}
+
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	vm := &verifiable.CommonVerificationMethod{}
+	if err := json.Unmarshal(raw, vm); err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(vm.ID, "#") {
+		vm.ID = doc.ID + vm.ID
+	}
+	return vm, nil
+}
+
+func x25519PublicKey(vm *verifiable.CommonVerificationMethod) (*ecdh.PublicKey, error) {
+	var raw []byte
+	var err error
+	switch vm.Type {
+	case verificationMethodX25519KeyAgreementKey2019:
+		raw, err = base58.Decode(vm.PublicKeyBase58)
+	case verificationMethodX25519KeyAgreementKey2020, verificationMethodMultikey:
+		if !strings.HasPrefix(vm.PublicKeyMultibase, "z") {
+			return nil, errors.New("only base58btc multibase keys are supported")
+		}
+		raw, err = base58.Decode(vm.PublicKeyMultibase[1:])
+		if err == nil {
+			if len(raw) < len(x25519Multicodec) || !slices.Equal(raw[:len(x25519Multicodec)], x25519Multicodec) {
+				return nil, errors.New("not a X25519 multikey")
+			}
+			raw = raw[len(x25519Multicodec):]
+		}
+	case verificationMethodJSONWebKey2020:
+		if vm.PublicKeyJwk["kty"] != "OKP" || vm.PublicKeyJwk["crv"] != "X25519" {
+			return nil, errors.New("not a X25519 JWK")
+		}
+		x, _ := vm.PublicKeyJwk["x"].(string)
+		raw, err = base64.RawURLEncoding.DecodeString(x)
+	default:
+		return nil, fmt.Errorf("unsupported key agreement verification method type %s", vm.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ecdh.X25519().NewPublicKey(raw)
+}
+
+// deriveKEK derives the key encryption key with the Concat KDF of RFC 7518 section 4.6.2.
+// For ECDH-1PU the tag of the content encryption is appended to SuppPubInfo, as in the key wrapping mode of
+// draft-madden-jose-ecdh-1pu-04.
+func deriveKEK(z []byte, header jweHeader, ccTag []byte) ([]byte, error) {
+	apu, err := base64.RawURLEncoding.DecodeString(header.APU)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid apu", ErrInvalidJWE)
+	}
+	apv, err := base64.RawURLEncoding.DecodeString(header.APV)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid apv", ErrInvalidJWE)
+	}
+	supPubInfo := binary.BigEndian.AppendUint32(nil, kekSize*8)
+	if ccTag != nil {
+		supPubInfo = append(supPubInfo, lengthPrefixed(ccTag)...)
+	}
+	reader := josecipher.NewConcatKDF(crypto.SHA256, z, lengthPrefixed([]byte(header.Alg)), lengthPrefixed(apu), lengthPrefixed(apv), supPubInfo, []byte{})
+	kek := make([]byte, kekSize)
+	if _, err := reader.Read(kek); err != nil {
+		return nil, err
+	}
+	return kek, nil
+}
+
+func lengthPrefixed(data []byte) []byte {
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(data))), data...)
+}
@@ -0,0 +1,239 @@
+package packagemanager
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/iden3/iden3comm/v2"
+	"github.com/iden3/iden3comm/v2/packers"
+	"github.com/iden3/iden3comm/v2/protocol"
+	"github.com/mr-tron/base58"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+const (
+	jweIssuerDID = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+	jweUserDID   = "did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+)
+
+// keyAgreementStore is an in memory KeyAgreementStore
+type keyAgreementStore map[kms.KeyID]*ecdh.PrivateKey
+
+func (s keyAgreementStore) KeyAgreement(_ context.Context, keyID kms.KeyID, peerPublicKey []byte) ([]byte, error) {
+	privateKey, ok := s[keyID]
+	if !ok {
+		return nil, kms.ErrKeyNotFound
+	}
+	peerKey, err := ecdh.X25519().NewPublicKey(peerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return privateKey.ECDH(peerKey)
+}
+
+func (s keyAgreementStore) newKey(t *testing.T, did string) kms.KeyID {
+	t.Helper()
+	privateKey, err := ecdh.X25519().GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	keyID := kms.KeyID{Type: kms.KeyTypeX25519, ID: did + "/X25519:" + hex.EncodeToString(privateKey.PublicKey().Bytes())}
+	s[keyID] = privateKey
+	return keyID
+}
+
+func TestJWEPacker(t *testing.T) {
+	store := keyAgreementStore{}
+	issuerKey := store.newKey(t, jweIssuerDID)
+	userKey := store.newKey(t, jweUserDID)
+	issuerKID, err := KeyAgreementKID(issuerKey)
+	require.NoError(t, err)
+	userKID, err := KeyAgreementKID(userKey)
+	require.NoError(t, err)
+
+	// the issuer key is published as a multikey and the user one as a JWK
+	documents := map[string]*verifiable.DIDDocument{
+		jweIssuerDID: {
+			ID: jweIssuerDID,
+			VerificationMethod: []verifiable.CommonVerificationMethod{{
+				ID:                 issuerKID,
+				Type:               "Multikey",
+				Controller:         jweIssuerDID,
+				PublicKeyMultibase: "z" + base58.Encode(append([]byte{0xec, 0x01}, store[issuerKey].PublicKey().Bytes()...)),
+			}},
+			KeyAgreement: []interface{}{issuerKID},
+		},
+		jweUserDID: {
+			ID: jweUserDID,
+			KeyAgreement: []interface{}{map[string]interface{}{
+				"id":         userKID,
+				"type":       "JsonWebKey2020",
+				"controller": jweUserDID,
+				"publicKeyJwk": map[string]interface{}{
+					"kty": "OKP",
+					"crv": "X25519",
+					"x":   base64.RawURLEncoding.EncodeToString(store[userKey].PublicKey().Bytes()),
+				},
+			}},
+		},
+	}
+	packer := NewJWEPacker(store, func(did string) (*verifiable.DIDDocument, error) {
+		doc, ok := documents[did]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return doc, nil
+	})
+
+	message := func(from string) []byte {
+		msg, err := json.Marshal(iden3comm.BasicMessage{
+			ID:   "123",
+			Typ:  packers.MediaTypePlainMessage,
+			Type: protocol.CredentialIssuanceResponseMessageType,
+			From: from,
+			To:   jweUserDID,
+			Body: json.RawMessage(`{"credential":{}}`),
+		})
+		require.NoError(t, err)
+		return msg
+	}
+
+	t.Run("should anoncrypt to the recipient key agreement key", func(t *testing.T) {
+		envelope, err := packer.Pack(message(jweIssuerDID), JWEPackerParams{RecipientDID: jweUserDID})
+		require.NoError(t, err)
+
+		var header jweHeader
+		require.NoError(t, decodeSegment(envelope, &header))
+		assert.Equal(t, AnoncryptAlgorithm, header.Alg)
+		assert.Equal(t, userKID, header.KID)
+		assert.Empty(t, header.SKID)
+
+		msg, err := packer.Unpack(envelope)
+		require.NoError(t, err)
+		assert.Equal(t, protocol.CredentialIssuanceResponseMessageType, msg.Type)
+		assert.JSONEq(t, `{"credential":{}}`, string(msg.Body))
+	})
+
+	t.Run("should authcrypt with the sender key", func(t *testing.T) {
+		envelope, err := packer.Pack(message(jweIssuerDID), JWEPackerParams{RecipientDID: jweUserDID, SenderKeyID: &issuerKey})
+		require.NoError(t, err)
+
+		var header jweHeader
+		require.NoError(t, decodeSegment(envelope, &header))
+		assert.Equal(t, AuthcryptAlgorithm, header.Alg)
+		assert.Equal(t, issuerKID, header.SKID)
+
+		msg, err := packer.Unpack(envelope)
+		require.NoError(t, err)
+		assert.Equal(t, jweIssuerDID, msg.From)
+	})
+
+	t.Run("should refuse authcrypted messages from another DID", func(t *testing.T) {
+		envelope, err := packer.Pack(message(jweUserDID), JWEPackerParams{RecipientDID: jweUserDID, SenderKeyID: &issuerKey})
+		require.NoError(t, err)
+		_, err = packer.Unpack(envelope)
+		assert.ErrorIs(t, err, ErrInvalidJWE)
+	})
+
+	t.Run("should refuse tampered envelopes", func(t *testing.T) {
+		envelope, err := packer.Pack(message(jweIssuerDID), JWEPackerParams{RecipientDID: jweUserDID})
+		require.NoError(t, err)
+		envelope[len(envelope)-2] ^= 1
+		_, err = packer.Unpack(envelope)
+		assert.Error(t, err)
+	})
+
+	t.Run("should unpack the nested envelope and route by its media type", func(t *testing.T) {
+		payloads := iden3comm.NewPackageManager()
+		require.NoError(t, payloads.RegisterPackers(tokenPacker{}, &packers.PlainMessagePacker{}, packer))
+		packer.payloads = payloads
+		defer func() { packer.payloads = nil }()
+
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"` + packers.MediaTypeZKPMessage + `"}`))
+		token := header + "." + base64.RawURLEncoding.EncodeToString(message(jweUserDID)) + ".proof"
+		envelope, err := packer.Pack([]byte(token), JWEPackerParams{RecipientDID: jweIssuerDID})
+		require.NoError(t, err)
+
+		msg, mediaType, err := payloads.Unpack(envelope)
+		require.NoError(t, err)
+		assert.Equal(t, packers.MediaTypeEncryptedMessage, mediaType)
+		assert.Equal(t, jweUserDID, msg.From)
+		assert.Equal(t, packers.MediaTypeZKPMessage, PayloadMediaType(msg, mediaType))
+	})
+
+	t.Run("should route plain payloads as plain messages whatever their typ", func(t *testing.T) {
+		plain, err := json.Marshal(iden3comm.BasicMessage{ID: "123", Typ: packers.MediaTypeZKPMessage, From: jweUserDID, To: jweIssuerDID})
+		require.NoError(t, err)
+		envelope, err := packer.Pack(plain, JWEPackerParams{RecipientDID: jweIssuerDID})
+		require.NoError(t, err)
+
+		msg, err := packer.Unpack(envelope)
+		require.NoError(t, err)
+		assert.Equal(t, packers.MediaTypePlainMessage, PayloadMediaType(msg, packers.MediaTypeEncryptedMessage))
+	})
+
+	t.Run("should fail without a recipient key agreement key", func(t *testing.T) {
+		documents["did:example:123"] = &verifiable.DIDDocument{ID: "did:example:123"}
+		_, err := packer.Pack(message(jweIssuerDID), JWEPackerParams{RecipientDID: "did:example:123"})
+		assert.ErrorIs(t, err, ErrNoKeyAgreementKey)
+	})
+}
+
+// tokenPacker unpacks fake ZKP tokens whose payload is the message, without verifying any proof
+type tokenPacker struct{}
+
+func (tokenPacker) Pack([]byte, iden3comm.PackerParams) ([]byte, error) {
+	return nil, errors.New("not supported")
+}
+
+func (tokenPacker) Unpack(envelope []byte) (*iden3comm.BasicMessage, error) {
+	parts := bytes.Split(envelope, []byte("."))
+	payload, err := base64.RawURLEncoding.DecodeString(string(parts[1]))
+	if err != nil {
+		return nil, err
+	}
+	var msg iden3comm.BasicMessage
+	return &msg, json.Unmarshal(payload, &msg)
+}
+
+func (tokenPacker) MediaType() iden3comm.MediaType { return packers.MediaTypeZKPMessage }
+
+func (tokenPacker) GetSupportedProfiles() []string { return nil }
+
+func (tokenPacker) IsProfileSupported(string) bool { return false }
+
+func TestParseEncryptedProfile(t *testing.T) {
+	algorithms, ok := ParseEncryptedProfile("iden3comm/v1;env=application/iden3comm-encrypted-json;alg=ECDH-1PU+A256KW")
+	assert.True(t, ok)
+	assert.Equal(t, []string{AuthcryptAlgorithm}, algorithms)
+
+	algorithms, ok = ParseEncryptedProfile("iden3comm/v1;env=application/iden3comm-encrypted-json")
+	assert.True(t, ok)
+	assert.Equal(t, []string{AnoncryptAlgorithm, AuthcryptAlgorithm}, algorithms)
+
+	_, ok = ParseEncryptedProfile("iden3comm/v1;env=application/iden3-zkp-json;alg=groth16")
+	assert.False(t, ok)
+
+	packer := NewJWEPacker(keyAgreementStore{}, nil)
+	for _, profile := range packer.GetSupportedProfiles() {
+		assert.True(t, packer.IsProfileSupported(profile))
+	}
+}
+
+func decodeSegment(envelope []byte, v interface{}) error {
+	segment, _, _ := bytes.Cut(envelope, []byte("."))
+	raw, err := base64.RawURLEncoding.DecodeString(string(segment))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
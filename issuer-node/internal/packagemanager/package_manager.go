@@ -14,8 +14,10 @@ import (
 	"github.com/polygonid/sh-id-platform/pkg/loaders"
 )
 
-// New initializes the iden3comm package manager
-func New(ctx context.Context, ethStateContracts map[string]*abi.State, circuitsPath string, didResolverHandler packers.DIDResolverHandlerFunc) (*iden3comm.PackageManager, error) {
+// New initializes the iden3comm package manager.
+// Encrypted messages are decrypted with the X25519 keys of keys, and encrypted to the key agreement keys resolved
+// with didResolverHandler. Their payload is a plain message, or a JWS or ZKP envelope.
+func New(ctx context.Context, ethStateContracts map[string]*abi.State, circuitsPath string, didResolverHandler packers.DIDResolverHandlerFunc, keys KeyAgreementStore) (*iden3comm.PackageManager, error) {
 	circuitsLoaderService := loaders.NewCircuits(circuitsPath)
 	authV2Set, err := circuitsLoaderService.Load(circuits.AuthV2CircuitID)
 	if err != nil {
@@ -27,12 +29,15 @@ func New(ctx context.Context, ethStateContracts map[string]*abi.State, circuitsP
 
 	zkpPackerV2 := packers.NewZKPPacker(nil, verifications)
 	jwsPacker := packers.NewJWSPacker(didResolverHandler, nil)
+	jwePacker := NewJWEPacker(keys, didResolverHandler)
 	packageManager := iden3comm.NewPackageManager()
-	err = packageManager.RegisterPackers(zkpPackerV2, &packers.PlainMessagePacker{}, jwsPacker)
+	err = packageManager.RegisterPackers(zkpPackerV2, &packers.PlainMessagePacker{}, jwsPacker, jwePacker)
 	if err != nil {
 		log.Error(ctx, "failed to register packers", "error", err)
 		return nil, err
 	}
+	// the payloads of encrypted messages are unpacked with the other packers
+	jwePacker.payloads = packageManager
 
 	return packageManager, err
 }
@@ -98,7 +98,7 @@ func TestJWS(t *testing.T) {
 		err := json.Unmarshal([]byte(exampleDidDocJS), didDoc)
 		require.NoError(t, err)
 		return didDoc, nil
-	})
+	}, keyStore)
 	require.NoError(t, err)
 	message, mediaType, err := packager.Unpack([]byte(token))
 	require.NoError(t, err)
@@ -0,0 +1,37 @@
+package primitive
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+// ETHSigner represents signer with ethereum key
+type ETHSigner struct {
+	kms   kms.KMSType
+	keyID kms.KeyID
+}
+
+// NewETHSigner creates new instance of ethereum signer
+func NewETHSigner(keyMS kms.KMSType, keyID kms.KeyID) (*ETHSigner, error) {
+	if keyID.Type != kms.KeyTypeEthereum {
+		return nil, errors.New("wrong key type")
+	}
+	if keyID.ID == "" {
+		return nil, errors.New("empty key ID")
+	}
+	if keyMS == nil {
+		return nil, errors.New("KMS is nil")
+	}
+	return &ETHSigner{keyMS, keyID}, nil
+}
+
+// Sign signs a 32 bytes digest and returns the [R || S || V] signature
+func (s *ETHSigner) Sign(ctx context.Context, digest []byte) ([]byte, error) {
+	if s.kms == nil {
+		return nil, errors.WithStack(errorNotInitialized)
+	}
+	return s.kms.Sign(ctx, s.keyID, digest)
+}
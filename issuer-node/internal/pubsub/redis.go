@@ -7,6 +7,9 @@ import (
 
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 type logger func(ctx context.Context, msg string, args ...any)
@@ -15,10 +18,32 @@ type logger func(ctx context.Context, msg string, args ...any)
 // ID is a unique identifier that could be used to ensure idempotency
 // Time is the creation time of the event.
 // Msg is the payload to send.
+// Trace is the trace context of the publisher, so the handling of the event continues its trace.
 type payload struct {
-	ID   uuid.UUID
-	Time time.Time
-	Msg  []byte
+	ID    uuid.UUID
+	Time  time.Time
+	Msg   []byte
+	Trace map[string]string `json:",omitempty"`
+}
+
+// newPayload wraps the message with the trace context of ctx
+func newPayload(ctx context.Context, msg Message) payload {
+	return payload{
+		ID:    uuid.New(),
+		Time:  time.Now(),
+		Msg:   []byte(msg),
+		Trace: telemetry.Inject(ctx),
+	}
+}
+
+// handle calls the handler of the topic with the message of the payload, in a span that continues the trace of the
+// publisher
+func (p payload) handle(ctx context.Context, topic string, callback EventHandler) error {
+	telemetry.PubSubLag.WithLabelValues(topic).Observe(time.Since(p.Time).Seconds())
+	ctx, span := telemetry.Start(telemetry.Extract(ctx, p.Trace), "pubsub "+topic, trace.WithSpanKind(trace.SpanKindConsumer))
+	err := callback(ctx, p.Msg)
+	telemetry.End(span, err)
+	return err
 }
 
 // MarshalBinary satisfies BinaryMarshaller interface and it is required by redis
@@ -48,19 +73,13 @@ func (rdb *RedisClient) Publish(ctx context.Context, topic string, event Event)
 	if err != nil {
 		return err
 	}
-	payload := payload{
-		ID:   uuid.New(),
-		Time: time.Now(),
-		Msg:  []byte(msg),
-	}
-	return rdb.conn.Publish(ctx, topic, payload).Err()
+	return rdb.conn.Publish(ctx, topic, newPayload(ctx, msg)).Err()
 }
 
 // Subscribe adds a topic to the
 func (rdb *RedisClient) Subscribe(ctx context.Context, topic string, callback EventHandler) {
 	pubsub := rdb.conn.Subscribe(ctx, topic)
 	go func() {
-		var payload payload
 		defer func() {
 			if err := pubsub.Close(); err != nil {
 				rdb.log(ctx, "closing redis pubsub on subscriber", "err", err)
@@ -74,6 +93,7 @@ func (rdb *RedisClient) Subscribe(ctx context.Context, topic string, callback Ev
 					rdb.log(ctx, "msg channel != topic", "msg channel", event.Channel, "topic", topic)
 					continue
 				}
+				var payload payload
 				if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
 					rdb.log(ctx, "redis pubsub: unmarshalling payload event")
 					continue
@@ -84,7 +104,7 @@ func (rdb *RedisClient) Subscribe(ctx context.Context, topic string, callback Ev
 							rdb.log(ctx, "panic in event handler", "r", r, "topic", topic)
 						}
 					}()
-					if err := callback(ctx, payload.Msg); err != nil {
+					if err := payload.handle(ctx, topic, callback); err != nil {
 						rdb.log(ctx, "executing callback function", "err", err, "topic", topic)
 					}
 				}()
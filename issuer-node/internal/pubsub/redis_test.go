@@ -10,9 +10,15 @@ import (
 	"github.com/alicebob/miniredis/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
 	"github.com/polygonid/sh-id-platform/internal/redis"
+	"github.com/polygonid/sh-id-platform/internal/telemetry"
 )
 
 type MyEvent struct {
@@ -103,3 +109,31 @@ func TestRedisRecover(t *testing.T) {
 
 	assert.Equal(t, nEvents, int(count.Load()))
 }
+
+func TestPayloadTrace(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(previous)
+
+	ctx, span := telemetry.Start(context.Background(), "issue credential")
+	msg, err := (&MyEvent{Field1: "field1"}).Marshal()
+	require.NoError(t, err)
+	data, err := newPayload(ctx, msg).MarshalBinary()
+	require.NoError(t, err)
+	span.End()
+
+	var received payload
+	require.NoError(t, json.Unmarshal(data, &received))
+	require.NoError(t, received.handle(context.Background(), "topic", func(ctx context.Context, payload Message) error {
+		assert.Equal(t, span.SpanContext().TraceID(), trace.SpanFromContext(ctx).SpanContext().TraceID())
+		assert.Equal(t, msg, payload)
+		return nil
+	}))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "pubsub topic", spans[1].Name())
+	assert.Equal(t, span.SpanContext().SpanID(), spans[1].Parent().SpanID())
+}
@@ -3,9 +3,7 @@ package pubsub
 import (
 	"context"
 	"encoding/json"
-	"time"
 
-	"github.com/google/uuid"
 	"github.com/valkey-io/valkey-go"
 
 	"github.com/polygonid/sh-id-platform/internal/log"
@@ -28,13 +26,7 @@ func (vk *valkeyClient) Publish(ctx context.Context, topic string, event Event)
 	if err != nil {
 		return err
 	}
-	payload := payload{
-		ID:   uuid.New(),
-		Time: time.Now(),
-		Msg:  []byte(msg),
-	}
-
-	p, err := payload.MarshalBinary()
+	p, err := newPayload(ctx, msg).MarshalBinary()
 	if err != nil {
 		log.Error(ctx, "error marshalling payload", "err:", err)
 		return err
@@ -52,7 +44,7 @@ func (vk *valkeyClient) Subscribe(ctx context.Context, topic string, callback Ev
 			log.Error(ctx, "error unmarshalling payload", "err:", err)
 			return
 		}
-		err = callback(ctx, payload.Msg)
+		err = payload.handle(ctx, topic, callback)
 		if err != nil {
 			log.Error(ctx, "error processing message", "err:", err)
 		}
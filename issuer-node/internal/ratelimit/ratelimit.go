@@ -0,0 +1,226 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// ErrInvalidLimit is returned when the configuration of a limit is not valid
+var ErrInvalidLimit = errors.New("invalid rate limit")
+
+// Key tells how the clients of an operation are told apart
+type Key string
+
+const (
+	KeyIP     Key = "ip"     // KeyIP limits the clients by source IP
+	KeyDID    Key = "did"    // KeyDID limits the clients by the DID of the {identifier} path param
+	KeyAPIKey Key = "apikey" // KeyAPIKey limits the clients by the authenticated caller, an API key, user or token subject
+)
+
+// Limit is a token bucket that fills with Rate tokens per second up to Burst. Every request takes a token.
+// A rate of 0 means no limit.
+type Limit struct {
+	Rate  float64
+	Burst int
+	Key   Key
+}
+
+// Unlimited tells whether the limit lets every request through
+func (l Limit) Unlimited() bool {
+	return l.Rate == 0
+}
+
+func (l Limit) validate() error {
+	switch {
+	case l.Rate < 0:
+		return fmt.Errorf("%w: rate can't be negative", ErrInvalidLimit)
+	case l.Rate > 0 && l.Burst < 1:
+		return fmt.Errorf("%w: burst must be at least 1", ErrInvalidLimit)
+	case l.Key != KeyIP && l.Key != KeyDID && l.Key != KeyAPIKey:
+		return fmt.Errorf("%w: unknown key %q", ErrInvalidLimit, l.Key)
+	}
+	return nil
+}
+
+// bucketSweepInterval is the min time between two sweeps of the expired buckets kept in memory
+const bucketSweepInterval = time.Minute
+
+// bucket is the state of a token bucket kept in memory
+type bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// takeScript takes a token from the bucket in KEYS[1], a hash with the tokens and the time they were counted in
+// milliseconds. ARGV holds the rate per second, the burst, the current time and the ttl in milliseconds. It returns
+// whether the token was taken and the milliseconds until the next one.
+const takeScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+if now > ts then
+	tokens = math.min(burst, tokens + (now - ts) * rate / 1000)
+	ts = now
+end
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(ts))
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+return {allowed, wait}
+`
+
+// Limiter throttles the clients of the API with token buckets kept in the cache, so the instances of the node share
+// them. Tokens are taken atomically by a script run in the cache server. When the shared cache is not available the
+// buckets are kept in memory by the limiter, apart from the memory cache so they don't count as cache lookups.
+type Limiter struct {
+	shared  cache.Scripter
+	mu      sync.Mutex // serializes the buckets kept in memory
+	local   map[string]bucket
+	sweptAt time.Time
+	public  Limit
+	routes  map[string]Limit
+	now     func() time.Time
+}
+
+// NewLimiter creates a limiter with the limits of the configuration. When the shared cache can't run scripts, or
+// there is none, the buckets of each instance are kept in memory.
+func NewLimiter(shared cache.Cache, cfg config.RateLimit) (*Limiter, error) {
+	l := &Limiter{
+		local:  make(map[string]bucket),
+		public: Limit{Rate: cfg.Rate, Burst: cfg.Burst, Key: KeyIP},
+		routes: make(map[string]Limit, len(cfg.Routes)),
+		now:    time.Now,
+	}
+	if scripter, ok := shared.(cache.Scripter); ok {
+		l.shared = scripter
+	}
+	if err := l.public.validate(); err != nil {
+		return nil, err
+	}
+	for _, route := range cfg.Routes {
+		limit := Limit{Rate: route.Rate, Burst: route.Burst, Key: Key(route.Key)}
+		if limit.Key == "" {
+			limit.Key = KeyIP
+		}
+		if route.Operation == "" {
+			return nil, fmt.Errorf("%w: operation is required", ErrInvalidLimit)
+		}
+		if err := limit.validate(); err != nil {
+			return nil, fmt.Errorf("%w, operation %s", err, route.Operation)
+		}
+		l.routes[route.Operation] = limit
+	}
+	return l, nil
+}
+
+// Limit returns the limit of the operation: the one configured for it, or the one of the public operations.
+// It returns false when the operation has no limit.
+func (l *Limiter) Limit(operationID string, public bool) (Limit, bool) {
+	limit, ok := l.routes[operationID]
+	if !ok {
+		if !public {
+			return Limit{}, false
+		}
+		limit = l.public
+	}
+	return limit, !limit.Unlimited()
+}
+
+// Allow takes a token from the bucket of the client in the operation. When the bucket is empty it returns false and
+// the time until the next token.
+func (l *Limiter) Allow(ctx context.Context, operationID string, client string, limit Limit) (bool, time.Duration) {
+	key := fmt.Sprintf("ratelimit:%s:%s", operationID, client)
+	if l.shared != nil {
+		allowed, wait, err := takeShared(ctx, l.shared, key, limit, l.now())
+		if err == nil {
+			return allowed, wait
+		}
+		log.Warn(ctx, "taking rate limit token from the cache, using the memory one", "err", err, "operation", operationID)
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.take(key, limit, l.now())
+}
+
+// takeShared takes a token from the bucket kept in the shared cache
+func takeShared(ctx context.Context, c cache.Scripter, key string, limit Limit, now time.Time) (bool, time.Duration, error) {
+	res, err := c.Eval(ctx, takeScript, []string{key},
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64),
+		strconv.Itoa(limit.Burst),
+		strconv.FormatInt(now.UnixMilli(), 10),
+		strconv.FormatInt(bucketTTL(limit).Milliseconds(), 10),
+	)
+	if err != nil {
+		return false, 0, err
+	}
+	if len(res) != 2 {
+		return false, 0, fmt.Errorf("unexpected rate limit script result %v", res)
+	}
+	return res[0] == 1, time.Duration(res[1]) * time.Millisecond, nil
+}
+
+// take takes a token from the bucket kept in memory. Callers must hold the lock of the limiter.
+func (l *Limiter) take(key string, limit Limit, now time.Time) (bool, time.Duration) {
+	l.sweep(now)
+	b, ok := l.local[key]
+	if !ok || !now.Before(b.ExpiresAt) {
+		b = bucket{Tokens: float64(limit.Burst), UpdatedAt: now}
+	}
+	if elapsed := now.Sub(b.UpdatedAt); elapsed > 0 {
+		b.Tokens = math.Min(float64(limit.Burst), b.Tokens+elapsed.Seconds()*limit.Rate)
+	}
+	b.UpdatedAt = now
+
+	allowed := b.Tokens >= 1
+	var wait time.Duration
+	if allowed {
+		b.Tokens--
+	} else {
+		wait = time.Duration((1 - b.Tokens) / limit.Rate * float64(time.Second))
+	}
+	b.ExpiresAt = now.Add(bucketTTL(limit))
+	l.local[key] = b
+	return allowed, wait
+}
+
+// sweep removes the expired buckets kept in memory, at most once every bucketSweepInterval.
+// Callers must hold the lock of the limiter.
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < bucketSweepInterval {
+		return
+	}
+	for key, b := range l.local {
+		if !now.Before(b.ExpiresAt) {
+			delete(l.local, key)
+		}
+	}
+	l.sweptAt = now
+}
+
+// bucketTTL is the time a bucket is kept. An expired bucket is a full one, so it is only kept until it fills up again.
+func bucketTTL(limit Limit) time.Duration {
+	return time.Duration(float64(limit.Burst)/limit.Rate*float64(time.Second)) + time.Second
+}
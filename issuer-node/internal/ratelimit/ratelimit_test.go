@@ -0,0 +1,178 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/config"
+	"github.com/polygonid/sh-id-platform/internal/redis"
+)
+
+type failingCache struct {
+	cache.Cache
+}
+
+func (c failingCache) Eval(_ context.Context, _ string, _ []string, _ ...string) ([]int64, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	ctx := context.Background()
+	limiter, err := NewLimiter(cache.NewMemoryCache(), config.RateLimit{Rate: 2, Burst: 3})
+	require.NoError(t, err)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+	limit, ok := limiter.Limit("Agent", true)
+	require.True(t, ok)
+
+	t.Run("should let the burst through and throttle the rest", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			allowed, _ := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit)
+			assert.True(t, allowed)
+		}
+		allowed, retryAfter := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit)
+		assert.False(t, allowed)
+		assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+		allowed, _ = limiter.Allow(ctx, "Agent", "ip:10.0.0.2", limit)
+		assert.True(t, allowed, "every client has its own bucket")
+	})
+
+	t.Run("should refill the bucket over time", func(t *testing.T) {
+		now = now.Add(time.Second)
+		for i := 0; i < 2; i++ {
+			allowed, _ := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit)
+			assert.True(t, allowed)
+		}
+		allowed, _ := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit)
+		assert.False(t, allowed)
+	})
+
+	t.Run("should take every token once under concurrent requests", func(t *testing.T) {
+		var allowed atomic.Int64
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if ok, _ := limiter.Allow(ctx, "Agent", "ip:10.0.0.3", limit); ok {
+					allowed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int64(3), allowed.Load())
+	})
+
+	t.Run("should keep the buckets in memory when the cache fails", func(t *testing.T) {
+		limiter, err := NewLimiter(failingCache{cache.NewMemoryCache()}, config.RateLimit{Rate: 1, Burst: 1})
+		require.NoError(t, err)
+		limit, _ := limiter.Limit("GetQrFromStore", true)
+		allowed, _ := limiter.Allow(ctx, "GetQrFromStore", "ip:10.0.0.1", limit)
+		assert.True(t, allowed)
+		allowed, _ = limiter.Allow(ctx, "GetQrFromStore", "ip:10.0.0.1", limit)
+		assert.False(t, allowed)
+	})
+
+	t.Run("should drop the expired buckets kept in memory", func(t *testing.T) {
+		limiter, err := NewLimiter(nil, config.RateLimit{Rate: 1, Burst: 1})
+		require.NoError(t, err)
+		now := time.Now()
+		limiter.now = func() time.Time { return now }
+		limit, _ := limiter.Limit("GetQrFromStore", true)
+		limiter.Allow(ctx, "GetQrFromStore", "ip:10.0.0.1", limit)
+		limiter.Allow(ctx, "GetQrFromStore", "ip:10.0.0.2", limit)
+		assert.Len(t, limiter.local, 2)
+
+		now = now.Add(bucketSweepInterval)
+		allowed, _ := limiter.Allow(ctx, "GetQrFromStore", "ip:10.0.0.1", limit)
+		assert.True(t, allowed, "an expired bucket is a full one")
+		assert.Len(t, limiter.local, 1)
+	})
+}
+
+func TestLimiter_AllowShared(t *testing.T) {
+	ctx := context.Background()
+	s := miniredis.RunT(t)
+	client, err := redis.Open(ctx, "redis://"+s.Addr())
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, client.Close()) }()
+
+	limiter, err := NewLimiter(cache.NewRedisCache(client), config.RateLimit{Rate: 2, Burst: 3})
+	require.NoError(t, err)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+	limit, _ := limiter.Limit("Agent", true)
+
+	t.Run("should take every token once under concurrent requests", func(t *testing.T) {
+		var allowed atomic.Int64
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if ok, _ := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit); ok {
+					allowed.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int64(3), allowed.Load())
+
+		ok, retryAfter := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit)
+		assert.False(t, ok)
+		assert.Equal(t, 500*time.Millisecond, retryAfter)
+	})
+
+	t.Run("should refill the bucket over time", func(t *testing.T) {
+		now = now.Add(time.Second)
+		for i := 0; i < 2; i++ {
+			ok, _ := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit)
+			assert.True(t, ok)
+		}
+		ok, _ := limiter.Allow(ctx, "Agent", "ip:10.0.0.1", limit)
+		assert.False(t, ok)
+		assert.True(t, s.Exists("ratelimit:Agent:ip:10.0.0.1"))
+	})
+}
+
+func TestLimiter_Limit(t *testing.T) {
+	limiter, err := NewLimiter(nil, config.RateLimit{Rate: 10, Burst: 20, Routes: config.RateLimitRoutes{
+		{Operation: "Agent", Rate: 1, Burst: 5, Key: "did"},
+		{Operation: "CreateCredential", Rate: 5, Burst: 10, Key: "apikey"},
+		{Operation: "GetQrFromStore"},
+	}})
+	require.NoError(t, err)
+
+	limit, ok := limiter.Limit("Agent", true)
+	require.True(t, ok)
+	assert.Equal(t, Limit{Rate: 1, Burst: 5, Key: KeyDID}, limit)
+	limit, ok = limiter.Limit("CreateCredential", false)
+	require.True(t, ok)
+	assert.Equal(t, KeyAPIKey, limit.Key)
+	limit, ok = limiter.Limit("GetRevocationStatusV2", true)
+	require.True(t, ok)
+	assert.Equal(t, Limit{Rate: 10, Burst: 20, Key: KeyIP}, limit)
+	_, ok = limiter.Limit("GetQrFromStore", true)
+	assert.False(t, ok, "a rate of 0 disables the limit of the operation")
+	_, ok = limiter.Limit("GetIdentities", false)
+	assert.False(t, ok, "authenticated operations are only limited when configured")
+
+	t.Run("should reject invalid limits", func(t *testing.T) {
+		_, err := NewLimiter(nil, config.RateLimit{Rate: -1})
+		assert.ErrorIs(t, err, ErrInvalidLimit)
+		_, err = NewLimiter(nil, config.RateLimit{Rate: 1, Burst: 0})
+		assert.ErrorIs(t, err, ErrInvalidLimit)
+		_, err = NewLimiter(nil, config.RateLimit{Routes: config.RateLimitRoutes{{Operation: "Agent", Rate: 1, Burst: 1, Key: "token"}}})
+		assert.ErrorIs(t, err, ErrInvalidLimit)
+	})
+}
@@ -0,0 +1,85 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// defender is a client for relayers with an OpenZeppelin Defender style REST API:
+//
+//	POST {url}/txs      sends a transaction
+//	GET  {url}/txs/{id} returns the status of a transaction
+//
+// Requests are authenticated with the X-Api-Key and X-Api-Secret headers.
+type defender struct {
+	client    *http.Client
+	url       string
+	apiKey    string
+	apiSecret string
+}
+
+func newDefender(client *http.Client, cfg Config) *defender {
+	return &defender{
+		client:    client,
+		url:       strings.TrimSuffix(cfg.URL, "/"),
+		apiKey:    cfg.APIKey,
+		apiSecret: cfg.APISecret,
+	}
+}
+
+// Send sends the transaction to the relayer
+func (d *defender) Send(ctx context.Context, req TxRequest) (*Tx, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	return d.do(ctx, http.MethodPost, d.url+"/txs", body)
+}
+
+// Get returns the status of the relayed transaction
+func (d *defender) Get(ctx context.Context, id string) (*Tx, error) {
+	return d.do(ctx, http.MethodGet, d.url+"/txs/"+url.PathEscape(id), nil)
+}
+
+func (d *defender) do(ctx context.Context, method string, endpoint string, body []byte) (*Tx, error) {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", d.apiKey)
+	req.Header.Set("X-Api-Secret", d.apiSecret)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("relayer request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var tx Tx
+	if err := json.Unmarshal(respBody, &tx); err != nil {
+		return nil, fmt.Errorf("invalid relayer response: %w", err)
+	}
+	if tx.ID == "" {
+		return nil, fmt.Errorf("invalid relayer response: missing transactionId")
+	}
+	return &tx, nil
+}
@@ -0,0 +1,119 @@
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	defaultForwarderName    = "MinimalForwarder"
+	defaultForwarderVersion = "0.0.1"
+
+	// forwarderABI is the part of the OpenZeppelin MinimalForwarder used to relay meta-transactions
+	forwarderABI = `[
+{"inputs":[{"internalType":"address","name":"from","type":"address"}],"name":"getNonce","outputs":[{"internalType":"uint256","name":"","type":"uint256"}],"stateMutability":"view","type":"function"},
+{"inputs":[{"components":[{"internalType":"address","name":"from","type":"address"},{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"value","type":"uint256"},{"internalType":"uint256","name":"gas","type":"uint256"},{"internalType":"uint256","name":"nonce","type":"uint256"},{"internalType":"bytes","name":"data","type":"bytes"}],"internalType":"struct MinimalForwarder.ForwardRequest","name":"req","type":"tuple"},{"internalType":"bytes","name":"signature","type":"bytes"}],"name":"execute","outputs":[{"internalType":"bool","name":"","type":"bool"},{"internalType":"bytes","name":"","type":"bytes"}],"stateMutability":"payable","type":"function"}
+]`
+)
+
+// ForwarderConfig holds the ERC-2771 forwarder that relays the meta-transactions signed by the ethereum identities.
+// Name and Version are the ones of its EIP-712 domain.
+type ForwarderConfig struct {
+	Address string `yaml:"address"`
+	Name    string `yaml:"name"`
+	Version string `yaml:"version"`
+}
+
+// ForwardRequest is a meta-transaction executed by an OpenZeppelin MinimalForwarder. The forwarder appends From to the
+// call data, so a contract trusting the forwarder takes From as the sender of the call.
+type ForwardRequest struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Gas   *big.Int
+	Nonce *big.Int
+	Data  []byte
+}
+
+// TypedData returns the EIP-712 typed data of the request, that must be signed by From
+func (r ForwardRequest) TypedData(cfg ForwarderConfig, chainID *big.Int) apitypes.TypedData {
+	name, version := cfg.Name, cfg.Version
+	if name == "" {
+		name = defaultForwarderName
+	}
+	if version == "" {
+		version = defaultForwarderVersion
+	}
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"ForwardRequest": {
+				{Name: "from", Type: "address"},
+				{Name: "to", Type: "address"},
+				{Name: "value", Type: "uint256"},
+				{Name: "gas", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "data", Type: "bytes"},
+			},
+		},
+		PrimaryType: "ForwardRequest",
+		Domain: apitypes.TypedDataDomain{
+			Name:              name,
+			Version:           version,
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: common.HexToAddress(cfg.Address).Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":  r.From.Hex(),
+			"to":    r.To.Hex(),
+			"value": r.Value,
+			"gas":   r.Gas,
+			"nonce": r.Nonce,
+			"data":  r.Data,
+		},
+	}
+}
+
+// PackExecute returns the call data of the forwarder execute method for the signed request
+func PackExecute(req ForwardRequest, signature []byte) ([]byte, error) {
+	parsed, err := ethabi.JSON(strings.NewReader(forwarderABI))
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("execute", req, signature)
+}
+
+// ForwarderNonce returns the next nonce of the from address in the forwarder
+func ForwarderNonce(ctx context.Context, caller bind.ContractCaller, forwarder common.Address, from common.Address) (*big.Int, error) {
+	parsed, err := ethabi.JSON(strings.NewReader(forwarderABI))
+	if err != nil {
+		return nil, err
+	}
+	contract := bind.NewBoundContract(forwarder, parsed, caller, nil, nil)
+	var out []interface{}
+	if err := contract.Call(&bind.CallOpts{Context: ctx}, &out, "getNonce", from); err != nil {
+		return nil, fmt.Errorf("failed to get the forwarder nonce: %w", err)
+	}
+	if len(out) != 1 {
+		return nil, errors.New("unexpected forwarder nonce response")
+	}
+	nonce, ok := out[0].(*big.Int)
+	if !ok {
+		return nil, errors.New("unexpected forwarder nonce type")
+	}
+	return nonce, nil
+}
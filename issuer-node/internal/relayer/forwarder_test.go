@@ -0,0 +1,70 @@
+package relayer
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	ethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardRequest(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	req := ForwardRequest{
+		From:  crypto.PubkeyToAddress(key.PublicKey),
+		To:    common.HexToAddress("0x134B1BE34911E39A8397ec6289782989729807a4"),
+		Value: big.NewInt(0),
+		Gas:   big.NewInt(300000),
+		Nonce: big.NewInt(3),
+		Data:  []byte{0x01, 0x02, 0x03},
+	}
+	cfg := ForwarderConfig{Address: "0x5FbDB2315678afecb367f032d93F642f64180aa3"}
+
+	t.Run("should sign the typed data of the request", func(t *testing.T) {
+		hash, _, err := apitypes.TypedDataAndHash(req.TypedData(cfg, big.NewInt(80002)))
+		require.NoError(t, err)
+		signature, err := crypto.Sign(hash, key)
+		require.NoError(t, err)
+
+		pub, err := crypto.SigToPub(hash, signature)
+		require.NoError(t, err)
+		assert.Equal(t, req.From, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("should use the default domain of the forwarder", func(t *testing.T) {
+		typedData := req.TypedData(cfg, big.NewInt(80002))
+		assert.Equal(t, defaultForwarderName, typedData.Domain.Name)
+		assert.Equal(t, defaultForwarderVersion, typedData.Domain.Version)
+		assert.Equal(t, common.HexToAddress(cfg.Address).Hex(), typedData.Domain.VerifyingContract)
+
+		custom := req.TypedData(ForwarderConfig{Address: cfg.Address, Name: "Forwarder", Version: "1"}, big.NewInt(80002))
+		assert.Equal(t, "Forwarder", custom.Domain.Name)
+		assert.Equal(t, "1", custom.Domain.Version)
+	})
+
+	t.Run("should pack the execute call", func(t *testing.T) {
+		signature := []byte{0xaa, 0xbb}
+		data, err := PackExecute(req, signature)
+		require.NoError(t, err)
+
+		parsed, err := ethabi.JSON(strings.NewReader(forwarderABI))
+		require.NoError(t, err)
+		method, err := parsed.MethodById(data[:4])
+		require.NoError(t, err)
+		assert.Equal(t, "execute", method.Name)
+
+		args, err := method.Inputs.Unpack(data[4:])
+		require.NoError(t, err)
+		require.Len(t, args, 2)
+		assert.Equal(t, signature, args[1])
+		packed, err := method.Inputs.Pack(req, signature)
+		require.NoError(t, err)
+		assert.Equal(t, data[4:], packed)
+	})
+}
@@ -0,0 +1,112 @@
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+const (
+	methodSendTransaction = "relay_sendTransaction"
+	methodGetTransaction  = "relay_getTransaction"
+)
+
+// jsonRPC is a client for relayers exposing a JSON-RPC 2.0 API with the methods:
+//
+//	relay_sendTransaction [TxRequest] -> Tx
+//	relay_getTransaction  [id]        -> Tx
+//
+// The API key, if configured, is sent as a bearer token.
+type jsonRPC struct {
+	client *http.Client
+	url    string
+	apiKey string
+	nextID atomic.Int64
+}
+
+type jsonRPCRequest struct {
+	JSONRPC string `json:"jsonrpc"`
+	ID      int64  `json:"id"`
+	Method  string `json:"method"`
+	Params  []any  `json:"params"`
+}
+
+type jsonRPCResponse struct {
+	ID     int64         `json:"id"`
+	Result *Tx           `json:"result"`
+	Error  *jsonRPCError `json:"error"`
+}
+
+type jsonRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func newJSONRPC(client *http.Client, cfg Config) *jsonRPC {
+	return &jsonRPC{
+		client: client,
+		url:    cfg.URL,
+		apiKey: cfg.APIKey,
+	}
+}
+
+// Send sends the transaction to the relayer
+func (j *jsonRPC) Send(ctx context.Context, req TxRequest) (*Tx, error) {
+	return j.call(ctx, methodSendTransaction, req)
+}
+
+// Get returns the status of the relayed transaction
+func (j *jsonRPC) Get(ctx context.Context, id string) (*Tx, error) {
+	return j.call(ctx, methodGetTransaction, id)
+}
+
+func (j *jsonRPC) call(ctx context.Context, method string, params ...any) (*Tx, error) {
+	body, err := json.Marshal(jsonRPCRequest{
+		JSONRPC: "2.0",
+		ID:      j.nextID.Add(1),
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, j.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if j.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+j.apiKey)
+	}
+
+	resp, err := j.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("relayer request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	var rpcResp jsonRPCResponse
+	if err := json.Unmarshal(respBody, &rpcResp); err != nil {
+		return nil, fmt.Errorf("invalid relayer response: %w", err)
+	}
+	if rpcResp.Error != nil {
+		return nil, fmt.Errorf("relayer error %d: %s", rpcResp.Error.Code, rpcResp.Error.Message)
+	}
+	if rpcResp.Result == nil || rpcResp.Result.ID == "" {
+		return nil, fmt.Errorf("invalid relayer response: missing transactionId")
+	}
+	return rpcResp.Result, nil
+}
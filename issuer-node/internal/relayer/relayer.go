@@ -0,0 +1,164 @@
+// Package relayer implements clients for transaction relayers, services that sign and send
+// transactions on behalf of the issuer node, so the node does not need to hold native tokens
+// to publish states.
+package relayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+const (
+	// TypeDefender is a relayer with an OpenZeppelin Defender style REST API
+	TypeDefender = "defender"
+	// TypeJSONRPC is a relayer exposing a JSON-RPC API
+	TypeJSONRPC = "jsonrpc"
+
+	defaultPollInterval = time.Second
+	defaultTimeout      = 30 * time.Second
+	defaultHTTPTimeout  = 10 * time.Second
+)
+
+var (
+	// ErrUnsupportedRelayerType is returned when the relayer type in the configuration is unknown
+	ErrUnsupportedRelayerType = errors.New("unsupported relayer type")
+	// ErrRelayedTxFailed is returned when the relayer reports the transaction as failed
+	ErrRelayedTxFailed = errors.New("relayed transaction failed")
+	// ErrRelayedTxTimeout is returned when the relayer doesn't send the transaction to the network before the timeout
+	ErrRelayedTxTimeout = errors.New("timeout waiting for relayed transaction")
+)
+
+// Status of a relayed transaction
+type Status string
+
+const (
+	// StatusPending - the relayer accepted the transaction but it has not been sent yet
+	StatusPending Status = "pending"
+	// StatusSent - the transaction has been sent to the network
+	StatusSent Status = "sent"
+	// StatusSubmitted - the transaction has been sent to the network
+	StatusSubmitted Status = "submitted"
+	// StatusInMempool - the transaction is in the mempool of the network
+	StatusInMempool Status = "inmempool"
+	// StatusMined - the transaction has been included in a block
+	StatusMined Status = "mined"
+	// StatusConfirmed - the transaction has enough confirmations
+	StatusConfirmed Status = "confirmed"
+	// StatusFailed - the transaction could not be sent or was reverted
+	StatusFailed Status = "failed"
+)
+
+// Config holds the relayer settings of a network
+type Config struct {
+	Type         string        `yaml:"type"`
+	URL          string        `yaml:"url"`
+	APIKey       string        `yaml:"apiKey"`
+	APISecret    string        `yaml:"apiSecret"`
+	Speed        string        `yaml:"speed"`
+	GasLimit     uint64        `yaml:"gasLimit"`
+	PollInterval time.Duration `yaml:"pollInterval"`
+	Timeout      time.Duration `yaml:"timeout"`
+	// Forwarder, when set, relays the state transitions of the ethereum identities as meta-transactions signed by them
+	Forwarder *ForwarderConfig `yaml:"forwarder"`
+}
+
+// TxRequest is a transaction to be signed and sent by the relayer
+type TxRequest struct {
+	To       string `json:"to"`
+	Data     string `json:"data"`
+	Value    string `json:"value,omitempty"`
+	GasLimit uint64 `json:"gasLimit,omitempty"`
+	Speed    string `json:"speed,omitempty"`
+}
+
+// Tx is a transaction relayed. Hash is empty until the relayer sends it to the network and
+// it may change if the relayer re-prices the transaction.
+type Tx struct {
+	ID     string `json:"transactionId"`
+	Hash   string `json:"hash"`
+	Status Status `json:"status"`
+}
+
+// Relayer sends transactions on behalf of the issuer node
+type Relayer interface {
+	Send(ctx context.Context, req TxRequest) (*Tx, error)
+	Get(ctx context.Context, id string) (*Tx, error)
+}
+
+// New returns the relayer client for the given configuration
+func New(cfg Config) (Relayer, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("relayer url is required")
+	}
+	client := &http.Client{Timeout: defaultHTTPTimeout}
+	switch cfg.Type {
+	case TypeDefender:
+		return newDefender(client, cfg), nil
+	case TypeJSONRPC:
+		return newJSONRPC(client, cfg), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedRelayerType, cfg.Type)
+	}
+}
+
+// Relay sends the transaction through the relayer and polls its status until the relayer sends it to the network,
+// so its hash is known. Waiting for the receipt is up to the caller. ErrRelayedTxFailed is returned if the relayer
+// can't send the transaction and ErrRelayedTxTimeout if it's not sent before the configured timeout.
+func Relay(ctx context.Context, r Relayer, cfg Config, req TxRequest) (*Tx, error) {
+	if req.Speed == "" {
+		req.Speed = cfg.Speed
+	}
+	if req.GasLimit == 0 {
+		req.GasLimit = cfg.GasLimit
+	}
+
+	tx, err := r.Send(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	log.Info(ctx, "transaction sent to relayer", "relayerTxID", tx.ID, "status", tx.Status)
+	return WaitSent(ctx, r, cfg, tx)
+}
+
+// WaitSent polls the relayer until the transaction has a hash, it fails or the configured timeout is reached
+func WaitSent(ctx context.Context, r Relayer, cfg Config, tx *Tx) (*Tx, error) {
+	pollInterval := cfg.PollInterval
+	if pollInterval == 0 {
+		pollInterval = defaultPollInterval
+	}
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		switch {
+		case tx.Status == StatusFailed:
+			return tx, fmt.Errorf("%w: %s", ErrRelayedTxFailed, tx.ID)
+		case tx.Hash != "":
+			return tx, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ErrRelayedTxTimeout
+		case <-ticker.C:
+			latest, err := r.Get(ctx, tx.ID)
+			if err != nil {
+				log.Warn(ctx, "failed to get relayed transaction status", "err", err, "relayerTxID", tx.ID)
+				continue
+			}
+			tx = latest
+		}
+	}
+}
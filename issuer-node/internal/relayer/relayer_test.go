@@ -0,0 +1,215 @@
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRelayer serves the defender and JSON-RPC relayer APIs. Each transaction is accepted without hash and reaches
+// finalStatus on the first status request, with a hash unless the relayer couldn't send it.
+type fakeRelayer struct {
+	mu          sync.Mutex
+	txs         map[string]*Tx
+	requests    []TxRequest
+	polls       map[string]int
+	finalStatus Status
+	headers     http.Header
+}
+
+func newFakeRelayer(finalStatus Status) *fakeRelayer {
+	return &fakeRelayer{
+		txs:         make(map[string]*Tx),
+		polls:       make(map[string]int),
+		finalStatus: finalStatus,
+	}
+}
+
+func (f *fakeRelayer) send(req TxRequest) *Tx {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, req)
+	id := fmt.Sprintf("tx-%d", len(f.requests))
+	tx := &Tx{ID: id, Status: StatusPending}
+	f.txs[id] = tx
+	return tx
+}
+
+func (f *fakeRelayer) get(id string) (*Tx, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	tx, ok := f.txs[id]
+	if !ok {
+		return nil, false
+	}
+	f.polls[id]++
+	tx.Status = f.finalStatus
+	if tx.Status != StatusPending && tx.Status != StatusFailed {
+		tx.Hash = "0x02"
+	}
+	res := *tx
+	return &res, true
+}
+
+func (f *fakeRelayer) defenderHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /txs", func(w http.ResponseWriter, r *http.Request) {
+		f.headers = r.Header.Clone()
+		var req TxRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(f.send(req))
+	})
+	mux.HandleFunc("GET /txs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		tx, ok := f.get(r.PathValue("id"))
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(tx)
+	})
+	return mux
+}
+
+func (f *fakeRelayer) jsonRPCHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.headers = r.Header.Clone()
+		var req struct {
+			ID     int64             `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || len(req.Params) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := map[string]any{"jsonrpc": "2.0", "id": req.ID}
+		switch req.Method {
+		case methodSendTransaction:
+			var txReq TxRequest
+			_ = json.Unmarshal(req.Params[0], &txReq)
+			resp["result"] = f.send(txReq)
+		case methodGetTransaction:
+			var id string
+			_ = json.Unmarshal(req.Params[0], &id)
+			if tx, ok := f.get(id); ok {
+				resp["result"] = tx
+			} else {
+				resp["error"] = map[string]any{"code": -32000, "message": "transaction not found"}
+			}
+		default:
+			resp["error"] = map[string]any{"code": -32601, "message": "method not found"}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
+
+func TestRelay(t *testing.T) {
+	ctx := context.Background()
+	for _, relayerType := range []string{TypeDefender, TypeJSONRPC} {
+		t.Run(relayerType+" should poll until the transaction is sent", func(t *testing.T) {
+			fake := newFakeRelayer(StatusSubmitted)
+			cfg := newTestServer(t, fake, relayerType)
+			r, err := New(cfg)
+			require.NoError(t, err)
+
+			tx, err := Relay(ctx, r, cfg, TxRequest{To: "0x1234", Data: "0xabcd"})
+			require.NoError(t, err)
+			assert.Equal(t, "tx-1", tx.ID)
+			assert.Equal(t, "0x02", tx.Hash)
+			assert.Equal(t, StatusSubmitted, tx.Status, "the receipt is not awaited")
+			assert.Equal(t, 1, fake.polls["tx-1"])
+
+			require.Len(t, fake.requests, 1)
+			assert.Equal(t, "0x1234", fake.requests[0].To)
+			assert.Equal(t, "0xabcd", fake.requests[0].Data)
+			assert.Equal(t, "fast", fake.requests[0].Speed)
+			assert.Equal(t, uint64(600000), fake.requests[0].GasLimit)
+		})
+
+		t.Run(relayerType+" should return an error if the transaction fails", func(t *testing.T) {
+			fake := newFakeRelayer(StatusFailed)
+			cfg := newTestServer(t, fake, relayerType)
+			r, err := New(cfg)
+			require.NoError(t, err)
+
+			_, err = Relay(ctx, r, cfg, TxRequest{To: "0x1234", Data: "0xabcd"})
+			assert.ErrorIs(t, err, ErrRelayedTxFailed)
+		})
+	}
+
+	t.Run("should time out if the relayer doesn't send the transaction", func(t *testing.T) {
+		fake := newFakeRelayer(StatusPending)
+		cfg := newTestServer(t, fake, TypeDefender)
+		cfg.Timeout = 50 * time.Millisecond
+		r, err := New(cfg)
+		require.NoError(t, err)
+
+		tx, err := Relay(ctx, r, cfg, TxRequest{To: "0x1234", Data: "0xabcd"})
+		assert.ErrorIs(t, err, ErrRelayedTxTimeout)
+		assert.Nil(t, tx)
+	})
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(Config{Type: "unknown", URL: "http://localhost"})
+	assert.ErrorIs(t, err, ErrUnsupportedRelayerType)
+
+	_, err = New(Config{Type: TypeDefender})
+	assert.Error(t, err)
+}
+
+func TestAuthenticationHeaders(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeRelayer(StatusMined)
+
+	cfg := newTestServer(t, fake, TypeDefender)
+	r, err := New(cfg)
+	require.NoError(t, err)
+	_, err = r.Send(ctx, TxRequest{To: "0x1234"})
+	require.NoError(t, err)
+	assert.Equal(t, "key", fake.headers.Get("X-Api-Key"))
+	assert.Equal(t, "secret", fake.headers.Get("X-Api-Secret"))
+
+	cfg = newTestServer(t, fake, TypeJSONRPC)
+	r, err = New(cfg)
+	require.NoError(t, err)
+	_, err = r.Send(ctx, TxRequest{To: "0x1234"})
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer key", fake.headers.Get("Authorization"))
+
+	_, err = r.Get(ctx, "unknown")
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "transaction not found"))
+}
+
+func newTestServer(t *testing.T, fake *fakeRelayer, relayerType string) Config {
+	t.Helper()
+	handler := fake.defenderHandler()
+	if relayerType == TypeJSONRPC {
+		handler = fake.jsonRPCHandler()
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return Config{
+		Type:         relayerType,
+		URL:          server.URL,
+		APIKey:       "key",
+		APISecret:    "secret",
+		Speed:        "fast",
+		GasLimit:     600000,
+		PollInterval: 5 * time.Millisecond,
+		Timeout:      5 * time.Second,
+	}
+}
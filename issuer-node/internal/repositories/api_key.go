@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// APIKeyNotFoundErr is the error returned when the API key does not exist
+	APIKeyNotFoundErr = errors.New("api key not found")
+	// APIKeyDuplicatedErr is the error returned when the prefix of the API key is already in use
+	APIKeyDuplicatedErr = errors.New("api key prefix already exists")
+)
+
+const apiKeyFields = `id, name, prefix, hash, role, tenant_id, issuer_ids, expires_at, revoked_at, created_at`
+
+type apiKey struct {
+	conn db.Storage
+}
+
+// NewAPIKey returns a new repository of API keys
+func NewAPIKey(conn db.Storage) ports.APIKeyRepository {
+	return &apiKey{conn: conn}
+}
+
+// Save stores a new API key
+func (r *apiKey) Save(ctx context.Context, key *domain.APIKey) error {
+	const insertKey = `INSERT INTO api_keys (` + apiKeyFields + `) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	issuerDIDs := key.IssuerDIDs
+	if issuerDIDs == nil {
+		issuerDIDs = []string{}
+	}
+	_, err := r.conn.Pgx.Exec(ctx, insertKey,
+		key.ID,
+		key.Name,
+		key.Prefix,
+		key.Hash,
+		key.Role,
+		key.TenantID,
+		issuerDIDs,
+		key.ExpiresAt,
+		key.RevokedAt,
+		key.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return APIKeyDuplicatedErr
+		}
+		return fmt.Errorf("failed to save api key: %w", err)
+	}
+	return nil
+}
+
+// GetByPrefix returns the API key with the prefix
+func (r *apiKey) GetByPrefix(ctx context.Context, prefix string) (*domain.APIKey, error) {
+	sql := `SELECT ` + apiKeyFields + ` FROM api_keys WHERE prefix=$1`
+	rows, err := r.conn.Pgx.Query(ctx, sql, prefix)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := scanAPIKeys(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, APIKeyNotFoundErr
+	}
+	return &keys[0], nil
+}
+
+// GetAll returns the API keys of the tenant, or all the keys of the node when tenantID is nil, newest first
+func (r *apiKey) GetAll(ctx context.Context, tenantID *uuid.UUID) ([]domain.APIKey, error) {
+	sql := `SELECT ` + apiKeyFields + ` FROM api_keys WHERE $1::uuid IS NULL OR tenant_id=$1 ORDER BY created_at DESC`
+	rows, err := r.conn.Pgx.Query(ctx, sql, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	return scanAPIKeys(rows)
+}
+
+// Revoke sets the API key of the tenant, or of any tenant when tenantID is nil, as revoked. Revoking a revoked key
+// keeps its first revocation date.
+func (r *apiKey) Revoke(ctx context.Context, id uuid.UUID, tenantID *uuid.UUID, revokedAt time.Time) error {
+	const revoke = `UPDATE api_keys SET revoked_at=COALESCE(revoked_at, $2) WHERE id=$1 AND ($3::uuid IS NULL OR tenant_id=$3)`
+	res, err := r.conn.Pgx.Exec(ctx, revoke, id, revokedAt, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return APIKeyNotFoundErr
+	}
+	return nil
+}
+
+func scanAPIKeys(rows pgx.Rows) ([]domain.APIKey, error) {
+	defer rows.Close()
+	keys := make([]domain.APIKey, 0)
+	for rows.Next() {
+		var key domain.APIKey
+		var role string
+		if err := rows.Scan(&key.ID, &key.Name, &key.Prefix, &key.Hash, &role, &key.TenantID, &key.IssuerDIDs, &key.ExpiresAt, &key.RevokedAt, &key.CreatedAt); err != nil {
+			return nil, err
+		}
+		key.Role = domain.Role(role)
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
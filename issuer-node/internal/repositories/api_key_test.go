@@ -0,0 +1,50 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestAPIKey(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAPIKey(*storage)
+
+	issuerDID := randomDID(t)
+	key, _, err := domain.NewAPIKey("operator", domain.RoleIssuer, nil, []string{issuerDID.String()}, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, key))
+	admin, _, err := domain.NewAPIKey("admin", domain.RoleAdmin, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, admin))
+
+	saved, err := repo.GetByPrefix(ctx, key.Prefix)
+	require.NoError(t, err)
+	assert.Equal(t, key.Hash, saved.Hash)
+	assert.Equal(t, domain.RoleIssuer, saved.Role)
+	assert.Equal(t, key.IssuerDIDs, saved.IssuerDIDs)
+	assert.Nil(t, saved.RevokedAt)
+	saved, err = repo.GetByPrefix(ctx, admin.Prefix)
+	require.NoError(t, err)
+	assert.Empty(t, saved.IssuerDIDs)
+
+	_, err = repo.GetByPrefix(ctx, "unknown")
+	assert.ErrorIs(t, err, APIKeyNotFoundErr)
+	assert.ErrorIs(t, repo.Save(ctx, &domain.APIKey{ID: uuid.New(), Prefix: key.Prefix, Role: domain.RoleAdmin, CreatedAt: time.Now()}), APIKeyDuplicatedErr)
+
+	keys, err := repo.GetAll(ctx, nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, len(keys), 2)
+
+	require.NoError(t, repo.Revoke(ctx, key.ID, nil, time.Now()))
+	saved, err = repo.GetByPrefix(ctx, key.Prefix)
+	require.NoError(t, err)
+	assert.NotNil(t, saved.RevokedAt)
+	assert.ErrorIs(t, repo.Revoke(ctx, uuid.New(), nil, time.Now()), APIKeyNotFoundErr)
+}
@@ -0,0 +1,184 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+const auditFields = `issuer_id, seq, actor, actor_method, entity, operation, entity_id, diff, request_id, source_ip, created_at, prev_hash, hash`
+
+type audit struct {
+	conn db.Storage
+}
+
+// NewAudit returns a new repository of the audit log
+func NewAudit(conn db.Storage) ports.AuditRepository {
+	return &audit{conn: conn}
+}
+
+// GetLast locks the audit log of the issuer until the end of the transaction and returns its last entry
+func (r *audit) GetLast(ctx context.Context, conn db.Querier, issuerDID w3c.DID) (*domain.AuditEntry, error) {
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('audit_log:' || $1))`, issuerDID.String()); err != nil {
+		return nil, fmt.Errorf("failed to lock audit log: %w", err)
+	}
+	rows, err := conn.Query(ctx, `SELECT `+auditFields+` FROM audit_log WHERE issuer_id=$1 ORDER BY seq DESC LIMIT 1`, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	entries, err := scanAuditEntries(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	return &entries[0], nil
+}
+
+// Save appends the entry to the audit log and moves the head of the log of the issuer to it
+func (r *audit) Save(ctx context.Context, conn db.Querier, entry *domain.AuditEntry) error {
+	const insertEntry = `INSERT INTO audit_log (` + auditFields + `) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)`
+	const moveHead = `INSERT INTO audit_heads (issuer_id, seq, hash) VALUES($1, $2, $3)
+			ON CONFLICT (issuer_id) DO UPDATE SET seq=$2, hash=$3, modified_at=NOW()`
+	diff, err := json.Marshal(entry.Diff)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Exec(ctx, insertEntry,
+		entry.IssuerDID,
+		entry.Seq,
+		entry.Actor,
+		entry.ActorMethod,
+		entry.Entity,
+		entry.Operation,
+		entry.EntityID,
+		string(diff),
+		entry.RequestID,
+		entry.SourceIP,
+		entry.CreatedAt,
+		entry.PrevHash,
+		entry.Hash,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save audit entry: %w", err)
+	}
+	if _, err := conn.Exec(ctx, moveHead, entry.IssuerDID, entry.Seq, entry.Hash); err != nil {
+		return fmt.Errorf("failed to save audit head: %w", err)
+	}
+	return nil
+}
+
+// GetHead returns the head of the audit log of the issuer, or nil when it is empty
+func (r *audit) GetHead(ctx context.Context, issuerDID w3c.DID) (*domain.AuditHead, error) {
+	head := &domain.AuditHead{}
+	err := r.conn.Pgx.QueryRow(ctx, `SELECT issuer_id, seq, hash FROM audit_heads WHERE issuer_id=$1`, issuerDID.String()).
+		Scan(&head.IssuerDID, &head.Seq, &head.Hash)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return head, nil
+}
+
+// GetAll returns a page of the audit log of the issuer, the most recent entries first, and the number of entries
+// that match the filter
+func (r *audit) GetAll(ctx context.Context, issuerDID w3c.DID, filter ports.AuditFilter) ([]domain.AuditEntry, uint, error) {
+	where := []string{"issuer_id=$1"}
+	args := []interface{}{issuerDID.String()}
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		where = append(where, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.Entity != nil {
+		add("entity=$%d", *filter.Entity)
+	}
+	if filter.Operation != nil {
+		add("operation=$%d", *filter.Operation)
+	}
+	if filter.EntityID != nil {
+		add("entity_id=$%d", *filter.EntityID)
+	}
+	if filter.Actor != nil {
+		add("actor=$%d", *filter.Actor)
+	}
+	if filter.From != nil {
+		add("created_at>=$%d", *filter.From)
+	}
+	if filter.To != nil {
+		add("created_at<$%d", *filter.To)
+	}
+	conditions := strings.Join(where, " AND ")
+
+	var count uint
+	if err := r.conn.Pgx.QueryRow(ctx, `SELECT COUNT(*) FROM audit_log WHERE `+conditions, args...).Scan(&count); err != nil {
+		return nil, 0, err
+	}
+
+	sql := fmt.Sprintf(`SELECT %s FROM audit_log WHERE %s ORDER BY seq DESC OFFSET %d LIMIT %d`, auditFields, conditions, (filter.Page-1)*filter.MaxResults, filter.MaxResults)
+	rows, err := r.conn.Pgx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	entries, err := scanAuditEntries(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return entries, count, nil
+}
+
+// GetChain returns at most limit entries of the issuer after the entry with the sequence number, in order
+func (r *audit) GetChain(ctx context.Context, issuerDID w3c.DID, afterSeq int64, limit int) ([]domain.AuditEntry, error) {
+	sql := `SELECT ` + auditFields + ` FROM audit_log WHERE issuer_id=$1 AND seq>$2 ORDER BY seq LIMIT $3`
+	rows, err := r.conn.Pgx.Query(ctx, sql, issuerDID.String(), afterSeq, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanAuditEntries(rows)
+}
+
+func scanAuditEntries(rows pgx.Rows) ([]domain.AuditEntry, error) {
+	defer rows.Close()
+	entries := make([]domain.AuditEntry, 0)
+	for rows.Next() {
+		var (
+			entry             domain.AuditEntry
+			entity, operation string
+			diff              string
+		)
+		if err := rows.Scan(
+			&entry.IssuerDID,
+			&entry.Seq,
+			&entry.Actor,
+			&entry.ActorMethod,
+			&entity,
+			&operation,
+			&entry.EntityID,
+			&diff,
+			&entry.RequestID,
+			&entry.SourceIP,
+			&entry.CreatedAt,
+			&entry.PrevHash,
+			&entry.Hash,
+		); err != nil {
+			return nil, err
+		}
+		entry.Entity, entry.Operation = domain.AuditEntity(entity), domain.AuditOperation(operation)
+		if err := json.Unmarshal([]byte(diff), &entry.Diff); err != nil {
+			return nil, fmt.Errorf("failed to decode audit diff: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
@@ -0,0 +1,91 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+func TestAudit(t *testing.T) {
+	ctx := context.Background()
+	repo := NewAudit(*storage)
+	issuerDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "BJJ")
+	require.NoError(t, err)
+
+	for _, op := range []domain.AuditOperation{domain.AuditOperationCreate, domain.AuditOperationUpdate, domain.AuditOperationRevoke} {
+		require.NoError(t, storage.Pgx.BeginFunc(ctx, func(tx pgx.Tx) error {
+			last, err := repo.GetLast(ctx, tx, issuerDID)
+			if err != nil {
+				return err
+			}
+			entry := &domain.AuditEntry{
+				IssuerDID: issuerDID.String(),
+				Actor:     "alice",
+				Entity:    domain.AuditEntityCredential,
+				Operation: op,
+				EntityID:  "8edd8112-c415-11ed-b036-debe37e1cbd6",
+				Diff:      domain.AuditDiff{"revoked": {After: json.RawMessage(`{"b": 1, "a": 2}`)}},
+				CreatedAt: time.Now(),
+			}
+			if err := entry.Chain(last); err != nil {
+				return err
+			}
+			return repo.Save(ctx, tx, entry)
+		}))
+	}
+
+	chain, err := repo.GetChain(ctx, issuerDID, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, chain, 3)
+	var prev *domain.AuditEntry
+	for i := range chain {
+		assert.True(t, chain[i].Follows(prev), "the stored entries keep their hash")
+		prev = &chain[i]
+	}
+
+	entries, total, err := repo.GetAll(ctx, issuerDID, ports.AuditFilter{Operation: common.ToPointer(domain.AuditOperationUpdate), MaxResults: 10, Page: 1})
+	require.NoError(t, err)
+	assert.Equal(t, uint(1), total)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(2), entries[0].Seq)
+
+	entries, total, err = repo.GetAll(ctx, issuerDID, ports.AuditFilter{MaxResults: 2, Page: 2})
+	require.NoError(t, err)
+	assert.Equal(t, uint(3), total)
+	require.Len(t, entries, 1)
+	assert.Equal(t, int64(1), entries[0].Seq, "most recent entries first")
+
+	head, err := repo.GetHead(ctx, issuerDID)
+	require.NoError(t, err)
+	require.NotNil(t, head)
+	assert.Equal(t, chain[2].Seq, head.Seq)
+	assert.Equal(t, chain[2].Hash, head.Hash)
+
+	head, err = repo.GetHead(ctx, randomDID(t))
+	require.NoError(t, err)
+	assert.Nil(t, head)
+
+	t.Run("should be append only", func(t *testing.T) {
+		_, err := storage.Pgx.Exec(ctx, "UPDATE audit_log SET actor='mallory' WHERE issuer_id=$1", issuerDID.String())
+		assert.Error(t, err)
+		_, err = storage.Pgx.Exec(ctx, "DELETE FROM audit_log WHERE issuer_id=$1", issuerDID.String())
+		assert.Error(t, err)
+	})
+
+	t.Run("should only move the head forward", func(t *testing.T) {
+		_, err := storage.Pgx.Exec(ctx, "UPDATE audit_heads SET seq=1 WHERE issuer_id=$1", issuerDID.String())
+		assert.Error(t, err)
+		_, err = storage.Pgx.Exec(ctx, "DELETE FROM audit_heads WHERE issuer_id=$1", issuerDID.String())
+		assert.Error(t, err)
+	})
+}
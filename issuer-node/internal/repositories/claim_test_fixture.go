@@ -33,7 +33,7 @@ func (f *Fixture) CreateClaim(t *testing.T, claim *domain.Claim) uuid.UUID {
 // CreateSchema creates an entry in schema table
 func (f *Fixture) CreateSchema(t *testing.T, ctx context.Context, s *domain.Schema) {
 	t.Helper()
-	require.NoError(t, f.schemaRepository.Save(ctx, s))
+	require.NoError(t, f.schemaRepository.Save(ctx, f.storage.Pgx, s))
 }
 
 // GetDefaultAuthClaimOfIssuer returns the default auth claim of an issuer just created
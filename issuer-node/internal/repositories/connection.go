@@ -113,13 +113,16 @@ func (c *connection) GetByIDAndIssuerID(ctx context.Context, conn db.Querier, id
 	return toConnectionDomain(&connection)
 }
 
-func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID) (*domain.Connection, error) {
+// GetByUserSessionID returns the connection authenticated in the session. When tenantID is not nil, only the
+// connections of the issuers of the tenant are returned.
+func (c *connection) GetByUserSessionID(ctx context.Context, conn db.Querier, sessionID uuid.UUID, tenantID *uuid.UUID) (*domain.Connection, error) {
 	connection := dbConnection{}
 	err := conn.QueryRow(ctx,
 		`SELECT connections.id, connections.issuer_id,connections.user_id,connections.issuer_doc,connections.user_doc,connections.created_at,connections.modified_at 
 				FROM connections 
 				JOIN user_authentications ON connections.id = user_authentications.connection_id
-				WHERE user_authentications.session_id = $1`, sessionID.String()).Scan(
+				WHERE user_authentications.session_id = $1
+				AND ($2::uuid IS NULL OR connections.issuer_id IN (SELECT identifier FROM identities WHERE tenant_id = $2))`, sessionID.String(), tenantID).Scan(
 		&connection.ID,
 		&connection.IssuerDID,
 		&connection.UserDID,
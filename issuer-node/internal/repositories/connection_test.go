@@ -101,12 +101,24 @@ func TestSaveUserAuthentication(t *testing.T) {
 
 	require.NoError(t, connectionsRepo.SaveUserAuthentication(context.Background(), storage.Pgx, connID, sessionID, time.Now()))
 
-	connDB, err := connectionsRepo.GetByUserSessionID(context.Background(), storage.Pgx, sessionID)
+	connDB, err := connectionsRepo.GetByUserSessionID(context.Background(), storage.Pgx, sessionID, nil)
 	require.NoError(t, err)
 
 	assert.Equal(t, connDB.ID, connID)
 	assert.Equal(t, connDB.IssuerDID.String(), issuerDID.String())
 	assert.Equal(t, connDB.UserDID.String(), userDID.String())
+
+	t.Run("should only find the connections of the issuers of the tenant", func(t *testing.T) {
+		fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String(), TenantID: domain.DefaultTenantID})
+
+		connDB, err := connectionsRepo.GetByUserSessionID(context.Background(), storage.Pgx, sessionID, &domain.DefaultTenantID)
+		require.NoError(t, err)
+		assert.Equal(t, connID, connDB.ID)
+
+		otherTenant := uuid.New()
+		_, err = connectionsRepo.GetByUserSessionID(context.Background(), storage.Pgx, sessionID, &otherTenant)
+		assert.ErrorIs(t, err, ErrConnectionDoesNotExist)
+	})
 }
 
 func TestDelete(t *testing.T) {
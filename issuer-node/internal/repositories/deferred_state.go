@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+type deferredState struct {
+	conn db.Storage
+}
+
+// NewDeferredState returns a new repository of the state transitions held by the publishing scheduler
+func NewDeferredState(conn db.Storage) ports.DeferredStateRepository {
+	return &deferredState{conn: conn}
+}
+
+// Hold records the state transition of the identity as held. Holding it again has no effect.
+func (r *deferredState) Hold(ctx context.Context, issuerDID w3c.DID) error {
+	const insert = `INSERT INTO deferred_state_transitions (issuer_id) VALUES($1) ON CONFLICT (issuer_id) DO NOTHING`
+	if _, err := r.conn.Pgx.Exec(ctx, insert, issuerDID.String()); err != nil {
+		return fmt.Errorf("failed to hold state transition: %w", err)
+	}
+	return nil
+}
+
+// Release removes the held state transition of the identity
+func (r *deferredState) Release(ctx context.Context, issuerDID w3c.DID) error {
+	if _, err := r.conn.Pgx.Exec(ctx, `DELETE FROM deferred_state_transitions WHERE issuer_id=$1`, issuerDID.String()); err != nil {
+		return fmt.Errorf("failed to release state transition: %w", err)
+	}
+	return nil
+}
+
+// GetAll returns the identities whose state transition is held, oldest first
+func (r *deferredState) GetAll(ctx context.Context) ([]w3c.DID, error) {
+	rows, err := r.conn.Pgx.Query(ctx, `SELECT issuer_id FROM deferred_state_transitions ORDER BY created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dids := make([]w3c.DID, 0)
+	for rows.Next() {
+		var issuerID string
+		if err := rows.Scan(&issuerID); err != nil {
+			return nil, err
+		}
+		did, err := w3c.ParseDID(issuerID)
+		if err != nil {
+			return nil, err
+		}
+		dids = append(dids, *did)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return dids, nil
+}
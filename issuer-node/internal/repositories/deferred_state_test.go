@@ -0,0 +1,28 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeferredState(t *testing.T) {
+	ctx := context.Background()
+	repo := NewDeferredState(*storage)
+	issuerDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "BJJ")
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Hold(ctx, issuerDID))
+	require.NoError(t, repo.Hold(ctx, issuerDID), "holding twice has no effect")
+	held, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, held, issuerDID)
+
+	require.NoError(t, repo.Release(ctx, issuerDID))
+	held, err = repo.GetAll(ctx)
+	require.NoError(t, err)
+	assert.NotContains(t, held, issuerDID)
+}
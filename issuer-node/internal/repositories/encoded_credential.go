@@ -0,0 +1,82 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// EncodedCredentialNotFoundErr is the error returned when the credential has not been encoded in the requested format
+var EncodedCredentialNotFoundErr = errors.New("encoded credential not found")
+
+// EncodedCredential represents the encoded credentials repository
+type EncodedCredential struct {
+	conn db.Storage
+}
+
+// NewEncodedCredential creates a new encoded credentials repository
+func NewEncodedCredential(conn db.Storage) ports.EncodedCredentialRepository {
+	return &EncodedCredential{
+		conn,
+	}
+}
+
+// Save stores the credential encoding. The first encoding of a credential in a format is kept.
+func (e *EncodedCredential) Save(ctx context.Context, credential *domain.EncodedCredential) error {
+	sql := `INSERT INTO encoded_credentials (id, identifier, format, credential)
+			VALUES($1, $2, $3, $4) ON CONFLICT (id, identifier, format) DO NOTHING`
+	_, err := e.conn.Pgx.Exec(ctx, sql, credential.ID, credential.Identifier, credential.Format, credential.Credential)
+	if err != nil {
+		return fmt.Errorf("failed to save encoded credential: %w", err)
+	}
+	return nil
+}
+
+// Get returns the credential encoded in the given format
+func (e *EncodedCredential) Get(ctx context.Context, issuerDID w3c.DID, id uuid.UUID, format domain.CredentialFormat) (*domain.EncodedCredential, error) {
+	sql := `SELECT id, identifier, format, credential, created_at
+FROM encoded_credentials
+WHERE id=$1 AND identifier=$2 AND format=$3`
+	credentials, err := e.query(ctx, sql, id, issuerDID.String(), format)
+	if err != nil {
+		return nil, err
+	}
+	if len(credentials) == 0 {
+		return nil, EncodedCredentialNotFoundErr
+	}
+	return &credentials[0], nil
+}
+
+// GetByCredential returns all the encodings of the credential, the oldest first
+func (e *EncodedCredential) GetByCredential(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) ([]domain.EncodedCredential, error) {
+	sql := `SELECT id, identifier, format, credential, created_at
+FROM encoded_credentials
+WHERE id=$1 AND identifier=$2
+ORDER BY created_at`
+	return e.query(ctx, sql, id, issuerDID.String())
+}
+
+func (e *EncodedCredential) query(ctx context.Context, sql string, args ...interface{}) ([]domain.EncodedCredential, error) {
+	rows, err := e.conn.Pgx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credentials := make([]domain.EncodedCredential, 0)
+	for rows.Next() {
+		var credential domain.EncodedCredential
+		if err := rows.Scan(&credential.ID, &credential.Identifier, &credential.Format, &credential.Credential, &credential.CreatedAt); err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+	}
+	return credentials, rows.Err()
+}
@@ -0,0 +1,44 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestEncodedCredential_SaveAndGet(t *testing.T) {
+	ctx := context.Background()
+	encodedCredentialRepository := NewEncodedCredential(*storage)
+	issuerDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "ETH")
+	require.NoError(t, err)
+
+	id := uuid.New()
+	jwt := &domain.EncodedCredential{ID: id, Identifier: issuerDID.String(), Format: domain.CredentialFormatJWT, Credential: "header.payload.signature"}
+	sdJWT := &domain.EncodedCredential{ID: id, Identifier: issuerDID.String(), Format: domain.CredentialFormatSDJWT, Credential: "header.payload.signature~disclosure~"}
+
+	t.Run("should keep the first encoding of every format", func(t *testing.T) {
+		require.NoError(t, encodedCredentialRepository.Save(ctx, jwt))
+		require.NoError(t, encodedCredentialRepository.Save(ctx, sdJWT))
+		require.NoError(t, encodedCredentialRepository.Save(ctx, &domain.EncodedCredential{ID: id, Identifier: issuerDID.String(), Format: domain.CredentialFormatJWT, Credential: "other"}))
+
+		saved, err := encodedCredentialRepository.Get(ctx, issuerDID, id, domain.CredentialFormatJWT)
+		require.NoError(t, err)
+		assert.Equal(t, jwt.Credential, saved.Credential)
+
+		encodings, err := encodedCredentialRepository.GetByCredential(ctx, issuerDID, id)
+		require.NoError(t, err)
+		require.Len(t, encodings, 2)
+		assert.Equal(t, domain.CredentialFormatJWT, encodings[0].Format)
+	})
+
+	t.Run("should not find a credential that was not encoded", func(t *testing.T) {
+		_, err := encodedCredentialRepository.Get(ctx, issuerDID, uuid.New(), domain.CredentialFormatJWT)
+		assert.ErrorIs(t, err, EncodedCredentialNotFoundErr)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/jackc/pgconn"
 
@@ -29,7 +30,11 @@ func NewIdentity() ports.IdentityRepository {
 
 // Save - Create new identity
 func (i *identity) Save(ctx context.Context, conn db.Querier, identity *domain.Identity) error {
-	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier, address, keyType, display_name) VALUES ($1, $2, $3, $4)`, identity.Identifier, identity.Address, identity.KeyType, identity.DisplayName)
+	tenantID := identity.TenantID
+	if tenantID == uuid.Nil {
+		tenantID = domain.DefaultTenantID
+	}
+	_, err := conn.Exec(ctx, `INSERT INTO identities (identifier, address, keyType, display_name, tenant_id) VALUES ($1, $2, $3, $4, $5)`, identity.Identifier, identity.Address, identity.KeyType, identity.DisplayName, tenantID)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
@@ -52,6 +57,7 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 	}
 	row := conn.QueryRow(ctx,
 		`SELECT  identities.identifier,
+						identities.tenant_id,
 						identities.keyType,
 						identities.address,
 						identities.display_name,
@@ -79,6 +85,7 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 				ORDER BY state_id DESC LIMIT 1`, identifier.String())
 
 	err := row.Scan(&identity.Identifier,
+		&identity.TenantID,
 		&identity.KeyType,
 		&identity.Address,
 		&identity.DisplayName,
@@ -104,8 +111,9 @@ func (i *identity) GetByID(ctx context.Context, conn db.Querier, identifier w3c.
 	return &identity, err
 }
 
-func (i *identity) Get(ctx context.Context, conn db.Querier) (identities []domain.IdentityDisplayName, err error) {
-	rows, err := conn.Query(ctx, `SELECT identifier, display_name FROM identities`)
+// Get returns the identities of the tenant, or all the identities of the node when tenantID is nil
+func (i *identity) Get(ctx context.Context, conn db.Querier, tenantID *uuid.UUID) (identities []domain.IdentityDisplayName, err error) {
+	rows, err := conn.Query(ctx, `SELECT identifier, display_name FROM identities WHERE $1::uuid IS NULL OR tenant_id=$1`, tenantID)
 	if err != nil {
 		return nil, err
 	}
@@ -124,6 +132,17 @@ func (i *identity) Get(ctx context.Context, conn db.Querier) (identities []domai
 	return identities, err
 }
 
+// ExistsByNetwork returns true if there are identities on the blockchain network.
+// The identifiers have the format did:method:blockchain:network:id
+func (i *identity) ExistsByNetwork(ctx context.Context, conn db.Querier, blockchain, network string) (bool, error) {
+	var exists bool
+	err := conn.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM identities WHERE split_part(identifier, ':', 3) = $1 AND split_part(identifier, ':', 4) = $2)`, blockchain, network).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
 func (i *identity) GetUnprocessedIssuersIDs(ctx context.Context, conn db.Querier) (issuersIDs []*w3c.DID, err error) {
 	rows, err := conn.Query(ctx,
 		`WITH issuers_to_process AS
@@ -30,9 +30,10 @@ func (isr *identityState) Save(ctx context.Context, conn db.Querier, state domai
 		block_timestamp,
 		block_number,
 		tx_id,
+		relay_tx_id,
 		previous_state,
 		status
-	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) ON CONFLICT DO NOTHING`
+	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT DO NOTHING`
 	_, err := conn.Exec(ctx, query,
 		state.Identifier,
 		state.State,
@@ -42,6 +43,7 @@ func (isr *identityState) Save(ctx context.Context, conn db.Querier, state domai
 		state.BlockTimestamp,
 		state.BlockNumber,
 		state.TxID,
+		state.RelayTxID,
 		state.PreviousState,
 		state.Status,
 	)
@@ -56,7 +58,7 @@ func (isr *identityState) Save(ctx context.Context, conn db.Querier, state domai
 // If 'confirmed' and non-genesis state are not found. Return genesis state.
 func (isr *identityState) GetLatestStateByIdentifier(ctx context.Context, conn db.Querier, identifier *w3c.DID) (*domain.IdentityState, error) {
 	row := conn.QueryRow(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, 
-       revocation_tree_root, block_timestamp, block_number, tx_id, previous_state, status, modified_at, created_at 
+       revocation_tree_root, block_timestamp, block_number, tx_id, relay_tx_id, previous_state, status, modified_at, created_at 
 FROM identity_states
 WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, identifier.String())
 	state := domain.IdentityState{}
@@ -69,6 +71,7 @@ WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, id
 		&state.BlockTimestamp,
 		&state.BlockNumber,
 		&state.TxID,
+		&state.RelayTxID,
 		&state.PreviousState,
 		&state.Status,
 		&state.ModifiedAt,
@@ -82,7 +85,7 @@ WHERE identifier=$1 AND status = 'confirmed' ORDER BY state_id DESC LIMIT 1`, id
 // GetStatesByStatus returns states which are not transacted
 func (isr *identityState) GetStatesByStatus(ctx context.Context, conn db.Querier, status domain.IdentityStatus) ([]domain.IdentityState, error) {
 	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
-       tx_id, previous_state, status, modified_at, created_at 
+       tx_id, relay_tx_id, previous_state, status, modified_at, created_at 
 	FROM identity_states WHERE status = $1 and previous_state IS NOT NULL`, status)
 	if err != nil {
 		return nil, err
@@ -117,8 +120,8 @@ func (isr *identityState) GetStates(ctx context.Context, conn db.Querier, issuer
 
 func (isr *identityState) UpdateState(ctx context.Context, conn db.Querier, state *domain.IdentityState) (int64, error) {
 	tag, err := conn.Exec(ctx, `UPDATE identity_states 
-		SET block_timestamp=$1, block_number=$2, tx_id=$3, status=$4 WHERE state = $5 `,
-		state.BlockTimestamp, state.BlockNumber, state.TxID, state.Status, state.State)
+		SET block_timestamp=$1, block_number=$2, tx_id=$3, relay_tx_id=$4, status=$5 WHERE state = $6 `,
+		state.BlockTimestamp, state.BlockNumber, state.TxID, state.RelayTxID, state.Status, state.State)
 	if err != nil {
 		return 0, err
 	}
@@ -129,7 +132,7 @@ func (isr *identityState) UpdateState(ctx context.Context, conn db.Querier, stat
 // GetStatesByStatusAndIssuerID returns states which are not transacted
 func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn db.Querier, status domain.IdentityStatus, issuerID w3c.DID) ([]domain.IdentityState, error) {
 	rows, err := conn.Query(ctx, `SELECT state_id, identifier, state, root_of_roots, claims_tree_root, revocation_tree_root, block_timestamp, block_number, 
-       tx_id, previous_state, status, modified_at, created_at 
+       tx_id, relay_tx_id, previous_state, status, modified_at, created_at 
 	FROM identity_states WHERE identifier = $1 and status = $2 and previous_state IS NOT NULL
 	ORDER BY created_at DESC
 	`, issuerID.String(), status)
@@ -150,6 +153,7 @@ func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn
 			&state.BlockTimestamp,
 			&state.BlockNumber,
 			&state.TxID,
+			&state.RelayTxID,
 			&state.PreviousState,
 			&state.Status,
 			&state.ModifiedAt,
@@ -168,7 +172,9 @@ func (isr *identityState) GetStatesByStatusAndIssuerID(ctx context.Context, conn
 
 func (isr *identityState) GetGenesisState(ctx context.Context, conn db.Querier, identifier string) (*domain.IdentityState, error) {
 	state := domain.IdentityState{}
-	row := conn.QueryRow(ctx, "SELECT * FROM identity_states WHERE identifier=$1 AND previous_state IS NULL ", identifier)
+	row := conn.QueryRow(ctx, `SELECT state_id, identifier, state, root_of_roots, revocation_tree_root, claims_tree_root,
+       block_timestamp, block_number, tx_id, relay_tx_id, previous_state, status, modified_at, created_at
+FROM identity_states WHERE identifier=$1 AND previous_state IS NULL `, identifier)
 	if err := row.Scan(&state.StateID,
 		&state.Identifier,
 		&state.State,
@@ -178,6 +184,7 @@ func (isr *identityState) GetGenesisState(ctx context.Context, conn db.Querier,
 		&state.BlockTimestamp,
 		&state.BlockNumber,
 		&state.TxID,
+		&state.RelayTxID,
 		&state.PreviousState,
 		&state.Status,
 		&state.ModifiedAt,
@@ -199,6 +206,7 @@ func buildGetStatesQuery(filter *ports.GetStateTransactionsRequest) (string, str
 		"block_timestamp",
 		"block_number",
 		"tx_id",
+		"relay_tx_id",
 		"previous_state",
 		"status",
 		"modified_at",
@@ -234,6 +242,7 @@ func toIdentityStatesDomain(rows pgx.Rows) ([]domain.IdentityState, error) {
 			&state.BlockTimestamp,
 			&state.BlockNumber,
 			&state.TxID,
+			&state.RelayTxID,
 			&state.PreviousState,
 			&state.Status,
 			&state.ModifiedAt,
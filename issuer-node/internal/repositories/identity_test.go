@@ -26,8 +26,26 @@ func TestGetIdentities(t *testing.T) {
 
 	identityRepo := NewIdentity()
 	t.Run("should get identities", func(t *testing.T) {
-		identities, err := identityRepo.Get(context.Background(), storage.Pgx)
+		identities, err := identityRepo.Get(context.Background(), storage.Pgx, nil)
 		assert.NoError(t, err)
 		assert.True(t, len(identities) >= 2)
 	})
 }
+
+func TestExistsByNetwork(t *testing.T) {
+	fixture := NewFixture(storage)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: "did:polygonid:polygon:amoy:2qX8amKpq3ZxGrNDLJAwxnw9PJzD7u45dXV5E3brFV"})
+
+	identityRepo := NewIdentity()
+	t.Run("should find identities on the network", func(t *testing.T) {
+		exists, err := identityRepo.ExistsByNetwork(context.Background(), storage.Pgx, "polygon", "amoy")
+		assert.NoError(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("should not find identities on an unused network", func(t *testing.T) {
+		exists, err := identityRepo.ExistsByNetwork(context.Background(), storage.Pgx, "polygon", "unused")
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
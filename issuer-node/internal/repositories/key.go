@@ -70,9 +70,12 @@ func (k *key) GetByPublicKey(ctx context.Context, issuerDID w3c.DID, publicKey s
 }
 
 // Delete deletes a key by its public key
-func (k *key) Delete(ctx context.Context, issuerDID w3c.DID, publicKey string) error {
+func (k *key) Delete(ctx context.Context, conn db.Querier, issuerDID w3c.DID, publicKey string) error {
+	if conn == nil {
+		conn = k.conn.Pgx
+	}
 	sql := `DELETE FROM keys WHERE issuer_did=$1 AND public_key=$2`
-	_, err := k.conn.Pgx.Exec(ctx, sql, issuerDID.String(), publicKey)
+	_, err := conn.Exec(ctx, sql, issuerDID.String(), publicKey)
 	return err
 }
 
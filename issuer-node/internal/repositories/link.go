@@ -48,12 +48,12 @@ func (l link) Save(ctx context.Context, conn db.Querier, link *domain.Link) (*uu
 	}
 
 	var id uuid.UUID
-	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method)
-			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) ON CONFLICT (id) DO
+	sql := `INSERT INTO links (id, issuer_id, max_issuance, valid_until, schema_id, credential_expiration, credential_signature_proof, credential_mtp_proof, credential_attributes, active, refresh_service, display_method, credential_format, credential_status_type)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14) ON CONFLICT (id) DO
 			UPDATE SET issuer_id=$2, max_issuance=$3, valid_until=$4, schema_id=$5, credential_expiration=$6, credential_signature_proof=$7, credential_mtp_proof=$8, credential_attributes=$9, active=$10 
 			RETURNING id`
 	err := conn.QueryRow(ctx, sql, link.ID, link.IssuerCoreDID().String(), link.MaxIssuance, link.ValidUntil, link.SchemaID, link.CredentialExpiration, link.CredentialSignatureProof,
-		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod).Scan(&id)
+		link.CredentialMTPProof, pgAttrs, link.Active, link.RefreshService, link.DisplayMethod, link.CredentialFormat, link.CredentialStatusType).Scan(&id)
 
 	if err != nil && strings.Contains(err.Error(), `table "links" violates foreign key constraint "links_schemas_id_key"`) {
 		return nil, errorShemaNotFound
@@ -76,6 +76,8 @@ SELECT links.id,
        links.active,
 	   links.refresh_service,
 	   links.display_method,
+	   links.credential_format,
+	   links.credential_status_type,
        count(claims.id) as issued_claims,
        links.authorization_request_message,
        schemas.id as schema_id,
@@ -108,6 +110,8 @@ GROUP BY links.id, schemas.id
 		&link.Active,
 		&link.RefreshService,
 		&link.DisplayMethod,
+		&link.CredentialFormat,
+		&link.CredentialStatusType,
 		&link.IssuedClaims,
 		&link.AuthorizationRequestMessage,
 		&s.ID,
@@ -152,6 +156,8 @@ SELECT links.id,
        links.active,
 	   links.refresh_service,
 	   links.display_method,
+	   links.credential_format,
+	   links.credential_status_type,
 	   links.authorization_request_message,
        count(claims.id) as issued_claims,
        schemas.id as schema_id,
@@ -218,6 +224,8 @@ WHERE links.issuer_id = $1
 			&link.Active,
 			&link.RefreshService,
 			&link.DisplayMethod,
+			&link.CredentialFormat,
+			&link.CredentialStatusType,
 			&link.AuthorizationRequestMessage,
 			&link.IssuedClaims,
 			&schema.ID,
@@ -247,31 +255,23 @@ WHERE links.issuer_id = $1
 	return links, nil
 }
 
-func (l link) Delete(ctx context.Context, id uuid.UUID, issuerDID w3c.DID) error {
-	tx, err := l.conn.Pgx.BeginTx(ctx, pgx.TxOptions{})
-	if err != nil {
-		return err
-	}
-	const updateClaimsSql = `UPDATE claims SET link_id = NULL WHERE link_id = $1 AND identifier = $2`
-	_, err = tx.Exec(ctx, updateClaimsSql, id.String(), issuerDID.String())
-	if err != nil {
-		return err
-	}
-	const sql = `DELETE FROM links WHERE id = $1 AND issuer_id =$2`
-	cmd, err := tx.Exec(ctx, sql, id.String(), issuerDID.String())
-	if err != nil {
-		if err := tx.Rollback(ctx); err != nil {
+func (l link) Delete(ctx context.Context, conn db.Querier, id uuid.UUID, issuerDID w3c.DID) error {
+	return conn.BeginFunc(ctx, func(tx pgx.Tx) error {
+		const updateClaimsSql = `UPDATE claims SET link_id = NULL WHERE link_id = $1 AND identifier = $2`
+		_, err := tx.Exec(ctx, updateClaimsSql, id.String(), issuerDID.String())
+		if err != nil {
 			return err
 		}
-		return err
-	}
-	if err := tx.Commit(ctx); err != nil {
-		return err
-	}
-	if cmd.RowsAffected() == 0 {
-		return ErrLinkDoesNotExist
-	}
-	return nil
+		const sql = `DELETE FROM links WHERE id = $1 AND issuer_id =$2`
+		cmd, err := tx.Exec(ctx, sql, id.String(), issuerDID.String())
+		if err != nil {
+			return err
+		}
+		if cmd.RowsAffected() == 0 {
+			return ErrLinkDoesNotExist
+		}
+		return nil
+	})
 }
 
 func (l link) AddAuthorizationRequest(ctx context.Context, linkID uuid.UUID, issuerDID w3c.DID, authorizationRequest *protocol.AuthorizationRequestMessage) error {
@@ -119,7 +119,7 @@ func insertSchemaForLink(ctx context.Context, didStr string, store ports.SchemaR
 		Words:     data.attributes,
 		CreatedAt: time.Now(),
 	}
-	require.NoError(t, store.Save(ctx, s))
+	require.NoError(t, store.Save(ctx, storage.Pgx, s))
 	return s.ID
 }
 
@@ -386,10 +386,10 @@ func TestDeleteLink(t *testing.T) {
 		LinkID:          linkID,
 	})
 
-	err = linkStore.Delete(ctx, *linkID, *did2)
+	err = linkStore.Delete(ctx, storage.Pgx, *linkID, *did2)
 	assert.Error(t, err)
 
-	err = linkStore.Delete(ctx, *linkID, *did)
+	err = linkStore.Delete(ctx, storage.Pgx, *linkID, *did)
 	assert.NoError(t, err)
 
 	claimStorage := NewClaim()
@@ -397,7 +397,7 @@ func TestDeleteLink(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, claim)
 
-	err = linkStore.Delete(ctx, uuid.New(), *did)
+	err = linkStore.Delete(ctx, storage.Pgx, uuid.New(), *did)
 	assert.Error(t, err)
 	assert.Equal(t, ErrLinkDoesNotExist, err)
 }
@@ -0,0 +1,162 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// MessageNotQueuedErr is returned when the delivery of a message that is no longer queued is updated
+var MessageNotQueuedErr = errors.New("message not queued")
+
+const messageFields = `id, issuer_did, user_did, direction, message_id, thread_id, type, message, status, attempts,
+       next_attempt_at, last_error, created_at, modified_at`
+
+// Message represents the iden3comm messages repository
+type Message struct {
+	conn db.Storage
+}
+
+// NewMessage creates a new iden3comm messages repository
+func NewMessage(conn db.Storage) ports.MessageRepository {
+	return &Message{
+		conn,
+	}
+}
+
+// Save stores a new message
+func (m *Message) Save(ctx context.Context, message *domain.Message) error {
+	sql := `INSERT INTO messages (id, issuer_did, user_did, direction, message_id, thread_id, type, message, status, attempts, next_attempt_at, last_error)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`
+	_, err := m.conn.Pgx.Exec(ctx, sql,
+		message.ID,
+		message.IssuerDID,
+		message.UserDID,
+		message.Direction,
+		message.MessageID,
+		message.ThreadID,
+		message.Type,
+		message.Message,
+		message.Status,
+		message.Attempts,
+		message.NextAttemptAt,
+		message.LastError,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save message: %w", err)
+	}
+	return nil
+}
+
+// UpdateDelivery stores the result of a delivery attempt of a queued message.
+// It returns MessageNotQueuedErr when the message is no longer queued, e.g. it was acknowledged by the user meanwhile.
+func (m *Message) UpdateDelivery(ctx context.Context, message *domain.Message) error {
+	sql := `UPDATE messages SET status=$2, attempts=$3, next_attempt_at=$4, last_error=$5, modified_at=NOW()
+WHERE id=$1 AND status=$6`
+	tag, err := m.conn.Pgx.Exec(ctx, sql,
+		message.ID,
+		message.Status,
+		message.Attempts,
+		message.NextAttemptAt,
+		message.LastError,
+		domain.MessageStatusQueued,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update message delivery: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return MessageNotQueuedErr
+	}
+	return nil
+}
+
+// LeaseDue returns up to limit queued messages whose next attempt is due and moves their next attempt to leaseUntil,
+// so concurrent workers don't deliver the same message twice.
+func (m *Message) LeaseDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]domain.Message, error) {
+	sql := `UPDATE messages SET next_attempt_at=$3, modified_at=NOW()
+WHERE id IN (
+    SELECT id FROM messages
+    WHERE status = $1 AND next_attempt_at <= $2
+    ORDER BY next_attempt_at
+    LIMIT $4
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + messageFields
+	rows, err := m.conn.Pgx.Query(ctx, sql, domain.MessageStatusQueued, now, leaseUntil, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanMessages(rows)
+}
+
+// AcknowledgeThread marks the queued and sent messages of the thread from the issuer to the user as acknowledged.
+// It returns the number of updated rows.
+func (m *Message) AcknowledgeThread(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID, threadID string) (int64, error) {
+	sql := `UPDATE messages SET status=$5, next_attempt_at=NULL, modified_at=NOW()
+WHERE issuer_did=$1 AND user_did=$2 AND thread_id=$3 AND direction=$4 AND status IN ($6, $7)`
+	tag, err := m.conn.Pgx.Exec(ctx, sql,
+		issuerDID.String(),
+		userDID.String(),
+		threadID,
+		domain.MessageDirectionOutbound,
+		domain.MessageStatusAcknowledged,
+		domain.MessageStatusQueued,
+		domain.MessageStatusSent,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to acknowledge thread: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetByUser returns the messages exchanged between the issuer and the user, oldest first
+func (m *Message) GetByUser(ctx context.Context, issuerDID w3c.DID, userDID w3c.DID) ([]domain.Message, error) {
+	sql := `SELECT ` + messageFields + `
+FROM messages
+WHERE issuer_did=$1 AND user_did=$2
+ORDER BY created_at, id`
+	rows, err := m.conn.Pgx.Query(ctx, sql, issuerDID.String(), userDID.String())
+	if err != nil {
+		return nil, err
+	}
+	return scanMessages(rows)
+}
+
+func scanMessages(rows pgx.Rows) ([]domain.Message, error) {
+	defer rows.Close()
+	messages := make([]domain.Message, 0)
+	for rows.Next() {
+		var message domain.Message
+		if err := rows.Scan(
+			&message.ID,
+			&message.IssuerDID,
+			&message.UserDID,
+			&message.Direction,
+			&message.MessageID,
+			&message.ThreadID,
+			&message.Type,
+			&message.Message,
+			&message.Status,
+			&message.Attempts,
+			&message.NextAttemptAt,
+			&message.LastError,
+			&message.CreatedAt,
+			&message.ModifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return messages, nil
+}
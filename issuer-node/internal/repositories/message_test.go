@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestMessage_DeliveryAndThreads(t *testing.T) {
+	ctx := context.Background()
+	messageRepository := NewMessage(*storage)
+	issuerDID := randomDID(t)
+	userDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "BJJ")
+	require.NoError(t, err)
+
+	offer := domain.NewOutboundMessage(issuerDID.String(), userDID.String(), "1", "thread-1", "offer", json.RawMessage(`{"id":"1"}`))
+	offer.NextAttemptAt = common.ToPointer(time.Now().Add(-time.Minute))
+	require.NoError(t, messageRepository.Save(ctx, offer))
+
+	t.Run("should lease due messages only once", func(t *testing.T) {
+		now := time.Now()
+		leased, err := messageRepository.LeaseDue(ctx, now, now.Add(time.Minute), 1000)
+		require.NoError(t, err)
+		var found bool
+		for _, message := range leased {
+			if message.ID == offer.ID {
+				found = true
+				assert.JSONEq(t, `{"id":"1"}`, string(message.Message))
+			}
+		}
+		assert.True(t, found)
+
+		leased, err = messageRepository.LeaseDue(ctx, now, now.Add(time.Minute), 1000)
+		require.NoError(t, err)
+		for _, message := range leased {
+			assert.NotEqual(t, offer.ID, message.ID)
+		}
+	})
+
+	t.Run("should update the delivery status", func(t *testing.T) {
+		offer.Status = domain.MessageStatusSent
+		offer.Attempts = 1
+		offer.NextAttemptAt = nil
+		require.NoError(t, messageRepository.UpdateDelivery(ctx, offer))
+		assert.ErrorIs(t, messageRepository.UpdateDelivery(ctx, offer), MessageNotQueuedErr)
+	})
+
+	t.Run("should acknowledge the thread", func(t *testing.T) {
+		updated, err := messageRepository.AcknowledgeThread(ctx, issuerDID, userDID, "thread-1")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), updated)
+
+		updated, err = messageRepository.AcknowledgeThread(ctx, issuerDID, userDID, "thread-2")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), updated)
+	})
+
+	t.Run("should get the messages of the user", func(t *testing.T) {
+		reply := domain.NewInboundMessage(issuerDID.String(), userDID.String(), "2", "thread-1", "fetch", json.RawMessage(`{"id":"2"}`))
+		require.NoError(t, messageRepository.Save(ctx, reply))
+
+		messages, err := messageRepository.GetByUser(ctx, issuerDID, userDID)
+		require.NoError(t, err)
+		require.Len(t, messages, 2)
+		assert.Equal(t, domain.MessageStatusAcknowledged, messages[0].Status)
+		assert.Equal(t, 1, messages[0].Attempts)
+		assert.Equal(t, domain.MessageDirectionInbound, messages[1].Direction)
+		assert.Equal(t, domain.MessageStatusReceived, messages[1].Status)
+	})
+}
@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// OID4VCIOfferNotFoundErr is the error returned when the OpenID4VCI offer is not found
+	OID4VCIOfferNotFoundErr = errors.New("oid4vci offer not found")
+	// OID4VCIOfferAlreadyRedeemedErr is the error returned when the pre-authorized code of the offer was already used
+	OID4VCIOfferAlreadyRedeemedErr = errors.New("oid4vci offer already redeemed")
+)
+
+const oid4vciOfferFields = `id, issuer_did, link_id, pre_authorized_code_hash, code_expires_at, access_token_hash, token_expires_at,
+       c_nonce, holder_did, credential_id, created_at, modified_at`
+
+// OID4VCIOffer represents the OpenID4VCI offers repository
+type OID4VCIOffer struct {
+	conn db.Storage
+}
+
+// NewOID4VCIOffer creates a new OpenID4VCI offers repository
+func NewOID4VCIOffer(conn db.Storage) ports.OID4VCIOfferRepository {
+	return &OID4VCIOffer{
+		conn,
+	}
+}
+
+// Save stores the given offer. If it already exists, its nonce, holder and credential are updated.
+func (o *OID4VCIOffer) Save(ctx context.Context, offer *domain.OID4VCIOffer) error {
+	sql := `INSERT INTO oid4vci_offers (id, issuer_did, link_id, pre_authorized_code_hash, code_expires_at, c_nonce, holder_did, credential_id)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8) ON CONFLICT (id) DO
+			UPDATE SET c_nonce=$6, holder_did=$7, credential_id=$8, modified_at=NOW()`
+	_, err := o.conn.Pgx.Exec(ctx, sql,
+		offer.ID,
+		offer.IssuerDID,
+		offer.LinkID,
+		offer.PreAuthorizedCodeHash,
+		offer.CodeExpiresAt,
+		offer.CNonce,
+		offer.HolderDID,
+		offer.CredentialID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save oid4vci offer: %w", err)
+	}
+	return nil
+}
+
+// Redeem stores the access token issued for the pre-authorized code of the offer.
+// A code can only be redeemed once, so concurrent requests with the same code get OID4VCIOfferAlreadyRedeemedErr.
+func (o *OID4VCIOffer) Redeem(ctx context.Context, offer *domain.OID4VCIOffer) error {
+	sql := `UPDATE oid4vci_offers SET access_token_hash=$2, token_expires_at=$3, c_nonce=$4, modified_at=NOW()
+			WHERE id=$1 AND access_token_hash IS NULL`
+	res, err := o.conn.Pgx.Exec(ctx, sql, offer.ID, offer.AccessTokenHash, offer.TokenExpiresAt, offer.CNonce)
+	if err != nil {
+		return fmt.Errorf("failed to redeem oid4vci offer: %w", err)
+	}
+	if res.RowsAffected() == 0 {
+		return OID4VCIOfferAlreadyRedeemedErr
+	}
+	return nil
+}
+
+// GetByPreAuthorizedCode returns the offer of the issuer with the given pre-authorized code hash
+func (o *OID4VCIOffer) GetByPreAuthorizedCode(ctx context.Context, issuerDID w3c.DID, codeHash string) (*domain.OID4VCIOffer, error) {
+	sql := `SELECT ` + oid4vciOfferFields + `
+FROM oid4vci_offers
+WHERE issuer_did=$1 AND pre_authorized_code_hash=$2`
+	return o.get(ctx, sql, issuerDID.String(), codeHash)
+}
+
+// GetByAccessToken returns the offer of the issuer with the given access token hash
+func (o *OID4VCIOffer) GetByAccessToken(ctx context.Context, issuerDID w3c.DID, tokenHash string) (*domain.OID4VCIOffer, error) {
+	sql := `SELECT ` + oid4vciOfferFields + `
+FROM oid4vci_offers
+WHERE issuer_did=$1 AND access_token_hash=$2`
+	return o.get(ctx, sql, issuerDID.String(), tokenHash)
+}
+
+func (o *OID4VCIOffer) get(ctx context.Context, sql string, args ...interface{}) (*domain.OID4VCIOffer, error) {
+	var offer domain.OID4VCIOffer
+	err := o.conn.Pgx.QueryRow(ctx, sql, args...).Scan(
+		&offer.ID,
+		&offer.IssuerDID,
+		&offer.LinkID,
+		&offer.PreAuthorizedCodeHash,
+		&offer.CodeExpiresAt,
+		&offer.AccessTokenHash,
+		&offer.TokenExpiresAt,
+		&offer.CNonce,
+		&offer.HolderDID,
+		&offer.CredentialID,
+		&offer.CreatedAt,
+		&offer.ModifiedAt,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, OID4VCIOfferNotFoundErr
+		}
+		return nil, err
+	}
+	return &offer, nil
+}
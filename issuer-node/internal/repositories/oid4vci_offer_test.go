@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestOID4VCIOffer_SaveAndRedeem(t *testing.T) {
+	ctx := context.Background()
+	offerRepository := NewOID4VCIOffer(*storage)
+	issuerDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "ETH")
+	require.NoError(t, err)
+	schemaID := insertSchemaForLink(ctx, issuerDID.String(), NewSchema(*storage), t)
+	link := domain.NewLink(issuerDID, common.ToPointer(10), nil, schemaID, nil, true, false, domain.CredentialSubject{"birthday": 19790911, "documentType": 1}, nil, nil)
+	_, err = NewLink(*storage).Save(ctx, storage.Pgx, link)
+	require.NoError(t, err)
+
+	offer := &domain.OID4VCIOffer{
+		ID:                    uuid.New(),
+		IssuerDID:             issuerDID.String(),
+		LinkID:                link.ID,
+		PreAuthorizedCodeHash: uuid.NewString(),
+		CodeExpiresAt:         time.Now().Add(time.Hour),
+	}
+
+	t.Run("should save an offer that is not redeemed", func(t *testing.T) {
+		require.NoError(t, offerRepository.Save(ctx, offer))
+		saved, err := offerRepository.GetByPreAuthorizedCode(ctx, issuerDID, offer.PreAuthorizedCodeHash)
+		require.NoError(t, err)
+		assert.Equal(t, link.ID, saved.LinkID)
+		assert.False(t, saved.Redeemed())
+	})
+
+	t.Run("should redeem the pre-authorized code only once", func(t *testing.T) {
+		offer.AccessTokenHash = common.ToPointer(uuid.NewString())
+		offer.TokenExpiresAt = common.ToPointer(time.Now().Add(time.Minute))
+		offer.CNonce = common.ToPointer("nonce")
+		require.NoError(t, offerRepository.Redeem(ctx, offer))
+		assert.ErrorIs(t, offerRepository.Redeem(ctx, offer), OID4VCIOfferAlreadyRedeemedErr)
+
+		saved, err := offerRepository.GetByAccessToken(ctx, issuerDID, *offer.AccessTokenHash)
+		require.NoError(t, err)
+		assert.True(t, saved.Redeemed())
+		assert.Equal(t, "nonce", *saved.CNonce)
+	})
+
+	t.Run("should store the holder and the issued credential", func(t *testing.T) {
+		holderDID := randomDID(t)
+		offer.HolderDID = common.ToPointer(holderDID.String())
+		offer.CredentialID = common.ToPointer(uuid.New())
+		require.NoError(t, offerRepository.Save(ctx, offer))
+
+		saved, err := offerRepository.GetByAccessToken(ctx, issuerDID, *offer.AccessTokenHash)
+		require.NoError(t, err)
+		assert.Equal(t, offer.HolderDID, saved.HolderDID)
+		assert.Equal(t, offer.CredentialID, saved.CredentialID)
+	})
+
+	t.Run("should not find an unknown offer", func(t *testing.T) {
+		_, err := offerRepository.GetByAccessToken(ctx, issuerDID, uuid.NewString())
+		assert.ErrorIs(t, err, OID4VCIOfferNotFoundErr)
+	})
+}
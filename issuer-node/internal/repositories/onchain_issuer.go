@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// OnchainIssuerNotFoundErr is the error returned when the onchain issuer is not found
+	OnchainIssuerNotFoundErr = errors.New("onchain issuer not found")
+	// OnchainCredentialNotFoundErr is the error returned when the onchain credential is not found
+	OnchainCredentialNotFoundErr = errors.New("onchain credential not found")
+	// ErrOnchainIssuerDuplicated is the error returned when the identity contract is already registered
+	ErrOnchainIssuerDuplicated = errors.New("onchain issuer already registered")
+)
+
+const onchainIssuerFields = `id, identifier, controller_did, contract_address, chain_id, blockchain, network, created_at`
+
+// OnchainIssuer represents the onchain issuers repository
+type OnchainIssuer struct {
+	conn db.Storage
+}
+
+// NewOnchainIssuer creates a new onchain issuers repository
+func NewOnchainIssuer(conn db.Storage) ports.OnchainIssuerRepository {
+	return &OnchainIssuer{
+		conn,
+	}
+}
+
+// Save stores a new onchain issuer
+func (o *OnchainIssuer) Save(ctx context.Context, onchainIssuer *domain.OnchainIssuer) error {
+	sql := `INSERT INTO onchain_issuers (id, identifier, controller_did, contract_address, chain_id, blockchain, network)
+			VALUES($1, $2, $3, $4, $5, $6, $7)`
+	_, err := o.conn.Pgx.Exec(ctx, sql,
+		onchainIssuer.ID,
+		onchainIssuer.Identifier,
+		onchainIssuer.ControllerDID,
+		onchainIssuer.ContractAddress,
+		onchainIssuer.ChainID,
+		onchainIssuer.Blockchain,
+		onchainIssuer.Network,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "onchain_issuers_identifier_index") {
+			return ErrOnchainIssuerDuplicated
+		}
+		return fmt.Errorf("failed to save onchain issuer: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the onchain issuer with the given id
+func (o *OnchainIssuer) GetByID(ctx context.Context, id uuid.UUID) (*domain.OnchainIssuer, error) {
+	sql := `SELECT ` + onchainIssuerFields + `
+FROM onchain_issuers
+WHERE id=$1`
+	onchainIssuers, err := o.query(ctx, sql, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(onchainIssuers) == 0 {
+		return nil, OnchainIssuerNotFoundErr
+	}
+	return &onchainIssuers[0], nil
+}
+
+// GetByIdentifier returns the onchain issuer with the given did
+func (o *OnchainIssuer) GetByIdentifier(ctx context.Context, identifier w3c.DID) (*domain.OnchainIssuer, error) {
+	sql := `SELECT ` + onchainIssuerFields + `
+FROM onchain_issuers
+WHERE identifier=$1`
+	onchainIssuers, err := o.query(ctx, sql, identifier.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(onchainIssuers) == 0 {
+		return nil, OnchainIssuerNotFoundErr
+	}
+	return &onchainIssuers[0], nil
+}
+
+// GetByController returns the onchain issuers owned by the ethereum key of the given identity
+func (o *OnchainIssuer) GetByController(ctx context.Context, controllerDID w3c.DID) ([]domain.OnchainIssuer, error) {
+	sql := `SELECT ` + onchainIssuerFields + `
+FROM onchain_issuers
+WHERE controller_did=$1
+ORDER BY created_at`
+	return o.query(ctx, sql, controllerDID.String())
+}
+
+// SaveCredential stores the given onchain credential. If it already exists, its transaction and status are updated.
+func (o *OnchainIssuer) SaveCredential(ctx context.Context, conn db.Querier, credential *domain.OnchainCredential) error {
+	sql := `INSERT INTO onchain_credentials (id, onchain_issuer_id, user_did, tx_id, status)
+			VALUES($1, $2, $3, $4, $5) ON CONFLICT (id) DO
+			UPDATE SET tx_id=$4, status=$5, modified_at=NOW()`
+	_, err := conn.Exec(ctx, sql,
+		credential.ID,
+		credential.OnchainIssuerID,
+		credential.UserDID,
+		credential.TxID,
+		credential.Status,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save onchain credential: %w", err)
+	}
+	return nil
+}
+
+// GetCredential returns the onchain credential with the given id
+func (o *OnchainIssuer) GetCredential(ctx context.Context, id uuid.UUID) (*domain.OnchainCredential, error) {
+	sql := `SELECT id, onchain_issuer_id, user_did, tx_id, status, created_at, modified_at
+FROM onchain_credentials
+WHERE id=$1`
+	var credential domain.OnchainCredential
+	err := o.conn.Pgx.QueryRow(ctx, sql, id).Scan(
+		&credential.ID,
+		&credential.OnchainIssuerID,
+		&credential.UserDID,
+		&credential.TxID,
+		&credential.Status,
+		&credential.CreatedAt,
+		&credential.ModifiedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, OnchainCredentialNotFoundErr
+		}
+		return nil, err
+	}
+	return &credential, nil
+}
+
+// GetPendingCredentials returns up to limit onchain credentials whose transaction has not been mined yet, oldest first
+func (o *OnchainIssuer) GetPendingCredentials(ctx context.Context, limit int) ([]domain.OnchainCredential, error) {
+	sql := `SELECT id, onchain_issuer_id, user_did, tx_id, status, created_at, modified_at
+FROM onchain_credentials
+WHERE status=$1
+ORDER BY created_at
+LIMIT $2`
+	rows, err := o.conn.Pgx.Query(ctx, sql, domain.OnchainCredentialStatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	credentials := make([]domain.OnchainCredential, 0)
+	for rows.Next() {
+		var credential domain.OnchainCredential
+		if err := rows.Scan(
+			&credential.ID,
+			&credential.OnchainIssuerID,
+			&credential.UserDID,
+			&credential.TxID,
+			&credential.Status,
+			&credential.CreatedAt,
+			&credential.ModifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return credentials, nil
+}
+
+func (o *OnchainIssuer) query(ctx context.Context, sql string, args ...any) ([]domain.OnchainIssuer, error) {
+	rows, err := o.conn.Pgx.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return scanOnchainIssuers(rows)
+}
+
+func scanOnchainIssuers(rows pgx.Rows) ([]domain.OnchainIssuer, error) {
+	onchainIssuers := make([]domain.OnchainIssuer, 0)
+	for rows.Next() {
+		var onchainIssuer domain.OnchainIssuer
+		if err := rows.Scan(
+			&onchainIssuer.ID,
+			&onchainIssuer.Identifier,
+			&onchainIssuer.ControllerDID,
+			&onchainIssuer.ContractAddress,
+			&onchainIssuer.ChainID,
+			&onchainIssuer.Blockchain,
+			&onchainIssuer.Network,
+			&onchainIssuer.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		onchainIssuers = append(onchainIssuers, onchainIssuer)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return onchainIssuers, nil
+}
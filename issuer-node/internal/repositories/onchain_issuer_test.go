@@ -0,0 +1,92 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestOnchainIssuer_SaveAndGet(t *testing.T) {
+	ctx := context.Background()
+	onchainIssuerRepository := NewOnchainIssuer(*storage)
+	controllerDID := randomDID(t)
+	onchainDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", controllerDID.String(), "ETH")
+	require.NoError(t, err)
+
+	onchainIssuer := &domain.OnchainIssuer{
+		ID:              uuid.New(),
+		Identifier:      onchainDID.String(),
+		ControllerDID:   controllerDID.String(),
+		ContractAddress: "0x134B1BE34911E39A8397ec6289782989729807a4",
+		ChainID:         80002,
+		Blockchain:      "polygon",
+		Network:         "amoy",
+	}
+
+	t.Run("should save an onchain issuer once", func(t *testing.T) {
+		require.NoError(t, onchainIssuerRepository.Save(ctx, onchainIssuer))
+		saved, err := onchainIssuerRepository.GetByIdentifier(ctx, onchainDID)
+		require.NoError(t, err)
+		assert.Equal(t, onchainIssuer.ContractAddress, saved.ContractAddress)
+		assert.Equal(t, "polygon:amoy", saved.ResolverPrefix())
+
+		byID, err := onchainIssuerRepository.GetByID(ctx, onchainIssuer.ID)
+		require.NoError(t, err)
+		assert.Equal(t, onchainDID.String(), byID.Identifier)
+
+		duplicated := *onchainIssuer
+		duplicated.ID = uuid.New()
+		assert.ErrorIs(t, onchainIssuerRepository.Save(ctx, &duplicated), ErrOnchainIssuerDuplicated)
+
+		onchainIssuers, err := onchainIssuerRepository.GetByController(ctx, controllerDID)
+		require.NoError(t, err)
+		assert.Len(t, onchainIssuers, 1)
+	})
+
+	t.Run("should not find an unknown onchain issuer", func(t *testing.T) {
+		_, err := onchainIssuerRepository.GetByIdentifier(ctx, randomDID(t))
+		assert.ErrorIs(t, err, OnchainIssuerNotFoundErr)
+	})
+
+	t.Run("should return the pending credentials only", func(t *testing.T) {
+		userDID := randomDID(t)
+		credential := &domain.OnchainCredential{
+			ID:              uuid.New(),
+			OnchainIssuerID: onchainIssuer.ID,
+			UserDID:         userDID.String(),
+			TxID:            "0x01",
+			Status:          domain.OnchainCredentialStatusPending,
+		}
+		require.NoError(t, onchainIssuerRepository.SaveCredential(ctx, storage.Pgx, credential))
+		pending, err := onchainIssuerRepository.GetPendingCredentials(ctx, 1000)
+		require.NoError(t, err)
+		assert.True(t, containsOnchainCredential(pending, credential.ID))
+
+		credential.Status = domain.OnchainCredentialStatusPublished
+		require.NoError(t, onchainIssuerRepository.SaveCredential(ctx, storage.Pgx, credential))
+		pending, err = onchainIssuerRepository.GetPendingCredentials(ctx, 1000)
+		require.NoError(t, err)
+		assert.False(t, containsOnchainCredential(pending, credential.ID))
+
+		got, err := onchainIssuerRepository.GetCredential(ctx, credential.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.OnchainCredentialStatusPublished, got.Status)
+		_, err = onchainIssuerRepository.GetCredential(ctx, uuid.New())
+		assert.ErrorIs(t, err, OnchainCredentialNotFoundErr)
+	})
+}
+
+func containsOnchainCredential(credentials []domain.OnchainCredential, id uuid.UUID) bool {
+	for _, credential := range credentials {
+		if credential.ID == id {
+			return true
+		}
+	}
+	return false
+}
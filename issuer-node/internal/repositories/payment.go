@@ -390,7 +390,7 @@ func (p *payment) UpdatePaymentRequestStatus(ctx context.Context, issuerDID w3c.
 }
 
 // SavePaymentOption saves a payment option
-func (p *payment) SavePaymentOption(ctx context.Context, opt *domain.PaymentOption) (uuid.UUID, error) {
+func (p *payment) SavePaymentOption(ctx context.Context, conn db.Querier, opt *domain.PaymentOption) (uuid.UUID, error) {
 	const query = `
 		INSERT INTO payment_options (id, issuer_did, name, description, configuration, created_at, updated_at)
 		VALUES ($1, $2, $3, $4, $5, $6, $7)
@@ -398,7 +398,7 @@ func (p *payment) SavePaymentOption(ctx context.Context, opt *domain.PaymentOpti
 		RETURNING id;
 		`
 
-	_, err := p.conn.Pgx.Exec(ctx, query, opt.ID, opt.IssuerDID.String(), opt.Name, opt.Description, opt.Config, opt.CreatedAt, opt.UpdatedAt)
+	_, err := conn.Exec(ctx, query, opt.ID, opt.IssuerDID.String(), opt.Name, opt.Description, opt.Config, opt.CreatedAt, opt.UpdatedAt)
 	if err != nil {
 		if strings.Contains(err.Error(), "violates foreign key constraint") {
 			return uuid.Nil, ErrIdentityNotFound
@@ -476,10 +476,10 @@ WHERE id = $1
 }
 
 // DeletePaymentOption deletes a payment option
-func (p *payment) DeletePaymentOption(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) error {
+func (p *payment) DeletePaymentOption(ctx context.Context, conn db.Querier, issuerDID w3c.DID, id uuid.UUID) error {
 	const query = `DELETE FROM payment_options WHERE id = $1 and issuer_did = $2;`
 
-	cmd, err := p.conn.Pgx.Exec(ctx, query, id, issuerDID.String())
+	cmd, err := conn.Exec(ctx, query, id, issuerDID.String())
 	if err != nil {
 		return err
 	}
@@ -69,13 +69,13 @@ func TestPayment_SavePaymentOption(t *testing.T) {
 
 	repo := NewPayment(*storage)
 	t.Run("Save payment option", func(t *testing.T) {
-		id, err := repo.SavePaymentOption(ctx, domain.NewPaymentOption(*issuerID, "name", "description", &paymentOptionConfig))
+		id, err := repo.SavePaymentOption(ctx, storage.Pgx, domain.NewPaymentOption(*issuerID, "name", "description", &paymentOptionConfig))
 		assert.NoError(t, err)
 		assert.NotEqual(t, uuid.Nil, id)
 	})
 
 	t.Run("Save payment option linked to non existing issuer", func(t *testing.T) {
-		id, err := repo.SavePaymentOption(ctx, domain.NewPaymentOption(*issuerDIDOther, "name 2", "description 2", &paymentOptionConfig))
+		id, err := repo.SavePaymentOption(ctx, storage.Pgx, domain.NewPaymentOption(*issuerDIDOther, "name 2", "description 2", &paymentOptionConfig))
 		require.Error(t, err)
 		assert.Equal(t, uuid.Nil, id)
 	})
@@ -83,12 +83,12 @@ func TestPayment_SavePaymentOption(t *testing.T) {
 	t.Run("Save existing payment option - new name", func(t *testing.T) {
 		paymentOptionName := "payment-option-" + uuid.NewString()
 		paymentOption := domain.NewPaymentOption(*issuerID, paymentOptionName, "description", &paymentOptionConfig)
-		id, err := repo.SavePaymentOption(ctx, paymentOption)
+		id, err := repo.SavePaymentOption(ctx, storage.Pgx, paymentOption)
 		assert.NoError(t, err)
 		assert.NotEqual(t, uuid.Nil, id)
 		paymentOption.ID = id
 		paymentOption.Name = "new-name"
-		id, err = repo.SavePaymentOption(ctx, paymentOption)
+		id, err = repo.SavePaymentOption(ctx, storage.Pgx, paymentOption)
 		assert.NoError(t, err)
 		updatedPaymentOption, err := repo.GetPaymentOptionByID(ctx, issuerID, id)
 		assert.NoError(t, err)
@@ -98,12 +98,12 @@ func TestPayment_SavePaymentOption(t *testing.T) {
 	t.Run("Save existing payment option - new description", func(t *testing.T) {
 		paymentOptionName := "payment-option-" + uuid.NewString()
 		paymentOption := domain.NewPaymentOption(*issuerID, paymentOptionName, "description", &paymentOptionConfig)
-		id, err := repo.SavePaymentOption(ctx, paymentOption)
+		id, err := repo.SavePaymentOption(ctx, storage.Pgx, paymentOption)
 		assert.NoError(t, err)
 		assert.NotEqual(t, uuid.Nil, id)
 		paymentOption.ID = id
 		paymentOption.Description = "new-description"
-		id, err = repo.SavePaymentOption(ctx, paymentOption)
+		id, err = repo.SavePaymentOption(ctx, storage.Pgx, paymentOption)
 		assert.NoError(t, err)
 		updatedPaymentOption, err := repo.GetPaymentOptionByID(ctx, issuerID, id)
 		assert.NoError(t, err)
@@ -113,12 +113,12 @@ func TestPayment_SavePaymentOption(t *testing.T) {
 	t.Run("Save existing payment option - new config", func(t *testing.T) {
 		paymentOptionName := "payment-option-" + uuid.NewString()
 		paymentOption := domain.NewPaymentOption(*issuerID, paymentOptionName, "description", &paymentOptionConfig)
-		id, err := repo.SavePaymentOption(ctx, paymentOption)
+		id, err := repo.SavePaymentOption(ctx, storage.Pgx, paymentOption)
 		assert.NoError(t, err)
 		assert.NotEqual(t, uuid.Nil, id)
 		paymentOption.ID = id
 		paymentOption.Config = paymentOptionConfigToUpdate
-		id, err = repo.SavePaymentOption(ctx, paymentOption)
+		id, err = repo.SavePaymentOption(ctx, storage.Pgx, paymentOption)
 		assert.NoError(t, err)
 		updatedPaymentOption, err := repo.GetPaymentOptionByID(ctx, issuerID, id)
 		assert.NoError(t, err)
@@ -143,7 +143,7 @@ func TestPayment_GetAllPaymentOptions(t *testing.T) {
 	ids := make([]uuid.UUID, 0)
 	now := time.Now()
 	for i := 0; i < 10; i++ {
-		id, err := repo.SavePaymentOption(ctx, &domain.PaymentOption{
+		id, err := repo.SavePaymentOption(ctx, storage.Pgx, &domain.PaymentOption{
 			ID:          uuid.New(),
 			IssuerDID:   *issuerID,
 			Name:        fmt.Sprintf("name %d", i),
@@ -189,7 +189,7 @@ func TestPayment_GetPaymentOptionByID(t *testing.T) {
 	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerID.String()})
 	repo := NewPayment(*storage)
 	paymentOption := domain.NewPaymentOption(*issuerID, "payment option name "+uuid.NewString(), "description", &paymentOptionConfig)
-	id, err := repo.SavePaymentOption(ctx, paymentOption)
+	id, err := repo.SavePaymentOption(ctx, storage.Pgx, paymentOption)
 	assert.NoError(t, err)
 	assert.NotEqual(t, uuid.Nil, id)
 
@@ -216,7 +216,7 @@ func TestPayment_DeletePaymentOption(t *testing.T) {
 
 	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerID.String()})
 	repo := NewPayment(*storage)
-	id, err := repo.SavePaymentOption(ctx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
+	id, err := repo.SavePaymentOption(ctx, storage.Pgx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
 	assert.NoError(t, err)
 	assert.NotEqual(t, uuid.Nil, id)
 
@@ -224,7 +224,7 @@ func TestPayment_DeletePaymentOption(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, id, opt.ID)
 
-	require.NoError(t, repo.DeletePaymentOption(ctx, *issuerID, id))
+	require.NoError(t, repo.DeletePaymentOption(ctx, storage.Pgx, *issuerID, id))
 
 	opt, err = repo.GetPaymentOptionByID(ctx, issuerID, id)
 	assert.Error(t, err)
@@ -240,7 +240,7 @@ func TestPayment_SavePaymentRequest(t *testing.T) {
 	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerID.String()})
 	repo := NewPayment(*storage)
 
-	paymentOptionID, err := repo.SavePaymentOption(ctx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
+	paymentOptionID, err := repo.SavePaymentOption(ctx, storage.Pgx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
 	require.NoError(t, err)
 
 	t.Run("Save payment to not existing payment option id", func(t *testing.T) {
@@ -316,7 +316,7 @@ func TestPayment_GetPaymentRequestByID(t *testing.T) {
 	require.NoError(t, err)
 
 	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerID.String()})
-	paymentOptionID, err := repo.SavePaymentOption(ctx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
+	paymentOptionID, err := repo.SavePaymentOption(ctx, storage.Pgx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
 	require.NoError(t, err)
 	expected := fixture.CreatePaymentRequest(t, *issuerID, *issuerID, paymentOptionID, 10, nil)
 
@@ -348,7 +348,7 @@ func TestPayment_GetPaymentRequestItem(t *testing.T) {
 	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerID.String()})
 	repo := NewPayment(*storage)
 
-	payymentOptionID, err := repo.SavePaymentOption(ctx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
+	payymentOptionID, err := repo.SavePaymentOption(ctx, storage.Pgx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
 	require.NoError(t, err)
 	expected := fixture.CreatePaymentRequest(t, *issuerID, *issuerID, payymentOptionID, 10, nil)
 
@@ -368,7 +368,7 @@ func TestPayment_GetManyPaymentRequests(t *testing.T) {
 
 	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerID.String()})
 
-	paymentOptionID, err := repo.SavePaymentOption(ctx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
+	paymentOptionID, err := repo.SavePaymentOption(ctx, storage.Pgx, domain.NewPaymentOption(*issuerID, "name"+uuid.NewString(), "description", &domain.PaymentOptionConfig{}))
 	require.NoError(t, err)
 	ids := make([]string, 0)
 	for i := 0; i < 10; i++ {
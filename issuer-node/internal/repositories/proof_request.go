@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// ProofRequestNotFoundErr is the error returned when the proof request is not found
+	ProofRequestNotFoundErr = errors.New("proof request not found")
+	// ProofRequestNotPendingErr is the error returned when answering a proof request that has already been answered
+	ProofRequestNotPendingErr = errors.New("proof request is not pending")
+)
+
+const proofRequestFields = `id, connection_id, issuer_did, user_did, thread_id, reason, request, status, response, error,
+       verified_at, created_at, modified_at`
+
+// ProofRequest represents the proof requests repository
+type ProofRequest struct {
+	conn db.Storage
+}
+
+// NewProofRequest creates a new proof requests repository
+func NewProofRequest(conn db.Storage) ports.ProofRequestRepository {
+	return &ProofRequest{
+		conn,
+	}
+}
+
+// Save stores a new proof request
+func (p *ProofRequest) Save(ctx context.Context, proofRequest *domain.ProofRequest) error {
+	sql := `INSERT INTO proof_requests (id, connection_id, issuer_did, user_did, thread_id, reason, request, status, response, error, verified_at)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	_, err := p.conn.Pgx.Exec(ctx, sql,
+		proofRequest.ID,
+		proofRequest.ConnectionID,
+		proofRequest.IssuerDID,
+		proofRequest.UserDID,
+		proofRequest.ThreadID,
+		proofRequest.Reason,
+		proofRequest.Request,
+		proofRequest.Status,
+		proofRequest.Response,
+		proofRequest.Error,
+		proofRequest.VerifiedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save proof request: %w", err)
+	}
+	return nil
+}
+
+// Answer updates the status, response and error of a pending proof request. The update only applies while the
+// proof request is pending, so concurrent responses can't overwrite each other. It returns ProofRequestNotPendingErr
+// when the proof request has already been answered.
+func (p *ProofRequest) Answer(ctx context.Context, proofRequest *domain.ProofRequest) error {
+	sql := `UPDATE proof_requests SET status=$2, response=$3, error=$4, verified_at=$5, modified_at=NOW()
+			WHERE id=$1 AND status=$6`
+	tag, err := p.conn.Pgx.Exec(ctx, sql,
+		proofRequest.ID,
+		proofRequest.Status,
+		proofRequest.Response,
+		proofRequest.Error,
+		proofRequest.VerifiedAt,
+		domain.ProofRequestStatusPending,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to answer proof request: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ProofRequestNotPendingErr
+	}
+	return nil
+}
+
+// GetByID returns the proof request with the given id
+func (p *ProofRequest) GetByID(ctx context.Context, id uuid.UUID) (*domain.ProofRequest, error) {
+	sql := `SELECT ` + proofRequestFields + `
+FROM proof_requests
+WHERE id=$1`
+	var proofRequest domain.ProofRequest
+	err := p.conn.Pgx.QueryRow(ctx, sql, id).Scan(
+		&proofRequest.ID,
+		&proofRequest.ConnectionID,
+		&proofRequest.IssuerDID,
+		&proofRequest.UserDID,
+		&proofRequest.ThreadID,
+		&proofRequest.Reason,
+		&proofRequest.Request,
+		&proofRequest.Status,
+		&proofRequest.Response,
+		&proofRequest.Error,
+		&proofRequest.VerifiedAt,
+		&proofRequest.CreatedAt,
+		&proofRequest.ModifiedAt,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, ProofRequestNotFoundErr
+		}
+		return nil, err
+	}
+	return &proofRequest, nil
+}
+
+// GetByConnection returns the proof requests sent by the issuer to the connection, newest first
+func (p *ProofRequest) GetByConnection(ctx context.Context, issuerDID w3c.DID, connID uuid.UUID) ([]domain.ProofRequest, error) {
+	sql := `SELECT ` + proofRequestFields + `
+FROM proof_requests
+WHERE issuer_did=$1 AND connection_id=$2
+ORDER BY created_at DESC`
+	rows, err := p.conn.Pgx.Query(ctx, sql, issuerDID.String(), connID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	proofRequests := make([]domain.ProofRequest, 0)
+	for rows.Next() {
+		var proofRequest domain.ProofRequest
+		if err := rows.Scan(
+			&proofRequest.ID,
+			&proofRequest.ConnectionID,
+			&proofRequest.IssuerDID,
+			&proofRequest.UserDID,
+			&proofRequest.ThreadID,
+			&proofRequest.Reason,
+			&proofRequest.Request,
+			&proofRequest.Status,
+			&proofRequest.Response,
+			&proofRequest.Error,
+			&proofRequest.VerifiedAt,
+			&proofRequest.CreatedAt,
+			&proofRequest.ModifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		proofRequests = append(proofRequests, proofRequest)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return proofRequests, nil
+}
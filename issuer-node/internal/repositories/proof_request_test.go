@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestProofRequest_SaveAndGet(t *testing.T) {
+	ctx := context.Background()
+	proofRequestRepository := NewProofRequest(*storage)
+	fixture := NewFixture(storage)
+	issuerDID := randomDID(t)
+	userDID := randomDID(t)
+	connID := fixture.CreateConnection(t, &domain.Connection{
+		IssuerDID:  issuerDID,
+		UserDID:    userDID,
+		IssuerDoc:  nil,
+		UserDoc:    nil,
+		CreatedAt:  time.Now(),
+		ModifiedAt: time.Now(),
+	})
+
+	proofRequest := &domain.ProofRequest{
+		ID:           uuid.New(),
+		ConnectionID: connID,
+		IssuerDID:    issuerDID.String(),
+		UserDID:      userDID.String(),
+		ThreadID:     uuid.NewString(),
+		Reason:       "age check",
+		Request:      json.RawMessage(`{"type":"https://iden3-communication.io/authorization/1.0/request"}`),
+		Status:       domain.ProofRequestStatusPending,
+	}
+
+	t.Run("should save a pending proof request", func(t *testing.T) {
+		require.NoError(t, proofRequestRepository.Save(ctx, proofRequest))
+		saved, err := proofRequestRepository.GetByID(ctx, proofRequest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProofRequestStatusPending, saved.Status)
+		assert.Equal(t, connID, saved.ConnectionID)
+		assert.Nil(t, saved.Response)
+	})
+
+	t.Run("should store the verified response", func(t *testing.T) {
+		proofRequest.Status = domain.ProofRequestStatusVerified
+		proofRequest.Response = json.RawMessage(`[{"id":1}]`)
+		proofRequest.VerifiedAt = common.ToPointer(time.Now())
+		require.NoError(t, proofRequestRepository.Answer(ctx, proofRequest))
+
+		proofRequests, err := proofRequestRepository.GetByConnection(ctx, issuerDID, connID)
+		require.NoError(t, err)
+		require.Len(t, proofRequests, 1)
+		assert.Equal(t, domain.ProofRequestStatusVerified, proofRequests[0].Status)
+		assert.JSONEq(t, `[{"id":1}]`, string(proofRequests[0].Response))
+		assert.NotNil(t, proofRequests[0].VerifiedAt)
+	})
+
+	t.Run("should not answer a proof request twice", func(t *testing.T) {
+		proofRequest.Error = common.ToPointer("invalid proof")
+		assert.ErrorIs(t, proofRequestRepository.Answer(ctx, proofRequest), ProofRequestNotPendingErr)
+		saved, err := proofRequestRepository.GetByID(ctx, proofRequest.ID)
+		require.NoError(t, err)
+		assert.Nil(t, saved.Error)
+	})
+
+	t.Run("should not find an unknown proof request", func(t *testing.T) {
+		_, err := proofRequestRepository.GetByID(ctx, uuid.New())
+		assert.ErrorIs(t, err, ProofRequestNotFoundErr)
+	})
+}
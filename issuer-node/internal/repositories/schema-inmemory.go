@@ -7,6 +7,7 @@ import (
 	"github.com/iden3/go-iden3-core/v2/w3c"
 
 	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/db"
 )
 
 type schemaInMemory struct {
@@ -14,7 +15,7 @@ type schemaInMemory struct {
 }
 
 func (s *schemaInMemory) Update(ctx context.Context, schema *domain.Schema) error {
-	return s.Save(ctx, schema)
+	return s.Save(ctx, nil, schema)
 }
 
 // NewSchemaInMemory returns schemaRepository implemented in memory convenient for testing
@@ -22,7 +23,7 @@ func NewSchemaInMemory() *schemaInMemory {
 	return &schemaInMemory{schemas: make(map[uuid.UUID]domain.Schema)}
 }
 
-func (s *schemaInMemory) Save(_ context.Context, schema *domain.Schema) error {
+func (s *schemaInMemory) Save(_ context.Context, _ db.Querier, schema *domain.Schema) error {
 	s.schemas[schema.ID] = *schema
 	return nil
 }
@@ -48,7 +48,7 @@ func NewSchema(conn db.Storage) *schema {
 }
 
 // Save stores a new entry in schemas table
-func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
+func (r *schema) Save(ctx context.Context, conn db.Querier, s *domain.Schema) error {
 	const insertSchema = `INSERT INTO schemas (id, issuer_id, url, type,  context_url, hash,  words, created_at, version, title, description, display_method_id) VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
  	ON CONFLICT (id) DO
     UPDATE 
@@ -58,7 +58,7 @@ func (r *schema) Save(ctx context.Context, s *domain.Schema) error {
 	if err != nil {
 		return err
 	}
-	_, err = r.conn.Pgx.Exec(
+	_, err = conn.Exec(
 		ctx,
 		insertSchema,
 		s.ID,
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// SchemaDocumentNotFoundErr is the error returned when the schema document does not exist
+var SchemaDocumentNotFoundErr = errors.New("schema document not found")
+
+type schemaDocument struct {
+	conn db.Storage
+}
+
+// NewSchemaDocument returns a new repository of the schemas built by the node
+func NewSchemaDocument(conn db.Storage) ports.SchemaDocumentRepository {
+	return &schemaDocument{conn: conn}
+}
+
+// Save stores a new schema document
+func (r *schemaDocument) Save(ctx context.Context, doc *domain.SchemaDocument) error {
+	const insertSchemaDocument = `INSERT INTO schema_documents (id, issuer_id, type, version, title, description, json_schema, json_ld_context, url, context_url, created_at)
+	VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	_, err := r.conn.Pgx.Exec(ctx, insertSchemaDocument,
+		doc.ID,
+		doc.IssuerDID.String(),
+		doc.Type,
+		doc.Version,
+		doc.Title,
+		doc.Description,
+		string(doc.JSONSchema),
+		string(doc.JSONLdContext),
+		doc.URL,
+		doc.ContextURL,
+		doc.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save schema document: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the schema document
+func (r *schemaDocument) GetByID(ctx context.Context, id uuid.UUID) (*domain.SchemaDocument, error) {
+	const byID = `SELECT id, issuer_id, type, version, title, description, json_schema, json_ld_context, url, context_url, created_at
+	FROM schema_documents
+	WHERE id=$1`
+	var doc domain.SchemaDocument
+	var issuerID, jsonSchema, jsonLdContext string
+	err := r.conn.Pgx.QueryRow(ctx, byID, id).Scan(&doc.ID, &issuerID, &doc.Type, &doc.Version, &doc.Title, &doc.Description, &jsonSchema, &jsonLdContext, &doc.URL, &doc.ContextURL, &doc.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, SchemaDocumentNotFoundErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	issuerDID, err := w3c.ParseDID(issuerID)
+	if err != nil {
+		return nil, fmt.Errorf("parsing issuer DID from schema document: %w", err)
+	}
+	doc.IssuerDID = *issuerDID
+	doc.JSONSchema, doc.JSONLdContext = []byte(jsonSchema), []byte(jsonLdContext)
+	return &doc, nil
+}
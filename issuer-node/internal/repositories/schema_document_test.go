@@ -0,0 +1,48 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestSchemaDocument_GetByID(t *testing.T) {
+	ctx := context.Background()
+	schemaDocumentRepository := NewSchemaDocument(*storage)
+	issuerDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "BJJ")
+	require.NoError(t, err)
+
+	doc := &domain.SchemaDocument{
+		ID:            uuid.New(),
+		IssuerDID:     issuerDID,
+		Type:          "HackathonCreator",
+		Version:       "1.0.0",
+		Title:         common.ToPointer("Hackathon Creator"),
+		JSONSchema:    []byte(`{"type":"object"}`),
+		JSONLdContext: []byte(`{"@context":[]}`),
+		URL:           "ipfs://schema",
+		ContextURL:    "ipfs://context",
+		CreatedAt:     time.Now(),
+	}
+	require.NoError(t, schemaDocumentRepository.Save(ctx, doc))
+
+	saved, err := schemaDocumentRepository.GetByID(ctx, doc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, doc.IssuerDID, saved.IssuerDID)
+	assert.Equal(t, doc.Title, saved.Title)
+	assert.Nil(t, saved.Description)
+	assert.Equal(t, doc.JSONSchema, saved.JSONSchema)
+	assert.Equal(t, doc.JSONLdContext, saved.JSONLdContext)
+	assert.Equal(t, doc.URL, saved.URL)
+
+	_, err = schemaDocumentRepository.GetByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, SchemaDocumentNotFoundErr)
+}
@@ -0,0 +1,139 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// SchemaFamilyNotFoundErr is the error returned when the schema family does not exist
+	SchemaFamilyNotFoundErr = errors.New("schema family not found")
+	// SchemaFamilyDuplicatedErr is the error returned when the issuer already has a family with the same name
+	SchemaFamilyDuplicatedErr = errors.New("schema family already exists")
+	// SchemaFamilyVersionDuplicatedErr is the error returned when the schema is already a version of a family
+	SchemaFamilyVersionDuplicatedErr = errors.New("schema is already a version of a family")
+)
+
+type schemaFamily struct {
+	conn db.Storage
+}
+
+// NewSchemaFamily returns a new repository of the schema families
+func NewSchemaFamily(conn db.Storage) ports.SchemaFamilyRepository {
+	return &schemaFamily{conn: conn}
+}
+
+// Save stores a new family without versions
+func (r *schemaFamily) Save(ctx context.Context, family *domain.SchemaFamily) error {
+	const insertFamily = `INSERT INTO schema_families (id, issuer_id, name, created_at) VALUES($1, $2, $3, $4)`
+	_, err := r.conn.Pgx.Exec(ctx, insertFamily, family.ID, family.IssuerDID.String(), family.Name, family.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return SchemaFamilyDuplicatedErr
+		}
+		return fmt.Errorf("failed to save schema family: %w", err)
+	}
+	return nil
+}
+
+// AddVersion appends the version to the family. Its position is set to the one after the last version.
+func (r *schemaFamily) AddVersion(ctx context.Context, familyID uuid.UUID, version *domain.SchemaFamilyVersion) error {
+	const insertVersion = `INSERT INTO schema_family_versions (family_id, schema_id, position, mapping, created_at)
+	SELECT $1, $2, COALESCE(MAX(position), 0) + 1, $3, $4 FROM schema_family_versions WHERE family_id=$1
+	RETURNING position`
+	err := r.conn.Pgx.QueryRow(ctx, insertVersion, familyID, version.SchemaID, version.Mapping, version.CreatedAt).Scan(&version.Position)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+			return SchemaFamilyVersionDuplicatedErr
+		}
+		return fmt.Errorf("failed to add schema family version: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the family of the issuer with its versions
+func (r *schemaFamily) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaFamily, error) {
+	const byID = `SELECT id, name, created_at FROM schema_families WHERE issuer_id=$1 AND id=$2`
+	family := domain.SchemaFamily{IssuerDID: issuerDID}
+	err := r.conn.Pgx.QueryRow(ctx, byID, issuerDID.String(), id).Scan(&family.ID, &family.Name, &family.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, SchemaFamilyNotFoundErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	families := []domain.SchemaFamily{family}
+	if err := r.loadVersions(ctx, families); err != nil {
+		return nil, err
+	}
+	return &families[0], nil
+}
+
+// GetAll returns the families of the issuer with their versions, newest first
+func (r *schemaFamily) GetAll(ctx context.Context, issuerDID w3c.DID) ([]domain.SchemaFamily, error) {
+	const all = `SELECT id, name, created_at FROM schema_families WHERE issuer_id=$1 ORDER BY created_at DESC`
+	rows, err := r.conn.Pgx.Query(ctx, all, issuerDID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	families := make([]domain.SchemaFamily, 0)
+	for rows.Next() {
+		family := domain.SchemaFamily{IssuerDID: issuerDID}
+		if err := rows.Scan(&family.ID, &family.Name, &family.CreatedAt); err != nil {
+			return nil, err
+		}
+		families = append(families, family)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if err := r.loadVersions(ctx, families); err != nil {
+		return nil, err
+	}
+	return families, nil
+}
+
+func (r *schemaFamily) loadVersions(ctx context.Context, families []domain.SchemaFamily) error {
+	if len(families) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, len(families))
+	byID := make(map[uuid.UUID]*domain.SchemaFamily, len(families))
+	for i := range families {
+		ids[i] = families[i].ID
+		byID[families[i].ID] = &families[i]
+		families[i].Versions = make([]domain.SchemaFamilyVersion, 0)
+	}
+	const versions = `SELECT family_id, schema_id, position, mapping, created_at
+	FROM schema_family_versions
+	WHERE family_id = ANY($1)
+	ORDER BY family_id, position`
+	rows, err := r.conn.Pgx.Query(ctx, versions, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var familyID uuid.UUID
+		var version domain.SchemaFamilyVersion
+		if err := rows.Scan(&familyID, &version.SchemaID, &version.Position, &version.Mapping, &version.CreatedAt); err != nil {
+			return err
+		}
+		family := byID[familyID]
+		family.Versions = append(family.Versions, version)
+	}
+	return rows.Err()
+}
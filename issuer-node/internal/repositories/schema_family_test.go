@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestSchemaFamily(t *testing.T) {
+	ctx := context.Background()
+	schemaRepository := NewSchema(*storage)
+	familyRepository := NewSchemaFamily(*storage)
+	migrationRepository := NewSchemaMigration(*storage)
+	issuerDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "BJJ")
+	require.NoError(t, err)
+
+	schemas := make([]*domain.Schema, 2)
+	for i := range schemas {
+		schemas[i] = &domain.Schema{
+			ID:        uuid.New(),
+			IssuerDID: issuerDID,
+			URL:       "https://schemas.example.com/" + uuid.NewString() + ".json",
+			Type:      "Recruiter",
+			Hash:      core.NewSchemaHashFromInt(big.NewInt(rand.Int63())),
+			CreatedAt: time.Now(),
+			Version:   "1.0.0",
+		}
+		require.NoError(t, schemaRepository.Save(ctx, storage.Pgx, schemas[i]))
+	}
+
+	family := &domain.SchemaFamily{ID: uuid.New(), IssuerDID: issuerDID, Name: "Recruiter", CreatedAt: time.Now()}
+	require.NoError(t, familyRepository.Save(ctx, family))
+	assert.ErrorIs(t, familyRepository.Save(ctx, &domain.SchemaFamily{ID: uuid.New(), IssuerDID: issuerDID, Name: "Recruiter", CreatedAt: time.Now()}), SchemaFamilyDuplicatedErr)
+
+	first := &domain.SchemaFamilyVersion{SchemaID: schemas[0].ID, Mapping: domain.SchemaMapping{}, CreatedAt: time.Now()}
+	require.NoError(t, familyRepository.AddVersion(ctx, family.ID, first))
+	second := &domain.SchemaFamilyVersion{
+		SchemaID:  schemas[1].ID,
+		Mapping:   domain.SchemaMapping{{Op: domain.SchemaMappingRename, Field: "company", To: "employer"}},
+		CreatedAt: time.Now(),
+	}
+	require.NoError(t, familyRepository.AddVersion(ctx, family.ID, second))
+	assert.Equal(t, 1, first.Position)
+	assert.Equal(t, 2, second.Position)
+	assert.ErrorIs(t, familyRepository.AddVersion(ctx, family.ID, &domain.SchemaFamilyVersion{SchemaID: schemas[0].ID, CreatedAt: time.Now()}), SchemaFamilyVersionDuplicatedErr)
+
+	saved, err := familyRepository.GetByID(ctx, issuerDID, family.ID)
+	require.NoError(t, err)
+	assert.Equal(t, family.Name, saved.Name)
+	require.Len(t, saved.Versions, 2)
+	assert.Equal(t, schemas[0].ID, saved.Versions[0].SchemaID)
+	assert.Equal(t, second.Mapping, saved.Versions[1].Mapping)
+
+	families, err := familyRepository.GetAll(ctx, issuerDID)
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	assert.Len(t, families[0].Versions, 2)
+
+	_, err = familyRepository.GetByID(ctx, issuerDID, uuid.New())
+	assert.ErrorIs(t, err, SchemaFamilyNotFoundErr)
+
+	t.Run("should lease the pending migrations once", func(t *testing.T) {
+		migration := domain.NewSchemaMigration(issuerDID, family.ID, schemas[0].ID, schemas[1].ID, true)
+		require.NoError(t, migrationRepository.Save(ctx, migration))
+
+		leased, err := migrationRepository.LeasePending(ctx, 100)
+		require.NoError(t, err)
+		var found bool
+		for _, m := range leased {
+			found = found || m.ID == migration.ID
+		}
+		assert.True(t, found)
+		leased, err = migrationRepository.LeasePending(ctx, 100)
+		require.NoError(t, err)
+		for _, m := range leased {
+			assert.NotEqual(t, migration.ID, m.ID)
+		}
+
+		migration.Status = domain.SchemaMigrationDone
+		migration.Total, migration.Migrated = 2, 1
+		migration.Fail(uuid.New(), "did:iden3:subject", errors.New("missing employer"))
+		require.NoError(t, migrationRepository.Save(ctx, migration))
+		saved, err := migrationRepository.GetByID(ctx, issuerDID, migration.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.SchemaMigrationDone, saved.Status)
+		assert.True(t, saved.DryRun)
+		assert.Equal(t, 1, saved.Migrated)
+		assert.Equal(t, migration.Failures, saved.Failures)
+
+		_, err = migrationRepository.GetByID(ctx, issuerDID, uuid.New())
+		assert.ErrorIs(t, err, SchemaMigrationNotFoundErr)
+	})
+}
@@ -0,0 +1,128 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// SchemaMigrationNotFoundErr is the error returned when the schema migration does not exist
+var SchemaMigrationNotFoundErr = errors.New("schema migration not found")
+
+const schemaMigrationFields = `id, issuer_id, family_id, from_schema_id, to_schema_id, dry_run, status, total, migrated,
+       failures, error, created_at, modified_at`
+
+type schemaMigration struct {
+	conn db.Storage
+}
+
+// NewSchemaMigration returns a new repository of the migrations of credentials between schema versions
+func NewSchemaMigration(conn db.Storage) ports.SchemaMigrationRepository {
+	return &schemaMigration{conn: conn}
+}
+
+// Save stores the migration. If it already exists, its status and report are updated.
+func (r *schemaMigration) Save(ctx context.Context, migration *domain.SchemaMigration) error {
+	const insertMigration = `INSERT INTO schema_migrations (id, issuer_id, family_id, from_schema_id, to_schema_id, dry_run, status, total, migrated, failures, error, created_at, modified_at)
+	VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13) ON CONFLICT (id) DO
+	UPDATE SET status=$7, total=$8, migrated=$9, failures=$10, error=$11, modified_at=$13`
+	_, err := r.conn.Pgx.Exec(ctx, insertMigration,
+		migration.ID,
+		migration.IssuerDID.String(),
+		migration.FamilyID,
+		migration.FromSchemaID,
+		migration.ToSchemaID,
+		migration.DryRun,
+		migration.Status,
+		migration.Total,
+		migration.Migrated,
+		migration.Failures,
+		migration.Error,
+		migration.CreatedAt,
+		migration.ModifiedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save schema migration: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the migration of the issuer
+func (r *schemaMigration) GetByID(ctx context.Context, issuerDID w3c.DID, id uuid.UUID) (*domain.SchemaMigration, error) {
+	sql := `SELECT ` + schemaMigrationFields + ` FROM schema_migrations WHERE issuer_id=$1 AND id=$2`
+	rows, err := r.conn.Pgx.Query(ctx, sql, issuerDID.String(), id)
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := scanSchemaMigrations(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) == 0 {
+		return nil, SchemaMigrationNotFoundErr
+	}
+	return &migrations[0], nil
+}
+
+// LeasePending returns up to limit pending migrations, oldest first, and sets them as running,
+// so concurrent workers don't process the same migration twice.
+func (r *schemaMigration) LeasePending(ctx context.Context, limit int) ([]domain.SchemaMigration, error) {
+	sql := `UPDATE schema_migrations SET status=$2, modified_at=NOW()
+WHERE id IN (
+    SELECT id FROM schema_migrations
+    WHERE status = $1
+    ORDER BY created_at
+    LIMIT $3
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING ` + schemaMigrationFields
+	rows, err := r.conn.Pgx.Query(ctx, sql, domain.SchemaMigrationPending, domain.SchemaMigrationRunning, limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanSchemaMigrations(rows)
+}
+
+func scanSchemaMigrations(rows pgx.Rows) ([]domain.SchemaMigration, error) {
+	defer rows.Close()
+	migrations := make([]domain.SchemaMigration, 0)
+	for rows.Next() {
+		var migration domain.SchemaMigration
+		var issuerID string
+		if err := rows.Scan(
+			&migration.ID,
+			&issuerID,
+			&migration.FamilyID,
+			&migration.FromSchemaID,
+			&migration.ToSchemaID,
+			&migration.DryRun,
+			&migration.Status,
+			&migration.Total,
+			&migration.Migrated,
+			&migration.Failures,
+			&migration.Error,
+			&migration.CreatedAt,
+			&migration.ModifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		issuerDID, err := w3c.ParseDID(issuerID)
+		if err != nil {
+			return nil, fmt.Errorf("parsing issuer DID from schema migration: %w", err)
+		}
+		migration.IssuerDID = *issuerDID
+		migrations = append(migrations, migration)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return migrations, nil
+}
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+type schemaSnapshotInMemory struct {
+	snapshots map[string]domain.SchemaSnapshot
+}
+
+// NewSchemaSnapshotInMemory returns a schema snapshots repository implemented in memory convenient for testing
+func NewSchemaSnapshotInMemory() *schemaSnapshotInMemory {
+	return &schemaSnapshotInMemory{snapshots: make(map[string]domain.SchemaSnapshot)}
+}
+
+func (s *schemaSnapshotInMemory) Save(_ context.Context, snapshot *domain.SchemaSnapshot) error {
+	if _, found := s.snapshots[snapshot.URL]; !found {
+		s.snapshots[snapshot.URL] = *snapshot
+	}
+	return nil
+}
+
+func (s *schemaSnapshotInMemory) GetByURL(_ context.Context, url string) (*domain.SchemaSnapshot, error) {
+	if snapshot, found := s.snapshots[url]; found {
+		return &snapshot, nil
+	}
+	return nil, SchemaSnapshotNotFoundErr
+}
+
+func (s *schemaSnapshotInMemory) UpdateRemoteDigest(_ context.Context, url string, remoteDigest string, checkedAt time.Time) error {
+	snapshot, found := s.snapshots[url]
+	if !found {
+		return SchemaSnapshotNotFoundErr
+	}
+	snapshot.RemoteDigest, snapshot.CheckedAt = &remoteDigest, &checkedAt
+	s.snapshots[url] = snapshot
+	return nil
+}
@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+// SchemaSnapshotNotFoundErr is the error returned when there is no snapshot of the document
+var SchemaSnapshotNotFoundErr = errors.New("schema snapshot not found")
+
+type schemaSnapshot struct {
+	conn db.Storage
+}
+
+// NewSchemaSnapshot returns a new repository of the snapshots of the imported schemas
+func NewSchemaSnapshot(conn db.Storage) ports.SchemaSnapshotRepository {
+	return &schemaSnapshot{conn: conn}
+}
+
+// Save stores the snapshot of a document. The snapshot taken first is kept when there is already one of the url.
+func (r *schemaSnapshot) Save(ctx context.Context, snapshot *domain.SchemaSnapshot) error {
+	const insertSnapshot = `INSERT INTO schema_snapshots (url, digest, content, created_at) VALUES($1, $2, $3, $4)
+	ON CONFLICT (url) DO NOTHING`
+	if _, err := r.conn.Pgx.Exec(ctx, insertSnapshot, snapshot.URL, snapshot.Digest, snapshot.Content, snapshot.CreatedAt); err != nil {
+		return fmt.Errorf("failed to save schema snapshot: %w", err)
+	}
+	return nil
+}
+
+// GetByURL returns the snapshot of the document at url
+func (r *schemaSnapshot) GetByURL(ctx context.Context, url string) (*domain.SchemaSnapshot, error) {
+	const byURL = `SELECT url, digest, content, remote_digest, checked_at, created_at
+	FROM schema_snapshots
+	WHERE url=$1`
+	var snapshot domain.SchemaSnapshot
+	err := r.conn.Pgx.QueryRow(ctx, byURL, url).Scan(&snapshot.URL, &snapshot.Digest, &snapshot.Content, &snapshot.RemoteDigest, &snapshot.CheckedAt, &snapshot.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, SchemaSnapshotNotFoundErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// UpdateRemoteDigest records the digest of the remote content of the document at url
+func (r *schemaSnapshot) UpdateRemoteDigest(ctx context.Context, url string, remoteDigest string, checkedAt time.Time) error {
+	const updateRemoteDigest = `UPDATE schema_snapshots SET remote_digest=$2, checked_at=$3 WHERE url=$1`
+	res, err := r.conn.Pgx.Exec(ctx, updateRemoteDigest, url, remoteDigest, checkedAt)
+	if err != nil {
+		return err
+	}
+	if res.RowsAffected() == 0 {
+		return SchemaSnapshotNotFoundErr
+	}
+	return nil
+}
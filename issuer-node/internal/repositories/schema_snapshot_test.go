@@ -0,0 +1,40 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestSchemaSnapshot(t *testing.T) {
+	ctx := context.Background()
+	schemaSnapshotRepository := NewSchemaSnapshot(*storage)
+	url := "https://schemas.example.com/" + uuid.NewString() + ".json"
+
+	require.NoError(t, schemaSnapshotRepository.Save(ctx, domain.NewSchemaSnapshot(url, []byte(`{"version":1}`))))
+	// the first snapshot of the url is kept
+	require.NoError(t, schemaSnapshotRepository.Save(ctx, domain.NewSchemaSnapshot(url, []byte(`{"version":2}`))))
+
+	snapshot, err := schemaSnapshotRepository.GetByURL(ctx, url)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"version":1}`), snapshot.Content)
+	assert.True(t, snapshot.Valid())
+	assert.Nil(t, snapshot.RemoteDigest)
+
+	remoteDigest := domain.SchemaSnapshotDigest([]byte(`{"version":2}`))
+	require.NoError(t, schemaSnapshotRepository.UpdateRemoteDigest(ctx, url, remoteDigest, time.Now()))
+	snapshot, err = schemaSnapshotRepository.GetByURL(ctx, url)
+	require.NoError(t, err)
+	assert.True(t, snapshot.Drifted())
+	assert.NotNil(t, snapshot.CheckedAt)
+
+	_, err = schemaSnapshotRepository.GetByURL(ctx, "https://schemas.example.com/unknown.json")
+	assert.ErrorIs(t, err, SchemaSnapshotNotFoundErr)
+	assert.ErrorIs(t, schemaSnapshotRepository.UpdateRemoteDigest(ctx, "https://schemas.example.com/unknown.json", remoteDigest, time.Now()), SchemaSnapshotNotFoundErr)
+}
@@ -44,7 +44,7 @@ func TestGetSchema(t *testing.T) {
 		Description: common.ToPointer("some description"),
 		Version:     "1.0.0",
 	}
-	require.NoError(t, store.Save(ctx, schema1))
+	require.NoError(t, store.Save(ctx, storage.Pgx, schema1))
 
 	schema2, err := store.GetByID(ctx, *did, schema1.ID)
 	require.NoError(t, err)
@@ -85,14 +85,14 @@ func TestCreateSchema(t *testing.T) {
 		Version:     uuid.NewString(),
 	}
 
-	require.NoError(t, store.Save(ctx, schema1))
+	require.NoError(t, store.Save(ctx, storage.Pgx, schema1))
 	schema1.ID = uuid.New()
-	assert.ErrorIs(t, ErrDuplicated, store.Save(ctx, schema1), "cannot have duplicated schemas with the same version for the same issuer and type")
+	assert.ErrorIs(t, ErrDuplicated, store.Save(ctx, storage.Pgx, schema1), "cannot have duplicated schemas with the same version for the same issuer and type")
 
 	schema2 := schema1
 	schema2.Version = uuid.NewString()
 	schema2.ID = uuid.New()
-	assert.NoError(t, store.Save(ctx, schema2))
+	assert.NoError(t, store.Save(ctx, storage.Pgx, schema2))
 }
 
 func TestGetSchemaWithNullAttributes(t *testing.T) {
@@ -119,7 +119,7 @@ func TestGetSchemaWithNullAttributes(t *testing.T) {
 		Version:     uuid.NewString(),
 	}
 
-	require.NoError(t, store.Save(ctx, schema1))
+	require.NoError(t, store.Save(ctx, storage.Pgx, schema1))
 
 	bdSchema, err := store.GetByID(ctx, *did, schema1.ID)
 	require.NoError(t, err)
@@ -312,7 +312,7 @@ func insertSchemaGetAllData(t *testing.T, ctx context.Context, did w3c.DID, stor
 			Words:     d.attributes,
 			CreatedAt: time.Now(),
 		}
-		require.NoError(t, store.Save(ctx, s))
+		require.NoError(t, store.Save(ctx, storage.Pgx, s))
 		time.Sleep(2 * time.Millisecond)
 	}
 }
@@ -2,19 +2,28 @@ package repositories
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/iden3/iden3comm/v2/protocol"
 
 	"github.com/polygonid/sh-id-platform/internal/cache"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
 	"github.com/polygonid/sh-id-platform/internal/core/ports"
 )
 
 const (
 	defaultTTL = 5 * time.Minute
+	// oid4vpSessionTTL keeps the verification results available for a while after the wallet responds
+	oid4vpSessionTTL   = time.Hour
+	oid4vpSessionKeyFn = "oid4vp-session-%s"
 )
 
+// OID4VPSessionNotFoundErr is the error returned when the OpenID4VP session is not found or has expired
+var OID4VPSessionNotFoundErr = errors.New("oid4vp session not found")
+
 type cached struct {
 	cache cache.Cache
 }
@@ -39,3 +48,17 @@ func (c *cached) Get(ctx context.Context, key string) (protocol.AuthorizationReq
 func (c *cached) Set(ctx context.Context, key string, value protocol.AuthorizationRequestMessage) error {
 	return c.cache.Set(ctx, key, value, defaultTTL)
 }
+
+// GetOID4VP returns the cached OpenID4VP session
+func (c *cached) GetOID4VP(ctx context.Context, id uuid.UUID) (*domain.OID4VPSession, error) {
+	var session domain.OID4VPSession
+	if found := c.cache.Get(ctx, fmt.Sprintf(oid4vpSessionKeyFn, id), &session); !found {
+		return nil, OID4VPSessionNotFoundErr
+	}
+	return &session, nil
+}
+
+// SetOID4VP stores the OpenID4VP session
+func (c *cached) SetOID4VP(ctx context.Context, session *domain.OID4VPSession) error {
+	return c.cache.Set(ctx, fmt.Sprintf(oid4vpSessionKeyFn, session.ID), *session, oid4vpSessionTTL)
+}
@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// StatusListNotFoundErr is the error returned when the status list does not exist
+	StatusListNotFoundErr = errors.New("status list not found")
+	// StatusListFullErr is the error returned when every index of the status list has been allocated
+	StatusListFullErr = errors.New("status list is full")
+)
+
+type statusList struct{}
+
+// NewStatusList creates a new status lists repository
+func NewStatusList() ports.StatusListRepository {
+	return &statusList{}
+}
+
+// Save creates the status list or updates its statuses and credential
+func (s *statusList) Save(ctx context.Context, conn db.Querier, list *domain.StatusList) error {
+	sql := `INSERT INTO status_lists (id, issuer_id, purpose, size, next_index, bitstring, credential)
+			VALUES($1, $2, $3, $4, $5, $6, $7) ON CONFLICT (id) DO
+			UPDATE SET bitstring=$6, credential=$7, updated_at=CURRENT_TIMESTAMP`
+	_, err := conn.Exec(ctx, sql, list.ID, list.Identifier, list.Purpose, list.Size, list.NextIndex, list.Bitstring, list.Credential)
+	if err != nil {
+		return fmt.Errorf("failed to save status list: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns the status list
+func (s *statusList) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.StatusList, error) {
+	sql := `SELECT id, issuer_id, purpose, size, next_index, bitstring, credential, created_at, updated_at
+FROM status_lists
+WHERE id=$1`
+	return s.get(ctx, conn, sql, id)
+}
+
+// GetForUpdate returns the status list and locks it until the end of the transaction of conn,
+// so revocations of credentials of the same list are not lost
+func (s *statusList) GetForUpdate(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.StatusList, error) {
+	sql := `SELECT id, issuer_id, purpose, size, next_index, bitstring, credential, created_at, updated_at
+FROM status_lists
+WHERE id=$1
+FOR UPDATE`
+	return s.get(ctx, conn, sql, id)
+}
+
+// GetCurrent returns the latest status list of the issuer for the purpose
+func (s *statusList) GetCurrent(ctx context.Context, conn db.Querier, issuerDID w3c.DID, purpose string) (*domain.StatusList, error) {
+	sql := `SELECT id, issuer_id, purpose, size, next_index, bitstring, credential, created_at, updated_at
+FROM status_lists
+WHERE issuer_id=$1 AND purpose=$2
+ORDER BY created_at DESC
+LIMIT 1`
+	return s.get(ctx, conn, sql, issuerDID.String(), purpose)
+}
+
+// AllocateIndex returns the next free index of the status list. Concurrent allocations never get the same index.
+func (s *statusList) AllocateIndex(ctx context.Context, conn db.Querier, id uuid.UUID) (int, error) {
+	sql := `UPDATE status_lists SET next_index=next_index+1
+WHERE id=$1 AND next_index < size
+RETURNING next_index-1`
+	var index int
+	if err := conn.QueryRow(ctx, sql, id).Scan(&index); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, StatusListFullErr
+		}
+		return 0, err
+	}
+	return index, nil
+}
+
+func (s *statusList) get(ctx context.Context, conn db.Querier, sql string, args ...interface{}) (*domain.StatusList, error) {
+	var list domain.StatusList
+	err := conn.QueryRow(ctx, sql, args...).Scan(&list.ID, &list.Identifier, &list.Purpose, &list.Size, &list.NextIndex, &list.Bitstring, &list.Credential, &list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, StatusListNotFoundErr
+		}
+		return nil, err
+	}
+	return &list, nil
+}
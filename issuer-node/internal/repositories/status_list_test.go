@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestStatusList_AllocateIndex(t *testing.T) {
+	ctx := context.Background()
+	statusListRepository := NewStatusList()
+	issuerDID := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", issuerDID.String(), "ETH")
+	require.NoError(t, err)
+
+	list := &domain.StatusList{ID: uuid.New(), Identifier: issuerDID.String(), Purpose: "revocation", Size: 2, Bitstring: []byte{0}, Credential: "header.payload.signature"}
+	require.NoError(t, statusListRepository.Save(ctx, storage.Pgx, list))
+
+	t.Run("should allocate every index once", func(t *testing.T) {
+		index, err := statusListRepository.AllocateIndex(ctx, storage.Pgx, list.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, index)
+		index, err = statusListRepository.AllocateIndex(ctx, storage.Pgx, list.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, index)
+		_, err = statusListRepository.AllocateIndex(ctx, storage.Pgx, list.ID)
+		assert.ErrorIs(t, err, StatusListFullErr)
+
+		current, err := statusListRepository.GetCurrent(ctx, storage.Pgx, issuerDID, "revocation")
+		require.NoError(t, err)
+		assert.True(t, current.Full())
+	})
+
+	t.Run("should update the statuses and the credential", func(t *testing.T) {
+		list.Bitstring = []byte{0x80}
+		list.Credential = "header.payload.signature2"
+		require.NoError(t, statusListRepository.Save(ctx, storage.Pgx, list))
+		saved, err := statusListRepository.GetByID(ctx, storage.Pgx, list.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0x80}, saved.Bitstring)
+		assert.Equal(t, list.Credential, saved.Credential)
+		assert.Equal(t, 2, saved.NextIndex)
+	})
+
+	t.Run("should not find an unknown list", func(t *testing.T) {
+		_, err := statusListRepository.GetByID(ctx, storage.Pgx, uuid.New())
+		assert.ErrorIs(t, err, StatusListNotFoundErr)
+	})
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// TenantNotFoundErr is the error returned when the tenant does not exist
+	TenantNotFoundErr = errors.New("tenant not found")
+	// TenantDuplicatedErr is the error returned when the name of the tenant is already in use
+	TenantDuplicatedErr = errors.New("tenant name already exists")
+)
+
+const tenantFields = `tenants.id, tenants.name, tenants.max_identities, tenants.max_credentials_per_month, tenants.issuer_name, tenants.issuer_logo, tenants.created_at`
+
+type tenant struct {
+	conn db.Storage
+}
+
+// NewTenant returns a new repository of tenants
+func NewTenant(conn db.Storage) ports.TenantRepository {
+	return &tenant{conn: conn}
+}
+
+// Save stores a new tenant
+func (r *tenant) Save(ctx context.Context, tenant *domain.Tenant) error {
+	const insertTenant = `INSERT INTO tenants (id, name, max_identities, max_credentials_per_month, issuer_name, issuer_logo, created_at) VALUES($1, $2, $3, $4, $5, $6, $7)`
+	_, err := r.conn.Pgx.Exec(ctx, insertTenant,
+		tenant.ID,
+		tenant.Name,
+		tenant.MaxIdentities,
+		tenant.MaxCredentialsPerMonth,
+		tenant.IssuerName,
+		tenant.IssuerLogo,
+		tenant.CreatedAt,
+	)
+	if err != nil {
+		return tenantError(err)
+	}
+	return nil
+}
+
+// Update stores the name, the quotas and the configuration overrides of the tenant
+func (r *tenant) Update(ctx context.Context, tenant *domain.Tenant) error {
+	const updateTenant = `UPDATE tenants SET name=$2, max_identities=$3, max_credentials_per_month=$4, issuer_name=$5, issuer_logo=$6 WHERE id=$1`
+	res, err := r.conn.Pgx.Exec(ctx, updateTenant,
+		tenant.ID,
+		tenant.Name,
+		tenant.MaxIdentities,
+		tenant.MaxCredentialsPerMonth,
+		tenant.IssuerName,
+		tenant.IssuerLogo,
+	)
+	if err != nil {
+		return tenantError(err)
+	}
+	if res.RowsAffected() == 0 {
+		return TenantNotFoundErr
+	}
+	return nil
+}
+
+// GetByID returns the tenant
+func (r *tenant) GetByID(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error) {
+	return r.get(ctx, conn, `SELECT `+tenantFields+` FROM tenants WHERE id=$1`, id)
+}
+
+// GetByIssuer returns the tenant that owns the issuer
+func (r *tenant) GetByIssuer(ctx context.Context, issuerDID w3c.DID) (*domain.Tenant, error) {
+	sql := `SELECT ` + tenantFields + ` FROM tenants JOIN identities ON identities.tenant_id = tenants.id WHERE identities.identifier=$1`
+	return r.get(ctx, r.conn.Pgx, sql, issuerDID.String())
+}
+
+// GetAll returns the tenants of the node, by name
+func (r *tenant) GetAll(ctx context.Context) ([]domain.Tenant, error) {
+	rows, err := r.conn.Pgx.Query(ctx, `SELECT `+tenantFields+` FROM tenants ORDER BY name`)
+	if err != nil {
+		return nil, err
+	}
+	return scanTenants(rows)
+}
+
+// Lock returns the tenant and locks it until the end of the transaction of conn, so its quotas can be checked
+// without races
+func (r *tenant) Lock(ctx context.Context, conn db.Querier, id uuid.UUID) (*domain.Tenant, error) {
+	return r.get(ctx, conn, `SELECT `+tenantFields+` FROM tenants WHERE id=$1 FOR UPDATE`, id)
+}
+
+// CountIdentities returns the number of identities of the tenant
+func (r *tenant) CountIdentities(ctx context.Context, conn db.Querier, id uuid.UUID) (int, error) {
+	var count int
+	if err := conn.QueryRow(ctx, `SELECT COUNT(*) FROM identities WHERE tenant_id=$1`, id).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// CountCredentials returns the number of credentials issued by the issuers of the tenant and by the onchain issuers
+// they control since the date, without the auth credentials of the issuers
+func (r *tenant) CountCredentials(ctx context.Context, conn db.Querier, id uuid.UUID, since time.Time) (int, error) {
+	const countCredentials = `SELECT COUNT(*) FROM claims
+		WHERE claims.issuer IN (
+			SELECT identifier FROM identities WHERE tenant_id=$1
+			UNION SELECT onchain_issuers.identifier FROM onchain_issuers
+			JOIN identities ON identities.identifier = onchain_issuers.controller_did WHERE identities.tenant_id=$1
+		) AND claims.created_at>=$2 AND claims.schema_type<>$3`
+	var count int
+	if err := conn.QueryRow(ctx, countCredentials, id, since, domain.AuthBJJCredentialSchemaType).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *tenant) get(ctx context.Context, conn db.Querier, sql string, args ...interface{}) (*domain.Tenant, error) {
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	tenants, err := scanTenants(rows)
+	if err != nil {
+		return nil, err
+	}
+	if len(tenants) == 0 {
+		return nil, TenantNotFoundErr
+	}
+	return &tenants[0], nil
+}
+
+func tenantError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == duplicateViolationErrorCode {
+		return TenantDuplicatedErr
+	}
+	return fmt.Errorf("failed to save tenant: %w", err)
+}
+
+func scanTenants(rows pgx.Rows) ([]domain.Tenant, error) {
+	defer rows.Close()
+	tenants := make([]domain.Tenant, 0)
+	for rows.Next() {
+		var tenant domain.Tenant
+		if err := rows.Scan(&tenant.ID, &tenant.Name, &tenant.MaxIdentities, &tenant.MaxCredentialsPerMonth, &tenant.IssuerName, &tenant.IssuerLogo, &tenant.CreatedAt); err != nil {
+			return nil, err
+		}
+		tenants = append(tenants, tenant)
+	}
+	return tenants, rows.Err()
+}
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+)
+
+func TestTenant(t *testing.T) {
+	ctx := context.Background()
+	fixture := NewFixture(storage)
+	repo := NewTenant(*storage)
+
+	tenant, err := domain.NewTenant("acme " + uuid.NewString())
+	require.NoError(t, err)
+	tenant.MaxIdentities = common.ToPointer(1)
+	require.NoError(t, repo.Save(ctx, tenant))
+	assert.ErrorIs(t, repo.Save(ctx, &domain.Tenant{ID: uuid.New(), Name: tenant.Name, CreatedAt: time.Now()}), TenantDuplicatedErr)
+
+	issuerDID := randomDID(t)
+	fixture.CreateIdentity(t, &domain.Identity{Identifier: issuerDID.String(), TenantID: tenant.ID})
+
+	t.Run("should get the tenant of the issuer", func(t *testing.T) {
+		saved, err := repo.GetByIssuer(ctx, issuerDID)
+		require.NoError(t, err)
+		assert.Equal(t, tenant.ID, saved.ID)
+		require.NotNil(t, saved.MaxIdentities)
+		assert.Equal(t, 1, *saved.MaxIdentities)
+		assert.Nil(t, saved.IssuerName)
+
+		_, err = repo.GetByIssuer(ctx, randomDID(t))
+		assert.ErrorIs(t, err, TenantNotFoundErr)
+	})
+
+	t.Run("should update the tenant", func(t *testing.T) {
+		tenant.IssuerName = common.ToPointer("Acme")
+		require.NoError(t, repo.Update(ctx, tenant))
+		saved, err := repo.GetByID(ctx, storage.Pgx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Acme", *saved.IssuerName)
+
+		assert.ErrorIs(t, repo.Update(ctx, &domain.Tenant{ID: uuid.New(), Name: "unknown"}), TenantNotFoundErr)
+	})
+
+	t.Run("should count the identities and the credentials of the tenant", func(t *testing.T) {
+		count, err := repo.CountIdentities(ctx, storage.Pgx, tenant.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		count, err = repo.CountCredentials(ctx, storage.Pgx, tenant.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 0, count)
+		auth := fixture.NewClaim(t, issuerDID.String())
+		auth.SchemaType = domain.AuthBJJCredentialSchemaType
+		auth.CreatedAt = time.Now()
+		fixture.CreateClaim(t, auth)
+		claim := fixture.NewClaim(t, issuerDID.String())
+		claim.SchemaType = "KYCAgeCredential"
+		claim.CreatedAt = time.Now()
+		fixture.CreateClaim(t, claim)
+		count, err = repo.CountCredentials(ctx, storage.Pgx, tenant.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		onchainDID := randomDID(t)
+		require.NoError(t, NewOnchainIssuer(*storage).Save(ctx, &domain.OnchainIssuer{
+			ID:              uuid.New(),
+			Identifier:      onchainDID.String(),
+			ControllerDID:   issuerDID.String(),
+			ContractAddress: "0x134B1BE34911E39A8397ec6289782989729807a4",
+			ChainID:         80002,
+			Blockchain:      "polygon",
+			Network:         "amoy",
+		}))
+		onchain := fixture.NewClaim(t, onchainDID.String())
+		onchain.SchemaType = "KYCAgeCredential"
+		onchain.CreatedAt = time.Now()
+		fixture.CreateClaim(t, onchain)
+		count, err = repo.CountCredentials(ctx, storage.Pgx, tenant.ID, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, count, "credentials of the onchain issuers controlled by the tenant identities")
+	})
+
+	t.Run("should assign identities without tenant to the default one", func(t *testing.T) {
+		did := randomDID(t)
+		fixture.CreateIdentity(t, &domain.Identity{Identifier: did.String()})
+		saved, err := repo.GetByIssuer(ctx, did)
+		require.NoError(t, err)
+		assert.Equal(t, domain.DefaultTenantID, saved.ID)
+	})
+}
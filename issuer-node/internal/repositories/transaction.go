@@ -0,0 +1,247 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+	"github.com/polygonid/sh-id-platform/internal/db"
+)
+
+var (
+	// TransactionNotFoundErr is the error returned when the transaction is not found
+	TransactionNotFoundErr = errors.New("transaction not found")
+	// TransactionConflictErr is the error returned when the tx_id is already recorded for the reference with another issuer or transaction type
+	TransactionConflictErr = errors.New("transaction already recorded for another issuer or type")
+)
+
+// Transaction represents the transaction history repository
+type Transaction struct {
+	conn db.Storage
+}
+
+// NewTransaction creates a new transaction history repository
+func NewTransaction(conn db.Storage) ports.TransactionRepository {
+	return &Transaction{
+		conn,
+	}
+}
+
+// Save stores the given transaction. If a transaction with the same reference and tx_id already exists for the same
+// issuer and type, its status and receipt data are updated. Otherwise, the existing transaction is left untouched
+// and TransactionConflictErr is returned.
+func (t *Transaction) Save(ctx context.Context, tx *domain.Transaction) error {
+	sql := `INSERT INTO transactions (id, issuer_did, network, type, reference, tx_id, status, gas_used, effective_gas_price, fee, block_number)
+			VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) ON CONFLICT (reference, tx_id) DO
+			UPDATE SET status=$7, gas_used=$8, effective_gas_price=$9, fee=$10, block_number=$11, modified_at=NOW()
+			WHERE transactions.issuer_did=EXCLUDED.issuer_did AND transactions.type=EXCLUDED.type`
+	tag, err := t.conn.Pgx.Exec(ctx, sql,
+		tx.ID,
+		tx.IssuerDID,
+		tx.Network,
+		tx.Type,
+		tx.Reference,
+		tx.TxID,
+		tx.Status,
+		bigIntToString(tx.GasUsed),
+		bigIntToString(tx.EffectiveGasPrice),
+		bigIntToString(tx.Fee),
+		tx.BlockNumber,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save transaction: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return TransactionConflictErr
+	}
+	return nil
+}
+
+// SaveReplacement stores newTxID as a pending transaction replacing oldTxID in the mempool, with the same issuer,
+// network, type and reference. If newTxID is already recorded for the reference, e.g. with its receipt, it's linked
+// to oldTxID. It returns the number of saved rows, 0 if oldTxID is not found.
+func (t *Transaction) SaveReplacement(ctx context.Context, reference string, oldTxID string, newTxID string) (int64, error) {
+	sql := `INSERT INTO transactions (id, issuer_did, network, type, reference, tx_id, status, replaces)
+			SELECT $1, issuer_did, network, type, reference, $4, $5, tx_id FROM transactions WHERE reference=$2 AND tx_id=$3
+			ON CONFLICT (reference, tx_id) DO UPDATE SET replaces=EXCLUDED.replaces, modified_at=NOW()
+			WHERE transactions.issuer_did=EXCLUDED.issuer_did AND transactions.type=EXCLUDED.type`
+	tag, err := t.conn.Pgx.Exec(ctx, sql, uuid.New(), reference, oldTxID, newTxID, domain.TransactionStatusPending)
+	if err != nil {
+		return 0, fmt.Errorf("failed to save transaction replacement: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetByTxID returns the transaction with the given tx_id sent for the reference
+func (t *Transaction) GetByTxID(ctx context.Context, reference string, txID string) (*domain.Transaction, error) {
+	sql := `SELECT id, issuer_did, network, type, reference, tx_id, status, gas_used::text, effective_gas_price::text, fee::text,
+       block_number, replaces, created_at, modified_at
+FROM transactions
+WHERE reference=$1 AND tx_id=$2`
+	var tx domain.Transaction
+	var gasUsed, effectiveGasPrice, fee *string
+	err := t.conn.Pgx.QueryRow(ctx, sql, reference, txID).Scan(
+		&tx.ID,
+		&tx.IssuerDID,
+		&tx.Network,
+		&tx.Type,
+		&tx.Reference,
+		&tx.TxID,
+		&tx.Status,
+		&gasUsed,
+		&effectiveGasPrice,
+		&fee,
+		&tx.BlockNumber,
+		&tx.Replaces,
+		&tx.CreatedAt,
+		&tx.ModifiedAt,
+	)
+	if err != nil {
+		if strings.Contains(err.Error(), "no rows in result set") {
+			return nil, TransactionNotFoundErr
+		}
+		return nil, err
+	}
+	if tx.GasUsed, err = stringToBigInt(gasUsed); err != nil {
+		return nil, err
+	}
+	if tx.EffectiveGasPrice, err = stringToBigInt(effectiveGasPrice); err != nil {
+		return nil, err
+	}
+	if tx.Fee, err = stringToBigInt(fee); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// GetByReference returns the transactions of the given type sent for the reference, the original one and its
+// replacements, from the newest to the oldest.
+func (t *Transaction) GetByReference(ctx context.Context, txType domain.TransactionType, reference string) ([]domain.Transaction, error) {
+	sql := `SELECT id, issuer_did, network, type, reference, tx_id, status, gas_used::text, effective_gas_price::text, fee::text,
+       block_number, replaces, created_at, modified_at
+FROM transactions
+WHERE type=$1 AND reference=$2
+ORDER BY created_at DESC`
+	rows, err := t.conn.Pgx.Query(ctx, sql, txType, reference)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	txs := make([]domain.Transaction, 0)
+	for rows.Next() {
+		var tx domain.Transaction
+		var gasUsed, effectiveGasPrice, fee *string
+		if err := rows.Scan(
+			&tx.ID,
+			&tx.IssuerDID,
+			&tx.Network,
+			&tx.Type,
+			&tx.Reference,
+			&tx.TxID,
+			&tx.Status,
+			&gasUsed,
+			&effectiveGasPrice,
+			&fee,
+			&tx.BlockNumber,
+			&tx.Replaces,
+			&tx.CreatedAt,
+			&tx.ModifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		if tx.GasUsed, err = stringToBigInt(gasUsed); err != nil {
+			return nil, err
+		}
+		if tx.EffectiveGasPrice, err = stringToBigInt(effectiveGasPrice); err != nil {
+			return nil, err
+		}
+		if tx.Fee, err = stringToBigInt(fee); err != nil {
+			return nil, err
+		}
+		txs = append(txs, tx)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return txs, nil
+}
+
+// GetCostTotals returns the number of transactions, replacements, gas used and fees paid by the issuer,
+// grouped by network and transaction type. The payment transactions are paid by the holders, so they are left out.
+// The date range is applied to the creation date of the transaction.
+func (t *Transaction) GetCostTotals(ctx context.Context, issuerDID w3c.DID, filter ports.TransactionCostFilter) ([]domain.TransactionCostTotal, error) {
+	sql := `SELECT network, type, COUNT(*) FILTER (WHERE replaces IS NULL), COUNT(*) FILTER (WHERE replaces IS NOT NULL),
+       COALESCE(SUM(gas_used), 0)::text, COALESCE(SUM(fee), 0)::text
+FROM transactions
+WHERE issuer_did = $1 AND type <> $2`
+	sqlArgs := []interface{}{issuerDID.String(), domain.TransactionTypePayment}
+	if filter.From != nil {
+		sqlArgs = append(sqlArgs, *filter.From)
+		sql += fmt.Sprintf(" AND created_at >= $%d", len(sqlArgs))
+	}
+	if filter.To != nil {
+		sqlArgs = append(sqlArgs, *filter.To)
+		sql += fmt.Sprintf(" AND created_at < $%d", len(sqlArgs))
+	}
+	if filter.Network != nil {
+		sqlArgs = append(sqlArgs, *filter.Network)
+		sql += fmt.Sprintf(" AND network = $%d", len(sqlArgs))
+	}
+	sql += " GROUP BY network, type ORDER BY network, type"
+
+	rows, err := t.conn.Pgx.Query(ctx, sql, sqlArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	totals := make([]domain.TransactionCostTotal, 0)
+	for rows.Next() {
+		var total domain.TransactionCostTotal
+		var gasUsed, fee *string
+		if err := rows.Scan(&total.Network, &total.Type, &total.Transactions, &total.Replacements, &gasUsed, &fee); err != nil {
+			return nil, err
+		}
+		if total.GasUsed, err = stringToBigInt(gasUsed); err != nil {
+			return nil, err
+		}
+		if total.Fee, err = stringToBigInt(fee); err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return totals, nil
+}
+
+func bigIntToString(value *big.Int) *string {
+	if value == nil {
+		return nil
+	}
+	s := value.String()
+	return &s
+}
+
+func stringToBigInt(value *string) (*big.Int, error) {
+	const base10 = 10
+	if value == nil {
+		return nil, nil
+	}
+	// numeric sums may be returned with a fractional part (e.g. 100.0)
+	integer, _, _ := strings.Cut(*value, ".")
+	n, ok := new(big.Int).SetString(integer, base10)
+	if !ok {
+		return nil, fmt.Errorf("could not parse numeric value into big.Int: %s", *value)
+	}
+	return n, nil
+}
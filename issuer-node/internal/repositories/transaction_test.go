@@ -0,0 +1,180 @@
+package repositories
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/common"
+	"github.com/polygonid/sh-id-platform/internal/core/domain"
+	"github.com/polygonid/sh-id-platform/internal/core/ports"
+)
+
+func TestTransaction_SaveAndReplacement(t *testing.T) {
+	ctx := context.Background()
+	transactionRepository := NewTransaction(*storage)
+	did := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", did.String(), "BJJ")
+	require.NoError(t, err)
+
+	reference := "state" + did.String()
+	tx := domain.NewTransaction(did.String(), "privado:main", domain.TransactionTypeStateTransition, reference, "0x01"+did.String())
+
+	t.Run("should save a pending transaction", func(t *testing.T) {
+		require.NoError(t, transactionRepository.Save(ctx, tx))
+		saved, err := transactionRepository.GetByTxID(ctx, reference, tx.TxID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.TransactionStatusPending, saved.Status)
+		assert.Nil(t, saved.Fee)
+		assert.Nil(t, saved.Replaces)
+	})
+
+	newTxID := "0x02" + did.String()
+	t.Run("should save the replacement keeping the original transaction", func(t *testing.T) {
+		inserted, err := transactionRepository.SaveReplacement(ctx, reference, tx.TxID, newTxID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), inserted)
+		original, err := transactionRepository.GetByTxID(ctx, reference, tx.TxID)
+		require.NoError(t, err)
+		assert.Nil(t, original.Replaces)
+		replacement, err := transactionRepository.GetByTxID(ctx, reference, newTxID)
+		require.NoError(t, err)
+		assert.Equal(t, tx.TxID, *replacement.Replaces)
+		assert.Equal(t, reference, replacement.Reference)
+		assert.Equal(t, domain.TransactionStatusPending, replacement.Status)
+
+		sent, err := transactionRepository.GetByReference(ctx, domain.TransactionTypeStateTransition, reference)
+		require.NoError(t, err)
+		require.Len(t, sent, 2)
+		assert.Equal(t, newTxID, sent[0].TxID)
+		assert.Equal(t, tx.TxID, sent[1].TxID)
+	})
+
+	t.Run("should update the receipt data of the transaction mined", func(t *testing.T) {
+		mined := domain.NewTransaction(did.String(), "privado:main", domain.TransactionTypeStateTransition, reference, tx.TxID)
+		mined.Status = domain.TransactionStatusSuccess
+		mined.GasUsed = big.NewInt(21000)
+		mined.EffectiveGasPrice = big.NewInt(30000000000)
+		mined.Fee = new(big.Int).Mul(mined.GasUsed, mined.EffectiveGasPrice)
+		mined.BlockNumber = common.ToPointer(int64(100))
+		require.NoError(t, transactionRepository.Save(ctx, mined))
+
+		saved, err := transactionRepository.GetByTxID(ctx, reference, tx.TxID)
+		require.NoError(t, err)
+		assert.Equal(t, tx.ID, saved.ID)
+		assert.Equal(t, domain.TransactionStatusSuccess, saved.Status)
+		assert.Equal(t, "630000000000000", saved.Fee.String())
+		assert.Equal(t, int64(100), *saved.BlockNumber)
+	})
+
+	t.Run("should not overwrite the transaction of another issuer or type", func(t *testing.T) {
+		otherDID := randomDID(t)
+		other := domain.NewTransaction(otherDID.String(), "privado:main", domain.TransactionTypeStateTransition, reference, newTxID)
+		other.Status = domain.TransactionStatusFailed
+		assert.ErrorIs(t, transactionRepository.Save(ctx, other), TransactionConflictErr)
+
+		other = domain.NewTransaction(did.String(), "privado:main", domain.TransactionTypeOnchainCredential, reference, newTxID)
+		other.Status = domain.TransactionStatusFailed
+		assert.ErrorIs(t, transactionRepository.Save(ctx, other), TransactionConflictErr)
+
+		saved, err := transactionRepository.GetByTxID(ctx, reference, newTxID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.TransactionStatusPending, saved.Status)
+	})
+
+	t.Run("should save the same tx_id for another reference", func(t *testing.T) {
+		payment := domain.NewTransaction(did.String(), "privado:main", domain.TransactionTypePayment, "payment"+did.String(), newTxID)
+		require.NoError(t, transactionRepository.Save(ctx, payment))
+		saved, err := transactionRepository.GetByTxID(ctx, reference, newTxID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.TransactionTypeStateTransition, saved.Type)
+	})
+
+	t.Run("should link a replacement already recorded with its receipt", func(t *testing.T) {
+		mined := domain.NewTransaction(did.String(), "privado:main", domain.TransactionTypeStateTransition, reference, "0x03"+did.String())
+		mined.Status = domain.TransactionStatusSuccess
+		require.NoError(t, transactionRepository.Save(ctx, mined))
+		saved, err := transactionRepository.SaveReplacement(ctx, reference, newTxID, mined.TxID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), saved)
+		replacement, err := transactionRepository.GetByTxID(ctx, reference, mined.TxID)
+		require.NoError(t, err)
+		assert.Equal(t, newTxID, *replacement.Replaces)
+		assert.Equal(t, domain.TransactionStatusSuccess, replacement.Status)
+	})
+
+	t.Run("should return 0 inserted rows replacing an unknown transaction", func(t *testing.T) {
+		inserted, err := transactionRepository.SaveReplacement(ctx, reference, "0xunknown", "0xother")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), inserted)
+	})
+}
+
+func TestTransaction_GetCostTotals(t *testing.T) {
+	ctx := context.Background()
+	transactionRepository := NewTransaction(*storage)
+	did := randomDID(t)
+	_, err := storage.Pgx.Exec(ctx, "INSERT INTO identities (identifier, keytype) VALUES ($1, $2)", did.String(), "BJJ")
+	require.NoError(t, err)
+
+	save := func(network string, txType domain.TransactionType, txID string, fee int64) {
+		tx := domain.NewTransaction(did.String(), network, txType, "reference", txID+did.String())
+		tx.Status = domain.TransactionStatusSuccess
+		tx.GasUsed = big.NewInt(fee)
+		tx.EffectiveGasPrice = big.NewInt(1)
+		tx.Fee = big.NewInt(fee)
+		require.NoError(t, transactionRepository.Save(ctx, tx))
+	}
+	save("polygon:amoy", domain.TransactionTypeStateTransition, "0xa1", 100)
+	save("polygon:amoy", domain.TransactionTypeStateTransition, "0xa2", 200)
+	save("polygon:amoy", domain.TransactionTypeOnchainCredential, "0xa3", 50)
+	save("privado:main", domain.TransactionTypeStateTransition, "0xb1", 10)
+	save("polygon:amoy", domain.TransactionTypePayment, "0xa4", 1000)
+	pending := domain.NewTransaction(did.String(), "privado:main", domain.TransactionTypeStateTransition, "reference", "0xb2"+did.String())
+	require.NoError(t, transactionRepository.Save(ctx, pending))
+	_, err = transactionRepository.SaveReplacement(ctx, "reference", pending.TxID, "0xb3"+did.String())
+	require.NoError(t, err)
+
+	t.Run("should return the totals grouped by network and type", func(t *testing.T) {
+		totals, err := transactionRepository.GetCostTotals(ctx, did, ports.TransactionCostFilter{})
+		require.NoError(t, err)
+		require.Len(t, totals, 3, "the payments are paid by the holders")
+
+		assert.Equal(t, "polygon:amoy", totals[0].Network)
+		assert.Equal(t, domain.TransactionTypeOnchainCredential, totals[0].Type)
+		assert.Equal(t, "50", totals[0].Fee.String())
+
+		assert.Equal(t, domain.TransactionTypeStateTransition, totals[1].Type)
+		assert.Equal(t, 2, totals[1].Transactions)
+		assert.Equal(t, "300", totals[1].Fee.String())
+		assert.Equal(t, "300", totals[1].GasUsed.String())
+
+		assert.Equal(t, "privado:main", totals[2].Network)
+		assert.Equal(t, 2, totals[2].Transactions, "the replacements are not counted as transactions")
+		assert.Equal(t, 1, totals[2].Replacements)
+		assert.Equal(t, "10", totals[2].Fee.String())
+	})
+
+	t.Run("should filter by network", func(t *testing.T) {
+		totals, err := transactionRepository.GetCostTotals(ctx, did, ports.TransactionCostFilter{Network: common.ToPointer("privado:main")})
+		require.NoError(t, err)
+		require.Len(t, totals, 1)
+		assert.Equal(t, "privado:main", totals[0].Network)
+	})
+
+	t.Run("should filter by date range", func(t *testing.T) {
+		future := time.Now().Add(time.Hour)
+		totals, err := transactionRepository.GetCostTotals(ctx, did, ports.TransactionCostFilter{From: &future})
+		require.NoError(t, err)
+		assert.Empty(t, totals)
+
+		past := time.Now().Add(-time.Hour)
+		totals, err = transactionRepository.GetCostTotals(ctx, did, ports.TransactionCostFilter{From: &past, To: &future})
+		require.NoError(t, err)
+		assert.Len(t, totals, 3)
+	})
+}
@@ -7,7 +7,6 @@ import (
 
 	ethCommon "github.com/ethereum/go-ethereum/common"
 	proof "github.com/iden3/merkletree-proof"
-	proofEth "github.com/iden3/merkletree-proof/eth"
 	proofHttp "github.com/iden3/merkletree-proof/http"
 
 	"github.com/polygonid/sh-id-platform/internal/kms"
@@ -100,11 +99,9 @@ func (f *factory) initOffChainRHS(ctx context.Context, resolverPrefix string) (p
 	}, nil
 }
 
+// initOnChainRHSCli returns the client of the on-chain reverse hash service. Its transactions are sent with kmsKey,
+// taking the nonces from the tracker of the eth client of the network, see onChainRHSCli.
 func (f *factory) initOnChainRHSCli(ctx context.Context, resolverPrefix string, kmsKey *kms.KeyID) (proof.ReverseHashCli, error) {
-	// TODO:
-	// This can be a  problem in the future.
-	// Since between counting the miner tip and using this transaction option can be a big time gap.
-	// And while executing a transaction, we can have bigger tips on the network than we counted.
 	rhsSettings, err := f.networkResolver.GetRhsSettings(ctx, resolverPrefix)
 	if err != nil {
 		return nil, err
@@ -116,18 +113,12 @@ func (f *factory) initOnChainRHSCli(ctx context.Context, resolverPrefix string,
 		return nil, err
 	}
 
-	txOpts, err := ethClient.CreateTxOpts(ctx, *kmsKey)
-	if err != nil {
-		log.Error(ctx, "failed to create tx opts", "err", err)
-		return nil, err
-	}
-
 	if rhsSettings.ContractAddress == nil || *rhsSettings.ContractAddress == "" {
 		return nil, errors.New("rhs contract address must be configured")
 	}
 
 	contractAddress := ethCommon.HexToAddress(*rhsSettings.ContractAddress)
-	cli, err := proofEth.NewReverseHashCli(ethClient.GetEthereumClient(), contractAddress, txOpts.From, txOpts.Signer)
+	cli, err := newOnChainRHSCli(ethClient, contractAddress, *kmsKey, f.responseTimeout)
 	if err != nil {
 		log.Error(ctx, "failed to create on-chain rhs client", "err", err)
 		return nil, err
@@ -0,0 +1,78 @@
+package reversehash
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	rhsABI "github.com/iden3/contracts-abi/rhs-storage/go/abi"
+	proof "github.com/iden3/merkletree-proof"
+	proofEth "github.com/iden3/merkletree-proof/eth"
+
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+	"github.com/polygonid/sh-id-platform/internal/log"
+)
+
+// onChainRHSCli reads the nodes of the on-chain reverse hash service with the merkletree-proof client, and saves them
+// with transactions built by the eth client. So their nonces come from the same tracker as the ones of the state
+// transitions published with the same key, and their fees are capped the same way.
+type onChainRHSCli struct {
+	*proofEth.ReverseHashCli
+	ethClient *eth.Client
+	contract  *rhsABI.IRHSStorage
+	kmsKey    kms.KeyID
+	timeout   time.Duration
+}
+
+func newOnChainRHSCli(ethClient *eth.Client, contractAddress ethCommon.Address, kmsKey kms.KeyID, timeout time.Duration) (*onChainRHSCli, error) {
+	// the merkletree-proof client is only used to read nodes, so it doesn't need the sender nor the signer
+	reader, err := proofEth.NewReverseHashCli(ethClient.GetEthereumClient(), contractAddress, ethCommon.Address{}, nil, proofEth.WithRPCTimeout(timeout))
+	if err != nil {
+		return nil, err
+	}
+	contract, err := rhsABI.NewIRHSStorage(contractAddress, ethClient.GetEthereumClient())
+	if err != nil {
+		return nil, err
+	}
+	return &onChainRHSCli{
+		ReverseHashCli: reader,
+		ethClient:      ethClient,
+		contract:       contract,
+		kmsKey:         kmsKey,
+		timeout:        timeout,
+	}, nil
+}
+
+// SaveNodes sends a saveNodes transaction to the reverse hash service contract, without waiting for its receipt
+func (c *onChainRHSCli) SaveNodes(ctx context.Context, nodes []proof.Node) error {
+	nodesBigInt := make([][]*big.Int, len(nodes))
+	for i, node := range nodes {
+		nodesBigInt[i] = make([]*big.Int, len(node.Children))
+		for j, child := range node.Children {
+			nodesBigInt[i][j] = child.BigInt()
+		}
+	}
+
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	opts, err := c.ethClient.CreateTxOpts(ctx, c.kmsKey)
+	if err != nil {
+		log.Error(ctx, "failed to create tx opts", "err", err)
+		return err
+	}
+	opts.Context = ctx
+	tx, err := c.contract.SaveNodes(opts, nodesBigInt)
+	if err != nil {
+		c.ethClient.ReleaseNonceAfterError(opts, err)
+		log.Error(ctx, "failed to save nodes in the on-chain rhs", "err", err, "nonce", opts.Nonce)
+		return err
+	}
+	log.Info(ctx, "nodes sent to the on-chain rhs", "tx", tx.Hash().Hex(), "nonce", tx.Nonce())
+	return nil
+}
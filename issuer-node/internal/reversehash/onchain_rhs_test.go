@@ -0,0 +1,95 @@
+package reversehash
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/iden3/go-merkletree-sql/v2"
+	proof "github.com/iden3/merkletree-proof"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/polygonid/sh-id-platform/internal/eth"
+	"github.com/polygonid/sh-id-platform/internal/kms"
+)
+
+func TestOnChainRHSCli_SaveNodes(t *testing.T) {
+	ctx := context.Background()
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := crypto.PubkeyToAddress(privKey.PublicKey)
+	// the contract stops on any call, so it accepts the saveNodes transactions
+	rhsContract := common.HexToAddress("0x000000000000000000000000000000000000c0de")
+
+	ipcPath := filepath.Join(t.TempDir(), "sim.ipc")
+	backend := simulated.NewBackend(types.GenesisAlloc{
+		from:        {Balance: new(big.Int).Mul(big.NewInt(1000), big.NewInt(1e18))},
+		rhsContract: {Code: []byte{0x00}},
+	}, func(nodeConf *node.Config, _ *ethconfig.Config) {
+		nodeConf.IPCPath = ipcPath
+	})
+	t.Cleanup(func() { _ = backend.Close() })
+	ethClient, err := ethclient.Dial(ipcPath)
+	require.NoError(t, err)
+	t.Cleanup(ethClient.Close)
+
+	keysFile := filepath.Join(t.TempDir(), "kms_localstorage_keys.json")
+	content := fmt.Sprintf(`[{"key_type":"ethereum","key_path":"pbkey","private_key":%q}]`, hex.EncodeToString(crypto.FromECDSA(privKey)))
+	require.NoError(t, os.WriteFile(keysFile, []byte(content), 0o600))
+	keyStore := kms.NewKMS()
+	require.NoError(t, keyStore.RegisterKeyProvider(kms.KeyTypeEthereum, kms.NewLocalEthKeyProvider(kms.KeyTypeEthereum, kms.NewFileStorageManager(keysFile))))
+	keyID := kms.KeyID{Type: kms.KeyTypeEthereum, ID: "pbkey"}
+	client := eth.NewClient(ethClient, &eth.ClientConfig{RPCResponseTimeout: 5 * time.Second}, keyStore)
+
+	cli, err := newOnChainRHSCli(client, rhsContract, keyID, 5*time.Second)
+	require.NoError(t, err)
+
+	// a state transition of the same key is being built, its nonce is not in the node mempool yet
+	stateTransition, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), stateTransition.Nonce.Uint64())
+
+	child, err := merkletree.NewHashFromBigInt(big.NewInt(1))
+	require.NoError(t, err)
+	require.NoError(t, cli.SaveNodes(ctx, []proof.Node{{Hash: child, Children: []*merkletree.Hash{child, child}}}))
+
+	chainID, err := client.ChainID(ctx)
+	require.NoError(t, err)
+	baseFee, err := client.BaseFee(ctx)
+	require.NoError(t, err)
+	tx, err := stateTransition.Signer(from, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     stateTransition.Nonce.Uint64(),
+		GasTipCap: stateTransition.GasTipCap,
+		GasFeeCap: new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), stateTransition.GasTipCap),
+		Gas:       21000,
+		To:        &from,
+	}))
+	require.NoError(t, err)
+	require.NoError(t, client.SendRawTx(ctx, tx))
+	backend.Commit()
+
+	block, err := ethClient.BlockByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, block.Transactions(), 2, "no nonce is shared nor skipped")
+	saved := block.Transactions()[1]
+	assert.Equal(t, rhsContract, *saved.To())
+	assert.Equal(t, uint64(1), saved.Nonce(), "the rhs transaction takes the nonce after the one held by the state transition")
+
+	next, err := client.CreateTxOpts(ctx, keyID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), next.Nonce.Uint64())
+}
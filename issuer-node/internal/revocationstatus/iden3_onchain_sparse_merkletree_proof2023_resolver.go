@@ -1,6 +1,8 @@
 package revocationstatus
 
 import (
+	"fmt"
+
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/iden3/go-iden3-core/v2/w3c"
 	"github.com/iden3/go-schema-processor/v2/verifiable"
@@ -18,3 +20,13 @@ func (r *iden3OnChainSparseMerkleTreeProof2023Resolver) resolve(credentialStatus
 		RevocationNonce: nonce,
 	}
 }
+
+// OnchainIssuerCredentialStatus returns the status of a credential issued by an identity contract.
+// The revocation tree of onchain issuers lives in the identity contract itself, so the status has no state.
+func OnchainIssuerCredentialStatus(issuerDID w3c.DID, nonce uint64, contractAddress ethcommon.Address, chainID string) *verifiable.CredentialStatus {
+	return &verifiable.CredentialStatus{
+		ID:              fmt.Sprintf("%s/credentialStatus?revocationNonce=%v&contractAddress=%s:%s", issuerDID.String(), nonce, chainID, contractAddress.Hex()),
+		Type:            verifiable.Iden3OnchainSparseMerkleTreeProof2023,
+		RevocationNonce: nonce,
+	}
+}
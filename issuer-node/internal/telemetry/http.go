@@ -0,0 +1,66 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type operationKey struct{}
+
+// operation is the name of the request, set once it is routed
+type operation struct {
+	name string
+}
+
+// Middleware traces the requests, continuing the traces of the callers, and measures their latency by the operation
+// set with SetOperation, or by route when there is none.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := Start(ctx, "HTTP "+r.Method, trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+
+		op := &operation{}
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r.WithContext(context.WithValue(ctx, operationKey{}, op)))
+
+		status := ww.Status()
+		if status == 0 {
+			status = http.StatusOK
+		}
+		if op.name == "" {
+			op.name = "unmatched"
+			if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePattern() != "" {
+				op.name = rctx.RoutePattern()
+			}
+		}
+		HTTPDuration.WithLabelValues(op.name, strconv.Itoa(status)).Observe(time.Since(start).Seconds())
+		span.SetAttributes(
+			attribute.String("http.request.method", r.Method),
+			attribute.String("http.route", op.name),
+			attribute.Int("http.response.status_code", status),
+		)
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	})
+}
+
+// SetOperation names the trace and the metrics of the request after its operation
+func SetOperation(ctx context.Context, operationID string) {
+	if op, ok := ctx.Value(operationKey{}).(*operation); ok {
+		op.name = operationID
+	}
+	trace.SpanFromContext(ctx).SetName(operationID)
+}
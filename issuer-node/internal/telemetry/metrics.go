@@ -0,0 +1,109 @@
+package telemetry
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "issuer"
+
+var (
+	// HTTPDuration is the latency of the requests to the API by operation id and status code
+	HTTPDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of the requests to the API by operation id and status code",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "code"})
+
+	// CredentialsIssued is the number of credentials issued by the issuers of the node
+	CredentialsIssued = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "credentials_issued_total",
+		Help:      "Credentials issued",
+	})
+
+	// CredentialsRevoked is the number of credentials revoked by the issuers of the node
+	CredentialsRevoked = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "credentials_revoked_total",
+		Help:      "Credentials revoked",
+	})
+
+	// StateTransitions is the number of identity states that reached each status
+	StateTransitions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "state_transitions_total",
+		Help:      "Identity states that reached each status",
+	}, []string{"status"})
+
+	// PublishingDuration is the time to prove and send a state transition, by result
+	PublishingDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "publishing_duration_seconds",
+		Help:      "Time to prove and send a state transition, by result",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 10),
+	}, []string{"result"})
+
+	// PublishingGas is the gas used by the state transition transactions, by network
+	PublishingGas = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "publishing_gas_used",
+		Help:      "Gas used by the state transition transactions, by network",
+		Buckets:   prometheus.ExponentialBuckets(50_000, 1.5, 10),
+	}, []string{"network"})
+
+	// PubSubLag is the time between the publication of a message and its handling, by topic
+	PubSubLag = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "pubsub_lag_seconds",
+		Help:      "Time between the publication of a message and its handling, by topic",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"topic"})
+
+	// KMSSignDuration is the latency of the signatures of the key store, by key provider and key type
+	KMSSignDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "kms_sign_duration_seconds",
+		Help:      "Latency of the signatures of the key store, by key provider and key type",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"provider", "key_type"})
+
+	// CacheRequests is the number of lookups in the cache, by result (hit or miss)
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Lookups in the cache, by result",
+	}, []string{"result"})
+)
+
+var cacheHits, cacheLookups atomic.Int64
+
+func init() {
+	promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "cache_hit_ratio",
+		Help:      "Ratio of the lookups in the cache found since the process started",
+	}, cacheHitRatio)
+}
+
+// ObserveCacheLookup counts a lookup in the cache
+func ObserveCacheLookup(hit bool) {
+	cacheLookups.Add(1)
+	if hit {
+		cacheHits.Add(1)
+		CacheRequests.WithLabelValues("hit").Inc()
+		return
+	}
+	CacheRequests.WithLabelValues("miss").Inc()
+}
+
+func cacheHitRatio() float64 {
+	lookups := cacheLookups.Load()
+	if lookups == 0 {
+		return 0
+	}
+	return float64(cacheHits.Load()) / float64(lookups)
+}
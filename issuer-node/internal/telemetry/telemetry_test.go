@@ -0,0 +1,98 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTracing(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	_, err := Setup(context.Background(), "test", "", 1)
+	require.NoError(t, err)
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func TestInjectExtract(t *testing.T) {
+	recorder := setupTracing(t)
+	ctx, span := Start(context.Background(), "issue credential")
+	carrier := Inject(ctx)
+	require.Contains(t, carrier, "traceparent")
+	span.End()
+
+	_, child := Start(Extract(context.Background(), carrier), "notification push")
+	child.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, spans[0].SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Equal(t, spans[0].SpanContext().SpanID(), spans[1].Parent().SpanID())
+
+	t.Run("should not inject without trace", func(t *testing.T) {
+		assert.Nil(t, Inject(context.Background()))
+		assert.Equal(t, context.Background(), Extract(context.Background(), nil))
+	})
+}
+
+func TestMiddleware(t *testing.T) {
+	recorder := setupTracing(t)
+	mux := chi.NewRouter()
+	mux.Use(Middleware)
+	mux.Get("/v2/identities/{identifier}", func(w http.ResponseWriter, r *http.Request) {
+		SetOperation(r.Context(), "GetIdentityDetails")
+		assert.True(t, trace.SpanFromContext(r.Context()).SpanContext().IsValid())
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.Get("/status", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	before := testutil.CollectAndCount(HTTPDuration)
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v2/identities/did:iden3:1", nil))
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/status", nil))
+	assert.Equal(t, before+2, testutil.CollectAndCount(HTTPDuration))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "GetIdentityDetails", spans[0].Name())
+	assert.Equal(t, "HTTP GET", spans[1].Name())
+	assert.Equal(t, "Error", spans[1].Status().Code.String())
+
+	t.Run("should continue the trace of the caller", func(t *testing.T) {
+		ctx, span := Start(context.Background(), "caller")
+		req := httptest.NewRequest(http.MethodGet, "/status", nil)
+		for k, v := range Inject(ctx) {
+			req.Header.Set(k, v)
+		}
+		mux.ServeHTTP(httptest.NewRecorder(), req)
+		span.End()
+		spans := recorder.Ended()
+		assert.Equal(t, span.SpanContext().TraceID(), spans[2].SpanContext().TraceID())
+	})
+}
+
+func TestObserveCacheLookup(t *testing.T) {
+	cacheHits.Store(0)
+	cacheLookups.Store(0)
+	assert.Zero(t, cacheHitRatio())
+	ObserveCacheLookup(true)
+	ObserveCacheLookup(true)
+	ObserveCacheLookup(true)
+	ObserveCacheLookup(false)
+	assert.Equal(t, 0.75, cacheHitRatio())
+}
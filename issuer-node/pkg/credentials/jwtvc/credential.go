@@ -0,0 +1,69 @@
+package jwtvc
+
+import (
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+const (
+	// VCDM2ContextURL is the base context of the W3C Verifiable Credentials Data Model 2.0
+	VCDM2ContextURL = "https://www.w3.org/ns/credentials/v2"
+	// VCDM1ContextURL is the base context of the W3C Verifiable Credentials Data Model 1.1
+	VCDM1ContextURL = "https://www.w3.org/2018/credentials/v1"
+)
+
+// Credential is a W3C Verifiable Credentials Data Model 2.0 credential without embedded proofs.
+// It is the payload secured by the JWT based formats.
+type Credential struct {
+	Context           []string                     `json:"@context"`
+	ID                string                       `json:"id,omitempty"`
+	Type              []string                     `json:"type"`
+	Issuer            string                       `json:"issuer"`
+	ValidFrom         *time.Time                   `json:"validFrom,omitempty"`
+	ValidUntil        *time.Time                   `json:"validUntil,omitempty"`
+	CredentialSubject map[string]interface{}       `json:"credentialSubject"`
+	CredentialSchema  *verifiable.CredentialSchema `json:"credentialSchema,omitempty"`
+	CredentialStatus  interface{}                  `json:"credentialStatus,omitempty"`
+}
+
+// FromW3CCredential converts an iden3 credential to the Data Model 2.0. The base context is replaced,
+// the issuance and expiration dates become the validity period and the iden3 proofs are dropped,
+// as the credential is secured by the JWT signature instead.
+func FromW3CCredential(vc *verifiable.W3CCredential) *Credential {
+	contexts := make([]string, 0, len(vc.Context))
+	contexts = append(contexts, VCDM2ContextURL)
+	for _, c := range vc.Context {
+		if c != VCDM1ContextURL && c != VCDM2ContextURL {
+			contexts = append(contexts, c)
+		}
+	}
+
+	return &Credential{
+		Context:           contexts,
+		ID:                vc.ID,
+		Type:              vc.Type,
+		Issuer:            vc.Issuer,
+		ValidFrom:         vc.IssuanceDate,
+		ValidUntil:        vc.Expiration,
+		CredentialSubject: vc.CredentialSubject,
+		CredentialSchema:  &vc.CredentialSchema,
+		CredentialStatus:  vc.CredentialStatus,
+	}
+}
+
+// Subject returns the id of the credential subject, if any
+func (c *Credential) Subject() string {
+	if id, ok := c.CredentialSubject["id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// CredentialType returns the most specific type of the credential
+func (c *Credential) CredentialType() string {
+	if len(c.Type) == 0 {
+		return ""
+	}
+	return c.Type[len(c.Type)-1]
+}
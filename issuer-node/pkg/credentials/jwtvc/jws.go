@@ -0,0 +1,197 @@
+package jwtvc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-core/v2/w3c"
+)
+
+const (
+	// AlgES256K is the JWS algorithm of the credentials: ECDSA over secp256k1 with SHA-256
+	AlgES256K = "ES256K"
+	// EthereumKeyFragment is the fragment of the verification method of ethereum based iden3 identities
+	EthereumKeyFragment = "ethereum-based-id"
+
+	signatureLength = 64
+)
+
+var (
+	// ErrMalformedToken is returned when the token is not a compact JWS
+	ErrMalformedToken = errors.New("malformed token")
+	// ErrUnsupportedAlgorithm is returned when the token is not signed with ES256K
+	ErrUnsupportedAlgorithm = errors.New("unsupported signature algorithm")
+	// ErrUnsupportedIssuer is returned when the key of the issuer can't be resolved from its DID
+	ErrUnsupportedIssuer = errors.New("issuer DID is not an ethereum based iden3 identity")
+	// ErrInvalidSignature is returned when the token is not signed by the issuer
+	ErrInvalidSignature = errors.New("invalid token signature")
+)
+
+// Signer signs a 32 bytes digest with a secp256k1 key and returns the [R || S || V] signature,
+// like the ethereum keys of the issuer KMS.
+type Signer interface {
+	Sign(ctx context.Context, digest []byte) ([]byte, error)
+}
+
+type header struct {
+	Alg string          `json:"alg"`
+	Typ string          `json:"typ"`
+	Kid string          `json:"kid,omitempty"`
+	JWK json.RawMessage `json:"jwk,omitempty"`
+}
+
+// KeyID returns the kid of the tokens signed by the issuer. Keys are resolved from the issuer DID,
+// that for ethereum based identities is built from the address of the key.
+func KeyID(issuer string) string {
+	return fmt.Sprintf("%s#%s", issuer, EthereumKeyFragment)
+}
+
+// IssuerAddress resolves the ethereum address that signs the tokens of the did:iden3 (or did:polygonid) issuer
+func IssuerAddress(issuer string) (common.Address, error) {
+	did, err := w3c.ParseDID(issuer)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrUnsupportedIssuer, err)
+	}
+	id, err := core.IDFromDID(*did)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrUnsupportedIssuer, err)
+	}
+	address, err := core.EthAddressFromID(id)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", ErrUnsupportedIssuer, err)
+	}
+	return address, nil
+}
+
+// Sign returns the payload as a JWT of the given typ, signed with ES256K by the key of the kid
+func Sign(ctx context.Context, typ string, kid string, payload interface{}, signer Signer) (string, error) {
+	return sign(ctx, header{Alg: AlgES256K, Typ: typ, Kid: kid}, payload, signer)
+}
+
+func sign(ctx context.Context, h header, payload interface{}, signer Signer) (string, error) {
+	encodedHeader, err := encodeSegment(h)
+	if err != nil {
+		return "", err
+	}
+	encodedPayload, err := encodeSegment(payload)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := encodedHeader + "." + encodedPayload
+	digest := sha256.Sum256([]byte(signingInput))
+	sig, err := signer.Sign(ctx, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("signing token: %w", err)
+	}
+	if len(sig) < signatureLength {
+		return "", fmt.Errorf("signing token: unexpected signature length %d", len(sig))
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig[:signatureLength]), nil
+}
+
+// jws is a parsed compact JWS
+type jws struct {
+	header       header
+	payload      []byte
+	signingInput string
+	signature    []byte
+}
+
+func parse(token string, typ string) (*jws, error) {
+	t, err := parseWithAlgorithms(token, typ, AlgES256K)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.signature) != signatureLength {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+	return t, nil
+}
+
+func parseWithAlgorithms(token string, typ string, algs ...string) (*jws, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrMalformedToken
+	}
+
+	var h header
+	if err := decodeSegment(parts[0], &h); err != nil {
+		return nil, err
+	}
+	if !slices.Contains(algs, h.Alg) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, h.Alg)
+	}
+	if h.Typ != typ {
+		return nil, fmt.Errorf("%w: unexpected type %s", ErrMalformedToken, h.Typ)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+
+	return &jws{
+		header:       h,
+		payload:      payload,
+		signingInput: parts[0] + "." + parts[1],
+		signature:    sig,
+	}, nil
+}
+
+// verifySignature checks the token is signed by the key resolved from the issuer DID
+func (t *jws) verifySignature(issuer string) error {
+	if t.header.Kid != "" && t.header.Kid != KeyID(issuer) {
+		return fmt.Errorf("%w: unexpected key %s", ErrInvalidSignature, t.header.Kid)
+	}
+	return t.recoverSigner(issuer)
+}
+
+// recoverSigner checks the ES256K signature was made by the ethereum key the DID is built from
+func (t *jws) recoverSigner(did string) error {
+	address, err := IssuerAddress(did)
+	if err != nil {
+		return err
+	}
+
+	// JWS signatures don't carry the recovery id, so both candidates are checked against the issuer address
+	digest := sha256.Sum256([]byte(t.signingInput))
+	for v := byte(0); v < 2; v++ {
+		pub, err := crypto.SigToPub(digest[:], append(append([]byte{}, t.signature...), v))
+		if err == nil && crypto.PubkeyToAddress(*pub) == address {
+			return nil
+		}
+	}
+	return ErrInvalidSignature
+}
+
+func encodeSegment(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	return nil
+}
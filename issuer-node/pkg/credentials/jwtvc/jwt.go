@@ -0,0 +1,82 @@
+package jwtvc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// TypJWT is the typ header of the JWT-VC tokens
+const TypJWT = "JWT"
+
+var (
+	// ErrExpired is returned when the credential is used after its expiration
+	ErrExpired = errors.New("credential has expired")
+	// ErrNotYetValid is returned when the credential is used before its validity period
+	ErrNotYetValid = errors.New("credential is not valid yet")
+)
+
+// jwtClaims is the payload of a JWT-VC, with the registered claims mirroring the credential
+type jwtClaims struct {
+	Iss string      `json:"iss"`
+	Sub string      `json:"sub,omitempty"`
+	Jti string      `json:"jti,omitempty"`
+	Iat int64       `json:"iat"`
+	Nbf int64       `json:"nbf,omitempty"`
+	Exp int64       `json:"exp,omitempty"`
+	VC  *Credential `json:"vc"`
+}
+
+// EncodeJWT returns the credential as a JWT-VC (jwt_vc_json) signed with ES256K by the issuer key
+func EncodeJWT(ctx context.Context, credential *Credential, signer Signer) (string, error) {
+	claims := jwtClaims{
+		Iss: credential.Issuer,
+		Sub: credential.Subject(),
+		Jti: credential.ID,
+		Iat: time.Now().Unix(),
+		VC:  credential,
+	}
+	if credential.ValidFrom != nil {
+		claims.Nbf = credential.ValidFrom.Unix()
+	}
+	if credential.ValidUntil != nil {
+		claims.Exp = credential.ValidUntil.Unix()
+	}
+	return sign(ctx, header{Alg: AlgES256K, Typ: TypJWT, Kid: KeyID(credential.Issuer)}, claims, signer)
+}
+
+// VerifyJWT checks a JWT-VC is signed by the key of its issuer DID and is in its validity period,
+// and returns the credential. The key is resolved from the DID, so no network access is needed.
+func VerifyJWT(token string) (*Credential, error) {
+	t, err := parse(token, TypJWT)
+	if err != nil {
+		return nil, err
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(t.payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	if claims.VC == nil || claims.VC.Issuer != claims.Iss {
+		return nil, fmt.Errorf("%w: vc issuer doesn't match the token issuer", ErrMalformedToken)
+	}
+	if err := t.verifySignature(claims.Iss); err != nil {
+		return nil, err
+	}
+	if err := checkValidity(claims.Nbf, claims.Exp); err != nil {
+		return nil, err
+	}
+	return claims.VC, nil
+}
+
+func checkValidity(nbf, exp int64) error {
+	now := time.Now().Unix()
+	if nbf != 0 && now < nbf {
+		return ErrNotYetValid
+	}
+	if exp != 0 && now >= exp {
+		return ErrExpired
+	}
+	return nil
+}
@@ -0,0 +1,345 @@
+package jwtvc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keySigner signs with an in memory ethereum key the same way the issuer KMS does
+type keySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *keySigner) Sign(_ context.Context, digest []byte) ([]byte, error) {
+	return crypto.Sign(digest, s.key)
+}
+
+func newIssuer(t *testing.T) (*keySigner, string) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	typ, err := core.BuildDIDType(core.DIDMethodIden3, core.Polygon, core.Amoy)
+	require.NoError(t, err)
+	id := core.NewID(typ, core.GenesisFromEthAddress(crypto.PubkeyToAddress(key.PublicKey)))
+	did, err := core.ParseDIDFromID(id)
+	require.NoError(t, err)
+	return &keySigner{key: key}, did.String()
+}
+
+func newCredential(issuer string, validUntil time.Time) *Credential {
+	validFrom := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	return FromW3CCredential(&verifiable.W3CCredential{
+		ID: "urn:uuid:8ac9dbb4-0a2a-11ef-8a0d-0242ac110005",
+		Context: []string{
+			VCDM1ContextURL,
+			"https://schema.iden3.io/core/jsonld/iden3proofs.jsonld",
+			"https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+		},
+		Type:         []string{"VerifiableCredential", "KYCAgeCredential"},
+		Issuer:       issuer,
+		IssuanceDate: &validFrom,
+		Expiration:   &validUntil,
+		CredentialSubject: map[string]interface{}{
+			"id":           "did:iden3:polygon:amoy:x6x5sor7zpySUbxeFoAZUYbUh1kmkjhgUbhW2sbpJ",
+			"type":         "KYCAgeCredential",
+			"birthday":     float64(19960424),
+			"documentType": float64(2),
+		},
+		CredentialSchema: verifiable.CredentialSchema{
+			ID:   "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+			Type: "JsonSchema2023",
+		},
+		Proof: verifiable.CredentialProofs{&verifiable.BJJSignatureProof2021{Type: verifiable.BJJSignatureProofType}},
+	})
+}
+
+func TestFromW3CCredential(t *testing.T) {
+	credential := newCredential("did:iden3:polygon:amoy:x6x5sor7zpySUbxeFoAZUYbUh1kmkjhgUbhW2sbpJ", time.Now().Add(time.Hour))
+	assert.Equal(t, []string{
+		VCDM2ContextURL,
+		"https://schema.iden3.io/core/jsonld/iden3proofs.jsonld",
+		"https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld",
+	}, credential.Context)
+	assert.Equal(t, "KYCAgeCredential", credential.CredentialType())
+	assert.Equal(t, "did:iden3:polygon:amoy:x6x5sor7zpySUbxeFoAZUYbUh1kmkjhgUbhW2sbpJ", credential.Subject())
+}
+
+func TestJWT(t *testing.T) {
+	ctx := context.Background()
+	signer, issuer := newIssuer(t)
+	credential := newCredential(issuer, time.Now().Add(time.Hour).UTC().Truncate(time.Second))
+
+	token, err := EncodeJWT(ctx, credential, signer)
+	require.NoError(t, err)
+
+	t.Run("should verify the credential with the key of the issuer DID", func(t *testing.T) {
+		verified, err := VerifyJWT(token)
+		require.NoError(t, err)
+		assert.Equal(t, credential, verified)
+	})
+
+	t.Run("should reject tokens signed by other keys", func(t *testing.T) {
+		other, _ := newIssuer(t)
+		forged, err := EncodeJWT(ctx, credential, other)
+		require.NoError(t, err)
+		_, err = VerifyJWT(forged)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("should reject issuers that are not ethereum based identities", func(t *testing.T) {
+		bjjIssuer := newCredential("did:iden3:polygon:amoy:x6x5sor7zpySUbxeFoAZUYbUh1kmkjhgUbhW2sbpJ", time.Now().Add(time.Hour))
+		token, err := EncodeJWT(ctx, bjjIssuer, signer)
+		require.NoError(t, err)
+		_, err = VerifyJWT(token)
+		assert.ErrorIs(t, err, ErrUnsupportedIssuer)
+	})
+
+	t.Run("should reject expired credentials", func(t *testing.T) {
+		expired, err := EncodeJWT(ctx, newCredential(issuer, time.Now().Add(-time.Minute)), signer)
+		require.NoError(t, err)
+		_, err = VerifyJWT(expired)
+		assert.ErrorIs(t, err, ErrExpired)
+	})
+}
+
+func TestSDJWT(t *testing.T) {
+	ctx := context.Background()
+	signer, issuer := newIssuer(t)
+	credential := newCredential(issuer, time.Now().Add(time.Hour))
+
+	sdJWT, err := EncodeSDJWT(ctx, credential, signer)
+	require.NoError(t, err)
+
+	t.Run("should hide the subject attributes behind disclosures", func(t *testing.T) {
+		token, disclosures, err := split(sdJWT)
+		require.NoError(t, err)
+		assert.Len(t, disclosures, 3)
+		assert.NotContains(t, token, "birthday")
+	})
+
+	t.Run("should verify all the disclosures", func(t *testing.T) {
+		verified, err := VerifySDJWT(sdJWT)
+		require.NoError(t, err)
+		assert.Equal(t, issuer, verified.Issuer)
+		assert.Equal(t, "KYCAgeCredential", verified.Type)
+		assert.Equal(t, credential.Subject(), verified.Subject)
+		assert.Equal(t, map[string]interface{}{
+			"type":         "KYCAgeCredential",
+			"birthday":     float64(19960424),
+			"documentType": float64(2),
+		}, verified.Claims)
+	})
+
+	t.Run("should present only the selected attributes", func(t *testing.T) {
+		presented, err := Disclose(sdJWT, "birthday")
+		require.NoError(t, err)
+		verified, err := VerifySDJWT(presented)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"birthday": float64(19960424)}, verified.Claims)
+	})
+
+	t.Run("should reject disclosures that are not committed in the token", func(t *testing.T) {
+		other, err := EncodeSDJWT(ctx, credential, signer)
+		require.NoError(t, err)
+		token, _, err := split(sdJWT)
+		require.NoError(t, err)
+		_, disclosures, err := split(other)
+		require.NoError(t, err)
+
+		_, err = VerifySDJWT(token + "~" + strings.Join(disclosures, "~") + "~")
+		assert.ErrorIs(t, err, ErrInvalidDisclosure)
+	})
+
+	t.Run("should reject key binding JWTs", func(t *testing.T) {
+		_, err := VerifySDJWT(sdJWT + "eyJhbGciOiJFUzI1NksifQ.e30.sig")
+		assert.ErrorIs(t, err, ErrKeyBindingNotSupported)
+	})
+}
+
+// p256Signer signs with an in memory P-256 key like the wallets holding did:jwk keys
+type p256Signer struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *p256Signer) Sign(_ context.Context, digest []byte) ([]byte, error) {
+	r, sv, err := ecdsa.Sign(rand.Reader, s.key, digest)
+	if err != nil {
+		return nil, err
+	}
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	sv.FillBytes(sig[32:])
+	return sig, nil
+}
+
+func (s *p256Signer) jwk(t *testing.T) json.RawMessage {
+	t.Helper()
+	k, err := json.Marshal(jwk{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(s.key.X.FillBytes(make([]byte, 32))),
+		Y:   base64.RawURLEncoding.EncodeToString(s.key.Y.FillBytes(make([]byte, 32))),
+	})
+	require.NoError(t, err)
+	return k
+}
+
+func TestVerifyProof(t *testing.T) {
+	ctx := context.Background()
+	const (
+		audience = "https://issuer.example.com/v2/oid4vci/did:iden3:polygon:amoy:x6x5sor7zpySUbxeFoAZUYbUh1kmkjhgUbhW2sbpJ"
+		nonce    = "f0a2c3b1"
+	)
+	ethSigner, ethHolder := newIssuer(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	jwkSigner := &p256Signer{key: key}
+
+	newProof := func(t *testing.T, h header, signer Signer, claims proofClaims) string {
+		t.Helper()
+		token, err := sign(ctx, h, claims, signer)
+		require.NoError(t, err)
+		return token
+	}
+	validClaims := proofClaims{Aud: audience, Iat: time.Now().Unix(), Nonce: nonce}
+
+	t.Run("should return the DID of an ethereum based holder", func(t *testing.T) {
+		proof := newProof(t, header{Alg: AlgES256K, Typ: TypProof, Kid: KeyID(ethHolder)}, ethSigner, validClaims)
+		holder, err := VerifyProof(proof, audience, nonce, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, ethHolder, holder)
+	})
+
+	t.Run("should return the did:jwk of a jwk holder", func(t *testing.T) {
+		proof := newProof(t, header{Alg: AlgES256, Typ: TypProof, JWK: jwkSigner.jwk(t)}, jwkSigner, validClaims)
+		holder, err := VerifyProof(proof, audience, nonce, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, DIDJWKPrefix+base64.RawURLEncoding.EncodeToString(jwkSigner.jwk(t)), holder)
+
+		byKid := newProof(t, header{Alg: AlgES256, Typ: TypProof, Kid: holder + "#0"}, jwkSigner, validClaims)
+		holderByKid, err := VerifyProof(byKid, audience, nonce, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, holder, holderByKid)
+	})
+
+	t.Run("should reject proofs signed by other keys", func(t *testing.T) {
+		other, _ := newIssuer(t)
+		proof := newProof(t, header{Alg: AlgES256K, Typ: TypProof, Kid: KeyID(ethHolder)}, other, validClaims)
+		_, err := VerifyProof(proof, audience, nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidProof)
+
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		proof = newProof(t, header{Alg: AlgES256, Typ: TypProof, JWK: jwkSigner.jwk(t)}, &p256Signer{key: otherKey}, validClaims)
+		_, err = VerifyProof(proof, audience, nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidProof)
+	})
+
+	t.Run("should reject proofs for other issuers, nonces or too old", func(t *testing.T) {
+		h := header{Alg: AlgES256, Typ: TypProof, JWK: jwkSigner.jwk(t)}
+		for _, claims := range []proofClaims{
+			{Aud: "https://other.example.com", Iat: time.Now().Unix(), Nonce: nonce},
+			{Aud: audience, Iat: time.Now().Unix(), Nonce: "other"},
+			{Aud: audience, Iat: time.Now().Add(-time.Hour).Unix(), Nonce: nonce},
+		} {
+			_, err := VerifyProof(newProof(t, h, jwkSigner, claims), audience, nonce, time.Minute)
+			assert.ErrorIs(t, err, ErrInvalidProof)
+		}
+	})
+
+	t.Run("should reject tokens that are not proofs", func(t *testing.T) {
+		token, err := EncodeJWT(ctx, newCredential(ethHolder, time.Now().Add(time.Hour)), ethSigner)
+		require.NoError(t, err)
+		_, err = VerifyProof(token, audience, nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidProof)
+	})
+}
+
+func TestPresentations(t *testing.T) {
+	ctx := context.Background()
+	const (
+		audience = "https://verifier.example.com/v2/oid4vp/sessions/1/response"
+		nonce    = "a81bc81b"
+	)
+	issuerSigner, issuer := newIssuer(t)
+	holderSigner, holder := newIssuer(t)
+	credential := newCredential(issuer, time.Now().Add(time.Hour))
+	credential.CredentialSubject["id"] = holder
+
+	t.Run("should verify a JWT-VP of the holder credentials", func(t *testing.T) {
+		vc, err := EncodeJWT(ctx, credential, issuerSigner)
+		require.NoError(t, err)
+		vp, err := EncodeJWTVP(ctx, holder, audience, nonce, []string{vc}, holderSigner)
+		require.NoError(t, err)
+
+		presentation, err := VerifyJWTVP(vp, audience, nonce, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, holder, presentation.Holder)
+		require.Len(t, presentation.Credentials, 1)
+		assert.Equal(t, credential.ID, presentation.Credentials[0].ID)
+
+		_, err = VerifyJWTVP(vp, audience, "other", time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidPresentation)
+	})
+
+	t.Run("should reject a JWT-VP of credentials of other subjects", func(t *testing.T) {
+		vc, err := EncodeJWT(ctx, newCredential(issuer, time.Now().Add(time.Hour)), issuerSigner)
+		require.NoError(t, err)
+		vp, err := EncodeJWTVP(ctx, holder, audience, nonce, []string{vc}, holderSigner)
+		require.NoError(t, err)
+		_, err = VerifyJWTVP(vp, audience, nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrHolderMismatch)
+	})
+
+	t.Run("should reject a JWT-VP not signed by the holder", func(t *testing.T) {
+		vp, err := EncodeJWTVP(ctx, holder, audience, nonce, nil, issuerSigner)
+		require.NoError(t, err)
+		_, err = VerifyJWTVP(vp, audience, nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("should verify an SD-JWT presented with a key binding JWT", func(t *testing.T) {
+		sdJWT, err := EncodeSDJWT(ctx, credential, issuerSigner)
+		require.NoError(t, err)
+		disclosed, err := Disclose(sdJWT, "birthday")
+		require.NoError(t, err)
+		presented, err := KeyBind(ctx, disclosed, holder, audience, nonce, holderSigner)
+		require.NoError(t, err)
+
+		verified, err := VerifySDJWTPresentation(presented, audience, nonce, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"birthday": float64(19960424)}, verified.Claims)
+
+		_, err = VerifySDJWTPresentation(disclosed, audience, nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidPresentation)
+		_, err = VerifySDJWTPresentation(presented, "https://other.example.com", nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidPresentation)
+	})
+
+	t.Run("should reject key binding JWTs over other disclosures", func(t *testing.T) {
+		sdJWT, err := EncodeSDJWT(ctx, credential, issuerSigner)
+		require.NoError(t, err)
+		disclosed, err := Disclose(sdJWT, "birthday")
+		require.NoError(t, err)
+		presented, err := KeyBind(ctx, sdJWT, holder, audience, nonce, holderSigner)
+		require.NoError(t, err)
+		_, _, keyBinding, err := splitKeyBinding(presented)
+		require.NoError(t, err)
+
+		_, err = VerifySDJWTPresentation(disclosed+keyBinding, audience, nonce, time.Minute)
+		assert.ErrorIs(t, err, ErrInvalidPresentation)
+	})
+}
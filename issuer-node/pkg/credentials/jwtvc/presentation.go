@@ -0,0 +1,196 @@
+package jwtvc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// TypKeyBinding is the typ header of the key binding JWTs appended by the holders to SD-JWT presentations
+	TypKeyBinding = "kb+jwt"
+	// VerifiablePresentationType is the type of the presentations of JWT-VP tokens
+	VerifiablePresentationType = "VerifiablePresentation"
+)
+
+var (
+	// ErrInvalidPresentation is returned when a presentation is not bound to the verifier request or the holder key
+	ErrInvalidPresentation = errors.New("invalid presentation")
+	// ErrHolderMismatch is returned when a presented credential was not issued to the holder of the presentation
+	ErrHolderMismatch = errors.New("credential subject is not the holder of the presentation")
+)
+
+// Presentation is a W3C verifiable presentation of JWT-VC credentials
+type Presentation struct {
+	Context              []string `json:"@context"`
+	Type                 []string `json:"type"`
+	Holder               string   `json:"holder,omitempty"`
+	VerifiableCredential []string `json:"verifiableCredential"`
+}
+
+// vpClaims is the payload of a JWT-VP
+type vpClaims struct {
+	Iss   string        `json:"iss"`
+	Aud   string        `json:"aud"`
+	Nonce string        `json:"nonce"`
+	Iat   int64         `json:"iat"`
+	Exp   int64         `json:"exp,omitempty"`
+	VP    *Presentation `json:"vp"`
+}
+
+// kbClaims is the payload of a key binding JWT
+type kbClaims struct {
+	Aud    string `json:"aud"`
+	Nonce  string `json:"nonce"`
+	Iat    int64  `json:"iat"`
+	SDHash string `json:"sd_hash"`
+}
+
+// JWTPresentation is a verified JWT-VP with the verified credentials of its holder,
+// in the order of the tokens in the presentation
+type JWTPresentation struct {
+	Holder       string
+	Presentation *Presentation
+	Credentials  []*Credential
+}
+
+// EncodeJWTVP returns a JWT-VP of the JWT-VC credentials for the verifier audience and nonce,
+// signed with ES256K by the key of the ethereum based holder.
+func EncodeJWTVP(ctx context.Context, holder string, audience string, nonce string, credentials []string, signer Signer) (string, error) {
+	claims := vpClaims{
+		Iss:   holder,
+		Aud:   audience,
+		Nonce: nonce,
+		Iat:   time.Now().Unix(),
+		VP: &Presentation{
+			Context:              []string{VCDM2ContextURL},
+			Type:                 []string{VerifiablePresentationType},
+			Holder:               holder,
+			VerifiableCredential: credentials,
+		},
+	}
+	return sign(ctx, header{Alg: AlgES256K, Typ: TypJWT, Kid: KeyID(holder)}, claims, signer)
+}
+
+// VerifyJWTVP checks a JWT-VP is signed by its holder for the verifier audience and nonce, and was created
+// no longer than maxAge ago. Every credential of the presentation is verified with VerifyJWT and must have
+// the holder as subject. Holders are ethereum based iden3 identities or did:jwk keys, as in VerifyProof.
+func VerifyJWTVP(token string, audience string, nonce string, maxAge time.Duration) (*JWTPresentation, error) {
+	t, err := parseWithAlgorithms(token, TypJWT, AlgES256K, AlgES256)
+	if err != nil {
+		return nil, err
+	}
+	var claims vpClaims
+	if err := json.Unmarshal(t.payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	if claims.VP == nil {
+		return nil, fmt.Errorf("%w: vp claim is required", ErrMalformedToken)
+	}
+	holder := claims.Iss
+	if claims.VP.Holder != "" && claims.VP.Holder != holder {
+		return nil, fmt.Errorf("%w: vp holder doesn't match the token issuer", ErrMalformedToken)
+	}
+	if err := checkBinding(claims.Aud, claims.Nonce, claims.Iat, audience, nonce, maxAge); err != nil {
+		return nil, err
+	}
+	if err := t.verifyHolderSignature(holder); err != nil {
+		return nil, err
+	}
+	if err := checkValidity(0, claims.Exp); err != nil {
+		return nil, err
+	}
+
+	presentation := &JWTPresentation{
+		Holder:       holder,
+		Presentation: claims.VP,
+		Credentials:  make([]*Credential, 0, len(claims.VP.VerifiableCredential)),
+	}
+	for _, vc := range claims.VP.VerifiableCredential {
+		credential, err := VerifyJWT(vc)
+		if err != nil {
+			return nil, err
+		}
+		if credential.Subject() != holder {
+			return nil, fmt.Errorf("%w: %s", ErrHolderMismatch, credential.ID)
+		}
+		presentation.Credentials = append(presentation.Credentials, credential)
+	}
+	return presentation, nil
+}
+
+// KeyBind appends to the SD-JWT a key binding JWT for the verifier audience and nonce,
+// signed with ES256K by the key of the ethereum based holder.
+func KeyBind(ctx context.Context, sdJWT string, holder string, audience string, nonce string, signer Signer) (string, error) {
+	if _, _, err := split(sdJWT); err != nil {
+		return "", err
+	}
+	claims := kbClaims{
+		Aud:    audience,
+		Nonce:  nonce,
+		Iat:    time.Now().Unix(),
+		SDHash: digest(sdJWT),
+	}
+	kb, err := sign(ctx, header{Alg: AlgES256K, Typ: TypKeyBinding, Kid: KeyID(holder)}, claims, signer)
+	if err != nil {
+		return "", err
+	}
+	return sdJWT + kb, nil
+}
+
+// VerifySDJWTPresentation checks an SD-JWT VC presented with a key binding JWT. The credential is verified
+// as in VerifySDJWT, and the key binding JWT must be signed by the subject of the credential for the verifier
+// audience and nonce, no longer than maxAge ago, over the presented token and disclosures.
+func VerifySDJWTPresentation(sdJWT string, audience string, nonce string, maxAge time.Duration) (*SDJWTCredential, error) {
+	token, disclosures, keyBinding, err := splitKeyBinding(sdJWT)
+	if err != nil {
+		return nil, err
+	}
+	if keyBinding == "" {
+		return nil, fmt.Errorf("%w: key binding JWT is required", ErrInvalidPresentation)
+	}
+	credential, err := verifySDJWT(token, disclosures)
+	if err != nil {
+		return nil, err
+	}
+	if credential.Subject == "" {
+		return nil, fmt.Errorf("%w: credential is not bound to a holder", ErrInvalidPresentation)
+	}
+
+	t, err := parseWithAlgorithms(keyBinding, TypKeyBinding, AlgES256K, AlgES256)
+	if err != nil {
+		return nil, err
+	}
+	var claims kbClaims
+	if err := json.Unmarshal(t.payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	if err := checkBinding(claims.Aud, claims.Nonce, claims.Iat, audience, nonce, maxAge); err != nil {
+		return nil, err
+	}
+	if claims.SDHash != digest(strings.TrimSuffix(sdJWT, keyBinding)) {
+		return nil, fmt.Errorf("%w: sd_hash doesn't match the presented token", ErrInvalidPresentation)
+	}
+	if err := t.verifyHolderSignature(credential.Subject); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// checkBinding checks a holder signed token was created for the verifier audience and nonce no longer than maxAge ago
+func checkBinding(aud, tokenNonce string, iat int64, audience string, nonce string, maxAge time.Duration) error {
+	if aud != audience {
+		return fmt.Errorf("%w: unexpected audience %s", ErrInvalidPresentation, aud)
+	}
+	if tokenNonce == "" || tokenNonce != nonce {
+		return fmt.Errorf("%w: unexpected nonce", ErrInvalidPresentation)
+	}
+	issuedAt := time.Unix(iat, 0)
+	if now := time.Now(); issuedAt.After(now.Add(proofClockSkew)) || issuedAt.Before(now.Add(-maxAge)) {
+		return fmt.Errorf("%w: issued at %s", ErrInvalidPresentation, issuedAt.UTC())
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package jwtvc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+const (
+	// TypProof is the typ header of the OpenID4VCI proof of possession JWTs
+	TypProof = "openid4vci-proof+jwt"
+	// AlgES256 is the JWS algorithm of ECDSA over P-256 with SHA-256, used by wallets holding did:jwk keys
+	AlgES256 = "ES256"
+	// DIDJWKPrefix is the prefix of the did:jwk DIDs of holders binding credentials to a JWK
+	DIDJWKPrefix = "did:jwk:"
+
+	proofClockSkew = time.Minute
+)
+
+// ErrInvalidProof is returned when the proof of possession of the holder key can't be verified
+var ErrInvalidProof = errors.New("invalid proof of possession")
+
+// proofClaims is the payload of an OpenID4VCI proof of possession JWT
+type proofClaims struct {
+	Iss   string `json:"iss,omitempty"`
+	Aud   string `json:"aud"`
+	Iat   int64  `json:"iat"`
+	Nonce string `json:"nonce"`
+}
+
+// jwk is a public EC JSON Web Key
+type jwk struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// VerifyProof checks an OpenID4VCI proof of possession JWT is signed by the holder for the given credential issuer
+// and nonce, and was created no longer than maxAge ago. It returns the DID of the holder, to be used as the subject
+// of the credential. Holders are either ethereum based iden3 identities signing with ES256K and their DID in kid,
+// or keys signing with ES256 and given as a jwk header or a did:jwk kid, whose DID is did:jwk.
+func VerifyProof(token string, audience string, nonce string, maxAge time.Duration) (string, error) {
+	t, err := parseWithAlgorithms(token, TypProof, AlgES256K, AlgES256)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	var claims proofClaims
+	if err := json.Unmarshal(t.payload, &claims); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	if claims.Aud != audience {
+		return "", fmt.Errorf("%w: unexpected audience %s", ErrInvalidProof, claims.Aud)
+	}
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return "", fmt.Errorf("%w: unexpected nonce", ErrInvalidProof)
+	}
+	issuedAt := time.Unix(claims.Iat, 0)
+	if now := time.Now(); issuedAt.After(now.Add(proofClockSkew)) || issuedAt.Before(now.Add(-maxAge)) {
+		return "", fmt.Errorf("%w: proof issued at %s", ErrInvalidProof, issuedAt.UTC())
+	}
+
+	holder, err := t.proofHolder()
+	if err != nil {
+		return "", err
+	}
+	if err := t.verifyHolderSignature(holder); err != nil {
+		return "", fmt.Errorf("%w: %v", ErrInvalidProof, err)
+	}
+	return holder, nil
+}
+
+// proofHolder returns the DID of the holder of a proof: the DID in kid, or the did:jwk of the key in the jwk header
+func (t *jws) proofHolder() (string, error) {
+	if len(t.header.JWK) > 0 {
+		_, holder, err := parseJWK(t.header.JWK)
+		if err != nil {
+			return "", fmt.Errorf("%w: %v", ErrInvalidProof, err)
+		}
+		return holder, nil
+	}
+	holder, _, found := strings.Cut(t.header.Kid, "#")
+	if !found || holder == "" {
+		return "", fmt.Errorf("%w: a jwk header or a DID URL kid is required", ErrInvalidProof)
+	}
+	return holder, nil
+}
+
+// verifyHolderSignature checks the token is signed by the key of the holder DID. Keys are resolved from the DID,
+// that is either an ethereum based iden3 identity signing with ES256K or a did:jwk signing with ES256.
+func (t *jws) verifyHolderSignature(holder string) error {
+	if strings.HasPrefix(holder, DIDJWKPrefix) {
+		if t.header.Alg != AlgES256 {
+			return fmt.Errorf("%w: %s for %s", ErrUnsupportedAlgorithm, t.header.Alg, holder)
+		}
+		decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(holder, DIDJWKPrefix))
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrUnsupportedIssuer, err)
+		}
+		key, _, err := parseJWK(decoded)
+		if err != nil {
+			return err
+		}
+		return t.verifyP256Signature(key)
+	}
+	if t.header.Alg != AlgES256K {
+		return fmt.Errorf("%w: %s for %s", ErrUnsupportedAlgorithm, t.header.Alg, holder)
+	}
+	if len(t.signature) != signatureLength {
+		return fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+	if t.header.Kid != "" && !strings.HasPrefix(t.header.Kid, holder+"#") {
+		return fmt.Errorf("%w: unexpected key %s", ErrInvalidSignature, t.header.Kid)
+	}
+	return t.recoverSigner(holder)
+}
+
+// parseJWK returns the P-256 public key and its did:jwk
+func parseJWK(raw []byte) (*ecdsa.PublicKey, string, error) {
+	var k jwk
+	if err := json.Unmarshal(raw, &k); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupportedIssuer, err)
+	}
+	if k.Kty != "EC" || k.Crv != "P-256" {
+		return nil, "", fmt.Errorf("%w: unsupported key %s %s", ErrUnsupportedIssuer, k.Kty, k.Crv)
+	}
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupportedIssuer, err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrUnsupportedIssuer, err)
+	}
+	key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !key.Curve.IsOnCurve(key.X, key.Y) {
+		return nil, "", fmt.Errorf("%w: key is not on the curve", ErrUnsupportedIssuer)
+	}
+
+	// The DID is built from the members of the public key only, so the same key always gets the same DID
+	canonical, err := json.Marshal(k)
+	if err != nil {
+		return nil, "", err
+	}
+	return key, DIDJWKPrefix + base64.RawURLEncoding.EncodeToString(canonical), nil
+}
+
+func (t *jws) verifyP256Signature(key *ecdsa.PublicKey) error {
+	const coordinateLength = 32
+	if len(t.signature) != 2*coordinateLength {
+		return fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+	digest := sha256.Sum256([]byte(t.signingInput))
+	r := new(big.Int).SetBytes(t.signature[:coordinateLength])
+	s := new(big.Int).SetBytes(t.signature[coordinateLength:])
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package jwtvc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+)
+
+const (
+	// TypSDJWT is the typ header of the SD-JWT VC tokens
+	TypSDJWT = "vc+sd-jwt"
+	// SDAlg is the hash algorithm of the disclosure digests
+	SDAlg = "sha-256"
+
+	disclosureSeparator = "~"
+	saltLength          = 16
+)
+
+var (
+	// ErrInvalidDisclosure is returned when a disclosure is malformed or its digest is not in the token
+	ErrInvalidDisclosure = errors.New("invalid disclosure")
+	// ErrKeyBindingNotSupported is returned when the SD-JWT carries a key binding JWT where none is expected
+	ErrKeyBindingNotSupported = errors.New("key binding is not supported")
+)
+
+// sdJWTClaims is the payload of an SD-JWT VC. The credential subject attributes are only present as digests in SD.
+type sdJWTClaims struct {
+	Iss              string                       `json:"iss"`
+	Sub              string                       `json:"sub,omitempty"`
+	Jti              string                       `json:"jti,omitempty"`
+	Iat              int64                        `json:"iat"`
+	Nbf              int64                        `json:"nbf,omitempty"`
+	Exp              int64                        `json:"exp,omitempty"`
+	Vct              string                       `json:"vct"`
+	CredentialSchema *verifiable.CredentialSchema `json:"credentialSchema,omitempty"`
+	CredentialStatus interface{}                  `json:"credentialStatus,omitempty"`
+	SD               []string                     `json:"_sd"`
+	SDAlg            string                       `json:"_sd_alg"`
+}
+
+// SDJWTCredential is a verified SD-JWT VC with the disclosed attributes of the subject
+type SDJWTCredential struct {
+	Issuer           string
+	Subject          string
+	ID               string
+	Type             string
+	IssuedAt         time.Time
+	ValidFrom        *time.Time
+	ValidUntil       *time.Time
+	CredentialSchema *verifiable.CredentialSchema
+	CredentialStatus interface{}
+	Claims           map[string]interface{}
+}
+
+// EncodeSDJWT returns the credential as an SD-JWT VC (vc+sd-jwt) signed with ES256K by the issuer key.
+// Every attribute of the credential subject but its id is selectively disclosable, and all the disclosures
+// are appended to the token. Holders remove the ones they don't want to present with Disclose.
+func EncodeSDJWT(ctx context.Context, credential *Credential, signer Signer) (string, error) {
+	names := make([]string, 0, len(credential.CredentialSubject))
+	for name := range credential.CredentialSubject {
+		if name != "id" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	disclosures := make([]string, 0, len(names))
+	digests := make([]string, 0, len(names))
+	for _, name := range names {
+		disclosure, err := newDisclosure(name, credential.CredentialSubject[name])
+		if err != nil {
+			return "", err
+		}
+		disclosures = append(disclosures, disclosure)
+		digests = append(digests, digest(disclosure))
+	}
+	// Digests are sorted so their order doesn't reveal the attribute names
+	sort.Strings(digests)
+
+	claims := sdJWTClaims{
+		Iss:              credential.Issuer,
+		Sub:              credential.Subject(),
+		Jti:              credential.ID,
+		Iat:              time.Now().Unix(),
+		Vct:              credential.CredentialType(),
+		CredentialSchema: credential.CredentialSchema,
+		CredentialStatus: credential.CredentialStatus,
+		SD:               digests,
+		SDAlg:            SDAlg,
+	}
+	if credential.ValidFrom != nil {
+		claims.Nbf = credential.ValidFrom.Unix()
+	}
+	if credential.ValidUntil != nil {
+		claims.Exp = credential.ValidUntil.Unix()
+	}
+
+	token, err := sign(ctx, header{Alg: AlgES256K, Typ: TypSDJWT, Kid: KeyID(credential.Issuer)}, claims, signer)
+	if err != nil {
+		return "", err
+	}
+	return strings.Join(append([]string{token}, disclosures...), disclosureSeparator) + disclosureSeparator, nil
+}
+
+// Disclose returns the SD-JWT keeping only the disclosures of the given subject attributes
+func Disclose(sdJWT string, names ...string) (string, error) {
+	token, disclosures, err := split(sdJWT)
+	if err != nil {
+		return "", err
+	}
+	keep := make(map[string]bool, len(names))
+	for _, name := range names {
+		keep[name] = true
+	}
+
+	parts := []string{token}
+	for _, disclosure := range disclosures {
+		name, _, err := decodeDisclosure(disclosure)
+		if err != nil {
+			return "", err
+		}
+		if keep[name] {
+			parts = append(parts, disclosure)
+		}
+	}
+	return strings.Join(parts, disclosureSeparator) + disclosureSeparator, nil
+}
+
+// VerifySDJWT checks an SD-JWT VC is signed by the key of its issuer DID and is in its validity period,
+// and that every disclosure is committed in the token. It returns the credential with the disclosed attributes.
+func VerifySDJWT(sdJWT string) (*SDJWTCredential, error) {
+	token, disclosures, err := split(sdJWT)
+	if err != nil {
+		return nil, err
+	}
+	return verifySDJWT(token, disclosures)
+}
+
+func verifySDJWT(token string, disclosures []string) (*SDJWTCredential, error) {
+	t, err := parse(token, TypSDJWT)
+	if err != nil {
+		return nil, err
+	}
+	var claims sdJWTClaims
+	if err := json.Unmarshal(t.payload, &claims); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+	if claims.SDAlg != SDAlg {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, claims.SDAlg)
+	}
+	if err := t.verifySignature(claims.Iss); err != nil {
+		return nil, err
+	}
+	if err := checkValidity(claims.Nbf, claims.Exp); err != nil {
+		return nil, err
+	}
+
+	committed := make(map[string]bool, len(claims.SD))
+	for _, d := range claims.SD {
+		committed[d] = true
+	}
+	disclosed := make(map[string]interface{}, len(disclosures))
+	for _, disclosure := range disclosures {
+		if !committed[digest(disclosure)] {
+			return nil, ErrInvalidDisclosure
+		}
+		name, value, err := decodeDisclosure(disclosure)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := disclosed[name]; ok {
+			return nil, fmt.Errorf("%w: duplicated %s", ErrInvalidDisclosure, name)
+		}
+		disclosed[name] = value
+	}
+
+	credential := &SDJWTCredential{
+		Issuer:           claims.Iss,
+		Subject:          claims.Sub,
+		ID:               claims.Jti,
+		Type:             claims.Vct,
+		IssuedAt:         time.Unix(claims.Iat, 0).UTC(),
+		CredentialSchema: claims.CredentialSchema,
+		CredentialStatus: claims.CredentialStatus,
+		Claims:           disclosed,
+	}
+	if claims.Nbf != 0 {
+		validFrom := time.Unix(claims.Nbf, 0).UTC()
+		credential.ValidFrom = &validFrom
+	}
+	if claims.Exp != 0 {
+		validUntil := time.Unix(claims.Exp, 0).UTC()
+		credential.ValidUntil = &validUntil
+	}
+	return credential, nil
+}
+
+func newDisclosure(name string, value interface{}) (string, error) {
+	salt := make([]byte, saltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	encoded, err := json.Marshal([]interface{}{base64.RawURLEncoding.EncodeToString(salt), name, value})
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(encoded), nil
+}
+
+func decodeDisclosure(disclosure string) (string, interface{}, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(disclosure)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidDisclosure, err)
+	}
+	var parts []interface{}
+	if err := json.Unmarshal(decoded, &parts); err != nil {
+		return "", nil, fmt.Errorf("%w: %v", ErrInvalidDisclosure, err)
+	}
+	const disclosureParts = 3
+	if len(parts) != disclosureParts {
+		return "", nil, fmt.Errorf("%w: expected salt, name and value", ErrInvalidDisclosure)
+	}
+	name, ok := parts[1].(string)
+	if !ok {
+		return "", nil, fmt.Errorf("%w: malformed name", ErrInvalidDisclosure)
+	}
+	return name, parts[2], nil
+}
+
+func digest(disclosure string) string {
+	sum := sha256.Sum256([]byte(disclosure))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// split separates the issuer signed JWT from the disclosures
+func split(sdJWT string) (string, []string, error) {
+	token, disclosures, keyBinding, err := splitKeyBinding(sdJWT)
+	if err != nil {
+		return "", nil, err
+	}
+	if keyBinding != "" {
+		return "", nil, ErrKeyBindingNotSupported
+	}
+	return token, disclosures, nil
+}
+
+// splitKeyBinding separates the issuer signed JWT, the disclosures and the key binding JWT, if any
+func splitKeyBinding(sdJWT string) (string, []string, string, error) {
+	parts := strings.Split(sdJWT, disclosureSeparator)
+	if len(parts) < 2 || parts[0] == "" {
+		return "", nil, "", ErrMalformedToken
+	}
+	return parts[0], parts[1 : len(parts)-1], parts[len(parts)-1], nil
+}
@@ -0,0 +1,154 @@
+// Package oid4vci contains the messages of OpenID for Verifiable Credential Issuance (draft 13)
+// used by the issuer in the pre-authorized code flow.
+package oid4vci
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const (
+	// GrantTypePreAuthorizedCode is the grant type of the pre-authorized code flow
+	GrantTypePreAuthorizedCode = "urn:ietf:params:oauth:grant-type:pre-authorized_code"
+	// TokenTypeBearer is the type of the access tokens
+	TokenTypeBearer = "Bearer"
+	// ProofTypeJWT is the proof of possession of the holder key as a JWT
+	ProofTypeJWT = "jwt"
+	// CredentialOfferScheme is the scheme of the credential offer URIs scanned by the wallets
+	CredentialOfferScheme = "openid-credential-offer://"
+)
+
+// Error codes of the token and credential endpoints
+const (
+	ErrorInvalidRequest              = "invalid_request"
+	ErrorInvalidGrant                = "invalid_grant"
+	ErrorUnsupportedGrantType        = "unsupported_grant_type"
+	ErrorInvalidToken                = "invalid_token"
+	ErrorInvalidProof                = "invalid_proof"
+	ErrorInvalidCredentialRequest    = "invalid_credential_request"
+	ErrorUnsupportedCredentialType   = "unsupported_credential_type"
+	ErrorUnsupportedCredentialFormat = "unsupported_credential_format"
+)
+
+// CredentialOffer is the offer of credentials the wallet gets from the offer URI
+type CredentialOffer struct {
+	CredentialIssuer           string   `json:"credential_issuer"`
+	CredentialConfigurationIDs []string `json:"credential_configuration_ids"`
+	Grants                     Grants   `json:"grants"`
+}
+
+// Grants are the grants the wallet can use to get an access token for the offer
+type Grants struct {
+	PreAuthorizedCode *PreAuthorizedCodeGrant `json:"urn:ietf:params:oauth:grant-type:pre-authorized_code,omitempty"`
+}
+
+// PreAuthorizedCodeGrant is the pre-authorized code grant of an offer
+type PreAuthorizedCodeGrant struct {
+	PreAuthorizedCode string `json:"pre-authorized_code"`
+}
+
+// URI returns the offer by value as an openid-credential-offer URI
+func (o *CredentialOffer) URI() (string, error) {
+	offer, err := json.Marshal(o)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?credential_offer=%s", CredentialOfferScheme, url.QueryEscape(string(offer))), nil
+}
+
+// IssuerMetadata is the credential issuer metadata published at /.well-known/openid-credential-issuer
+type IssuerMetadata struct {
+	CredentialIssuer                  string                             `json:"credential_issuer"`
+	CredentialEndpoint                string                             `json:"credential_endpoint"`
+	Display                           []Display                          `json:"display,omitempty"`
+	CredentialConfigurationsSupported map[string]CredentialConfiguration `json:"credential_configurations_supported"`
+}
+
+// CredentialConfiguration describes a credential the issuer can issue and its format
+type CredentialConfiguration struct {
+	Format                               string                `json:"format"`
+	Scope                                string                `json:"scope,omitempty"`
+	CryptographicBindingMethodsSupported []string              `json:"cryptographic_binding_methods_supported,omitempty"`
+	CredentialSigningAlgValuesSupported  []string              `json:"credential_signing_alg_values_supported,omitempty"`
+	ProofTypesSupported                  map[string]ProofType  `json:"proof_types_supported,omitempty"`
+	CredentialDefinition                 *CredentialDefinition `json:"credential_definition,omitempty"`
+	Vct                                  string                `json:"vct,omitempty"`
+	Display                              []Display             `json:"display,omitempty"`
+}
+
+// ProofType are the algorithms supported for a type of proof of possession
+type ProofType struct {
+	ProofSigningAlgValuesSupported []string `json:"proof_signing_alg_values_supported"`
+}
+
+// CredentialDefinition are the types of a jwt_vc_json credential
+type CredentialDefinition struct {
+	Type []string `json:"type"`
+}
+
+// Display is how a wallet shows the issuer or a credential
+type Display struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// AuthorizationServerMetadata is the OAuth metadata of the issuer, that is its own authorization server
+type AuthorizationServerMetadata struct {
+	Issuer                                     string   `json:"issuer"`
+	TokenEndpoint                              string   `json:"token_endpoint"`
+	GrantTypesSupported                        []string `json:"grant_types_supported"`
+	PreAuthorizedGrantAnonymousAccessSupported bool     `json:"pre-authorized_grant_anonymous_access_supported"`
+}
+
+// TokenResponse is the response of the token endpoint
+type TokenResponse struct {
+	AccessToken     string `json:"access_token"`
+	TokenType       string `json:"token_type"`
+	ExpiresIn       int64  `json:"expires_in"`
+	CNonce          string `json:"c_nonce"`
+	CNonceExpiresIn int64  `json:"c_nonce_expires_in"`
+}
+
+// CredentialRequest is the request of the credential endpoint. The credential is selected by its configuration id
+// or by its format and type.
+type CredentialRequest struct {
+	CredentialConfigurationID string                `json:"credential_configuration_id,omitempty"`
+	Format                    string                `json:"format,omitempty"`
+	CredentialDefinition      *CredentialDefinition `json:"credential_definition,omitempty"`
+	Vct                       string                `json:"vct,omitempty"`
+	Proof                     *Proof                `json:"proof,omitempty"`
+}
+
+// Proof is the proof of possession of the key the credential is bound to
+type Proof struct {
+	ProofType string `json:"proof_type"`
+	JWT       string `json:"jwt,omitempty"`
+}
+
+// CredentialResponse is the response of the credential endpoint
+type CredentialResponse struct {
+	Credential      string `json:"credential"`
+	CNonce          string `json:"c_nonce,omitempty"`
+	CNonceExpiresIn int64  `json:"c_nonce_expires_in,omitempty"`
+}
+
+// Error is an error of the token or credential endpoints. Invalid proofs carry a fresh nonce for the next proof.
+type Error struct {
+	Code            string `json:"error"`
+	Description     string `json:"error_description,omitempty"`
+	CNonce          string `json:"c_nonce,omitempty"`
+	CNonceExpiresIn int64  `json:"c_nonce_expires_in,omitempty"`
+}
+
+// NewError creates an error with the given code and description
+func NewError(code string, description string) *Error {
+	return &Error{Code: code, Description: description}
+}
+
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
@@ -0,0 +1,188 @@
+package oid4vp
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"unicode/utf8"
+)
+
+// ErrInvalidFilter is returned when a field filter uses unsupported keywords or malformed values
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Filter is the JSON Schema a field value must be valid against. The keywords type, const, enum, minimum,
+// maximum, exclusiveMinimum, exclusiveMaximum, minLength, maxLength, pattern and contains are supported.
+type Filter map[string]interface{}
+
+// Validate checks the keywords of the filter are supported and well formed
+func (f Filter) Validate() error {
+	for keyword, expected := range f {
+		if _, err := matchKeyword(keyword, expected, nil); err != nil {
+			return err
+		}
+	}
+	if contains, ok := f["contains"]; ok {
+		return toFilter(contains).Validate()
+	}
+	return nil
+}
+
+// Match returns whether the value is valid against the filter
+func (f Filter) Match(value interface{}) (bool, error) {
+	for keyword, expected := range f {
+		ok, err := matchKeyword(keyword, expected, value)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func matchKeyword(keyword string, expected interface{}, value interface{}) (bool, error) {
+	switch keyword {
+	case "type":
+		typ, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("%w: type must be a string", ErrInvalidFilter)
+		}
+		return matchType(typ, value)
+	case "const":
+		return equal(expected, value), nil
+	case "enum":
+		values, ok := expected.([]interface{})
+		if !ok {
+			return false, fmt.Errorf("%w: enum must be an array", ErrInvalidFilter)
+		}
+		for _, v := range values {
+			if equal(v, value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+		limit, ok := number(expected)
+		if !ok {
+			return false, fmt.Errorf("%w: %s must be a number", ErrInvalidFilter, keyword)
+		}
+		n, ok := number(value)
+		if !ok {
+			return false, nil
+		}
+		switch keyword {
+		case "minimum":
+			return n >= limit, nil
+		case "maximum":
+			return n <= limit, nil
+		case "exclusiveMinimum":
+			return n > limit, nil
+		default:
+			return n < limit, nil
+		}
+	case "minLength", "maxLength":
+		limit, ok := number(expected)
+		if !ok {
+			return false, fmt.Errorf("%w: %s must be a number", ErrInvalidFilter, keyword)
+		}
+		s, ok := value.(string)
+		if !ok {
+			return false, nil
+		}
+		if keyword == "minLength" {
+			return float64(utf8.RuneCountInString(s)) >= limit, nil
+		}
+		return float64(utf8.RuneCountInString(s)) <= limit, nil
+	case "pattern":
+		pattern, ok := expected.(string)
+		if !ok {
+			return false, fmt.Errorf("%w: pattern must be a string", ErrInvalidFilter)
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+		}
+		s, ok := value.(string)
+		return ok && re.MatchString(s), nil
+	case "contains":
+		items, ok := value.([]interface{})
+		if !ok {
+			return false, nil
+		}
+		for _, item := range items {
+			match, err := toFilter(expected).Match(item)
+			if err != nil {
+				return false, err
+			}
+			if match {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("%w: unsupported keyword %s", ErrInvalidFilter, keyword)
+	}
+}
+
+func matchType(typ string, value interface{}) (bool, error) {
+	switch typ {
+	case "string":
+		_, ok := value.(string)
+		return ok, nil
+	case "number":
+		_, ok := number(value)
+		return ok, nil
+	case "integer":
+		n, ok := number(value)
+		return ok && n == float64(int64(n)), nil
+	case "boolean":
+		_, ok := value.(bool)
+		return ok, nil
+	case "array":
+		_, ok := value.([]interface{})
+		return ok, nil
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok, nil
+	case "null":
+		return value == nil, nil
+	default:
+		return false, fmt.Errorf("%w: unsupported type %s", ErrInvalidFilter, typ)
+	}
+}
+
+func toFilter(v interface{}) Filter {
+	switch f := v.(type) {
+	case Filter:
+		return f
+	case map[string]interface{}:
+		return f
+	default:
+		return Filter{"const": v}
+	}
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+func equal(a, b interface{}) bool {
+	if x, ok := number(a); ok {
+		y, ok := number(b)
+		return ok && x == y
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,88 @@
+package oid4vp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidPath is returned when a path is not a supported JSONPath expression
+var ErrInvalidPath = errors.New("invalid path")
+
+// Resolve returns the value at the JSONPath of the document, or false if there is none.
+// Only the subset of JSONPath used by presentation definitions and submissions is supported:
+// the root $ followed by .name, ['name'] and [index] segments.
+func Resolve(document interface{}, path string) (interface{}, bool, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return nil, false, err
+	}
+	value := document
+	for _, segment := range segments {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			name, ok := segment.(string)
+			if !ok {
+				return nil, false, nil
+			}
+			if value, ok = node[name]; !ok {
+				return nil, false, nil
+			}
+		case []interface{}:
+			index, ok := segment.(int)
+			if !ok || index >= len(node) {
+				return nil, false, nil
+			}
+			value = node[index]
+		default:
+			return nil, false, nil
+		}
+	}
+	return value, true, nil
+}
+
+// parsePath returns the member names (string) and array indexes (int) of the path
+func parsePath(path string) ([]interface{}, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("%w: %s must start with $", ErrInvalidPath, path)
+	}
+	var segments []interface{}
+	rest := path[1:]
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "."):
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidPath, path)
+			}
+			segments = append(segments, rest[:end])
+			rest = rest[end:]
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end == -1 {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidPath, path)
+			}
+			segments = append(segments, rest[2:end])
+			rest = rest[end+2:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end == -1 {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidPath, path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("%w: %s", ErrInvalidPath, path)
+			}
+			segments = append(segments, index)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPath, path)
+		}
+	}
+	return segments, nil
+}
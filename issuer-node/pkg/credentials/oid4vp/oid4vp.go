@@ -0,0 +1,102 @@
+// Package oid4vp contains the messages of OpenID for Verifiable Presentations (draft 20) used by the verifier
+// with the direct_post response mode, and the presentation definitions of DIF Presentation Exchange 2.0.
+package oid4vp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+const (
+	// ResponseTypeVPToken is the response type of the presentation requests
+	ResponseTypeVPToken = "vp_token"
+	// ResponseModeDirectPost is the response mode where the wallet posts the response to the response_uri
+	ResponseModeDirectPost = "direct_post"
+	// ClientIDSchemeDID is used when the request object is signed by a key of the verifier DID
+	ClientIDSchemeDID = "did"
+	// ClientIDSchemeRedirectURI is used when the request object is not signed and the client id is the response_uri
+	ClientIDSchemeRedirectURI = "redirect_uri"
+	// SelfIssuedAudience is the audience of the request objects
+	SelfIssuedAudience = "https://self-issued.me/v2"
+	// AuthorizationRequestScheme is the scheme of the authorization request URIs scanned by the wallets
+	AuthorizationRequestScheme = "openid4vp://"
+	// RequestObjectContentType is the media type of the request objects served by reference
+	RequestObjectContentType = "application/oauth-authz-req+jwt"
+	// TypRequestObject is the typ header of the request objects
+	TypRequestObject = "oauth-authz-req+jwt"
+)
+
+// Credential formats of the presentation definitions
+const (
+	FormatJWTVCJSON = "jwt_vc_json"
+	FormatJWTVPJSON = "jwt_vp_json"
+	FormatSDJWTVC   = "vc+sd-jwt"
+)
+
+// Error codes of the response endpoint
+const (
+	ErrorInvalidRequest        = "invalid_request"
+	ErrorAccessDenied          = "access_denied"
+	ErrorVPFormatsNotSupported = "vp_formats_not_supported"
+)
+
+// RequestObject are the claims of the authorization request the wallet gets from the request_uri
+type RequestObject struct {
+	Iss                    string                 `json:"iss,omitempty"`
+	Aud                    string                 `json:"aud"`
+	Iat                    int64                  `json:"iat"`
+	Exp                    int64                  `json:"exp"`
+	ResponseType           string                 `json:"response_type"`
+	ResponseMode           string                 `json:"response_mode"`
+	ClientID               string                 `json:"client_id"`
+	ClientIDScheme         string                 `json:"client_id_scheme"`
+	ResponseURI            string                 `json:"response_uri"`
+	Nonce                  string                 `json:"nonce"`
+	State                  string                 `json:"state"`
+	PresentationDefinition PresentationDefinition `json:"presentation_definition"`
+	ClientMetadata         *ClientMetadata        `json:"client_metadata,omitempty"`
+}
+
+// ClientMetadata is the metadata of the verifier sent in the request
+type ClientMetadata struct {
+	VPFormats map[string]Format `json:"vp_formats"`
+}
+
+// Unsecured returns the request object as an unsecured JWT (alg none), as required by the redirect_uri
+// client id scheme
+func (r *RequestObject) Unsecured() (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "none", "typ": TypRequestObject})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload) + ".", nil
+}
+
+// AuthorizationRequestURI returns the URI of the authorization request passed by reference
+func AuthorizationRequestURI(clientID string, requestURI string) string {
+	return fmt.Sprintf("%s?client_id=%s&request_uri=%s", AuthorizationRequestScheme, url.QueryEscape(clientID), url.QueryEscape(requestURI))
+}
+
+// Error is an error of the response endpoint
+type Error struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+// NewError creates an error with the given code and description
+func NewError(code string, description string) *Error {
+	return &Error{Code: code, Description: description}
+}
+
+func (e *Error) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Description)
+}
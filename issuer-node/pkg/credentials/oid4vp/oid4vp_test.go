@@ -0,0 +1,120 @@
+package oid4vp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const credentialJSON = `{
+	"iss": "did:iden3:polygon:amoy:x6x5sor7zpySUbxeFoAZUYbUh1kmkjhgUbhW2sbpJ",
+	"vc": {
+		"type": ["VerifiableCredential", "KYCAgeCredential"],
+		"credentialSubject": {"birthday": 19960424, "documentType": 2, "country": "ES"}
+	}
+}`
+
+func decode(t *testing.T, s string) interface{} {
+	t.Helper()
+	var document interface{}
+	require.NoError(t, json.Unmarshal([]byte(s), &document))
+	return document
+}
+
+func TestResolve(t *testing.T) {
+	credential := decode(t, credentialJSON)
+	for _, tc := range []struct {
+		path  string
+		value interface{}
+		found bool
+	}{
+		{path: "$.vc.credentialSubject.birthday", value: float64(19960424), found: true},
+		{path: "$['vc']['credentialSubject']['country']", value: "ES", found: true},
+		{path: "$.vc.type[1]", value: "KYCAgeCredential", found: true},
+		{path: "$.vc.type[2]"},
+		{path: "$.credentialSubject.birthday"},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			value, found, err := Resolve(credential, tc.path)
+			require.NoError(t, err)
+			assert.Equal(t, tc.found, found)
+			assert.Equal(t, tc.value, value)
+		})
+	}
+
+	for _, path := range []string{"vc.type", "$.", "$[a]", "$['vc'"} {
+		_, _, err := Resolve(credential, path)
+		assert.ErrorIs(t, err, ErrInvalidPath, path)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		filter Filter
+		value  interface{}
+		match  bool
+	}{
+		{name: "type", filter: Filter{"type": "integer"}, value: float64(2), match: true},
+		{name: "wrong type", filter: Filter{"type": "string"}, value: float64(2)},
+		{name: "const", filter: Filter{"const": float64(2)}, value: float64(2), match: true},
+		{name: "enum", filter: Filter{"enum": []interface{}{"ES", "FR"}}, value: "FR", match: true},
+		{name: "maximum", filter: Filter{"type": "number", "maximum": float64(20000101)}, value: float64(19960424), match: true},
+		{name: "exclusive minimum", filter: Filter{"exclusiveMinimum": float64(19960424)}, value: float64(19960424)},
+		{name: "pattern", filter: Filter{"pattern": "^[A-Z]{2}$"}, value: "ES", match: true},
+		{name: "length", filter: Filter{"minLength": float64(3)}, value: "ES"},
+		{name: "contains", filter: Filter{"contains": map[string]interface{}{"const": "KYCAgeCredential"}}, value: []interface{}{"VerifiableCredential", "KYCAgeCredential"}, match: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.filter.Validate())
+			match, err := tc.filter.Match(tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.match, match)
+		})
+	}
+
+	t.Run("should reject unsupported keywords", func(t *testing.T) {
+		assert.ErrorIs(t, Filter{"format": "date"}.Validate(), ErrInvalidFilter)
+		assert.ErrorIs(t, Filter{"contains": map[string]interface{}{"minimum": "1"}}.Validate(), ErrInvalidFilter)
+	})
+}
+
+func TestPresentationDefinition(t *testing.T) {
+	definition := &PresentationDefinition{
+		ID: "kyc",
+		InputDescriptors: []InputDescriptor{{
+			ID: "KYCAgeCredential",
+			Constraints: Constraints{Fields: []Field{
+				{Path: []string{"$.vc.type"}, Filter: Filter{"type": "array", "contains": map[string]interface{}{"const": "KYCAgeCredential"}}},
+				{Path: []string{"$.credentialSubject.birthday", "$.vc.credentialSubject.birthday"}, Filter: Filter{"type": "integer", "maximum": float64(20000101)}},
+				{Path: []string{"$.vc.credentialSubject.nationalID"}, Optional: true},
+			}},
+		}},
+	}
+	require.NoError(t, definition.Validate())
+	descriptor, ok := definition.InputDescriptor("KYCAgeCredential")
+	require.True(t, ok)
+
+	t.Run("should evaluate the field constraints", func(t *testing.T) {
+		assert.NoError(t, descriptor.Evaluate(decode(t, credentialJSON)))
+		assert.ErrorIs(t, descriptor.Evaluate(decode(t, `{"vc": {"type": ["KYCAgeCredential"], "credentialSubject": {"birthday": 20100101}}}`)), ErrConstraintsNotSatisfied)
+		assert.ErrorIs(t, descriptor.Evaluate(decode(t, `{"vc": {"type": ["KYCAgeCredential"]}}`)), ErrConstraintsNotSatisfied)
+	})
+
+	t.Run("should validate the submission covers every input descriptor", func(t *testing.T) {
+		submission := PresentationSubmission{ID: "1", DefinitionID: "kyc", DescriptorMap: []Descriptor{{ID: "KYCAgeCredential", Format: FormatSDJWTVC, Path: "$"}}}
+		assert.NoError(t, submission.Validate(definition))
+
+		submission.DescriptorMap = nil
+		assert.ErrorIs(t, submission.Validate(definition), ErrInvalidSubmission)
+		submission.DefinitionID = "other"
+		assert.ErrorIs(t, submission.Validate(definition), ErrInvalidSubmission)
+	})
+
+	t.Run("should reject malformed definitions", func(t *testing.T) {
+		invalid := &PresentationDefinition{ID: "kyc", InputDescriptors: []InputDescriptor{{ID: "a", Constraints: Constraints{Fields: []Field{{Path: []string{"birthday"}}}}}}}
+		assert.ErrorIs(t, invalid.Validate(), ErrInvalidDefinition)
+	})
+}
@@ -0,0 +1,172 @@
+package oid4vp
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidDefinition is returned when a presentation definition is malformed
+	ErrInvalidDefinition = errors.New("invalid presentation definition")
+	// ErrInvalidSubmission is returned when a presentation submission doesn't fulfil the presentation definition
+	ErrInvalidSubmission = errors.New("invalid presentation submission")
+	// ErrConstraintsNotSatisfied is returned when a presented credential doesn't satisfy the field constraints
+	ErrConstraintsNotSatisfied = errors.New("constraints not satisfied")
+)
+
+// LimitDisclosureRequired asks the holder to disclose only the constrained fields
+const LimitDisclosureRequired = "required"
+
+// PresentationDefinition are the credentials the verifier requests, one per input descriptor
+type PresentationDefinition struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name,omitempty"`
+	Purpose          string            `json:"purpose,omitempty"`
+	InputDescriptors []InputDescriptor `json:"input_descriptors"`
+}
+
+// InputDescriptor describes a requested credential by its formats and the constraints on its fields
+type InputDescriptor struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name,omitempty"`
+	Purpose     string            `json:"purpose,omitempty"`
+	Format      map[string]Format `json:"format,omitempty"`
+	Constraints Constraints       `json:"constraints"`
+}
+
+// Format are the signature algorithms accepted for a credential format
+type Format struct {
+	Alg            []string `json:"alg,omitempty"`
+	SDJWTAlgValues []string `json:"sd-jwt_alg_values,omitempty"`
+	KBJWTAlgValues []string `json:"kb-jwt_alg_values,omitempty"`
+}
+
+// Constraints are the fields a credential must have
+type Constraints struct {
+	LimitDisclosure string  `json:"limit_disclosure,omitempty"`
+	Fields          []Field `json:"fields,omitempty"`
+}
+
+// Field is a value at the first of the paths that is found in the credential, optionally valid against a filter
+type Field struct {
+	ID       string   `json:"id,omitempty"`
+	Path     []string `json:"path"`
+	Purpose  string   `json:"purpose,omitempty"`
+	Filter   Filter   `json:"filter,omitempty"`
+	Optional bool     `json:"optional,omitempty"`
+}
+
+// PresentationSubmission maps the input descriptors to the presented credentials in the vp_token
+type PresentationSubmission struct {
+	ID            string       `json:"id"`
+	DefinitionID  string       `json:"definition_id"`
+	DescriptorMap []Descriptor `json:"descriptor_map"`
+}
+
+// Descriptor is the location of the credential of an input descriptor. Credentials embedded in a presentation
+// are located with a path nested in the path of the presentation.
+type Descriptor struct {
+	ID         string      `json:"id"`
+	Format     string      `json:"format"`
+	Path       string      `json:"path"`
+	PathNested *Descriptor `json:"path_nested,omitempty"`
+}
+
+// Validate checks the input descriptors have unique ids and the paths and filters of their fields are supported
+func (d *PresentationDefinition) Validate() error {
+	if d.ID == "" || len(d.InputDescriptors) == 0 {
+		return fmt.Errorf("%w: an id and input descriptors are required", ErrInvalidDefinition)
+	}
+	ids := make(map[string]bool, len(d.InputDescriptors))
+	for _, descriptor := range d.InputDescriptors {
+		if descriptor.ID == "" || ids[descriptor.ID] {
+			return fmt.Errorf("%w: input descriptor ids must be unique", ErrInvalidDefinition)
+		}
+		ids[descriptor.ID] = true
+		for _, field := range descriptor.Constraints.Fields {
+			if len(field.Path) == 0 {
+				return fmt.Errorf("%w: field without path in %s", ErrInvalidDefinition, descriptor.ID)
+			}
+			for _, path := range field.Path {
+				if _, err := parsePath(path); err != nil {
+					return fmt.Errorf("%w: %v", ErrInvalidDefinition, err)
+				}
+			}
+			if err := field.Filter.Validate(); err != nil {
+				return fmt.Errorf("%w: %v", ErrInvalidDefinition, err)
+			}
+		}
+	}
+	return nil
+}
+
+// InputDescriptor returns the input descriptor with the id, if any
+func (d *PresentationDefinition) InputDescriptor(id string) (*InputDescriptor, bool) {
+	for i := range d.InputDescriptors {
+		if d.InputDescriptors[i].ID == id {
+			return &d.InputDescriptors[i], true
+		}
+	}
+	return nil, false
+}
+
+// Evaluate checks the credential, as a decoded JSON document, satisfies the constraints of the input descriptor
+func (d *InputDescriptor) Evaluate(credential interface{}) error {
+	for _, field := range d.Constraints.Fields {
+		value, found, err := field.resolve(credential)
+		if err != nil {
+			return err
+		}
+		if !found {
+			if field.Optional {
+				continue
+			}
+			return fmt.Errorf("%w: %s has no %v", ErrConstraintsNotSatisfied, d.ID, field.Path)
+		}
+		if field.Filter == nil {
+			continue
+		}
+		ok, err := field.Filter.Match(value)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("%w: %s has an invalid %v", ErrConstraintsNotSatisfied, d.ID, field.Path)
+		}
+	}
+	return nil
+}
+
+// resolve returns the value at the first path found in the credential
+func (f *Field) resolve(credential interface{}) (interface{}, bool, error) {
+	for _, path := range f.Path {
+		value, found, err := Resolve(credential, path)
+		if err != nil || found {
+			return value, found, err
+		}
+	}
+	return nil, false, nil
+}
+
+// Validate checks the submission is for the definition and locates a credential for every input descriptor
+func (s *PresentationSubmission) Validate(definition *PresentationDefinition) error {
+	if s.DefinitionID != definition.ID {
+		return fmt.Errorf("%w: unexpected definition %s", ErrInvalidSubmission, s.DefinitionID)
+	}
+	submitted := make(map[string]bool, len(s.DescriptorMap))
+	for _, descriptor := range s.DescriptorMap {
+		if _, ok := definition.InputDescriptor(descriptor.ID); !ok {
+			return fmt.Errorf("%w: unknown input descriptor %s", ErrInvalidSubmission, descriptor.ID)
+		}
+		if submitted[descriptor.ID] {
+			return fmt.Errorf("%w: duplicated input descriptor %s", ErrInvalidSubmission, descriptor.ID)
+		}
+		submitted[descriptor.ID] = true
+	}
+	for _, descriptor := range definition.InputDescriptors {
+		if !submitted[descriptor.ID] {
+			return fmt.Errorf("%w: missing input descriptor %s", ErrInvalidSubmission, descriptor.ID)
+		}
+	}
+	return nil
+}
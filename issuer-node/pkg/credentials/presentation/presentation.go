@@ -0,0 +1,266 @@
+package presentation
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-crypto/poseidon"
+	"github.com/iden3/go-merkletree-sql/v2"
+	"github.com/iden3/go-schema-processor/v2/merklize"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/suite"
+	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/suite/babyjubjub"
+)
+
+const (
+	// VerifiablePresentationType is the type of the presentation document
+	VerifiablePresentationType = "VerifiablePresentation"
+	// W3CPresentationContextURL is the context of the presentation document
+	W3CPresentationContextURL = "https://www.w3.org/2018/credentials/v1"
+	// ProofPurposeAssertionMethod is the purpose of the presentation proof
+	ProofPurposeAssertionMethod = "assertionMethod"
+)
+
+var (
+	// ErrNoFieldsToDisclose is returned when the presentation is requested without fields
+	ErrNoFieldsToDisclose = errors.New("at least one field must be disclosed")
+	// ErrFieldNotFound is returned when a disclosed field does not exist in the credential
+	ErrFieldNotFound = errors.New("field not found in the credential")
+	// ErrMerklizedRootMismatch is returned when the merklized root of the presentation doesn't match the core claim
+	ErrMerklizedRootMismatch = errors.New("merklized root does not match the credential core claim")
+)
+
+// Presentation is a verifiable presentation of a single credential with a subset of its fields disclosed.
+// Every disclosed field carries a merkle inclusion proof against the merklized root of the credential and
+// the whole document is signed with the BJJ key of the issuer.
+type Presentation struct {
+	Context              []string            `json:"@context"`
+	ID                   string              `json:"id"`
+	Type                 []string            `json:"type"`
+	Holder               string              `json:"holder,omitempty"`
+	VerifiableCredential DisclosedCredential `json:"verifiableCredential"`
+	Proof                *Proof              `json:"proof,omitempty"`
+}
+
+// DisclosedCredential is the part of the credential included in the presentation
+type DisclosedCredential struct {
+	ID               string                      `json:"id"`
+	Context          []string                    `json:"@context"`
+	Type             []string                    `json:"type"`
+	Issuer           string                      `json:"issuer"`
+	IssuanceDate     *time.Time                  `json:"issuanceDate,omitempty"`
+	Expiration       *time.Time                  `json:"expirationDate,omitempty"`
+	CredentialSchema verifiable.CredentialSchema `json:"credentialSchema"`
+	CoreClaim        string                      `json:"coreClaim,omitempty"`
+	MerklizedRoot    string                      `json:"merklizedRoot"`
+	Disclosures      []Disclosure                `json:"disclosures"`
+}
+
+// Disclosure is a single disclosed field of the credential. Path is the expanded JSON-LD path of the field,
+// that is the key of the entry in the merklized credential.
+type Disclosure struct {
+	Field    string            `json:"field"`
+	Path     []interface{}     `json:"path"`
+	Value    json.RawMessage   `json:"value"`
+	Datatype string            `json:"datatype,omitempty"`
+	Proof    *merkletree.Proof `json:"proof"`
+}
+
+// Proof is the issuer signature of the presentation
+type Proof struct {
+	Type         string     `json:"type"`
+	Created      time.Time  `json:"created"`
+	ProofPurpose string     `json:"proofPurpose"`
+	IssuerData   IssuerData `json:"issuerData"`
+	Signature    string     `json:"signature"`
+}
+
+// IssuerData identifies the key that signed the presentation
+type IssuerData struct {
+	ID            string `json:"id"`
+	AuthCoreClaim string `json:"authCoreClaim"`
+}
+
+// Build creates a presentation of the credential that only discloses the given fields. Fields are
+// paths in the credential document, like credentialSubject.birthday. The presentation is signed
+// with the signer, that must hold the BJJ key of the given auth claim.
+func Build(ctx context.Context, credential *verifiable.W3CCredential, fields []string, signer suite.Signer, authClaim *core.Claim, opts ...merklize.MerklizeOption) (*Presentation, error) {
+	if len(fields) == 0 {
+		return nil, ErrNoFieldsToDisclose
+	}
+
+	mz, err := credential.Merklize(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("merklizing credential: %w", err)
+	}
+
+	disclosures := make([]Disclosure, 0, len(fields))
+	for _, field := range fields {
+		disclosure, err := disclose(ctx, mz, field)
+		if err != nil {
+			return nil, err
+		}
+		disclosures = append(disclosures, *disclosure)
+	}
+
+	var coreClaimHex string
+	if coreClaim, err := credential.GetCoreClaimFromProof(verifiable.BJJSignatureProofType); err == nil {
+		if coreClaimHex, err = coreClaim.Hex(); err != nil {
+			return nil, err
+		}
+	}
+
+	authClaimHex, err := authClaim.Hex()
+	if err != nil {
+		return nil, err
+	}
+
+	presentation := &Presentation{
+		Context: []string{W3CPresentationContextURL},
+		ID:      fmt.Sprintf("urn:uuid:%s", uuid.NewString()),
+		Type:    []string{VerifiablePresentationType},
+		Holder:  holder(credential),
+		VerifiableCredential: DisclosedCredential{
+			ID:               credential.ID,
+			Context:          credential.Context,
+			Type:             credential.Type,
+			Issuer:           credential.Issuer,
+			IssuanceDate:     credential.IssuanceDate,
+			Expiration:       credential.Expiration,
+			CredentialSchema: credential.CredentialSchema,
+			CoreClaim:        coreClaimHex,
+			MerklizedRoot:    mz.Root().BigInt().String(),
+			Disclosures:      disclosures,
+		},
+	}
+
+	digest, err := presentation.Digest()
+	if err != nil {
+		return nil, err
+	}
+	signature, err := signer.Sign(ctx, merkletree.SwapEndianness(digest.Bytes()))
+	if err != nil {
+		return nil, fmt.Errorf("signing presentation: %w", err)
+	}
+
+	presentation.Proof = &Proof{
+		Type:         babyjubjub.SignatureType,
+		Created:      time.Now().UTC().Truncate(time.Second),
+		ProofPurpose: ProofPurposeAssertionMethod,
+		IssuerData: IssuerData{
+			ID:            credential.Issuer,
+			AuthCoreClaim: authClaimHex,
+		},
+		Signature: hex.EncodeToString(signature),
+	}
+	return presentation, nil
+}
+
+// Digest returns the poseidon hash of the presentation without its proof. This is the value signed by the issuer.
+func (p *Presentation) Digest() (*big.Int, error) {
+	unsigned := *p
+	unsigned.Proof = nil
+	doc, err := json.Marshal(unsigned)
+	if err != nil {
+		return nil, err
+	}
+	return poseidon.HashBytes(doc)
+}
+
+func disclose(ctx context.Context, mz *merklize.Merklizer, field string) (*Disclosure, error) {
+	path, err := mz.ResolveDocPath(field)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrFieldNotFound, field)
+	}
+	proof, value, err := mz.Proof(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("generating proof for %s: %w", field, err)
+	}
+	if !proof.Existence || value == nil {
+		return nil, fmt.Errorf("%w: %s", ErrFieldNotFound, field)
+	}
+
+	datatype, err := mz.JSONLDType(path)
+	if err != nil {
+		return nil, fmt.Errorf("getting type of %s: %w", field, err)
+	}
+	rawValue, err := mz.RawValue(path)
+	if err != nil {
+		return nil, fmt.Errorf("getting value of %s: %w", field, err)
+	}
+	encoded, err := json.Marshal(rawValue)
+	if err != nil {
+		return nil, err
+	}
+
+	disclosure := &Disclosure{
+		Field:    field,
+		Path:     path.Parts(),
+		Value:    encoded,
+		Datatype: datatype,
+		Proof:    proof,
+	}
+
+	// The value is hashed back from its JSON representation to be sure verifiers will get the same entry
+	expected, err := value.MtEntry()
+	if err != nil {
+		return nil, err
+	}
+	got, err := disclosure.valueMtEntry()
+	if err != nil {
+		return nil, fmt.Errorf("hashing value of %s: %w", field, err)
+	}
+	if expected.Cmp(got) != 0 {
+		return nil, fmt.Errorf("value of %s can't be disclosed: unsupported representation", field)
+	}
+	return disclosure, nil
+}
+
+// keyMtEntry returns the merkle tree key of the disclosed field
+func (d *Disclosure) keyMtEntry() (*big.Int, error) {
+	parts := make([]interface{}, len(d.Path))
+	for i, part := range d.Path {
+		// Array indexes are decoded as float64 from JSON
+		if n, ok := part.(float64); ok {
+			parts[i] = int(n)
+			continue
+		}
+		parts[i] = part
+	}
+	path, err := merklize.NewPath(parts...)
+	if err != nil {
+		return nil, err
+	}
+	return path.MtEntry()
+}
+
+// valueMtEntry returns the merkle tree value of the disclosed field
+func (d *Disclosure) valueMtEntry() (*big.Int, error) {
+	dec := json.NewDecoder(bytes.NewReader(d.Value))
+	dec.UseNumber()
+	var value interface{}
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	// Numbers are hashed from their literal representation, as it is done for xsd typed strings
+	if n, ok := value.(json.Number); ok {
+		value = n.String()
+	}
+	return merklize.HashValue(d.Datatype, value)
+}
+
+func holder(credential *verifiable.W3CCredential) string {
+	if id, ok := credential.CredentialSubject["id"].(string); ok {
+		return id
+	}
+	return ""
+}
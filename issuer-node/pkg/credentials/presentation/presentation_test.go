@@ -0,0 +1,231 @@
+package presentation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-iden3-crypto/utils"
+	"github.com/iden3/go-schema-processor/v2/merklize"
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+	"github.com/piprate/json-gold/ld"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const kycContextURL = "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/kyc-v3.json-ld"
+
+// fileLoader resolves the JSON-LD contexts of the tests from the testdata folder
+type fileLoader map[string]string
+
+func (l fileLoader) LoadDocument(u string) (*ld.RemoteDocument, error) {
+	file, ok := l[u]
+	if !ok {
+		return nil, fmt.Errorf("unexpected document %s", u)
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+	doc, err := ld.DocumentFromReader(f)
+	if err != nil {
+		return nil, err
+	}
+	return &ld.RemoteDocument{DocumentURL: u, Document: doc}, nil
+}
+
+var testLoader = fileLoader{
+	"https://www.w3.org/2018/credentials/v1": "testdata/credentials-v1.jsonld",
+	"https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/iden3credential-v2.json-ld": "testdata/iden3credential-v2.json-ld",
+	kycContextURL: "testdata/kyc-v3.json-ld",
+}
+
+// keySigner signs with an in memory BJJ key the same way the issuer KMS does
+type keySigner struct {
+	key babyjub.PrivateKey
+}
+
+func (s *keySigner) Sign(_ context.Context, data []byte) ([]byte, error) {
+	sig := s.key.SignPoseidon(new(big.Int).SetBytes(utils.SwapEndianness(data)))
+	compressed := sig.Compress()
+	return compressed[:], nil
+}
+
+func newCredential() *verifiable.W3CCredential {
+	issuanceDate := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	return &verifiable.W3CCredential{
+		ID: "urn:uuid:8ac9dbb4-0a2a-11ef-8a0d-0242ac110005",
+		Context: []string{
+			"https://www.w3.org/2018/credentials/v1",
+			"https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json-ld/iden3credential-v2.json-ld",
+			kycContextURL,
+		},
+		Type:         []string{"VerifiableCredential", "KYCAgeCredential"},
+		Issuer:       "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR",
+		IssuanceDate: &issuanceDate,
+		CredentialSubject: map[string]interface{}{
+			"id":           "did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi",
+			"type":         "KYCAgeCredential",
+			"birthday":     19960424,
+			"documentType": 2,
+		},
+		CredentialSchema: verifiable.CredentialSchema{
+			ID:   "https://raw.githubusercontent.com/iden3/claim-schema-vocab/main/schemas/json/KYCAgeCredential-v3.json",
+			Type: "JsonSchemaValidator2018",
+		},
+	}
+}
+
+func newAuthClaim(t *testing.T, key babyjub.PrivateKey) *core.Claim {
+	t.Helper()
+	pub := key.Public()
+	authClaim, err := core.NewClaim(core.AuthSchemaHash, core.WithIndexDataInts(pub.X, pub.Y), core.WithRevocationNonce(1))
+	require.NoError(t, err)
+	return authClaim
+}
+
+func TestBuildAndVerify(t *testing.T) {
+	ctx := context.Background()
+	key := babyjub.NewRandPrivKey()
+	signer := &keySigner{key: key}
+	authClaim := newAuthClaim(t, key)
+	credential := newCredential()
+
+	presentation, err := Build(ctx, credential, []string{"credentialSubject.birthday"}, signer, authClaim, merklize.WithDocumentLoader(testLoader))
+	require.NoError(t, err)
+	doc, err := json.Marshal(presentation)
+	require.NoError(t, err)
+	withKey := WithIssuerPublicKey(key.Public())
+
+	t.Run("should disclose only the requested fields", func(t *testing.T) {
+		require.Len(t, presentation.VerifiableCredential.Disclosures, 1)
+		disclosure := presentation.VerifiableCredential.Disclosures[0]
+		assert.Equal(t, "credentialSubject.birthday", disclosure.Field)
+		assert.JSONEq(t, "19960424", string(disclosure.Value))
+		assert.Equal(t, "http://www.w3.org/2001/XMLSchema#integer", disclosure.Datatype)
+		assert.Equal(t, credential.CredentialSubject["id"], presentation.Holder)
+		assert.NotContains(t, string(doc), "documentType")
+
+		mz, err := credential.Merklize(ctx, merklize.WithDocumentLoader(testLoader))
+		require.NoError(t, err)
+		assert.Equal(t, mz.Root().BigInt().String(), presentation.VerifiableCredential.MerklizedRoot)
+	})
+
+	t.Run("should verify the presentation offline", func(t *testing.T) {
+		verified, err := Verify(ctx, doc, withKey)
+		require.NoError(t, err)
+		assert.Equal(t, presentation.ID, verified.ID)
+
+		rotated := babyjub.NewRandPrivKey()
+		_, err = Verify(ctx, doc, WithIssuerKeyResolver(func(_ context.Context, issuerDID string) ([]*babyjub.PublicKey, error) {
+			assert.Equal(t, credential.Issuer, issuerDID)
+			return []*babyjub.PublicKey{rotated.Public(), key.Public()}, nil
+		}))
+		require.NoError(t, err)
+	})
+
+	t.Run("should require the issuer key", func(t *testing.T) {
+		_, err := Verify(ctx, doc)
+		assert.ErrorIs(t, err, ErrNoIssuerKey)
+	})
+
+	t.Run("should reject an unexpected issuer key", func(t *testing.T) {
+		other := babyjub.NewRandPrivKey()
+		_, err := Verify(ctx, doc, WithIssuerPublicKey(other.Public()))
+		assert.ErrorIs(t, err, ErrUnexpectedIssuerKey)
+
+		_, err = Verify(ctx, doc, WithIssuerKeyResolver(func(context.Context, string) ([]*babyjub.PublicKey, error) {
+			return []*babyjub.PublicKey{other.Public()}, nil
+		}))
+		assert.ErrorIs(t, err, ErrUnexpectedIssuerKey)
+	})
+
+	t.Run("should reject a self signed presentation naming another issuer", func(t *testing.T) {
+		// The forger signs with its own key and embeds its own auth claim, so the signature alone is valid
+		forgerKey := babyjub.NewRandPrivKey()
+		resolver := WithIssuerKeyResolver(func(_ context.Context, issuerDID string) ([]*babyjub.PublicKey, error) {
+			require.Equal(t, credential.Issuer, issuerDID)
+			return []*babyjub.PublicKey{key.Public()}, nil
+		})
+		forged, err := Build(ctx, credential, []string{"credentialSubject.birthday"}, &keySigner{key: forgerKey}, newAuthClaim(t, forgerKey), merklize.WithDocumentLoader(testLoader))
+		require.NoError(t, err)
+		forgedDoc, err := json.Marshal(forged)
+		require.NoError(t, err)
+		_, err = Verify(ctx, forgedDoc, resolver)
+		assert.ErrorIs(t, err, ErrUnexpectedIssuerKey)
+
+		// Naming the forger as the signer while the credential keeps the original issuer
+		forged.Proof.IssuerData.ID = "did:polygonid:polygon:amoy:2qFDkNkWePjd6URt6kGQX14a7wVKhBZt8bpy7HZJZi"
+		digest, err := forged.Digest()
+		require.NoError(t, err)
+		sig, err := (&keySigner{key: forgerKey}).Sign(ctx, utils.SwapEndianness(digest.Bytes()))
+		require.NoError(t, err)
+		forged.Proof.Signature = fmt.Sprintf("%x", sig)
+		forgedDoc, err = json.Marshal(forged)
+		require.NoError(t, err)
+		_, err = Verify(ctx, forgedDoc, WithIssuerPublicKey(forgerKey.Public()))
+		assert.ErrorIs(t, err, ErrIssuerMismatch)
+	})
+
+	t.Run("should reject a tampered presentation", func(t *testing.T) {
+		tampered := *presentation
+		tampered.Holder = "did:polygonid:polygon:amoy:2qQ68JkRcf3xrHPQPWZei3YeVzHPP58wYNxx2mEouR"
+		tamperedDoc, err := json.Marshal(tampered)
+		require.NoError(t, err)
+		_, err = Verify(ctx, tamperedDoc, withKey)
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("should reject a disclosed value that is not in the credential", func(t *testing.T) {
+		forged := *presentation
+		forged.VerifiableCredential.Disclosures = []Disclosure{presentation.VerifiableCredential.Disclosures[0]}
+		forged.VerifiableCredential.Disclosures[0].Value = json.RawMessage("20010101")
+		// Re-sign the forged document with the issuer key so only the inclusion proof can catch it
+		digest, err := forged.Digest()
+		require.NoError(t, err)
+		sig, err := signer.Sign(ctx, utils.SwapEndianness(digest.Bytes()))
+		require.NoError(t, err)
+		proof := *presentation.Proof
+		proof.Signature = fmt.Sprintf("%x", sig)
+		forged.Proof = &proof
+		forgedDoc, err := json.Marshal(forged)
+		require.NoError(t, err)
+
+		_, err = Verify(ctx, forgedDoc, withKey)
+		assert.ErrorIs(t, err, ErrInvalidDisclosure)
+	})
+
+	t.Run("should reject a merklized root that is not the one of the core claim", func(t *testing.T) {
+		coreClaim, err := core.NewClaim(core.SchemaHash{1}, core.WithIndexMerklizedRoot(big.NewInt(1)))
+		require.NoError(t, err)
+		coreClaimHex, err := coreClaim.Hex()
+		require.NoError(t, err)
+		withProof := newCredential()
+		withProof.Proof = verifiable.CredentialProofs{&verifiable.BJJSignatureProof2021{
+			Type:      verifiable.BJJSignatureProofType,
+			CoreClaim: coreClaimHex,
+		}}
+
+		signed, err := Build(ctx, withProof, []string{"credentialSubject.birthday"}, signer, authClaim, merklize.WithDocumentLoader(testLoader))
+		require.NoError(t, err)
+		signedDoc, err := json.Marshal(signed)
+		require.NoError(t, err)
+		_, err = Verify(ctx, signedDoc, withKey)
+		assert.ErrorIs(t, err, ErrMerklizedRootMismatch)
+	})
+
+	t.Run("should fail on unknown fields", func(t *testing.T) {
+		_, err := Build(ctx, credential, []string{"credentialSubject.unknown"}, signer, authClaim, merklize.WithDocumentLoader(testLoader))
+		assert.ErrorIs(t, err, ErrFieldNotFound)
+
+		_, err = Build(ctx, credential, nil, signer, authClaim, merklize.WithDocumentLoader(testLoader))
+		assert.ErrorIs(t, err, ErrNoFieldsToDisclose)
+	})
+}
@@ -0,0 +1,200 @@
+package presentation
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	core "github.com/iden3/go-iden3-core/v2"
+	"github.com/iden3/go-iden3-crypto/babyjub"
+	"github.com/iden3/go-merkletree-sql/v2"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/signature/suite/babyjubjub"
+)
+
+var (
+	// ErrInvalidPresentation is returned when the presentation document is malformed
+	ErrInvalidPresentation = errors.New("invalid presentation")
+	// ErrInvalidSignature is returned when the issuer signature of the presentation is not valid
+	ErrInvalidSignature = errors.New("invalid presentation signature")
+	// ErrInvalidDisclosure is returned when the inclusion proof of a disclosed field is not valid
+	ErrInvalidDisclosure = errors.New("invalid disclosure proof")
+	// ErrUnexpectedIssuerKey is returned when the presentation is signed with a key different from the expected one
+	ErrUnexpectedIssuerKey = errors.New("presentation is not signed with the expected issuer key")
+	// ErrIssuerMismatch is returned when the presentation is signed on behalf of an issuer that is not the credential one
+	ErrIssuerMismatch = errors.New("presentation signer is not the credential issuer")
+	// ErrNoIssuerKey is returned when Verify is called without a pinned issuer key nor an issuer key resolver
+	ErrNoIssuerKey = errors.New("an issuer public key or an issuer key resolver is required")
+)
+
+// IssuerKeyResolver returns the BJJ keys the issuer signs with, for instance the ones of the auth claims
+// in its current state
+type IssuerKeyResolver func(ctx context.Context, issuerDID string) ([]*babyjub.PublicKey, error)
+
+type verifyOptions struct {
+	issuerKey      *babyjub.PublicKey
+	issuerResolver IssuerKeyResolver
+}
+
+// VerifyOpt configures the presentation verification
+type VerifyOpt func(*verifyOptions)
+
+// WithIssuerPublicKey pins the BJJ key the presentation must be signed with
+func WithIssuerPublicKey(key *babyjub.PublicKey) VerifyOpt {
+	return func(o *verifyOptions) {
+		o.issuerKey = key
+	}
+}
+
+// WithIssuerKeyResolver resolves the keys of the credential issuer the presentation must be signed with
+func WithIssuerKeyResolver(resolver IssuerKeyResolver) VerifyOpt {
+	return func(o *verifyOptions) {
+		o.issuerResolver = resolver
+	}
+}
+
+// Verify checks a presentation created by Build: the issuer signature over the document, the inclusion proof of
+// every disclosed field against the merklized root and, when present, that the merklized root is the one of the
+// credential core claim. The key embedded in the proof is only trusted when it's the pinned one or one of the keys
+// the resolver returns for the credential issuer, so the verification is offline unless the resolver goes to the
+// network. It returns the parsed presentation.
+func Verify(ctx context.Context, doc []byte, opts ...VerifyOpt) (*Presentation, error) {
+	options := &verifyOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	if options.issuerKey == nil && options.issuerResolver == nil {
+		return nil, ErrNoIssuerKey
+	}
+
+	var presentation Presentation
+	if err := json.Unmarshal(doc, &presentation); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPresentation, err)
+	}
+	if presentation.Proof == nil || presentation.Proof.Type != babyjubjub.SignatureType {
+		return nil, fmt.Errorf("%w: missing %s proof", ErrInvalidPresentation, babyjubjub.SignatureType)
+	}
+
+	if presentation.Proof.IssuerData.ID != presentation.VerifiableCredential.Issuer {
+		return nil, ErrIssuerMismatch
+	}
+	if err := verifySignature(ctx, &presentation, options); err != nil {
+		return nil, err
+	}
+
+	credential := presentation.VerifiableCredential
+	root, ok := new(big.Int).SetString(credential.MerklizedRoot, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed merklized root", ErrInvalidPresentation)
+	}
+	if credential.CoreClaim != "" {
+		if err := checkCoreClaimRoot(credential.CoreClaim, root); err != nil {
+			return nil, err
+		}
+	}
+
+	rootHash, err := merkletree.NewHashFromBigInt(root)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPresentation, err)
+	}
+	for i := range credential.Disclosures {
+		if err := verifyDisclosure(rootHash, &credential.Disclosures[i]); err != nil {
+			return nil, err
+		}
+	}
+	return &presentation, nil
+}
+
+func verifySignature(ctx context.Context, presentation *Presentation, options *verifyOptions) error {
+	var authClaim core.Claim
+	if err := authClaim.FromHex(presentation.Proof.IssuerData.AuthCoreClaim); err != nil {
+		return fmt.Errorf("%w: malformed auth claim: %v", ErrInvalidPresentation, err)
+	}
+	if authClaim.GetSchemaHash() != core.AuthSchemaHash {
+		return fmt.Errorf("%w: issuer data is not an auth claim", ErrInvalidPresentation)
+	}
+	slots := authClaim.RawSlotsAsInts()
+	key := babyjub.PublicKey{X: slots[2], Y: slots[3]}
+	if err := checkIssuerKey(ctx, presentation.Proof.IssuerData.ID, &key, options); err != nil {
+		return err
+	}
+
+	sigBytes, err := hex.DecodeString(presentation.Proof.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+	var sigComp babyjub.SignatureComp
+	if len(sigBytes) != len(sigComp) {
+		return fmt.Errorf("%w: incorrect signature length", ErrInvalidSignature)
+	}
+	copy(sigComp[:], sigBytes)
+	sig, err := sigComp.Decompress()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSignature, err)
+	}
+
+	digest, err := presentation.Digest()
+	if err != nil {
+		return err
+	}
+	if !key.VerifyPoseidon(digest, sig) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// checkIssuerKey tells whether key is the pinned issuer key or one of the keys the resolver returns for the issuer
+func checkIssuerKey(ctx context.Context, issuerDID string, key *babyjub.PublicKey, options *verifyOptions) error {
+	if options.issuerKey != nil {
+		if options.issuerKey.Compress() != key.Compress() {
+			return ErrUnexpectedIssuerKey
+		}
+		return nil
+	}
+	keys, err := options.issuerResolver(ctx, issuerDID)
+	if err != nil {
+		return fmt.Errorf("resolving the keys of the issuer %s: %w", issuerDID, err)
+	}
+	for _, issuerKey := range keys {
+		if issuerKey != nil && issuerKey.Compress() == key.Compress() {
+			return nil
+		}
+	}
+	return ErrUnexpectedIssuerKey
+}
+
+func checkCoreClaimRoot(coreClaimHex string, root *big.Int) error {
+	var coreClaim core.Claim
+	if err := coreClaim.FromHex(coreClaimHex); err != nil {
+		return fmt.Errorf("%w: malformed core claim: %v", ErrInvalidPresentation, err)
+	}
+	claimRoot, err := coreClaim.GetMerklizedRoot()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrMerklizedRootMismatch, err)
+	}
+	if claimRoot.Cmp(root) != 0 {
+		return ErrMerklizedRootMismatch
+	}
+	return nil
+}
+
+func verifyDisclosure(root *merkletree.Hash, disclosure *Disclosure) error {
+	if disclosure.Proof == nil || !disclosure.Proof.Existence {
+		return fmt.Errorf("%w: %s", ErrInvalidDisclosure, disclosure.Field)
+	}
+	key, err := disclosure.keyMtEntry()
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidDisclosure, disclosure.Field, err)
+	}
+	value, err := disclosure.valueMtEntry()
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidDisclosure, disclosure.Field, err)
+	}
+	if !merkletree.VerifyProof(root, disclosure.Proof, key, value) {
+		return fmt.Errorf("%w: %s", ErrInvalidDisclosure, disclosure.Field)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+// Package statuslist implements the W3C Bitstring Status List v1.0, that publishes the status of credentials
+// as a compressed bitstring in a status list credential any verifier can fetch and check.
+package statuslist
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/iden3/go-schema-processor/v2/verifiable"
+
+	"github.com/polygonid/sh-id-platform/pkg/credentials/jwtvc"
+)
+
+const (
+	// BitstringStatusListEntry is the credential status type of the credentials with an index in a status list
+	BitstringStatusListEntry verifiable.CredentialStatusType = "BitstringStatusListEntry"
+	// TypeStatusList is the type of the subject of the status list credentials
+	TypeStatusList = "BitstringStatusList"
+	// TypeStatusListCredential is the type of the status list credentials
+	TypeStatusListCredential = "BitstringStatusListCredential"
+	// PurposeRevocation is the status purpose of the lists of revoked credentials
+	PurposeRevocation = "revocation"
+	// MinSize is the minimum number of entries of a list, 16KB, so a credential can't be told apart by its index
+	MinSize = 131072
+	// multibaseBase64URL is the multibase prefix of the base64url, no padding, encoding of the lists
+	multibaseBase64URL = "u"
+)
+
+var (
+	// ErrInvalidStatusList is returned when an encoded list or a status list credential is malformed
+	ErrInvalidStatusList = errors.New("invalid status list")
+	// ErrInvalidEntry is returned when a credential status is not a valid bitstring status list entry
+	ErrInvalidEntry = errors.New("invalid status list entry")
+	// ErrIndexOutOfRange is returned when an index is not in the list
+	ErrIndexOutOfRange = errors.New("status list index out of range")
+)
+
+// Bitstring is a list of statuses, one bit per credential. The first index is the left most bit of the first byte.
+type Bitstring []byte
+
+// New returns a bitstring of size entries, all unset. Size is rounded up to a whole number of bytes.
+func New(size int) Bitstring {
+	return make(Bitstring, (size+7)/8)
+}
+
+// Len returns the number of entries of the bitstring
+func (b Bitstring) Len() int {
+	return len(b) * 8
+}
+
+// Set sets the status of the entry at index
+func (b Bitstring) Set(index int, status bool) error {
+	if index < 0 || index >= b.Len() {
+		return ErrIndexOutOfRange
+	}
+	mask := byte(1) << (7 - index%8)
+	if status {
+		b[index/8] |= mask
+	} else {
+		b[index/8] &^= mask
+	}
+	return nil
+}
+
+// Get returns the status of the entry at index
+func (b Bitstring) Get(index int) (bool, error) {
+	if index < 0 || index >= b.Len() {
+		return false, ErrIndexOutOfRange
+	}
+	return b[index/8]&(byte(1)<<(7-index%8)) != 0, nil
+}
+
+// Encode returns the bitstring GZIP compressed and multibase encoded as base64url, as the encodedList of the lists
+func (b Bitstring) Encode() (string, error) {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(b); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return multibaseBase64URL + base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Decode returns the bitstring of an encodedList
+func Decode(encoded string) (Bitstring, error) {
+	if len(encoded) < len(multibaseBase64URL) || encoded[:len(multibaseBase64URL)] != multibaseBase64URL {
+		return nil, fmt.Errorf("%w: encoded list is not multibase base64url", ErrInvalidStatusList)
+	}
+	compressed, err := base64.RawURLEncoding.DecodeString(encoded[len(multibaseBase64URL):])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatusList, err)
+	}
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatusList, err)
+	}
+	defer func() { _ = r.Close() }()
+	list, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidStatusList, err)
+	}
+	return list, nil
+}
+
+// Entry is the credential status of a credential with an index in a status list
+type Entry struct {
+	ID                   string                          `json:"id"`
+	Type                 verifiable.CredentialStatusType `json:"type"`
+	StatusPurpose        string                          `json:"statusPurpose"`
+	StatusListIndex      string                          `json:"statusListIndex"`
+	StatusListCredential string                          `json:"statusListCredential"`
+}
+
+// NewEntry returns the revocation entry at index of the list published at statusListCredential
+func NewEntry(statusListCredential string, index int) *Entry {
+	return &Entry{
+		ID:                   fmt.Sprintf("%s#%d", statusListCredential, index),
+		Type:                 BitstringStatusListEntry,
+		StatusPurpose:        PurposeRevocation,
+		StatusListIndex:      strconv.Itoa(index),
+		StatusListCredential: statusListCredential,
+	}
+}
+
+// ParseEntry returns the entry of a credential status, as it is decoded from the JSON of a credential
+func ParseEntry(credentialStatus interface{}) (*Entry, error) {
+	raw, err := json.Marshal(credentialStatus)
+	if err != nil {
+		return nil, err
+	}
+	var entry Entry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEntry, err)
+	}
+	if entry.Type != BitstringStatusListEntry {
+		return nil, fmt.Errorf("%w: unexpected type %s", ErrInvalidEntry, entry.Type)
+	}
+	if entry.StatusListCredential == "" {
+		return nil, fmt.Errorf("%w: statusListCredential is required", ErrInvalidEntry)
+	}
+	if _, err := entry.Index(); err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// Index returns the index of the entry in the list
+func (e *Entry) Index() (int, error) {
+	index, err := strconv.Atoi(e.StatusListIndex)
+	if err != nil || index < 0 {
+		return 0, fmt.Errorf("%w: statusListIndex must be a non negative integer", ErrInvalidEntry)
+	}
+	return index, nil
+}
+
+// NewCredential returns the status list credential published at id by the issuer, with the statuses of the list.
+// It is secured as a JWT-VC by the issuer.
+func NewCredential(id string, issuer string, list Bitstring, validFrom time.Time) (*jwtvc.Credential, error) {
+	encoded, err := list.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return &jwtvc.Credential{
+		Context:   []string{jwtvc.VCDM2ContextURL},
+		ID:        id,
+		Type:      []string{verifiable.TypeW3CVerifiableCredential, TypeStatusListCredential},
+		Issuer:    issuer,
+		ValidFrom: &validFrom,
+		CredentialSubject: map[string]interface{}{
+			"id":            id + "#list",
+			"type":          TypeStatusList,
+			"statusPurpose": PurposeRevocation,
+			"encodedList":   encoded,
+		},
+	}, nil
+}
+
+// Status returns the status of the entry in the verified status list credential of the entry
+func Status(credential *jwtvc.Credential, entry *Entry) (bool, error) {
+	if credential.ID != entry.StatusListCredential {
+		return false, fmt.Errorf("%w: credential is not the list of the entry", ErrInvalidStatusList)
+	}
+	if purpose, _ := credential.CredentialSubject["statusPurpose"].(string); purpose != entry.StatusPurpose {
+		return false, fmt.Errorf("%w: unexpected status purpose %s", ErrInvalidStatusList, purpose)
+	}
+	encoded, ok := credential.CredentialSubject["encodedList"].(string)
+	if !ok {
+		return false, fmt.Errorf("%w: encodedList is required", ErrInvalidStatusList)
+	}
+	list, err := Decode(encoded)
+	if err != nil {
+		return false, err
+	}
+	index, err := entry.Index()
+	if err != nil {
+		return false, err
+	}
+	return list.Get(index)
+}
@@ -0,0 +1,80 @@
+package statuslist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBitstring(t *testing.T) {
+	list := New(MinSize)
+	assert.Equal(t, MinSize, list.Len())
+	require.NoError(t, list.Set(0, true))
+	require.NoError(t, list.Set(94567, true))
+	require.NoError(t, list.Set(MinSize-1, true))
+	assert.Equal(t, byte(0x80), list[0])
+	assert.ErrorIs(t, list.Set(MinSize, true), ErrIndexOutOfRange)
+
+	encoded, err := list.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, "u", encoded[:1])
+	// an empty 16KB list compresses to a few dozens of bytes
+	empty, err := New(MinSize).Encode()
+	require.NoError(t, err)
+	assert.Less(t, len(empty), 200)
+
+	decoded, err := Decode(encoded)
+	require.NoError(t, err)
+	for index, expected := range map[int]bool{0: true, 1: false, 94566: false, 94567: true, MinSize - 1: true} {
+		status, err := decoded.Get(index)
+		require.NoError(t, err)
+		assert.Equal(t, expected, status, index)
+	}
+
+	require.NoError(t, decoded.Set(94567, false))
+	status, err := decoded.Get(94567)
+	require.NoError(t, err)
+	assert.False(t, status)
+
+	_, err = Decode("zabc")
+	assert.ErrorIs(t, err, ErrInvalidStatusList)
+	_, err = Decode("uYWJj")
+	assert.ErrorIs(t, err, ErrInvalidStatusList)
+}
+
+func TestStatus(t *testing.T) {
+	const listURL = "https://issuer.example.com/v2/status-lists/0b1f2a3c-8f4e-4a5b-9c6d-7e8f9a0b1c2d"
+	list := New(MinSize)
+	require.NoError(t, list.Set(42, true))
+	credential, err := NewCredential(listURL, "did:iden3:polygon:amoy:x6x5sor7zpySUbxeFoAZUYbUh1kmkjhgUbhW2sbpJ", list, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"VerifiableCredential", TypeStatusListCredential}, credential.Type)
+	assert.Nil(t, credential.CredentialSchema)
+
+	// entries are parsed from the credential status as it is decoded from JSON
+	entry, err := ParseEntry(map[string]interface{}{
+		"id":                   listURL + "#42",
+		"type":                 "BitstringStatusListEntry",
+		"statusPurpose":        "revocation",
+		"statusListIndex":      "42",
+		"statusListCredential": listURL,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, NewEntry(listURL, 42), entry)
+
+	revoked, err := Status(credential, entry)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = Status(credential, NewEntry(listURL, 43))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	_, err = Status(credential, NewEntry("https://other.example.com/list", 42))
+	assert.ErrorIs(t, err, ErrInvalidStatusList)
+	_, err = ParseEntry(map[string]interface{}{"type": "Iden3commRevocationStatusV1.0", "id": "https://issuer.example.com/v2/agent"})
+	assert.ErrorIs(t, err, ErrInvalidEntry)
+	_, err = ParseEntry(map[string]interface{}{"type": "BitstringStatusListEntry", "statusListCredential": listURL, "statusListIndex": "-1"})
+	assert.ErrorIs(t, err, ErrInvalidEntry)
+}